@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"runtime/debug"
 
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/tendermint/tendermint/state"
 	"github.com/tendermint/tendermint/store"
@@ -23,8 +24,12 @@ func NewBlockStore(blockStore state.BlockStoreRPC) *BlockStore {
 	}
 }
 
-func NewBlockExplorer(dbBackendType dbm.BackendType, dbDir string) *BlockStore {
-	return NewBlockStore(store.NewBlockStore(dbm.NewDB("blockstore", dbBackendType, dbDir)))
+func NewBlockExplorer(dbBackendType dbm.BackendType, dbDir string) (*BlockStore, error) {
+	db, err := storage.OpenDB("blockstore", dbBackendType, dbDir)
+	if err != nil {
+		return nil, err
+	}
+	return NewBlockStore(store.NewBlockStore(db)), nil
 }
 
 func (bs *BlockStore) Block(height int64) (_ *Block, err error) {
@@ -69,12 +69,19 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 
 		restoreDumpOpt := cmd.StringOpt("restore-dump", "", "Including AppHash for restored file")
 
+		restoreDumpSignersOpt := cmd.StringsOpt("restore-dump-signer", nil, "Address of a key trusted to sign the "+
+			"manifest of the restored dump, may be given multiple times, defaults to the validators in the GenesisDoc")
+
+		restoreDumpUnverifiedOpt := cmd.BoolOpt("restore-dump-unverified", false, "Restore a dump whose "+
+			"manifest is unsigned or signed by any key")
+
 		pool := cmd.BoolOpt("pool", false, "Write config files for all the validators called burrowNNN.toml")
 
 		cmd.Spec = "[--keys-url=<keys URL> | --keys-dir=<keys directory>] [--curve-type=<name>]" +
 			"[ --config-template-in=<text template> --config-out=<output file>]... " +
 			"[--genesis-spec=<GenesisSpec file>] [--separate-genesis-doc=<genesis JSON file>] " +
-			"[--chain-name=<chain name>] [--restore-dump=<dump file>] [--json] [--debug] [--pool] " +
+			"[--chain-name=<chain name>] [--restore-dump=<dump file> [--restore-dump-signer=<address>]... " +
+			"[--restore-dump-unverified]] [--json] [--debug] [--pool] " +
 			"[--logging=<logging program>] [--describe-logging] [--empty-blocks=<'always','never',duration>]"
 
 		// no sourcing logs
@@ -183,10 +190,20 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("on restore, validators must be provided in GenesisDoc or GenesisSpec")
 				}
 
-				reader, err := dump.NewFileReader(*restoreDumpOpt)
+				trust, err := dumpTrust(*restoreDumpSignersOpt, *restoreDumpUnverifiedOpt, conf.GenesisDoc)
+				if err != nil {
+					output.Fatalf("could not determine trusted dump signers: %v", err)
+				}
+
+				reader, err := dump.NewFileReader(*restoreDumpOpt, trust)
 				if err != nil {
 					output.Fatalf("failed to read restore dump: %v", err)
 				}
+				defer reader.Close()
+				if reader.Manifest() == nil {
+					output.Logf("WARNING: dump %s was written without a manifest so its contents cannot be verified",
+						*restoreDumpOpt)
+				}
 
 				st, err := state.MakeGenesisState(dbm.NewMemDB(), conf.GenesisDoc)
 				if err != nil {
@@ -298,6 +315,8 @@ func Configure(output Output) func(cmd *cli.Cmd) {
 					conf.RPC.Info.ListenPort = fmt.Sprint(26758 + i)
 					conf.RPC.Web3.ListenHost = rpc.LocalHost
 					conf.RPC.Web3.ListenPort = fmt.Sprint(26860 + i)
+					conf.RPC.GraphQL.ListenHost = rpc.LocalHost
+					conf.RPC.GraphQL.ListenPort = fmt.Sprint(26960 + i)
 					conf.RPC.GRPC.ListenHost = rpc.LocalHost
 					conf.RPC.GRPC.ListenPort = fmt.Sprint(10997 + i)
 					conf.RPC.Metrics.ListenHost = rpc.LocalHost
@@ -3,11 +3,17 @@ package commands
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"os"
 	"time"
 
+	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/keys"
+	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
@@ -19,6 +25,11 @@ type dumpOptions struct {
 	height            *int
 	filename          *string
 	useBinaryEncoding *bool
+	compression       *string
+	manifest          *bool
+	signers           *[]string
+	keysURL           *string
+	keysDir           *string
 }
 
 func maybeOutput(verbose *bool, output Output, format string, args ...interface{}) {
@@ -28,7 +39,8 @@ func maybeOutput(verbose *bool, output Output, format string, args ...interface{
 }
 
 func addDumpOptions(cmd *cli.Cmd, specOptions ...string) *dumpOptions {
-	cmd.Spec += "[--height=<state height to dump at>] [--binary]"
+	cmd.Spec += "[--height=<state height to dump at>] [--binary] [--compression=<gzip|zstd>] [--manifest] " +
+		"[--sign=<address>]... [--keys-url=<keys URL> | --keys-dir=<keys directory>]"
 	for _, spec := range specOptions {
 		cmd.Spec += " " + spec
 	}
@@ -36,10 +48,72 @@ func addDumpOptions(cmd *cli.Cmd, specOptions ...string) *dumpOptions {
 	return &dumpOptions{
 		height:            cmd.IntOpt("h height", 0, "Block height to dump to, defaults to latest block height"),
 		useBinaryEncoding: cmd.BoolOpt("b binary", false, "Output in binary encoding (default is JSON)"),
-		filename:          cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
+		compression:       cmd.StringOpt("z compression", "", "Compress the dump with 'gzip' or 'zstd', implies --manifest"),
+		manifest: cmd.BoolOpt("m manifest", false, "Append a manifest recording the height, chain ID, "+
+			"record counts, and hash of the dump so that it can be verified on restore"),
+		signers: cmd.StringsOpt("s sign", nil, "Sign the manifest with the key for this address, "+
+			"may be given multiple times, implies --manifest"),
+		keysURL:  cmd.StringOpt("k keys-url", "", "Keys GRPC address to use for signing the manifest"),
+		keysDir:  cmd.StringOpt("keys-dir", keys.DefaultKeysDir, "Directory where keys for signing the manifest are stored"),
+		filename: cmd.StringArg("FILE", "", "Location to output dump, if no argument is given then this streams to STDOUT"),
 	}
 }
 
+func (opts *dumpOptions) withManifest() bool {
+	return *opts.manifest || *opts.compression != "" || len(*opts.signers) > 0
+}
+
+func (opts *dumpOptions) manifestSigners() ([]acm.AddressableSigner, error) {
+	if len(*opts.signers) == 0 {
+		return nil, nil
+	}
+	var keyClient keys.KeyClient
+	var err error
+	if *opts.keysURL != "" {
+		keyClient, err = keys.NewRemoteKeyClient(*opts.keysURL, logging.NewNoopLogger())
+		if err != nil {
+			return nil, fmt.Errorf("could not create remote key client: %v", err)
+		}
+	} else {
+		keyClient = keys.NewLocalKeyClient(keys.NewFilesystemKeyStore(*opts.keysDir, false), logging.NewNoopLogger())
+	}
+	signers := make([]acm.AddressableSigner, len(*opts.signers))
+	for i, addressString := range *opts.signers {
+		address, err := crypto.AddressFromHexString(addressString)
+		if err != nil {
+			return nil, fmt.Errorf("could not read signing address '%s': %v", addressString, err)
+		}
+		signers[i], err = keys.AddressableSigner(keyClient, address)
+		if err != nil {
+			return nil, fmt.Errorf("could not get signer for %v: %v", address, err)
+		}
+	}
+	return signers, nil
+}
+
+// dumpTrust returns the Trust with which to read a dump, requiring any manifest to be signed by the keys with the
+// addresses given, or by the validators of genesisDoc if none are, unless unverified. The dump of a fork must be taken
+// from its parent chain.
+func dumpTrust(signers []string, unverified bool, genesisDoc *genesis.GenesisDoc) (dump.Trust, error) {
+	trust := dump.Trust{Unverified: unverified}
+	if genesisDoc != nil && genesisDoc.Fork != nil {
+		trust.ChainID = genesisDoc.Fork.ParentChainID
+	}
+	for _, addressString := range signers {
+		address, err := crypto.AddressFromHexString(addressString)
+		if err != nil {
+			return trust, fmt.Errorf("could not read trusted signer address '%s': %v", addressString, err)
+		}
+		trust.Signers = append(trust.Signers, address)
+	}
+	if len(trust.Signers) == 0 && genesisDoc != nil {
+		for _, val := range genesisDoc.Validators {
+			trust.Signers = append(trust.Signers, val.PublicKey.GetAddress())
+		}
+	}
+	return trust, nil
+}
+
 // Dump saves the state from a remote chain
 func Dump(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
@@ -59,7 +133,7 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("could not obtain config: %v", err)
 				}
 
-				kern, err := core.NewKernel(conf.BurrowDir)
+				kern, err := core.NewKernel(conf.BurrowDir, conf.DBBackendType())
 				if err != nil {
 					output.Fatalf("could not create burrow kernel: %v", err)
 				}
@@ -78,7 +152,7 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 				source := dump.NewDumper(kern.State, kern.Blockchain).WithLogger(logger).
 					Source(0, uint64(*dumpOpts.height), dump.All)
 
-				err = dumpToFile(*dumpOpts.filename, source, *dumpOpts.useBinaryEncoding)
+				err = dumpToFile(dumpOpts, source, kern.Blockchain.ChainID())
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -125,7 +199,7 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 					output.Fatalf("failed to retrieve dump: %v", err)
 				}
 
-				err = dumpToFile(*dumpOpts.filename, receiver, *dumpOpts.useBinaryEncoding)
+				err = dumpToFile(dumpOpts, receiver, chainStatus.GetChainID())
 				if err != nil {
 					output.Fatalf("could not dump to file %s': %v", *dumpOpts.filename, err)
 				}
@@ -136,22 +210,37 @@ func Dump(output Output) func(cmd *cli.Cmd) {
 	}
 }
 
-func dumpToFile(filename string, source dump.Source, useBinaryEncoding bool) error {
+func dumpToFile(opts *dumpOptions, source dump.Source, chainID string) error {
 	var file *os.File
 	var err error
-	if filename == "" {
+	if *opts.filename == "" {
 		file = os.Stdout
 	} else {
-		file, err = os.OpenFile(filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
+		file, err = os.OpenFile(*opts.filename, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644)
 		if err != nil {
 			return err
 		}
 	}
 
 	// Receive
-	err = dump.Write(file, source, useBinaryEncoding, dump.All)
-	if err != nil {
-		return err
+	if opts.withManifest() {
+		compression, err := dump.CompressionFromString(*opts.compression)
+		if err != nil {
+			return err
+		}
+		signers, err := opts.manifestSigners()
+		if err != nil {
+			return err
+		}
+		_, err = dump.WriteWithManifest(file, source, *opts.useBinaryEncoding, compression, chainID, signers...)
+		if err != nil {
+			return err
+		}
+	} else {
+		err = dump.Write(file, source, *opts.useBinaryEncoding, dump.All)
+		if err != nil {
+			return err
+		}
 	}
 
 	err = file.Close()
@@ -6,7 +6,9 @@ import (
 	"os"
 
 	"github.com/hyperledger/burrow/config"
+	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/forensics"
+	"github.com/hyperledger/burrow/storage"
 
 	"github.com/hyperledger/burrow/bcm"
 
@@ -37,7 +39,10 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("genesis doc is required")
 			}
 
-			explorer = bcm.NewBlockExplorer(dbm.BackendType(tmConf.DBBackend), tmConf.DBDir())
+			explorer, err = bcm.NewBlockExplorer(dbm.BackendType(tmConf.DBBackend), tmConf.DBDir())
+			if err != nil {
+				output.Fatalf("could not open blockstore: %v", err)
+			}
 		}
 
 		cmd.Command("dump", "pretty print the state tree at the given height", func(cmd *cli.Cmd) {
@@ -52,7 +57,10 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 
 			cmd.Action = func() {
-				replay := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir)
+				replay, err := forensics.NewSourceFromDir(conf.GenesisDoc, *stateDir, conf.DBBackendType())
+				if err != nil {
+					output.Fatalf("could not open state: %v", err)
+				}
 				height := uint64(*heightOpt)
 				if height == 0 {
 					height, err = replay.LatestHeight()
@@ -60,7 +68,7 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 						output.Fatalf("could not read latest height: %v", err)
 					}
 				}
-				err := replay.LoadAt(height)
+				err = replay.LoadAt(height)
 				if err != nil {
 					output.Fatalf("could not load state: %v", err)
 				}
@@ -85,14 +93,16 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 
 			cmd.Action = func() {
-				replay1 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir),
-					forensics.NewSourceFromGenesis(conf.GenesisDoc),
-				)
-				replay2 := forensics.NewReplay(
-					forensics.NewSourceFromDir(conf.GenesisDoc, *badDir),
-					forensics.NewSourceFromGenesis(conf.GenesisDoc),
-				)
+				good, err := forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir, conf.DBBackendType())
+				if err != nil {
+					output.Fatalf("could not open first state: %v", err)
+				}
+				bad, err := forensics.NewSourceFromDir(conf.GenesisDoc, *badDir, conf.DBBackendType())
+				if err != nil {
+					output.Fatalf("could not open second state: %v", err)
+				}
+				replay1 := forensics.NewReplay(good, forensics.NewSourceFromGenesis(conf.GenesisDoc))
+				replay2 := forensics.NewReplay(bad, forensics.NewSourceFromGenesis(conf.GenesisDoc))
 
 				h1, err := replay1.Src.LatestHeight()
 				if err != nil {
@@ -135,6 +145,89 @@ func Explore(output Output) func(cmd *cli.Cmd) {
 			}
 		})
 
+		cmd.Command("bisect", "find the first block and tx at which two .burrow directories diverge",
+			func(cmd *cli.Cmd) {
+				goodDir := cmd.StringArg("GOOD", "", "Directory containing expected state")
+				badDir := cmd.StringArg("BAD", "", "Directory containing invalid state")
+				heightOpt := cmd.IntOpt("height", 0, "Only examine this height rather than searching for the "+
+					"first diverging height")
+				cmd.Spec = "[--height] GOOD BAD"
+
+				cmd.Before = func() {
+					if err := isDir(*goodDir); err != nil {
+						output.Fatalf("could not obtain state: %v", err)
+					}
+					if err := isDir(*badDir); err != nil {
+						output.Fatalf("could not obtain state: %v", err)
+					}
+				}
+
+				cmd.Action = func() {
+					good, err := forensics.NewSourceFromDir(conf.GenesisDoc, *goodDir, conf.DBBackendType())
+					if err != nil {
+						output.Fatalf("could not open good state: %v", err)
+					}
+					bad, err := forensics.NewSourceFromDir(conf.GenesisDoc, *badDir, conf.DBBackendType())
+					if err != nil {
+						output.Fatalf("could not open bad state: %v", err)
+					}
+
+					var divergence *forensics.Divergence
+					if *heightOpt > 0 {
+						divergence, err = forensics.DivergenceAt(good, bad, uint64(*heightOpt))
+					} else {
+						divergence, err = forensics.Bisect(good, bad)
+					}
+					if err != nil {
+						output.Fatalf("could not bisect states: %v", err)
+					}
+
+					output.Printf("%v", divergence)
+					if divergence.TxIndex >= 0 {
+						for _, txe := range []*exec.TxExecution{divergence.ExpectedTx, divergence.ActualTx,
+							divergence.ReplayedTx} {
+							bs, err := json.Marshal(txe)
+							if err != nil {
+								output.Fatalf("could not serialise tx execution: %v", err)
+							}
+							output.Printf(string(bs))
+						}
+					}
+				}
+			})
+
+		cmd.Command("migrate-db", "copy the databases of a .burrow directory to a new directory and database "+
+			"backend, verifying the app hash afterwards", func(cmd *cli.Cmd) {
+			fromOpt := cmd.StringOpt("from-backend", "", "Database backend of SOURCE, defaults to that of the config")
+			toOpt := cmd.StringOpt("to-backend", "", fmt.Sprintf("Database backend to migrate to, one of %v "+
+				"in this build, set DBBackend in the config to match before running from DEST", storage.Backends()))
+			srcDir := cmd.StringArg("SOURCE", "", "Directory containing state to migrate")
+			dstDir := cmd.StringArg("DEST", "", "Directory to write migrated state")
+			cmd.Spec = "[--from-backend] --to-backend SOURCE DEST"
+
+			cmd.Before = func() {
+				if err := isDir(*srcDir); err != nil {
+					output.Fatalf("could not obtain state: %v", err)
+				}
+			}
+
+			cmd.Action = func() {
+				from := conf.DBBackendType()
+				if *fromOpt != "" {
+					from = dbm.BackendType(*fromOpt)
+				}
+				migration, err := forensics.MigrateDB(conf.GenesisDoc, *srcDir, from, *dstDir,
+					dbm.BackendType(*toOpt))
+				if err != nil {
+					output.Fatalf("could not migrate databases: %v", err)
+				}
+				for _, mdb := range migration.DBs {
+					output.Printf("Migrated %v", mdb)
+				}
+				output.Printf("Verified app hash %v at height %d", migration.AppHash, migration.Height)
+			}
+		})
+
 		cmd.Command("blocks", "dump blocks to stdout", func(cmd *cli.Cmd) {
 			rangeArg := cmd.StringArg("RANGE", "", "Range as START_HEIGHT:END_HEIGHT where omitting "+
 				"either endpoint implicitly describes the start/end and a negative index counts back from the last block")
@@ -0,0 +1,124 @@
+package commands
+
+import (
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/config/source"
+	"github.com/hyperledger/burrow/dump"
+	"github.com/hyperledger/burrow/forensics"
+	"github.com/hyperledger/burrow/genesis"
+	cli "github.com/jawher/mow.cli"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Fork generates the GenesisDoc for a hard-fork upgrade of an existing chain from a dump of its state
+func Fork(output Output) func(cmd *cli.Cmd) {
+	return func(cmd *cli.Cmd) {
+		haltHeightOpt := cmd.IntOpt("halt-height", 0, "Height at which the parent chain was halted and dumped")
+		parentGenesisOpt := cmd.StringOpt("parent-genesis", "", "GenesisDoc of the parent chain")
+		parentDirOpt := cmd.StringOpt("parent-dir", ".burrow", "Burrow directory of a node of the parent chain "+
+			"used to verify the dump")
+		parentDBBackendOpt := cmd.StringOpt("parent-db-backend", "", "Database backend of the parent node, "+
+			"defaults to that of the config")
+		configFileOpt := cmd.String(configFileOption)
+		genesisOpt := cmd.StringOpt("g genesis", "", "GenesisDoc providing validators, accounts, and "+
+			"permissions for the new chain")
+		chainNameOpt := cmd.StringOpt("n chain-name", "", "Chain name for the new chain, defaults to that of the "+
+			"provided GenesisDoc")
+		outOpt := cmd.StringOpt("w separate-genesis-doc", "", "Write the new GenesisDoc as JSON to this file "+
+			"rather than STDOUT")
+		filename := cmd.StringArg("FILE", "", "Dump of the parent chain taken at the halt height")
+
+		cmd.Spec = "--halt-height=<height> --parent-genesis=<genesis json file> [--parent-dir=<burrow directory>] " +
+			"[--parent-db-backend=<backend>] " + configFileSpec + " " +
+			"--genesis=<genesis json file> [--chain-name=<chain name>] [--separate-genesis-doc=<genesis JSON file>] FILE"
+
+		cmd.Action = func() {
+			haltHeight := uint64(*haltHeightOpt)
+
+			parentGenesisDoc := new(genesis.GenesisDoc)
+			err := source.FromFile(*parentGenesisOpt, parentGenesisDoc)
+			if err != nil {
+				output.Fatalf("could not read parent GenesisDoc: %v", err)
+			}
+
+			genesisDoc := new(genesis.GenesisDoc)
+			err = source.FromFile(*genesisOpt, genesisDoc)
+			if err != nil {
+				output.Fatalf("could not read GenesisDoc: %v", err)
+			}
+			if *chainNameOpt != "" {
+				genesisDoc.ChainName = *chainNameOpt
+			}
+
+			if err := isDir(*parentDirOpt); err != nil {
+				output.Fatalf("could not obtain parent state: %v", err)
+			}
+			parentDBBackend := dbm.BackendType(*parentDBBackendOpt)
+			if parentDBBackend == "" {
+				conf, err := obtainDefaultConfig(*configFileOpt, "")
+				if err != nil {
+					output.Fatalf("could not obtain config: %v", err)
+				}
+				parentDBBackend = conf.DBBackendType()
+			}
+			parent, err := forensics.NewSourceFromDir(parentGenesisDoc, *parentDirOpt, parentDBBackend)
+			if err != nil {
+				output.Fatalf("could not open parent state: %v", err)
+			}
+			lastHeight, err := parent.LatestHeight()
+			if err != nil {
+				output.Fatalf("could not load parent blockchain: %v", err)
+			}
+			if haltHeight == 0 || haltHeight > lastHeight {
+				output.Fatalf("halt height must be between 1 and the parent's last block height %d", lastHeight)
+			}
+			parentChain := dump.NewMockchain(parentGenesisDoc.ChainID(), haltHeight)
+			err = parent.LoadAt(haltHeight)
+			if err != nil {
+				output.Fatalf("could not load parent state at height %d: %v", haltHeight, err)
+			}
+
+			// The dump need not be signed since it is compared row by row with the state of the parent chain
+			trust := dump.Trust{Unverified: true, ChainID: parentChain.ChainID()}
+			reader, err := dump.NewFileReader(*filename, trust)
+			if err != nil {
+				output.Fatalf("failed to read dump: %v", err)
+			}
+			defer reader.Close()
+			err = dump.Compare(dump.NewDumper(parent.State, parentChain).Source(0, haltHeight, dump.All), reader)
+			if err != nil {
+				output.Fatalf("dump %s does not match parent state at height %d: %v", *filename, haltHeight, err)
+			}
+			output.Logf("Dump matches parent chain %s at height %d", parentChain.ChainID(), haltHeight)
+
+			reader, err = dump.NewFileReader(*filename, trust)
+			if err != nil {
+				output.Fatalf("failed to read dump: %v", err)
+			}
+			defer reader.Close()
+			forkDoc, err := dump.Fork(reader, genesisDoc, &genesis.Fork{
+				ParentChainID: parentChain.ChainID(),
+				HaltHeight:    haltHeight,
+				ParentAppHash: parent.State.Hash(),
+			})
+			if err != nil {
+				output.Fatalf("could not fork from dump %s: %v", *filename, err)
+			}
+
+			genesisDocJSON, err := forkDoc.JSONBytes()
+			if err != nil {
+				output.Fatalf("could not form GenesisDoc JSON: %v", err)
+			}
+			if *outOpt == "" {
+				output.Printf(string(genesisDocJSON))
+				return
+			}
+			err = ioutil.WriteFile(*outOpt, genesisDocJSON, 0644)
+			if err != nil {
+				output.Fatalf("could not write GenesisDoc JSON: %v", err)
+			}
+			output.Logf("Forked chain %s with AppHash %v", forkDoc.ChainID(), forkDoc.AppHash)
+		}
+	}
+}
@@ -10,8 +10,11 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 	return func(cmd *cli.Cmd) {
 		configOpts := addConfigOptions(cmd)
 		silentOpt := cmd.BoolOpt("s silent", false, "If state already exists don't throw error")
+		signersOpt := cmd.StringsOpt("trusted-signer", nil, "Address of a key trusted to sign the manifest of the "+
+			"dump, may be given multiple times, defaults to the validators in the GenesisDoc")
+		unverifiedOpt := cmd.BoolOpt("unverified", false, "Restore a dump whose manifest is unsigned or signed by any key")
 		filename := cmd.StringArg("FILE", "", "Restore from this dump")
-		cmd.Spec += "[--silent] [FILE]"
+		cmd.Spec += "[--silent] [--trusted-signer=<address>...] [--unverified] [FILE]"
 
 		cmd.Action = func() {
 			conf, err := configOpts.obtainBurrowConfig()
@@ -25,7 +28,7 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 
 			output.Logf("Using validator address: %s", *conf.ValidatorAddress)
 
-			kern, err := core.NewKernel(conf.BurrowDir)
+			kern, err := core.NewKernel(conf.BurrowDir, conf.DBBackendType())
 			if err != nil {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
@@ -34,7 +37,12 @@ func Restore(output Output) func(cmd *cli.Cmd) {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
 
-			if err = kern.LoadDump(conf.GenesisDoc, *filename, *silentOpt); err != nil {
+			trust, err := dumpTrust(*signersOpt, *unverifiedOpt, conf.GenesisDoc)
+			if err != nil {
+				output.Fatalf("could not determine trusted dump signers: %v", err)
+			}
+
+			if err = kern.LoadDump(conf.GenesisDoc, *filename, *silentOpt, trust); err != nil {
 				output.Fatalf("could not create Burrow kernel: %v", err)
 			}
 
@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 	"os"
 	"os/signal"
+	"strings"
 	"sync"
 	"syscall"
 	"time"
@@ -14,9 +15,11 @@ import (
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/service"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
+	"github.com/hyperledger/burrow/vent/ventquery"
 	cli "github.com/jawher/mow.cli"
 )
 
@@ -31,13 +34,23 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				dbOpts := sqlDBOpts(cmd, cfg)
 				grpcAddrOpt := cmd.StringOpt("grpc-addr", cfg.GRPCAddr, "Address to connect to the Hyperledger Burrow gRPC server")
 				httpAddrOpt := cmd.StringOpt("http-addr", cfg.HTTPAddr, "Address to bind the HTTP server")
+				queryOpt := cmd.BoolOpt("query", false, "Serve a read-only query API over the projected tables under /query on the HTTP server")
+				queryGRPCAddrOpt := cmd.StringOpt("query-grpc-addr", cfg.QueryGRPCAddr, "Address to bind a gRPC server for the query API (requires --query)")
 				logLevelOpt := cmd.StringOpt("log-level", cfg.LogLevel, "Logging level (error, warn, info, debug)")
 				abiFileOpt := cmd.StringsOpt("abi", cfg.AbiFileOrDirs, "EVM Contract ABI file or folder")
 				specFileOrDirOpt := cmd.StringsOpt("spec", cfg.SpecFileOrDirs, "SQLSol specification file or folder")
+				stateSpecFileOrDirOpt := cmd.StringsOpt("state-spec", cfg.StateSpecFileOrDirs, "SQLSol state specification file or folder projecting account state and contract storage")
 				dbBlockOpt := cmd.BoolOpt("blocks", false, "Create block tables and persist related data")
 				dbTxOpt := cmd.BoolOpt("txs", false, "Create tx tables and persist related data")
+				deadLetterOpt := cmd.BoolOpt("dead-letter", false, "Record events that cannot be decoded for want of an ABI in a dead letter table")
+				multiChainOpt := cmd.BoolOpt("multi-chain", false, "Key all tables by chain ID so that several chains can be projected into the same database")
+				chainGRPCAddrOpt := cmd.StringsOpt("chain-grpc-addr", nil, "Address of a further Burrow gRPC server whose chain to project alongside the one at --grpc-addr (implies --multi-chain)")
 
 				announceEveryOpt := cmd.StringOpt("announce-every", "5s", "Announce vent status every period as a Go duration, e.g. 1ms, 3s, 1h")
+				busURLOpt := cmd.StringOpt("bus-url", cfg.BusURL, "NATS server URL to which to publish a change data capture stream of projected rows (to JetStream) instead of writing them to the database")
+				busStreamOpt := cmd.StringOpt("bus-stream", cfg.BusStream, "JetStream stream capturing the subjects published to with --bus-url, created if it does not exist")
+				busTopicPrefixOpt := cmd.StringOpt("bus-topic-prefix", cfg.BusTopicPrefix, "Prefix of the subject of each table published to with --bus-url, ending with '.'")
+				notifyRetentionOpt := cmd.StringOpt("notify-retention", cfg.NotifyRetention.String(), "How long to keep notifications in the MySQL notify table for clients to poll as a Go duration, 0 keeps them forever")
 
 				cmd.Before = func() {
 					// Rather annoying boilerplate here... but there is no way to pass mow.cli a pointer for it to fill you value
@@ -46,15 +59,24 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					cfg.DBSchema = *dbOpts.schema
 					cfg.GRPCAddr = *grpcAddrOpt
 					cfg.HTTPAddr = *httpAddrOpt
+					cfg.QueryGRPCAddr = *queryGRPCAddrOpt
 					cfg.LogLevel = *logLevelOpt
 					cfg.AbiFileOrDirs = *abiFileOpt
 					cfg.SpecFileOrDirs = *specFileOrDirOpt
+					cfg.StateSpecFileOrDirs = *stateSpecFileOrDirOpt
 					if *dbBlockOpt {
 						cfg.SpecOpt |= sqlsol.Block
 					}
 					if *dbTxOpt {
 						cfg.SpecOpt |= sqlsol.Tx
 					}
+					if *deadLetterOpt {
+						cfg.SpecOpt |= sqlsol.DeadLetter
+					}
+					cfg.ChainGRPCAddrs = *chainGRPCAddrOpt
+					if *multiChainOpt || len(cfg.ChainGRPCAddrs) > 0 {
+						cfg.SpecOpt |= sqlsol.MultiChain
+					}
 
 					if *announceEveryOpt != "" {
 						var err error
@@ -63,10 +85,25 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 							output.Fatalf("could not parse announce-every duration %s: %v", *announceEveryOpt, err)
 						}
 					}
+
+					cfg.BusURL = *busURLOpt
+					cfg.BusStream = *busStreamOpt
+					cfg.BusTopicPrefix = *busTopicPrefixOpt
+					if cfg.BusURL != "" && !strings.HasSuffix(cfg.BusTopicPrefix, ".") {
+						output.Fatalf("bus-topic-prefix must end with '.' but is '%s'", cfg.BusTopicPrefix)
+					}
+
+					var err error
+					cfg.NotifyRetention, err = time.ParseDuration(*notifyRetentionOpt)
+					if err != nil {
+						output.Fatalf("could not parse notify-retention duration %s: %v", *notifyRetentionOpt, err)
+					}
 				}
 
-				cmd.Spec = "--spec=<spec file or dir> [--abi=<abi file or dir>] [--db-adapter] [--db-url] [--db-schema] " +
-					"[--blocks] [--txs] [--grpc-addr] [--http-addr] [--log-level] [--announce-every=<duration>]"
+				cmd.Spec = "[--spec=<spec file or dir>] [--state-spec=<state spec file or dir>] [--abi=<abi file or dir>] " +
+					"[--db-adapter] [--db-url] [--db-schema] [--blocks] [--txs] [--dead-letter] [--multi-chain] " +
+					"[--grpc-addr] [--chain-grpc-addr...] [--http-addr] [--query] [--query-grpc-addr] [--log-level] " +
+					"[--announce-every=<duration>] [--notify-retention=<duration>] [--bus-url] [--bus-stream] [--bus-topic-prefix]"
 
 				cmd.Action = func() {
 					log, err := logconfig.New().NewLogger()
@@ -76,13 +113,42 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 
 					log = log.With("service", "vent")
 					consumer := service.NewConsumer(cfg, log, make(chan types.EventData))
-					server := service.NewServer(cfg, log, consumer)
 
-					projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+					projection, err := sqlsol.ProjectionLoader(cfg.SpecFileOrDirs, cfg.StateSpecFileOrDirs, cfg.SpecOpt)
 					if err != nil {
 						output.Fatalf("Spec loader error: %v", err)
 					}
 
+					if cfg.BusURL != "" {
+						if *queryOpt {
+							output.Fatalf("The query API reads the database so cannot be served when publishing to --bus-url")
+						}
+						broker, err := sink.NewNATSBroker(cfg.BusURL, cfg.BusStream, cfg.BusTopicPrefix+">")
+						if err != nil {
+							output.Fatalf("Could not connect to bus: %v", err)
+						}
+						defer broker.Close()
+						consumer.Sink = sink.NewBusSink(broker, cfg.BusTopicPrefix, log)
+					}
+
+					var query *ventquery.Server
+					if *queryOpt {
+						// Queries have their own connection so they do not hold up the consumer
+						db, err := sqldb.NewSQLDB(types.SQLConnection{
+							DBAdapter:  cfg.DBAdapter,
+							DBURL:      cfg.DBURL,
+							DBSchema:   cfg.DBSchema,
+							MultiChain: cfg.SpecOpt.Enabled(sqlsol.MultiChain),
+							Log:        log,
+						})
+						if err != nil {
+							output.Fatalf("Could not connect to SQL DB: %v", err)
+						}
+						defer db.Close()
+						query = ventquery.NewServer(db, projection.Tables, log)
+					}
+					server := service.NewServer(cfg, log, consumer, query)
+
 					var wg sync.WaitGroup
 
 					// setup channel for termination signals
@@ -106,7 +172,10 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 					wg.Add(1)
 
 					go func() {
-						server.Run()
+						if err := server.Run(); err != nil {
+							output.Fatalf("Server execution error: %v", err)
+						}
+
 						wg.Done()
 					}()
 
@@ -130,20 +199,41 @@ func Vent(output Output) func(cmd *cli.Cmd) {
 				}
 			})
 
-		cmd.Command("spec", "Generate SQLSOL specification from ABIs",
+		cmd.Command("spec", "Generate SQLSOL specification from ABIs, or from the natspec and events in solc output",
 			func(cmd *cli.Cmd) {
 				abiFileOpt := cmd.StringsOpt("abi", nil, "EVM Contract ABI file or folder")
+				solcFileOpt := cmd.StringsOpt("solc", nil, "solc standard JSON output (or burrow deploy .bin) file or folder")
 				dest := cmd.StringArg("SPEC", "", "Write resulting spec to this json file")
 
+				cmd.Spec = "(--abi=<abi file or dir>... | --solc=<solc output file or dir>...) SPEC"
+
 				cmd.Action = func() {
-					abiSpec, err := abi.LoadPath(*abiFileOpt...)
-					if err != nil {
-						output.Fatalf("ABI loader error: %v", err)
-					}
+					var spec types.ProjectionSpec
+					var err error
+					if len(*solcFileOpt) > 0 {
+						contracts, err := sqlsol.LoadSolidityOutput(*solcFileOpt...)
+						if err != nil {
+							output.Fatalf("solc output loader error: %v", err)
+						}
 
-					spec, err := sqlsol.GenerateSpecFromAbis(abiSpec)
-					if err != nil {
-						output.Fatalf("error generating spec: %s\n", err)
+						var warnings []string
+						spec, warnings, err = sqlsol.GenerateSpecFromSolidity(contracts...)
+						if err != nil {
+							output.Fatalf("error generating spec: %v", err)
+						}
+						for _, warning := range warnings {
+							output.Logf("WARNING: %s", warning)
+						}
+					} else {
+						abiSpec, err := abi.LoadPath(*abiFileOpt...)
+						if err != nil {
+							output.Fatalf("ABI loader error: %v", err)
+						}
+
+						spec, err = sqlsol.GenerateSpecFromAbis(abiSpec)
+						if err != nil {
+							output.Fatalf("error generating spec: %s\n", err)
+						}
 					}
 
 					err = ioutil.WriteFile(*dest, []byte(source.JSONString(spec)), 0644)
@@ -224,8 +314,8 @@ type dbOpts struct {
 
 func sqlDBOpts(cmd *cli.Cmd, cfg *config.VentConfig) dbOpts {
 	return dbOpts{
-		adapter: cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres' or 'sqlite' (if built with the sqlite tag) are supported"),
-		url:     cmd.StringOpt("db-url", cfg.DBURL, "PostgreSQL database URL or SQLite db file path"),
-		schema:  cmd.StringOpt("db-schema", cfg.DBSchema, "PostgreSQL database schema (empty for SQLite)"),
+		adapter: cmd.StringOpt("db-adapter", cfg.DBAdapter, "Database adapter, 'postgres', 'mysql' or 'sqlite' (if built with the sqlite tag) are supported"),
+		url:     cmd.StringOpt("db-url", cfg.DBURL, "PostgreSQL database URL, MySQL DSN, or SQLite db file path"),
+		schema:  cmd.StringOpt("db-schema", cfg.DBSchema, "PostgreSQL database schema or MySQL database (empty for SQLite)"),
 	}
 }
@@ -78,6 +78,9 @@ func burrow(output commands.Output) *cli.Cli {
 	app.Command("restore", "Restore new chain from backup",
 		commands.Restore(output))
 
+	app.Command("fork", "Generate the GenesisDoc for a hard-fork upgrade of a chain from a dump of its state",
+		commands.Fork(output))
+
 	app.Command("accounts", "List accounts and metadata",
 		commands.Accounts(output))
 
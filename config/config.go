@@ -11,7 +11,9 @@ import (
 	"github.com/hyperledger/burrow/keys"
 	"github.com/hyperledger/burrow/logging/logconfig"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	tmConfig "github.com/tendermint/tendermint/config"
+	dbm "github.com/tendermint/tm-db"
 )
 
 const DefaultBurrowConfigTOMLFileName = "burrow.toml"
@@ -23,7 +25,10 @@ type BurrowConfig struct {
 	ValidatorAddress *crypto.Address `json:",omitempty" toml:",omitempty"`
 	Passphrase       *string         `json:",omitempty" toml:",omitempty"`
 	// From config file
-	BurrowDir  string
+	BurrowDir string
+	// The backend used for Burrow's state and Tendermint's stores (defaults to goleveldb), one of goleveldb or
+	// boltdb, or cleveldb or rocksdb when built with the tag of the same name
+	DBBackend  string                             `json:",omitempty" toml:",omitempty"`
 	GenesisDoc *genesis.GenesisDoc                `json:",omitempty" toml:",omitempty"`
 	Tendermint *tendermint.BurrowTendermintConfig `json:",omitempty" toml:",omitempty"`
 	Execution  *execution.ExecutionConfig         `json:",omitempty" toml:",omitempty"`
@@ -47,11 +52,24 @@ func (conf *BurrowConfig) Verify() error {
 	if conf.ValidatorAddress == nil {
 		return fmt.Errorf("could not finalise address - please provide one in config or via --account-address")
 	}
-	return nil
+	return storage.CheckBackend(conf.DBBackendType())
 }
 
 func (conf *BurrowConfig) TendermintConfig() (*tmConfig.Config, error) {
-	return conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	tmConf, err := conf.Tendermint.Config(conf.BurrowDir, conf.Execution.TimeoutFactor)
+	if err != nil {
+		return nil, err
+	}
+	tmConf.DBBackend = string(conf.DBBackendType())
+	return tmConf, nil
+}
+
+// DBBackendType returns the configured database backend
+func (conf *BurrowConfig) DBBackendType() dbm.BackendType {
+	if conf.DBBackend == "" {
+		return dbm.GoLevelDBBackend
+	}
+	return dbm.BackendType(conf.DBBackend)
 }
 
 func (conf *BurrowConfig) JSONString() string {
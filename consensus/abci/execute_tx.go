@@ -30,9 +30,12 @@ func ExecuteTx(logHeader string, executor execution.Executor, txDecoder txs.Deco
 	txe, err := executor.Execute(txEnv)
 	if err != nil {
 		ex := errors.AsException(err)
+		// Pass the exception on in data so the code can be recovered by the transactor
+		bs, _ := ex.Marshal()
 		return types.ResponseCheckTx{
 			Code: codes.TxExecutionErrorCode,
 			Log:  logf("Could not execute transaction: %s, error: %v", txEnv, ex.Exception),
+			Data: bs,
 		}
 	}
 
@@ -66,6 +66,14 @@ func (nv *NodeView) IsFastSyncing() bool {
 	return nv.tmNode.ConsensusReactor().FastSync()
 }
 
+// IsConsensusRunning returns whether the node has caught up with the network and is taking part in consensus
+func (nv *NodeView) IsConsensusRunning() bool {
+	if nv == nil {
+		return false
+	}
+	return !nv.IsFastSyncing() && nv.tmNode.ConsensusState().IsRunning()
+}
+
 func (nv *NodeView) Peers() p2p.IPeerSet {
 	return nv.tmNode.Switch().Peers()
 }
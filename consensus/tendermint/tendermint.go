@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/tendermint/tendermint/config"
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	"github.com/tendermint/tendermint/node"
@@ -29,13 +30,16 @@ type Node struct {
 	}
 }
 
-func DBProvider(ID string, backendType dbm.BackendType, dbDir string) dbm.DB {
-	return dbm.NewDB(ID, backendType, dbDir)
+func DBProvider(ID string, backendType dbm.BackendType, dbDir string) (dbm.DB, error) {
+	return storage.OpenDB(ID, backendType, dbDir)
 }
 
 // Since Tendermint doesn't close its DB connections
 func (n *Node) DBProvider(ctx *node.DBContext) (dbm.DB, error) {
-	db := DBProvider(ctx.ID, dbm.BackendType(ctx.Config.DBBackend), ctx.Config.DBDir())
+	db, err := DBProvider(ctx.ID, dbm.BackendType(ctx.Config.DBBackend), ctx.Config.DBDir())
+	if err != nil {
+		return nil, err
+	}
 	n.closers = append(n.closers, db)
 	return db, nil
 }
@@ -93,7 +93,7 @@ func (kern *Kernel) LoadTendermintFromConfig(conf *config.BurrowConfig, privVal
 
 // LoadKernelFromConfig builds and returns a Kernel based solely on the supplied configuration
 func LoadKernelFromConfig(conf *config.BurrowConfig) (*Kernel, error) {
-	kern, err := NewKernel(conf.BurrowDir)
+	kern, err := NewKernel(conf.BurrowDir, conf.DBBackendType())
 	if err != nil {
 		return nil, fmt.Errorf("could not create initial kernel: %v", err)
 	}
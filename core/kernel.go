@@ -30,6 +30,7 @@ import (
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/process"
 	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/streadway/simpleuuid"
 	"github.com/tendermint/tendermint/store"
@@ -73,12 +74,20 @@ type Kernel struct {
 	shutdownOnce   sync.Once
 }
 
-// NewKernel initializes an empty kernel
-func NewKernel(dbDir string) (*Kernel, error) {
+// NewKernel initializes an empty kernel with its state database in dbDir using the dbBackend
+func NewKernel(dbDir string, dbBackend dbm.BackendType) (*Kernel, error) {
 	if dbDir == "" {
 		return nil, fmt.Errorf("Burrow requires a database directory")
 	}
+	database, err := storage.OpenDB(BurrowDBName, dbBackend, dbDir)
+	if err != nil {
+		return nil, err
+	}
 	runID, err := simpleuuid.NewTime(time.Now()) // Create a random ID based on start time
+	if err != nil {
+		database.Close()
+		return nil, err
+	}
 	return &Kernel{
 		Logger:         logging.NewNoopLogger(),
 		RunID:          runID,
@@ -87,8 +96,8 @@ func NewKernel(dbDir string) (*Kernel, error) {
 		listeners:      make(map[string]net.Listener),
 		shutdownNotify: make(chan struct{}),
 		txCodec:        txs.NewProtobufCodec(),
-		database:       dbm.NewDB(BurrowDBName, dbm.GoLevelDBBackend, dbDir),
-	}, err
+		database:       database,
+	}, nil
 }
 
 // SetLogger initializes the kernel with the provided logger
@@ -154,8 +163,9 @@ func (kern *Kernel) LoadState(genesisDoc *genesis.GenesisDoc) (err error) {
 	return nil
 }
 
-// LoadDump restores chain state from the given dump file
-func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string, silent bool) (err error) {
+// LoadDump restores chain state from the given dump file, which must satisfy trust
+func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string, silent bool,
+	trust dump.Trust) (err error) {
 	var exists bool
 	if kern.Blockchain, exists, err = bcm.LoadOrNewBlockchain(kern.database, genesisDoc, kern.Logger); err != nil {
 		return fmt.Errorf("error creating or loading blockchain state: %v", err)
@@ -179,10 +189,35 @@ func (kern *Kernel) LoadDump(genesisDoc *genesis.GenesisDoc, restoreFile string,
 		return fmt.Errorf("AppHash is required when restoring chain")
 	}
 
-	reader, err := dump.NewFileReader(restoreFile)
+	fileReader, err := dump.NewFileReader(restoreFile, trust)
 	if err != nil {
 		return err
 	}
+	defer fileReader.Close()
+
+	var reader dump.Source = fileReader
+	if manifest := fileReader.Manifest(); manifest == nil {
+		kern.Logger.InfoMsg("WARNING: restoring dump written without a manifest so its contents cannot be verified",
+			"dump_file", restoreFile)
+	} else {
+		signers := make([]string, len(manifest.Signatures))
+		for i, sig := range manifest.Signatures {
+			signers[i] = sig.PublicKey.GetAddress().String()
+		}
+		kern.Logger.InfoMsg("Verified dump manifest",
+			"chain_id", manifest.ChainID,
+			"height", manifest.Height,
+			"hash", manifest.Hash.String(),
+			"signers", signers)
+	}
+
+	if genesisDoc.Fork != nil {
+		kern.Logger.InfoMsg("Restoring hard fork of parent chain",
+			"parent_chain_id", genesisDoc.Fork.ParentChainID,
+			"halt_height", genesisDoc.Fork.HaltHeight,
+			"parent_app_hash", genesisDoc.Fork.ParentAppHash.String())
+		reader = dump.AtHeight(reader, genesisDoc.Fork.HaltHeight)
+	}
 
 	err = dump.Load(reader, kern.State)
 	if err != nil {
@@ -3,6 +3,7 @@ package core
 import (
 	"context"
 	"fmt"
+	"net"
 	"net/http"
 	"time"
 
@@ -18,6 +19,7 @@ import (
 	"github.com/hyperledger/burrow/rpc/metrics"
 	"github.com/hyperledger/burrow/rpc/rpcdump"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcgraphql"
 	"github.com/hyperledger/burrow/rpc/rpcinfo"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
@@ -26,6 +28,8 @@ import (
 	"github.com/tendermint/tendermint/p2p"
 	"github.com/tendermint/tendermint/version"
 	hex "github.com/tmthrgd/go-hex"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
 )
 
 const (
@@ -38,9 +42,12 @@ const (
 	InfoProcessName        = "rpcConfig/info"
 	GRPCProcessName        = "rpcConfig/GRPC"
 	MetricsProcessName     = "rpcConfig/metrics"
+	GraphQLProcessName     = "rpcConfig/graphql"
 )
 
 func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig *keys.KeysConfig) []process.Launcher {
+	// Shared by the RPC servers so a client's calls to each count against the same limit
+	limiter := rpc.NewRateLimiter(rpcConfig.RateLimit)
 	// Run announcer after Tendermint so it can get some details
 	return []process.Launcher{
 		ProfileLauncher(kern, rpcConfig.Profiler),
@@ -48,10 +55,11 @@ func DefaultProcessLaunchers(kern *Kernel, rpcConfig *rpc.RPCConfig, keysConfig
 		NoConsensusLauncher(kern),
 		TendermintLauncher(kern),
 		StartupLauncher(kern),
-		Web3Launcher(kern, rpcConfig.Web3),
-		InfoLauncher(kern, rpcConfig.Info),
-		MetricsLauncher(kern, rpcConfig.Metrics),
-		GRPCLauncher(kern, rpcConfig.GRPC, keysConfig),
+		Web3Launcher(kern, rpcConfig.Web3, limiter),
+		InfoLauncher(kern, rpcConfig.Info, limiter),
+		MetricsLauncher(kern, rpcConfig.Metrics, limiter),
+		GRPCLauncher(kern, rpcConfig.GRPC, keysConfig, limiter),
+		GraphQLLauncher(kern, rpcConfig.GraphQL, limiter),
 	}
 }
 
@@ -214,7 +222,7 @@ func StartupLauncher(kern *Kernel) process.Launcher {
 	}
 }
 
-func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig, limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    InfoProcessName,
 		Enabled: conf.Enabled,
@@ -227,7 +235,11 @@ func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
-			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, kern.Logger)
+			tlsConf, err := newTLS(kern, InfoProcessName, conf)
+			if err != nil {
+				return nil, err
+			}
+			server, err := rpcinfo.StartServer(kern.Service, "/websocket", listener, limiter, tlsConf, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -236,7 +248,40 @@ func InfoLauncher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
-func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
+func GraphQLLauncher(kern *Kernel, conf *rpc.GraphQLConfig, limiter *rpc.RateLimiter) process.Launcher {
+	return process.Launcher{
+		Name:    GraphQLProcessName,
+		Enabled: conf.Enabled,
+		Launch: func() (process.Process, error) {
+			nodeView, err := kern.GetNodeView()
+			if err != nil {
+				return nil, err
+			}
+			listener, err := process.ListenerFromAddress(conf.ListenAddress())
+			if err != nil {
+				return nil, err
+			}
+			err = kern.registerListener(GraphQLProcessName, listener)
+			if err != nil {
+				return nil, err
+			}
+			tlsConf, err := newTLS(kern, GraphQLProcessName, &conf.ServerConfig)
+			if err != nil {
+				return nil, err
+			}
+			server, err := rpcgraphql.StartServer(
+				rpcquery.NewQueryServer(kern.State, kern.Blockchain, nodeView, kern.Logger),
+				rpcevents.NewExecutionEventsServer(kern.State, kern.Emitter, kern.Blockchain, kern.Logger),
+				kern.Blockchain, "/graphql", conf.AllowedOrigins, listener, limiter, tlsConf, kern.Logger)
+			if err != nil {
+				return nil, err
+			}
+			return server, nil
+		},
+	}
+}
+
+func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig, limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    Web3ProcessName,
 		Enabled: conf.Enabled,
@@ -249,8 +294,14 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
+			tlsConf, err := newTLS(kern, Web3ProcessName, conf)
+			if err != nil {
+				return nil, err
+			}
 
-			srv, err := server.StartHTTPServer(listener, web3.NewServer(kern.EthService), kern.Logger)
+			handler := rpc.AuthorizeHandler(tlsConf, rpc.RateLimitHandler(limiter, tlsConf,
+				rpc.InstrumentHandler("web3", web3.NewServer(kern.EthService))))
+			srv, err := server.StartHTTPServer(tlsConf.Listener(listener), handler, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -260,7 +311,7 @@ func Web3Launcher(kern *Kernel, conf *rpc.ServerConfig) process.Launcher {
 	}
 }
 
-func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
+func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig, limiter *rpc.RateLimiter) process.Launcher {
 	return process.Launcher{
 		Name:    MetricsProcessName,
 		Enabled: conf.Enabled,
@@ -273,8 +324,12 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 			if err != nil {
 				return nil, err
 			}
-			server, err := metrics.StartServer(kern.Service, conf.MetricsPath, listener, conf.BlockSampleSize,
-				kern.Logger)
+			tlsConf, err := newTLS(kern, MetricsProcessName, &conf.ServerConfig)
+			if err != nil {
+				return nil, err
+			}
+			server, err := metrics.StartServer(kern.Service, kern.Emitter, limiter, conf.MetricsPath, tlsConf.Listener(listener),
+				conf.BlockSampleSize, kern.Logger)
 			if err != nil {
 				return nil, err
 			}
@@ -283,7 +338,9 @@ func MetricsLauncher(kern *Kernel, conf *rpc.MetricsConfig) process.Launcher {
 	}
 }
 
-func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConfig) process.Launcher {
+func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConfig,
+	limiter *rpc.RateLimiter) process.Launcher {
+
 	return process.Launcher{
 		Name:    GRPCProcessName,
 		Enabled: conf.Enabled,
@@ -301,13 +358,16 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 			if err != nil {
 				return nil, err
 			}
+			tlsConf, err := newTLS(kern, GRPCProcessName, conf)
+			if err != nil {
+				return nil, err
+			}
 
-			grpcServer := rpc.NewGRPCServer(kern.Logger)
+			grpcServer := rpc.NewGRPCServer(limiter, tlsConf, kern.Logger)
 			var ks *keys.FilesystemKeyStore
 			if kern.keyStore != nil {
 				ks = kern.keyStore
 			}
-			grpcServer.GetServiceInfo()
 
 			if keyConfig.GRPCServiceEnabled {
 				if kern.keyStore == nil {
@@ -327,12 +387,25 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 
 			rpcdump.RegisterDumpServer(grpcServer, rpcdump.NewDumpServer(kern.State, kern.Blockchain, kern.Logger))
 
+			// Report health for each of the services registered above
+			var services []string
+			for service := range grpcServer.GetServiceInfo() {
+				services = append(services, service)
+			}
+			health := rpc.NewHealth(func() bool {
+				// Without a Tendermint node we commit locally so are always ready
+				return nodeView == nil || nodeView.IsConsensusRunning()
+			}, kern.Logger, services...)
+			grpc_health_v1.RegisterHealthServer(grpcServer, health)
+			health.Start(rpc.DefaultHealthCheckInterval)
+
 			// Provides metadata about services registered
-			// reflection.Register(grpcServer)
+			reflection.Register(grpcServer)
 
 			go grpcServer.Serve(listener)
 
 			return process.ShutdownFunc(func(ctx context.Context) error {
+				health.Stop()
 				grpcServer.Stop()
 				// listener is closed for us
 				return nil
@@ -340,3 +413,24 @@ func GRPCLauncher(kern *Kernel, conf *rpc.ServerConfig, keyConfig *keys.KeysConf
 		},
 	}
 }
+
+// Loads the certificates a server is configured to serve (if any)
+func newTLS(kern *Kernel, name string, conf *rpc.ServerConfig) (*rpc.TLS, error) {
+	tlsConf, err := rpc.NewTLS(conf.TLS, kern.Logger)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	if tlsConf == nil && !isLoopback(conf.ListenHost) {
+		kern.Logger.InfoMsg("Serving plaintext RPC beyond localhost, consider configuring TLS",
+			"process", name, "address", conf.ListenAddress())
+	}
+	return tlsConf, nil
+}
+
+func isLoopback(host string) bool {
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
@@ -39,6 +39,7 @@ type Blockchain interface {
 type Dumper struct {
 	state      *state.State
 	blockchain Blockchain
+	partitions int
 	logger     *logging.Logger
 }
 
@@ -47,6 +48,7 @@ func NewDumper(state *state.State, blockchain Blockchain) *Dumper {
 	return &Dumper{
 		state:      state,
 		blockchain: blockchain,
+		partitions: DefaultPartitions,
 		logger:     logging.NewNoopLogger(),
 	}
 }
@@ -77,73 +79,7 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 
 	if options.Enabled(Accounts) {
 		ds.logger.InfoMsg("Dumping accounts")
-		err = st.IterateAccounts(func(acc *acm.Account) error {
-			// Since we tend to want to handle accounts and their storage as a single unit we multiplex account
-			// and storage within the same row. If the storage gets too large we chunk it and send in separate rows
-			// (so that we stay well below the 4MiB GRPC message size limit and generally maintain stream-ability)
-			row := &Dump{
-				Height:  endHeight,
-				Account: acc,
-				AccountStorage: &AccountStorage{
-					Address: acc.Address,
-					Storage: make([]*Storage, 0),
-				},
-			}
-
-			for _, m := range acc.ContractMeta {
-				var metahash acmstate.MetadataHash
-				copy(metahash[:], m.MetadataHash.Bytes())
-				meta, err := ds.state.GetMetadata(metahash)
-				if err != nil {
-					return err
-				}
-				m.Metadata = meta
-				m.MetadataHash = []byte{}
-			}
-
-			var storageBytes int
-			err = st.IterateStorage(acc.Address, func(key binary.Word256, value []byte) error {
-				if storageBytes > thresholdAccountStorageBytesPerRow {
-					// Send the current row
-					err = sink.Send(row)
-					if err != nil {
-						return err
-					}
-					// Start a new pure storage row
-					row = &Dump{
-						Height: endHeight,
-						AccountStorage: &AccountStorage{
-							Address: acc.Address,
-							Storage: make([]*Storage, 0),
-						},
-					}
-				}
-				row.AccountStorage.Storage = append(row.AccountStorage.Storage, &Storage{Key: key, Value: value})
-				storageBytes += len(key) + len(value)
-				return nil
-			})
-			if err != nil {
-				return err
-			}
-
-			// Don't send empty storage
-			if len(row.AccountStorage.Storage) == 0 {
-				row.AccountStorage = nil
-				// Don't send an empty row
-				if row.Account == nil {
-					// We started a new storage row, but there was no subsequent storage to go in it
-					return nil
-				}
-			}
-
-			err = sink.Send(row)
-			if err != nil {
-				return err
-			}
-
-			return nil
-		})
-
+		err = ds.transmitAccounts(sink, st, endHeight)
 		if err != nil {
 			return err
 		}
@@ -205,6 +141,77 @@ func (ds *Dumper) Transmit(sink Sink, startHeight, endHeight uint64, options Opt
 	return nil
 }
 
+// Transmit the accounts (and their storage) with addresses in [start, end) as they were at height
+func (ds *Dumper) transmitAccountRange(sink Sink, st *state.ReadState, height uint64, start, end []byte) error {
+	return st.IterateAccountsInRange(start, end, func(acc *acm.Account) error {
+		// Since we tend to want to handle accounts and their storage as a single unit we multiplex account
+		// and storage within the same row. If the storage gets too large we chunk it and send in separate rows
+		// (so that we stay well below the 4MiB GRPC message size limit and generally maintain stream-ability)
+		row := &Dump{
+			Height:  height,
+			Account: acc,
+			AccountStorage: &AccountStorage{
+				Address: acc.Address,
+				Storage: make([]*Storage, 0),
+			},
+		}
+
+		for _, m := range acc.ContractMeta {
+			var metahash acmstate.MetadataHash
+			copy(metahash[:], m.MetadataHash.Bytes())
+			meta, err := ds.state.GetMetadata(metahash)
+			if err != nil {
+				return err
+			}
+			m.Metadata = meta
+			m.MetadataHash = []byte{}
+		}
+
+		var storageBytes int
+		err := st.IterateStorage(acc.Address, func(key binary.Word256, value []byte) error {
+			if storageBytes > thresholdAccountStorageBytesPerRow {
+				// Send the current row
+				err := sink.Send(row)
+				if err != nil {
+					return err
+				}
+				storageBytes = 0
+				// Start a new pure storage row
+				row = &Dump{
+					Height: height,
+					AccountStorage: &AccountStorage{
+						Address: acc.Address,
+						Storage: make([]*Storage, 0),
+					},
+				}
+			}
+			row.AccountStorage.Storage = append(row.AccountStorage.Storage, &Storage{Key: key, Value: value})
+			storageBytes += len(key) + len(value)
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+
+		// Don't send empty storage
+		if len(row.AccountStorage.Storage) == 0 {
+			row.AccountStorage = nil
+			// Don't send an empty row
+			if row.Account == nil {
+				// We started a new storage row, but there was no subsequent storage to go in it
+				return nil
+			}
+		}
+
+		err = sink.Send(row)
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+}
+
 // Return a Source that is a Pipe fed from this Dumper's Transmit function
 func (ds *Dumper) Source(startHeight, endHeight uint64, options Option) Source {
 	p := make(Pipe)
@@ -223,8 +230,53 @@ func (ds *Dumper) WithLogger(logger *logging.Logger) *Dumper {
 	return ds
 }
 
+// WithPartitions sets the number of partitions of the account keyspace that are read concurrently by Transmit
+func (ds *Dumper) WithPartitions(partitions int) *Dumper {
+	ds.partitions = partitions
+	return ds
+}
+
 // Write a dump to the Writer out by pulling rows from stream
 func Write(out io.Writer, source Source, useBinaryEncoding bool, options Option) error {
+	return writeRows(out, source, useBinaryEncoding, nil)
+}
+
+// WriteWithManifest writes a dump to out by pulling rows from stream, compressing them as specified, and appending a
+// Manifest of the rows signed by each of signers. The Manifest is returned.
+func WriteWithManifest(out io.Writer, source Source, useBinaryEncoding bool, compression Compression, chainID string,
+	signers ...acm.AddressableSigner) (*Manifest, error) {
+
+	_, err := out.Write(manifestMagic)
+	if err != nil {
+		return nil, err
+	}
+	compressor, err := compress(out, compression)
+	if err != nil {
+		return nil, err
+	}
+	counter := newManifestCounter()
+	err = writeRows(io.MultiWriter(compressor, counter.hasher), source, useBinaryEncoding, counter.count)
+	if err != nil {
+		return nil, err
+	}
+	err = compressor.Close()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compress dump: %v", err)
+	}
+	manifest := counter.Manifest()
+	manifest.ChainID = chainID
+	err = manifest.Sign(signers...)
+	if err != nil {
+		return nil, err
+	}
+	err = writeManifest(out, manifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to write dump manifest: %v", err)
+	}
+	return manifest, nil
+}
+
+func writeRows(out io.Writer, source Source, useBinaryEncoding bool, count func(*Dump)) error {
 	for {
 		resp, err := source.Recv()
 		if err != nil {
@@ -235,12 +287,16 @@ func Write(out io.Writer, source Source, useBinaryEncoding bool, options Option)
 			return fmt.Errorf("failed to recv dump: %v", err)
 		}
 
+		if count != nil {
+			count(resp)
+		}
+
 		if useBinaryEncoding {
 			_, err := encoding.WriteMessage(out, resp)
 			if err != nil {
 				return fmt.Errorf("failed write to binary dump message: %v", err)
 			}
-			return nil
+			continue
 		}
 
 		bs, err := json.Marshal(resp)
@@ -251,10 +307,9 @@ func Write(out io.Writer, source Source, useBinaryEncoding bool, options Option)
 		if len(bs) > 0 {
 			bs = append(bs, []byte("\n")...)
 			n, err := out.Write(bs)
-			if err == nil && n < len(bs) {
+			if err != nil || n < len(bs) {
 				return fmt.Errorf("failed to write dump: %v", err)
 			}
 		}
 	}
-
 }
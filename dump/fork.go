@@ -0,0 +1,108 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Fork builds the GenesisDoc for a new chain that hard-forks the parent chain described by fork by restoring the dump
+// provided by source on top of the state described by genesisDoc (which supplies validators, permissions, etc.).
+// The AppHash of the returned GenesisDoc is that of the restored state so that each node performing a restore can
+// verify it arrived at the same state.
+func Fork(source Source, genesisDoc *genesis.GenesisDoc, fork *genesis.Fork) (*genesis.GenesisDoc, error) {
+	if fork == nil {
+		return nil, fmt.Errorf("cannot fork without a parent chain")
+	}
+	if len(genesisDoc.Validators) == 0 {
+		return nil, fmt.Errorf("on fork, validators must be provided in GenesisDoc")
+	}
+	forkDoc := &genesis.GenesisDoc{
+		GenesisTime:       genesisDoc.GenesisTime,
+		ChainName:         genesisDoc.ChainName,
+		Params:            genesisDoc.Params,
+		Salt:              genesisDoc.Salt,
+		Fork:              fork,
+		GlobalPermissions: genesisDoc.GlobalPermissions,
+		Accounts:          genesisDoc.Accounts,
+		Validators:        genesisDoc.Validators,
+	}
+
+	st, err := state.MakeGenesisState(dbm.NewMemDB(), forkDoc)
+	if err != nil {
+		return nil, fmt.Errorf("could not generate state from genesis: %v", err)
+	}
+
+	err = Load(AtHeight(source, fork.HaltHeight), st)
+	if err != nil {
+		return nil, fmt.Errorf("could not restore dump: %v", err)
+	}
+
+	forkDoc.AppHash = st.Hash()
+	return forkDoc, nil
+}
+
+// AtHeight wraps source and returns an error if any of its state rows were not dumped at height, or if any of its
+// events are from after height
+func AtHeight(source Source, height uint64) Source {
+	return heightSource{source: source, height: height}
+}
+
+type heightSource struct {
+	source Source
+	height uint64
+}
+
+func (hs heightSource) Recv() (*Dump, error) {
+	row, err := hs.source.Recv()
+	if err != nil {
+		return nil, err
+	}
+	if row.EVMEvent != nil {
+		if row.Height > hs.height {
+			return nil, fmt.Errorf("dump contains event from height %d after halt height %d", row.Height, hs.height)
+		}
+	} else if row.Height != hs.height {
+		return nil, fmt.Errorf("dump contains state from height %d but expected halt height %d", row.Height, hs.height)
+	}
+	return row, nil
+}
+
+// Compare reads both sources to exhaustion and returns an error describing the first row at which they differ
+func Compare(expected, actual Source) error {
+	for i := 0; ; i++ {
+		expectedRow, expectedErr := expected.Recv()
+		actualRow, actualErr := actual.Recv()
+		if expectedErr == io.EOF && actualErr == io.EOF {
+			return nil
+		}
+		if expectedErr == io.EOF {
+			return fmt.Errorf("dump has more rows than expected, first extra row is %d", i)
+		}
+		if actualErr == io.EOF {
+			return fmt.Errorf("dump ended at row %d but expected more rows", i)
+		}
+		if expectedErr != nil {
+			return expectedErr
+		}
+		if actualErr != nil {
+			return actualErr
+		}
+		expectedBytes, err := proto.Marshal(expectedRow)
+		if err != nil {
+			return err
+		}
+		actualBytes, err := proto.Marshal(actualRow)
+		if err != nil {
+			return err
+		}
+		if !bytes.Equal(expectedBytes, actualBytes) {
+			return fmt.Errorf("dump differs at row %d: expected %v but got %v", i, expectedRow, actualRow)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package dump
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFork(t *testing.T) {
+	key := crypto.PrivateKeyFromSecret("validator", crypto.CurveTypeEd25519)
+	genesisDoc := &genesis.GenesisDoc{
+		ChainName:         "ForkedChain",
+		GlobalPermissions: permission.DefaultAccountPermissions,
+		Validators: []genesis.Validator{{
+			BasicAccount: genesis.BasicAccount{
+				Address:   key.GetPublicKey().GetAddress(),
+				PublicKey: key.GetPublicKey(),
+				Amount:    10,
+			},
+		}},
+	}
+	fork := &genesis.Fork{
+		ParentChainID: "Mockchain",
+		HaltHeight:    10,
+	}
+
+	t.Run("RestoresAtHaltHeight", func(t *testing.T) {
+		forkDoc, err := Fork(mockSourceAt(10), genesisDoc, fork)
+		require.NoError(t, err)
+		assert.Equal(t, fork, forkDoc.Fork)
+		assert.Equal(t, genesisDoc.ChainName, forkDoc.ChainName)
+
+		// Restoring the same dump on the forked genesis must arrive at the same AppHash
+		st, err := state.MakeGenesisState(testDB(t), forkDoc)
+		require.NoError(t, err)
+		err = Load(AtHeight(mockSourceAt(10), fork.HaltHeight), st)
+		require.NoError(t, err)
+		assert.Equal(t, []byte(forkDoc.AppHash), st.Hash())
+	})
+
+	t.Run("RejectsDumpFromOtherHeight", func(t *testing.T) {
+		_, err := Fork(mockSourceAt(9), genesisDoc, fork)
+		require.Error(t, err)
+	})
+
+	t.Run("RequiresValidators", func(t *testing.T) {
+		_, err := Fork(mockSourceAt(10), &genesis.GenesisDoc{}, fork)
+		require.Error(t, err)
+	})
+}
+
+func TestCompare(t *testing.T) {
+	require.NoError(t, Compare(mockSourceAt(10), mockSourceAt(10)))
+
+	// Different height
+	require.Error(t, Compare(mockSourceAt(10), mockSourceAt(11)))
+
+	// Truncated
+	truncated := mockSourceAt(10)
+	truncated.Events--
+	require.Error(t, Compare(mockSourceAt(10), truncated))
+	truncated = mockSourceAt(10)
+	truncated.Events--
+	require.Error(t, Compare(truncated, mockSourceAt(10)))
+}
+
+func mockSourceAt(height uint64) *MockSource {
+	mock := NewMockSource(10, 10, 5, 20)
+	mock.Mockchain = NewMockchain("Mockchain", height)
+	return mock
+}
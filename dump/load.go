@@ -13,97 +13,117 @@ import (
 	"github.com/hyperledger/burrow/txs/payload"
 )
 
-// Load a dump into state
+// Load a dump into state. Rows are read from source ahead of being applied and the storage of different accounts is
+// written concurrently. Storage is written to each account's storage tree in the order it appears in the dump and
+// every tree is first written in dump order so the resulting state is the same as if the rows were applied one by one.
 func Load(source Source, st *state.State) error {
 	_, _, err := st.Update(func(s state.Updatable) error {
-		txs := make([]*exec.TxExecution, 0)
+		done := make(chan struct{})
+		defer close(done)
 
-		var tx *exec.TxExecution
+		storage := newStorageWriters(DefaultPartitions)
+		txs, err := loadRows(prefetch(source, done), s, storage)
+		// Wait for storage to be written whether or not we succeeded
+		storage.close()
+		if err != nil {
+			return err
+		}
 
-		for {
-			row, err := source.Recv()
-			if err == io.EOF {
-				break
-			}
-			if err != nil {
-				return err
-			}
+		return s.AddBlock(&exec.BlockExecution{
+			Height:       0,
+			TxExecutions: txs,
+		})
+	})
+	return err
+}
 
-			if row.Account != nil {
-				if row.Account.Address != acm.GlobalPermissionsAddress {
-					for _, m := range row.Account.ContractMeta {
-						metahash := acmstate.GetMetadataHash(m.Metadata)
-						err = s.SetMetadata(metahash, m.Metadata)
-						if err != nil {
-							return err
-						}
-						m.MetadataHash = metahash.Bytes()
-						m.Metadata = ""
-					}
-					err := s.UpdateAccount(row.Account)
+func loadRows(source Source, s state.Updatable, storage *storageWriters) ([]*exec.TxExecution, error) {
+	txs := make([]*exec.TxExecution, 0)
+
+	var tx *exec.TxExecution
+
+	for {
+		row, err := source.Recv()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if row.Account != nil {
+			if row.Account.Address != acm.GlobalPermissionsAddress {
+				for _, m := range row.Account.ContractMeta {
+					metahash := acmstate.GetMetadataHash(m.Metadata)
+					err = s.SetMetadata(metahash, m.Metadata)
 					if err != nil {
-						return err
+						return nil, err
 					}
+					m.MetadataHash = metahash.Bytes()
+					m.Metadata = ""
+				}
+				err := s.UpdateAccount(row.Account)
+				if err != nil {
+					return nil, err
 				}
 			}
+		}
 
-			if row.AccountStorage != nil {
-				for _, storage := range row.AccountStorage.Storage {
-					err := s.SetStorage(row.AccountStorage.Address, storage.Key, storage.Value)
-					if err != nil {
-						return err
-					}
-				}
+		if row.AccountStorage != nil && len(row.AccountStorage.Storage) > 0 {
+			// Obtaining the setter marks the account's storage tree as written so must happen in dump order
+			setStorage, err := s.StorageSetter(row.AccountStorage.Address)
+			if err != nil {
+				return nil, err
 			}
+			storage.write(row.AccountStorage.Address.Bytes(), storageBatch{
+				setStorage: setStorage,
+				storage:    row.AccountStorage.Storage,
+			})
+		}
 
-			if row.Name != nil {
-				err := s.UpdateName(row.Name)
-				if err != nil {
-					return err
-				}
+		if row.Name != nil {
+			err := s.UpdateName(row.Name)
+			if err != nil {
+				return nil, err
 			}
+		}
 
-			if row.EVMEvent != nil {
-				if tx != nil && row.Height != tx.Height {
-					txs = append(txs, tx)
-					tx = nil
-				}
-				if tx == nil {
-					tx = &exec.TxExecution{
-						TxHeader: &exec.TxHeader{
-							TxHash: dumpTxHash(row.EVMEvent.ChainID, row.Height),
-							TxType: payload.TypeCall,
-							Origin: &exec.Origin{
-								ChainID: row.EVMEvent.ChainID,
-								Height:  row.Height,
-								Time:    row.EVMEvent.Time,
-								Index:   row.EVMEvent.Index,
-							},
+		if row.EVMEvent != nil {
+			if tx != nil && row.Height != tx.Height {
+				txs = append(txs, tx)
+				tx = nil
+			}
+			if tx == nil {
+				tx = &exec.TxExecution{
+					TxHeader: &exec.TxHeader{
+						TxHash: dumpTxHash(row.EVMEvent.ChainID, row.Height),
+						TxType: payload.TypeCall,
+						Origin: &exec.Origin{
+							ChainID: row.EVMEvent.ChainID,
+							Height:  row.Height,
+							Time:    row.EVMEvent.Time,
+							Index:   row.EVMEvent.Index,
 						},
-					}
-				}
-
-				tx.Events = append(tx.Events, &exec.Event{
-					Header: &exec.Header{
-						TxType:    payload.TypeCall,
-						EventType: exec.TypeLog,
-						Height:    row.Height,
 					},
-					Log: row.EVMEvent.Event,
-				})
+				}
 			}
-		}
 
-		if tx != nil {
-			txs = append(txs, tx)
+			tx.Events = append(tx.Events, &exec.Event{
+				Header: &exec.Header{
+					TxType:    payload.TypeCall,
+					EventType: exec.TypeLog,
+					Height:    row.Height,
+				},
+				Log: row.EVMEvent.Event,
+			})
 		}
+	}
 
-		return s.AddBlock(&exec.BlockExecution{
-			Height:       0,
-			TxExecutions: txs,
-		})
-	})
-	return err
+	if tx != nil {
+		txs = append(txs, tx)
+	}
+
+	return txs, nil
 }
 
 // Provides a psuedo-hash for the singular 'dump tx' that is generated by a restore
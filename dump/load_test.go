@@ -44,8 +44,9 @@ func TestLoadAndDump(t *testing.T) {
 	require.NoError(t, err)
 	dir, err := os.Getwd()
 	require.NoError(t, err)
-	src, err := NewFileReader(path.Join(dir, "test_dump.json"))
+	src, err := NewFileReader(path.Join(dir, "test_dump.json"), Trust{Unverified: true})
 	require.NoError(t, err)
+	defer src.Close()
 	err = Load(src, st)
 	require.NoError(t, err)
 
@@ -0,0 +1,251 @@
+package dump
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	bin "encoding/binary"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/ioutil"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/klauspost/compress/zstd"
+)
+
+type Compression string
+
+const (
+	NoCompression   Compression = ""
+	GzipCompression Compression = "gzip"
+	ZstdCompression Compression = "zstd"
+)
+
+// Leading bytes of a dump file written with a manifest
+var manifestMagic = []byte("burrow-dump-v1\n")
+
+// Length in bytes of the big-endian manifest length that terminates a dump file written with a manifest
+const manifestLengthBytes = 8
+
+var (
+	gzipMagic = []byte{0x1f, 0x8b}
+	zstdMagic = []byte{0x28, 0xb5, 0x2f, 0xfd}
+)
+
+func CompressionFromString(s string) (Compression, error) {
+	switch c := Compression(s); c {
+	case NoCompression, GzipCompression, ZstdCompression:
+		return c, nil
+	}
+	return NoCompression, fmt.Errorf("unknown dump compression '%s', expected one of '%s' or '%s'",
+		s, GzipCompression, ZstdCompression)
+}
+
+// Manifest trails the rows of a dump and records what they contain so that a truncated or tampered dump can be
+// detected before it is loaded
+type Manifest struct {
+	// The height at which state was dumped
+	Height uint64
+	// The ChainID of the dumped chain
+	ChainID string
+	// Record counts
+	Accounts uint64
+	Storage  uint64
+	Names    uint64
+	Events   uint64
+	// SHA256 hash of the uncompressed encoded rows
+	Hash binary.HexBytes
+	// Signatures over SignBytes
+	Signatures []ManifestSignature `json:",omitempty"`
+}
+
+type ManifestSignature struct {
+	PublicKey crypto.PublicKey
+	Signature *crypto.Signature
+}
+
+// SignBytes returns the canonical bytes of the manifest excluding its signatures
+func (m *Manifest) SignBytes() ([]byte, error) {
+	unsigned := *m
+	unsigned.Signatures = nil
+	return json.Marshal(unsigned)
+}
+
+// Sign appends a signature over SignBytes from each signer
+func (m *Manifest) Sign(signers ...acm.AddressableSigner) error {
+	bs, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		sig, err := signer.Sign(bs)
+		if err != nil {
+			return fmt.Errorf("could not sign dump manifest with %v: %v", signer.GetAddress(), err)
+		}
+		m.Signatures = append(m.Signatures, ManifestSignature{
+			PublicKey: signer.GetPublicKey(),
+			Signature: sig,
+		})
+	}
+	return nil
+}
+
+// Verify checks all signatures on the manifest and that every one of the required public keys has signed it
+func (m *Manifest) Verify(required ...crypto.PublicKey) error {
+	bs, err := m.SignBytes()
+	if err != nil {
+		return err
+	}
+	signed := make(map[crypto.Address]bool, len(m.Signatures))
+	for _, sig := range m.Signatures {
+		err = sig.PublicKey.Verify(bs, sig.Signature)
+		if err != nil {
+			return fmt.Errorf("invalid dump manifest signature from %v: %v", sig.PublicKey.GetAddress(), err)
+		}
+		signed[sig.PublicKey.GetAddress()] = true
+	}
+	for _, pk := range required {
+		if !signed[pk.GetAddress()] {
+			return fmt.Errorf("dump manifest is not signed by required key %v", pk.GetAddress())
+		}
+	}
+	return nil
+}
+
+// Check that the manifest describes the rows counted in other
+func (m *Manifest) check(other *Manifest) error {
+	if m.Accounts != other.Accounts || m.Storage != other.Storage || m.Names != other.Names ||
+		m.Events != other.Events {
+		return fmt.Errorf("dump manifest records %d accounts, %d storage, %d names, and %d events but dump "+
+			"contains %d accounts, %d storage, %d names, and %d events", m.Accounts, m.Storage, m.Names, m.Events,
+			other.Accounts, other.Storage, other.Names, other.Events)
+	}
+	if m.Height != other.Height {
+		return fmt.Errorf("dump manifest records height %d but dump contains state from height %d",
+			m.Height, other.Height)
+	}
+	if !bytes.Equal(m.Hash, other.Hash) {
+		return fmt.Errorf("dump manifest records hash %v but dump has hash %v", m.Hash, other.Hash)
+	}
+	return nil
+}
+
+// Accumulates the manifest for rows as they are written or read
+type manifestCounter struct {
+	manifest Manifest
+	hasher   hash.Hash
+}
+
+func newManifestCounter() *manifestCounter {
+	return &manifestCounter{hasher: sha256.New()}
+}
+
+func (mc *manifestCounter) count(row *Dump) {
+	if row.Account != nil {
+		mc.manifest.Accounts++
+	}
+	if row.AccountStorage != nil {
+		mc.manifest.Storage += uint64(len(row.AccountStorage.Storage))
+	}
+	if row.Name != nil {
+		mc.manifest.Names++
+	}
+	if row.EVMEvent != nil {
+		mc.manifest.Events++
+	} else if row.Height > mc.manifest.Height {
+		mc.manifest.Height = row.Height
+	}
+}
+
+func (mc *manifestCounter) Manifest() *Manifest {
+	manifest := mc.manifest
+	manifest.Hash = mc.hasher.Sum(nil)
+	return &manifest
+}
+
+func writeManifest(out io.Writer, manifest *Manifest) error {
+	bs, err := json.Marshal(manifest)
+	if err != nil {
+		return fmt.Errorf("could not marshal dump manifest: %v", err)
+	}
+	length := make([]byte, manifestLengthBytes)
+	bin.BigEndian.PutUint64(length, uint64(len(bs)))
+	_, err = out.Write(append(bs, length...))
+	return err
+}
+
+// Reads the manifest from the end of a dump file of size bytes returning the manifest and the section containing
+// the rows
+func readManifest(r io.ReaderAt, size int64) (*Manifest, *io.SectionReader, error) {
+	const errHeader = "could not read dump manifest, dump may be truncated:"
+	start := int64(len(manifestMagic))
+	if size < start+manifestLengthBytes {
+		return nil, nil, fmt.Errorf("%s dump is only %d bytes", errHeader, size)
+	}
+	bs := make([]byte, manifestLengthBytes)
+	_, err := r.ReadAt(bs, size-manifestLengthBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	length := bin.BigEndian.Uint64(bs)
+	end := size - manifestLengthBytes - int64(length)
+	if length > uint64(size) || end < start {
+		return nil, nil, fmt.Errorf("%s manifest length %d exceeds dump size", errHeader, length)
+	}
+	bs = make([]byte, length)
+	_, err = r.ReadAt(bs, end)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	manifest := new(Manifest)
+	err = json.Unmarshal(bs, manifest)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%s %v", errHeader, err)
+	}
+	return manifest, io.NewSectionReader(r, start, end-start), nil
+}
+
+func compress(out io.Writer, compression Compression) (io.WriteCloser, error) {
+	switch compression {
+	case GzipCompression:
+		return gzip.NewWriter(out), nil
+	case ZstdCompression:
+		return zstd.NewWriter(out)
+	case NoCompression:
+		return nopCloser{out}, nil
+	}
+	return nil, fmt.Errorf("unknown dump compression '%s'", compression)
+}
+
+// Returns a reader for the possibly compressed stream in, detecting compression from its leading bytes
+func decompress(in io.Reader) (io.ReadCloser, error) {
+	buf := bufio.NewReader(in)
+	header, err := buf.Peek(len(zstdMagic))
+	if err != nil && err != io.EOF {
+		return nil, err
+	}
+	switch {
+	case bytes.HasPrefix(header, gzipMagic):
+		return gzip.NewReader(buf)
+	case bytes.HasPrefix(header, zstdMagic):
+		dec, err := zstd.NewReader(buf)
+		if err != nil {
+			return nil, err
+		}
+		return dec.IOReadCloser(), nil
+	}
+	return ioutil.NopCloser(buf), nil
+}
+
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
@@ -0,0 +1,154 @@
+package dump
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWriteWithManifest(t *testing.T) {
+	signer := acm.GeneratePrivateAccountFromSecret("dumper")
+	for _, compression := range []Compression{NoCompression, GzipCompression, ZstdCompression} {
+		for _, binary := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s/binary=%t", compression, binary), func(t *testing.T) {
+				bs := writeManifestDump(t, binary, compression, signer)
+				filename := writeTempFile(t, bs)
+				defer os.Remove(filename)
+
+				manifest, err := ReadManifest(filename)
+				require.NoError(t, err)
+				require.NotNil(t, manifest)
+				assert.Equal(t, uint64(10), manifest.Height)
+				assert.Equal(t, "Mockchain", manifest.ChainID)
+				assert.Equal(t, uint64(11), manifest.Accounts)
+				assert.Equal(t, uint64(5), manifest.Names)
+				assert.Equal(t, uint64(20), manifest.Events)
+				require.NoError(t, manifest.Verify(signer.GetPublicKey()))
+
+				src, err := NewFileReader(filename, Trust{Signers: []crypto.Address{signer.GetAddress()}})
+				require.NoError(t, err)
+				defer src.Close()
+				assert.Equal(t, manifest, src.Manifest())
+				require.NoError(t, Compare(mockSourceAt(10), src))
+			})
+		}
+	}
+}
+
+func TestNewFileReaderRejects(t *testing.T) {
+	signer := acm.GeneratePrivateAccountFromSecret("dumper")
+	other := acm.GeneratePrivateAccountFromSecret("other")
+	trust := Trust{Signers: []crypto.Address{signer.GetAddress()}}
+	bs := writeManifestDump(t, false, GzipCompression, signer)
+
+	t.Run("Truncated", func(t *testing.T) {
+		for _, n := range []int{1, manifestLengthBytes + 1, len(bs) / 2} {
+			filename := writeTempFile(t, bs[:len(bs)-n])
+			_, err := NewFileReader(filename, trust)
+			os.Remove(filename)
+			require.Error(t, err)
+		}
+	})
+
+	t.Run("Tampered", func(t *testing.T) {
+		tampered := make([]byte, len(bs))
+		copy(tampered, bs)
+		// Flip a bit in the compressed rows
+		tampered[len(manifestMagic)+len(bs)/4] ^= 1
+		filename := writeTempFile(t, tampered)
+		defer os.Remove(filename)
+		_, err := NewFileReader(filename, trust)
+		require.Error(t, err)
+	})
+
+	t.Run("ForgedManifest", func(t *testing.T) {
+		forged := bytes.Replace(bs, []byte(`"ChainID":"Mockchain"`), []byte(`"ChainID":"Mockchaim"`), 1)
+		require.NotEqual(t, bs, forged)
+		filename := writeTempFile(t, forged)
+		defer os.Remove(filename)
+		_, err := NewFileReader(filename, trust)
+		require.Error(t, err)
+	})
+
+	t.Run("MissingSigner", func(t *testing.T) {
+		filename := writeTempFile(t, bs)
+		defer os.Remove(filename)
+		manifest, err := ReadManifest(filename)
+		require.NoError(t, err)
+		require.Error(t, manifest.Verify(other.GetPublicKey()))
+		_, err = NewFileReader(filename, Trust{Signers: []crypto.Address{other.GetAddress()}})
+		require.Error(t, err)
+	})
+
+	t.Run("UntrustedSigner", func(t *testing.T) {
+		// A dump re-signed after tampering with a key that is not trusted
+		filename := writeTempFile(t, writeManifestDump(t, false, GzipCompression, signer, other))
+		defer os.Remove(filename)
+		_, err := NewFileReader(filename, trust)
+		require.Error(t, err)
+	})
+
+	t.Run("OtherChain", func(t *testing.T) {
+		filename := writeTempFile(t, bs)
+		defer os.Remove(filename)
+		_, err := NewFileReader(filename, Trust{Signers: trust.Signers, ChainID: "Otherchain"})
+		require.Error(t, err)
+		src, err := NewFileReader(filename, Trust{Signers: trust.Signers, ChainID: "Mockchain"})
+		require.NoError(t, err)
+		require.NoError(t, src.Close())
+	})
+
+	t.Run("Unsigned", func(t *testing.T) {
+		filename := writeTempFile(t, writeManifestDump(t, false, GzipCompression))
+		defer os.Remove(filename)
+		_, err := NewFileReader(filename, trust)
+		require.Error(t, err)
+		_, err = NewFileReader(filename, Trust{Unverified: true})
+		require.NoError(t, err)
+	})
+}
+
+func TestNewFileReaderWithoutManifest(t *testing.T) {
+	buf := new(bytes.Buffer)
+	compressor, err := compress(buf, GzipCompression)
+	require.NoError(t, err)
+	require.NoError(t, Write(compressor, mockSourceAt(10), true, All))
+	require.NoError(t, compressor.Close())
+	filename := writeTempFile(t, buf.Bytes())
+	defer os.Remove(filename)
+
+	manifest, err := ReadManifest(filename)
+	require.NoError(t, err)
+	assert.Nil(t, manifest)
+
+	// Dumps written before manifests were introduced can still be read, without verification
+	signer := acm.GeneratePrivateAccountFromSecret("dumper")
+	src, err := NewFileReader(filename, Trust{Signers: []crypto.Address{signer.GetAddress()}})
+	require.NoError(t, err)
+	defer src.Close()
+	assert.Nil(t, src.Manifest())
+	require.NoError(t, Compare(mockSourceAt(10), src))
+}
+
+func writeManifestDump(t *testing.T, binary bool, compression Compression, signers ...acm.AddressableSigner) []byte {
+	buf := new(bytes.Buffer)
+	_, err := WriteWithManifest(buf, mockSourceAt(10), binary, compression, "Mockchain", signers...)
+	require.NoError(t, err)
+	return buf.Bytes()
+}
+
+func writeTempFile(t *testing.T, bs []byte) string {
+	dir, err := ioutil.TempDir("", "TestManifest")
+	require.NoError(t, err)
+	filename := path.Join(dir, "dump")
+	require.NoError(t, ioutil.WriteFile(filename, bs, 0644))
+	return filename
+}
@@ -0,0 +1,161 @@
+package dump
+
+import (
+	bin "encoding/binary"
+	"errors"
+	"hash/fnv"
+	"io"
+	"runtime"
+	"sync"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution/state"
+)
+
+// The number of partitions of the account keyspace read concurrently by Transmit by default
+var DefaultPartitions = runtime.NumCPU()
+
+// The number of rows each partition may read ahead of the partition currently being sent
+const partitionBufferRows = 64
+
+var errPartitionAborted = errors.New("dump partition aborted")
+
+// Transmit accounts by partitioning the account keyspace into contiguous ranges of addresses that are each read
+// concurrently. Rows are sent to sink in address order so that the output is identical to reading the keyspace
+// sequentially (which is required for a restore to produce the same state hash).
+func (ds *Dumper) transmitAccounts(sink Sink, st *state.ReadState, height uint64) error {
+	if ds.partitions <= 1 {
+		return ds.transmitAccountRange(sink, st, height, nil, nil)
+	}
+	bounds := partitionBounds(ds.partitions)
+	done := make(chan struct{})
+	defer close(done)
+
+	partitions := make([]chan msg, len(bounds)-1)
+	for i := range partitions {
+		partitions[i] = make(chan msg, partitionBufferRows)
+		go func(rows chan msg, start, end []byte) {
+			defer close(rows)
+			err := ds.transmitAccountRange(partitionSink{rows: rows, done: done}, st, height, start, end)
+			if err != nil && err != errPartitionAborted {
+				select {
+				case rows <- msg{err: err}:
+				case <-done:
+				}
+			}
+		}(partitions[i], bounds[i], bounds[i+1])
+	}
+
+	for _, rows := range partitions {
+		for m := range rows {
+			if m.err != nil {
+				return m.err
+			}
+			err := sink.Send(m.dump)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// Returns the partitions+1 boundaries of partitions contiguous ranges of the address keyspace, with nil for the
+// unbounded first and last boundaries
+func partitionBounds(partitions int) [][]byte {
+	// Partition on the first two bytes of the address
+	const keyspace = 1 << 16
+	if partitions > keyspace {
+		partitions = keyspace
+	}
+	bounds := make([][]byte, partitions+1)
+	for i := 1; i < partitions; i++ {
+		bounds[i] = make([]byte, 2)
+		bin.BigEndian.PutUint16(bounds[i], uint16(i*keyspace/partitions))
+	}
+	return bounds
+}
+
+type partitionSink struct {
+	rows chan<- msg
+	done <-chan struct{}
+}
+
+func (ps partitionSink) Send(row *Dump) error {
+	select {
+	case ps.rows <- msg{dump: row}:
+		return nil
+	case <-ps.done:
+		return errPartitionAborted
+	}
+}
+
+// Writes account storage to the storage trees of accounts concurrently. Each account's storage is always written by
+// the same worker so storage is written to each tree in the order it was received.
+type storageWriters struct {
+	workers []chan storageBatch
+	wg      sync.WaitGroup
+}
+
+type storageBatch struct {
+	setStorage func(key binary.Word256, value []byte)
+	storage    []*Storage
+}
+
+func newStorageWriters(workers int) *storageWriters {
+	sw := &storageWriters{
+		workers: make([]chan storageBatch, workers),
+	}
+	for i := range sw.workers {
+		batches := make(chan storageBatch, partitionBufferRows)
+		sw.workers[i] = batches
+		sw.wg.Add(1)
+		go func() {
+			defer sw.wg.Done()
+			for batch := range batches {
+				for _, st := range batch.storage {
+					batch.setStorage(st.Key, st.Value)
+				}
+			}
+		}()
+	}
+	return sw
+}
+
+// Queue storage for writing to the storage tree of the account with the given address
+func (sw *storageWriters) write(address []byte, batch storageBatch) {
+	hasher := fnv.New32a()
+	hasher.Write(address)
+	sw.workers[hasher.Sum32()%uint32(len(sw.workers))] <- batch
+}
+
+// Wait for all queued storage to be written
+func (sw *storageWriters) close() {
+	for _, batches := range sw.workers {
+		close(batches)
+	}
+	sw.wg.Wait()
+}
+
+// Reads rows from source ahead of them being received from the returned Source until done is closed
+func prefetch(source Source, done <-chan struct{}) Source {
+	rows := make(Pipe, partitionBufferRows)
+	go func() {
+		defer close(rows)
+		for {
+			row, err := source.Recv()
+			if err == io.EOF {
+				return
+			}
+			select {
+			case rows <- msg{dump: row, err: err}:
+				if err != nil {
+					return
+				}
+			case <-done:
+				return
+			}
+		}
+	}()
+	return rows
+}
@@ -0,0 +1,76 @@
+package dump
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPartitionBounds(t *testing.T) {
+	bounds := partitionBounds(4)
+	require.Len(t, bounds, 5)
+	assert.Nil(t, bounds[0])
+	assert.Equal(t, []byte{0x40, 0}, bounds[1])
+	assert.Equal(t, []byte{0x80, 0}, bounds[2])
+	assert.Equal(t, []byte{0xc0, 0}, bounds[3])
+	assert.Nil(t, bounds[4])
+
+	assert.Len(t, partitionBounds(1<<20), 1<<16+1)
+}
+
+func TestParallelDumpAndLoad(t *testing.T) {
+	defer func(partitions int) {
+		DefaultPartitions = partitions
+	}(DefaultPartitions)
+
+	// Use random addresses so that accounts are spread over partitions
+	st := testLoad(t, NewMockSource(0, 0, 0, 0))
+	_, _, err := st.Update(func(up state.Updatable) error {
+		source := NewMockSource(200, 300, 10, 50)
+		for i := 0; i < 200; i++ {
+			row, err := source.Recv()
+			require.NoError(t, err)
+			row.Account.Address[0] = byte(source.rand.Intn(256))
+			row.Account.Address[1] = byte(source.rand.Intn(256))
+			require.NoError(t, up.UpdateAccount(row.Account))
+			if row.AccountStorage != nil {
+				for _, storage := range row.AccountStorage.Storage {
+					require.NoError(t, up.SetStorage(row.Account.Address, storage.Key, storage.Value))
+				}
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+
+	sequential := &CollectSink{}
+	require.NoError(t, NewDumper(st, NewMockchain("Mockchain", 0)).WithPartitions(1).
+		Transmit(sequential, 0, 0, All))
+
+	for _, partitions := range []int{2, 3, 16} {
+		t.Run(fmt.Sprintf("partitions=%d", partitions), func(t *testing.T) {
+			parallel := &CollectSink{}
+			require.NoError(t, NewDumper(st, NewMockchain("Mockchain", 0)).WithPartitions(partitions).
+				Transmit(parallel, 0, 0, All))
+			assert.Equal(t, sequential.Rows, parallel.Rows)
+
+			DefaultPartitions = 1
+			expected := loadSink(t, sequential)
+			DefaultPartitions = partitions
+			actual := loadSink(t, parallel)
+			assert.Equal(t, expected.Hash(), actual.Hash())
+		})
+	}
+}
+
+func loadSink(t *testing.T, sink *CollectSink) *state.State {
+	st, err := state.MakeGenesisState(testDB(t), &genesis.GenesisDoc{GlobalPermissions: permission.DefaultAccountPermissions})
+	require.NoError(t, err)
+	require.NoError(t, Load(sink, st))
+	return st
+}
@@ -1,11 +1,13 @@
 package dump
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io"
 	"os"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/encoding"
 )
 
@@ -18,17 +20,124 @@ type StreamReader struct {
 	decode func(*Dump) error
 }
 
-func NewFileReader(filename string) (Source, error) {
+// FileReader is a Source reading a dump file, which it holds open until closed
+type FileReader struct {
+	*StreamReader
+	manifest *Manifest
+	closers  []io.Closer
+}
+
+// Trust determines which dumps may be read
+type Trust struct {
+	// Addresses of the keys trusted to sign dump manifests. A manifest must be signed by at least one of them and by
+	// no other key.
+	Signers []crypto.Address
+	// Read dumps with a manifest signed by any key (or none), though the contents of the dump are still checked
+	// against it
+	Unverified bool
+	// The ChainID of the chain from which the dump must have been taken, if known
+	ChainID string
+}
+
+// Verify checks that the possibly nil manifest satisfies trust. Dumps written without a manifest (as they were before
+// manifests were introduced) cannot be verified so are accepted, it is up to the caller to warn about them.
+func (trust Trust) Verify(manifest *Manifest) error {
+	if manifest == nil {
+		return nil
+	}
+	if trust.ChainID != "" && manifest.ChainID != trust.ChainID {
+		return fmt.Errorf("dump manifest records ChainID %s but dump was expected from chain %s",
+			manifest.ChainID, trust.ChainID)
+	}
+	if !trust.Unverified {
+		if len(manifest.Signatures) == 0 {
+			return fmt.Errorf("dump manifest is not signed")
+		}
+		trusted := make(map[crypto.Address]bool, len(trust.Signers))
+		for _, address := range trust.Signers {
+			trusted[address] = true
+		}
+		for _, sig := range manifest.Signatures {
+			if !trusted[sig.PublicKey.GetAddress()] {
+				return fmt.Errorf("dump manifest is signed by untrusted key %v", sig.PublicKey.GetAddress())
+			}
+		}
+	}
+	return manifest.Verify()
+}
+
+// NewFileReader returns a FileReader reading the dump in filename, which the caller must close. Compression and
+// encoding are detected automatically. The manifest is checked against trust and the entire dump is checked against
+// the manifest before the FileReader is returned.
+func NewFileReader(filename string, trust Trust) (*FileReader, error) {
 	f, err := os.OpenFile(filename, os.O_RDONLY, 0644)
 	if err != nil {
 		return nil, err
 	}
-	decoder, err := decoderFor(f)
+
+	fr, err := newFileReader(f, filename, trust)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return fr, nil
+}
+
+func newFileReader(f *os.File, filename string, trust Trust) (*FileReader, error) {
+	manifest, body, err := openFile(f)
+	if err != nil {
+		return nil, err
+	}
+
+	err = trust.Verify(manifest)
+	if err != nil {
+		return nil, fmt.Errorf("dump file %s failed verification: %v", filename, err)
+	}
+
+	if manifest != nil {
+		err = verifyManifest(manifest, body)
+		if err != nil {
+			return nil, fmt.Errorf("dump file %s failed verification: %v", filename, err)
+		}
+	}
+
+	reader, decompressor, err := newStreamReaderDetectingEncoding(body)
 	if err != nil {
 		return nil, err
 	}
+	return &FileReader{
+		StreamReader: reader,
+		manifest:     manifest,
+		closers:      []io.Closer{decompressor, f},
+	}, nil
+}
+
+// Manifest returns the verified manifest of the dump or nil if it was written without one
+func (fr *FileReader) Manifest() *Manifest {
+	return fr.manifest
+}
+
+// Close releases the dump file
+func (fr *FileReader) Close() error {
+	var firstErr error
+	for _, closer := range fr.closers {
+		err := closer.Close()
+		if err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
 
-	return NewStreamReader(f, decoder)
+// ReadManifest returns the manifest of the dump in filename or nil if it was written without one
+func ReadManifest(filename string) (*Manifest, error) {
+	f, err := os.OpenFile(filename, os.O_RDONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	manifest, _, err := openFile(f)
+	return manifest, err
 }
 
 func NewProtobufReader(reader io.Reader) (*StreamReader, error) {
@@ -46,6 +155,30 @@ func NewStreamReader(reader io.Reader, decode func(*Dump) error) (*StreamReader,
 	}, nil
 }
 
+// NewStreamReaderDetectingEncoding returns a StreamReader over the possibly compressed dump contained in body
+func NewStreamReaderDetectingEncoding(body *io.SectionReader) (*StreamReader, error) {
+	reader, _, err := newStreamReaderDetectingEncoding(body)
+	return reader, err
+}
+
+// Also returns the decompressor the StreamReader reads from so that it can be closed
+func newStreamReaderDetectingEncoding(body *io.SectionReader) (*StreamReader, io.Closer, error) {
+	decoder, err := decoderFor(body)
+	if err != nil {
+		return nil, nil, err
+	}
+	reader, err := decompress(rewind(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	sr, err := NewStreamReader(reader, decoder(reader))
+	if err != nil {
+		reader.Close()
+		return nil, nil, err
+	}
+	return sr, reader, nil
+}
+
 func (sr *StreamReader) Recv() (*Dump, error) {
 	row := new(Dump)
 
@@ -70,20 +203,80 @@ func jsonDecoder(r io.Reader) func(*Dump) error {
 	}
 }
 
-// Detects whether dump file appears to be protobuf or JSON encoded by trying to decode the first row with each
-func decoderFor(f *os.File) (func(*Dump) error, error) {
-	defer f.Seek(0, 0)
+// Returns the manifest (if there is one) and the section of the file containing the rows of the dump
+func openFile(f *os.File) (*Manifest, *io.SectionReader, error) {
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, nil, err
+	}
+	header := make([]byte, len(manifestMagic))
+	_, err = f.ReadAt(header, 0)
+	if err == nil && bytes.Equal(header, manifestMagic) {
+		return readManifest(f, stat.Size())
+	}
+	return nil, io.NewSectionReader(f, 0, stat.Size()), nil
+}
 
-	jsonErr := json.NewDecoder(f).Decode(&Dump{})
+// Reads every row of the dump checking the rows against the manifest
+func verifyManifest(manifest *Manifest, body *io.SectionReader) error {
+	decoder, err := decoderFor(body)
+	if err != nil {
+		return err
+	}
+	reader, err := decompress(rewind(body))
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+	counter := newManifestCounter()
+	// Hash the uncompressed bytes exactly as they were written
+	decode := decoder(io.TeeReader(reader, counter.hasher))
+	for {
+		row := new(Dump)
+		err = decode(row)
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		counter.count(row)
+	}
+	// Consume any trailing whitespace so it is included in the hash
+	_, err = io.Copy(counter.hasher, reader)
+	if err != nil {
+		return err
+	}
+	return manifest.check(counter.Manifest())
+}
+
+// Detects whether dump file appears to be protobuf or JSON encoded by trying to decode the first row with each
+func decoderFor(body *io.SectionReader) (func(io.Reader) func(*Dump) error, error) {
+	reader, err := decompress(rewind(body))
+	if err != nil {
+		return nil, err
+	}
+	jsonErr := json.NewDecoder(reader).Decode(&Dump{})
+	reader.Close()
 	if jsonErr == nil || jsonErr == io.EOF {
-		return jsonDecoder(f), nil
+		return jsonDecoder, nil
 	}
 
-	_, binErr := encoding.ReadMessage(f, &Dump{})
+	reader, err = decompress(rewind(body))
+	if err != nil {
+		return nil, err
+	}
+	_, binErr := encoding.ReadMessage(reader, &Dump{})
+	reader.Close()
 	if binErr != nil && binErr != io.EOF {
 		return nil, fmt.Errorf("could decode first row of dump file as protobuf (%v) or JSON (%v)",
 			binErr, jsonErr)
 	}
 
-	return protobufDecoder(f), nil
+	return protobufDecoder, nil
+}
+
+// Returns an independent reader from the start of body
+func rewind(body *io.SectionReader) io.Reader {
+	return io.NewSectionReader(body, 0, body.Size())
 }
@@ -17,8 +17,8 @@ import (
 
 const DefaultEventBufferCapacity = 2 << 10
 
-// TODO: manage the creation, closing, and draining of channels behind subscribe rather than only closing.
-// stop one subscriber from blocking everything!
+// DefaultSubscriptionMessageBudget bounds the total number of messages that subscribers may leave buffered
+const DefaultSubscriptionMessageBudget = 1 << 20
 
 // Emitter has methods for working with events
 type Emitter struct {
@@ -29,7 +29,8 @@ type Emitter struct {
 
 // NewEmitter initializes an emitter struct with a pubsubServer
 func NewEmitter() *Emitter {
-	pubsubServer := pubsub.NewServer(pubsub.BufferCapacity(DefaultEventBufferCapacity))
+	pubsubServer := pubsub.NewServer(pubsub.BufferCapacity(DefaultEventBufferCapacity),
+		pubsub.MessageBudget(DefaultSubscriptionMessageBudget))
 	pubsubServer.BaseService = *service.NewBaseService(nil, "Emitter", pubsubServer)
 	pubsubServer.Start()
 	return &Emitter{
@@ -55,13 +56,15 @@ func (em *Emitter) Publish(ctx context.Context, message interface{}, tags query.
 	return em.pubsubServer.PublishWithTags(ctx, message, tags)
 }
 
-// Subscribe tells the emitter to listen for messages on the given query
-func (em *Emitter) Subscribe(ctx context.Context, subscriber string, queryable query.Queryable, bufferSize int) (<-chan interface{}, error) {
+// Subscribe tells the emitter to listen for messages on the given query, options determine how a subscriber that does
+// not keep up is handled (by default messages that do not fit in its buffer are dropped)
+func (em *Emitter) Subscribe(ctx context.Context, subscriber string, queryable query.Queryable, bufferSize int,
+	options ...pubsub.SubscribeOption) (<-chan interface{}, error) {
 	qry, err := queryable.Query()
 	if err != nil {
 		return nil, err
 	}
-	return em.pubsubServer.Subscribe(ctx, subscriber, qry, bufferSize)
+	return em.pubsubServer.Subscribe(ctx, subscriber, qry, bufferSize, options...)
 }
 
 // Unsubscribe tells the emitter to stop listening for said messages
@@ -78,6 +81,16 @@ func (em *Emitter) UnsubscribeAll(ctx context.Context, subscriber string) error
 	return em.pubsubServer.UnsubscribeAll(ctx, subscriber)
 }
 
+// Err returns pubsub.ErrSlowSubscriber if a subscription was closed because the subscriber did not keep up
+func (em *Emitter) Err(subscriber string) error {
+	return em.pubsubServer.Err(subscriber)
+}
+
+// Stats returns statistics on subscriptions including those that are dropping messages
+func (em *Emitter) Stats() pubsub.Stats {
+	return em.pubsubServer.Stats()
+}
+
 // ***************
 // Helper function
 
@@ -17,6 +17,8 @@ import (
 	"context"
 	"errors"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/logging"
@@ -41,12 +43,20 @@ var (
 	// ErrAlreadySubscribed is returned when a client tries to subscribe twice or
 	// more using the same query.
 	ErrAlreadySubscribed = errors.New("already subscribed")
+
+	// ErrMessageBudgetExceeded is returned when a client tries to subscribe with
+	// an out buffer larger than the capacity remaining in the MessageBudget.
+	ErrMessageBudgetExceeded = errors.New("subscription message budget exceeded")
+
+	// ErrSlowSubscriber is returned by Err for a client that had a subscription
+	// disconnected for not keeping up with published messages.
+	ErrSlowSubscriber = errors.New("subscription disconnected for not keeping up with published messages")
 )
 
 type cmd struct {
 	op       operation
 	query    query.Query
-	ch       chan interface{}
+	sub      *subscription
 	clientID string
 	msg      interface{}
 	tags     query.Tagged
@@ -62,7 +72,18 @@ type Server struct {
 
 	mtx           sync.RWMutex
 	subscriptions map[string]map[string]query.Query // subscriber -> query (string) -> query.Query
+	errs          map[string]error                  // subscriber -> reason a subscription was disconnected
 	logger        *logging.Logger
+
+	// Total out buffer capacity (in messages) subscriptions may reserve, unlimited if zero
+	messageBudget int64
+	// Out buffer capacity reserved, dropped messages, and disconnected subscriptions (accessed atomically)
+	capacity     int64
+	dropped      uint64
+	disconnected uint64
+	// Guards the loop's state for Stats
+	stateMtx sync.RWMutex
+	state    *state
 }
 
 // Option sets a parameter for the server.
@@ -74,6 +95,7 @@ type Option func(*Server)
 func NewServer(options ...Option) *Server {
 	s := &Server{
 		subscriptions: make(map[string]map[string]query.Query),
+		errs:          make(map[string]error),
 		logger:        logging.NewNoopLogger(),
 	}
 	s.BaseService = *service.NewBaseService(nil, "PubSub", s)
@@ -100,6 +122,18 @@ func BufferCapacity(cap int) Option {
 	}
 }
 
+// MessageBudget bounds the total capacity, counted in messages rather than
+// bytes, of subscriptions' out buffers and so the number of messages slow
+// subscribers can hold on to. Subscribe returns ErrMessageBudgetExceeded rather
+// than exceed it. By default it is unlimited.
+func MessageBudget(budget int) Option {
+	return func(s *Server) {
+		if budget > 0 {
+			s.messageBudget = int64(budget)
+		}
+	}
+}
+
 func WithLogger(logger *logging.Logger) Option {
 	return func(s *Server) {
 		s.logger = logger.WithScope("PubSub")
@@ -111,11 +145,14 @@ func (s *Server) BufferCapacity() int {
 	return s.cmdsCap
 }
 
-// Subscribe creates a subscription for the given client. It accepts a channel
-// on which messages matching the given query can be received. An error will be
-// returned to the caller if the context is canceled or if subscription already
-// exist for pair clientID and query.
-func (s *Server) Subscribe(ctx context.Context, clientID string, qry query.Query, outBuffer int) (<-chan interface{}, error) {
+// Subscribe creates a subscription for the given client. It returns a channel
+// on which messages matching the given query can be received, buffering up to
+// outBuffer of them. What happens when the buffer is full is determined by the
+// subscription's Policy (see WithPolicy). An error will be returned to the
+// caller if the context is canceled, if subscription already exist for pair
+// clientID and query, or if the buffer would exceed the MessageBudget.
+func (s *Server) Subscribe(ctx context.Context, clientID string, qry query.Query, outBuffer int,
+	options ...SubscribeOption) (<-chan interface{}, error) {
 	s.mtx.RLock()
 	clientSubscriptions, ok := s.subscriptions[clientID]
 	if ok {
@@ -125,10 +162,19 @@ func (s *Server) Subscribe(ctx context.Context, clientID string, qry query.Query
 	if ok {
 		return nil, ErrAlreadySubscribed
 	}
+	if !s.reserve(outBuffer) {
+		return nil, ErrMessageBudgetExceeded
+	}
 	// We are responsible for closing this channel so we create it
-	out := make(chan interface{}, outBuffer)
+	subscription := &subscription{
+		out:          make(chan interface{}, outBuffer),
+		blockTimeout: DefaultBlockTimeout,
+	}
+	for _, option := range options {
+		option(subscription)
+	}
 	select {
-	case s.cmds <- cmd{op: sub, clientID: clientID, query: qry, ch: out}:
+	case s.cmds <- cmd{op: sub, clientID: clientID, query: qry, sub: subscription}:
 		s.mtx.Lock()
 		if _, ok = s.subscriptions[clientID]; !ok {
 			s.subscriptions[clientID] = make(map[string]query.Query)
@@ -137,12 +183,27 @@ func (s *Server) Subscribe(ctx context.Context, clientID string, qry query.Query
 		// see Unsubscribe
 		s.subscriptions[clientID][qry.String()] = qry
 		s.mtx.Unlock()
-		return out, nil
+		return subscription.out, nil
 	case <-ctx.Done():
+		s.release(outBuffer)
 		return nil, ctx.Err()
 	}
 }
 
+// Reserves out buffer capacity returning false if it would exceed the budget
+func (s *Server) reserve(capacity int) bool {
+	reserved := atomic.AddInt64(&s.capacity, int64(capacity))
+	if s.messageBudget > 0 && reserved > s.messageBudget {
+		s.release(capacity)
+		return false
+	}
+	return true
+}
+
+func (s *Server) release(capacity int) {
+	atomic.AddInt64(&s.capacity, -int64(capacity))
+}
+
 // Unsubscribe removes the subscription on the given query. An error will be
 // returned to the caller if the context is canceled or if subscription does
 // not exist.
@@ -184,6 +245,7 @@ func (s *Server) UnsubscribeAll(ctx context.Context, clientID string) error {
 	case s.cmds <- cmd{op: unsub, clientID: clientID}:
 		s.mtx.Lock()
 		delete(s.subscriptions, clientID)
+		delete(s.errs, clientID)
 		s.mtx.Unlock()
 		return nil
 	case <-ctx.Done():
@@ -191,6 +253,40 @@ func (s *Server) UnsubscribeAll(ctx context.Context, clientID string) error {
 	}
 }
 
+// Err returns ErrSlowSubscriber if a subscription of the client has been
+// disconnected (by closing its channel) for not keeping up with published
+// messages, until the client calls UnsubscribeAll.
+func (s *Server) Err(clientID string) error {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.errs[clientID]
+}
+
+// Stats returns statistics on subscriptions and their buffers.
+func (s *Server) Stats() Stats {
+	stats := Stats{
+		Capacity:     int(atomic.LoadInt64(&s.capacity)),
+		Dropped:      atomic.LoadUint64(&s.dropped),
+		Disconnected: atomic.LoadUint64(&s.disconnected),
+	}
+	s.stateMtx.RLock()
+	defer s.stateMtx.RUnlock()
+	if s.state == nil {
+		return stats
+	}
+	for _, clientToSubscription := range s.state.queries {
+		for _, sub := range clientToSubscription {
+			stats.Subscriptions++
+			buffered := len(sub.out)
+			stats.Buffered += buffered
+			if buffered == cap(sub.out) && buffered > 0 {
+				stats.Lagging++
+			}
+		}
+	}
+	return stats
+}
+
 // Publish publishes the given message. An error will be returned to the caller
 // if the context is canceled.
 func (s *Server) Publish(ctx context.Context, msg interface{}) error {
@@ -214,22 +310,28 @@ func (s *Server) OnStop() {
 	s.cmds <- cmd{op: shutdown}
 }
 
-// NOTE: not goroutine safe
+// NOTE: not goroutine safe, only the loop may modify state and it must hold
+// Server.stateMtx to do so
 type state struct {
-	// query -> client -> ch
-	queries map[query.Query]map[string]chan interface{}
+	// query -> client -> subscription
+	queries map[query.Query]map[string]*subscription
 	// client -> query -> struct{}
 	clients map[string]map[query.Query]struct{}
+	server  *Server
 	logger  *logging.Logger
 }
 
 // OnStart implements Service.OnStart by starting the server.
 func (s *Server) OnStart() error {
-	go s.loop(state{
-		queries: make(map[query.Query]map[string]chan interface{}),
+	s.stateMtx.Lock()
+	s.state = &state{
+		queries: make(map[query.Query]map[string]*subscription),
 		clients: make(map[string]map[query.Query]struct{}),
+		server:  s,
 		logger:  s.logger,
-	})
+	}
+	s.stateMtx.Unlock()
+	go s.loop(s.state)
 	return nil
 }
 
@@ -238,37 +340,57 @@ func (s *Server) OnReset() error {
 	return nil
 }
 
-func (s *Server) loop(state state) {
+func (s *Server) loop(state *state) {
 loop:
 	for cmd := range s.cmds {
 		switch cmd.op {
 		case unsub:
+			s.stateMtx.Lock()
 			if cmd.query != nil {
 				state.remove(cmd.clientID, cmd.query)
 			} else {
 				state.removeAll(cmd.clientID)
 			}
+			s.stateMtx.Unlock()
 		case shutdown:
+			s.stateMtx.Lock()
 			for clientID := range state.clients {
 				state.removeAll(clientID)
 			}
+			s.stateMtx.Unlock()
 			break loop
 		case sub:
-			state.add(cmd.clientID, cmd.query, cmd.ch)
+			s.stateMtx.Lock()
+			state.add(cmd.clientID, cmd.query, cmd.sub)
+			s.stateMtx.Unlock()
 		case pub:
-			state.send(cmd.msg, cmd.tags)
+			s.stateMtx.RLock()
+			slow := state.send(cmd.msg, cmd.tags)
+			s.stateMtx.RUnlock()
+			if len(slow) > 0 {
+				s.stateMtx.Lock()
+				for _, ss := range slow {
+					state.disconnect(ss.clientID, ss.query)
+				}
+				s.stateMtx.Unlock()
+			}
 		}
 	}
 }
 
-func (state *state) add(clientID string, q query.Query, ch chan interface{}) {
+func (state *state) add(clientID string, q query.Query, sub *subscription) {
 	// add query if needed
 	if _, ok := state.queries[q]; !ok {
-		state.queries[q] = make(map[string]chan interface{})
+		state.queries[q] = make(map[string]*subscription)
+	}
+
+	// replace any existing subscription
+	if existing, ok := state.queries[q][clientID]; ok {
+		state.close(existing)
 	}
 
 	// create subscription
-	state.queries[q][clientID] = ch
+	state.queries[q][clientID] = sub
 
 	// add client if needed
 	if _, ok := state.clients[clientID]; !ok {
@@ -278,14 +400,14 @@ func (state *state) add(clientID string, q query.Query, ch chan interface{}) {
 }
 
 func (state *state) remove(clientID string, q query.Query) {
-	clientToChannelMap, ok := state.queries[q]
+	clientToSubscription, ok := state.queries[q]
 	if !ok {
 		return
 	}
 
-	ch, ok := clientToChannelMap[clientID]
+	sub, ok := clientToSubscription[clientID]
 	if ok {
-		closeAndDrain(ch)
+		state.close(sub)
 
 		delete(state.clients[clientID], q)
 
@@ -308,8 +430,7 @@ func (state *state) removeAll(clientID string) {
 	}
 
 	for q := range queryMap {
-		ch := state.queries[q][clientID]
-		closeAndDrain(ch)
+		state.close(state.queries[q][clientID])
 
 		delete(state.queries[q], clientID)
 		if len(state.queries[q]) == 0 {
@@ -319,22 +440,52 @@ func (state *state) removeAll(clientID string) {
 	delete(state.clients, clientID)
 }
 
+// Closes the subscription's channel and releases its buffer capacity
+func (state *state) close(sub *subscription) {
+	closeAndDrain(sub.out)
+	state.server.release(cap(sub.out))
+}
+
+// Removes a subscription that could not keep up, leaving the client subscribed
+// from the perspective of Server so that it can find out why with Err
+func (state *state) disconnect(clientID string, q query.Query) {
+	sub, ok := state.queries[q][clientID]
+	if !ok {
+		return
+	}
+	state.logger.InfoMsg("pubsub Server disconnecting slow subscription",
+		"client_id", clientID, "query", q.String(), "policy", sub.policy.String())
+	state.remove(clientID, q)
+	atomic.AddUint64(&state.server.disconnected, 1)
+	s := state.server
+	s.mtx.Lock()
+	s.errs[clientID] = ErrSlowSubscriber
+	delete(s.subscriptions[clientID], q.String())
+	s.mtx.Unlock()
+}
+
 func closeAndDrain(ch chan interface{}) {
 	close(ch)
 	for range ch {
 	}
 }
 
-func (state *state) send(msg interface{}, tags query.Tagged) {
-	for q, clientToChannelMap := range state.queries {
+type clientQuery struct {
+	clientID string
+	query    query.Query
+}
+
+// Sends msg to the matching subscriptions according to their policies,
+// returning those that should be disconnected
+func (state *state) send(msg interface{}, tags query.Tagged) []clientQuery {
+	var slow []clientQuery
+	for q, clientToSubscription := range state.queries {
 		if q.Matches(tags) {
-			for _, ch := range clientToChannelMap {
-				select {
-				case ch <- msg:
-				default:
-					// It's difficult to do anything sensible here with retries/times outs since we may reorder a client's
-					// view of events by sending a later message before an earlier message we retry. If per-client order
-					// matters then we need a queue per client. Possible for us it does not...
+			for clientID, sub := range clientToSubscription {
+				// We do not retry later since we would reorder a client's view of events by sending a later message
+				// before an earlier message we retry
+				if !state.deliver(sub, msg) {
+					slow = append(slow, clientQuery{clientID: clientID, query: q})
 				}
 			}
 		}
@@ -343,4 +494,47 @@ func (state *state) send(msg interface{}, tags query.Tagged) {
 			state.logger.InfoMsg("pubsub Server could not execute query", structure.ErrorKey, err)
 		}
 	}
+	return slow
+}
+
+// Returns false if the subscription should be disconnected
+func (state *state) deliver(sub *subscription, msg interface{}) bool {
+	select {
+	case sub.out <- msg:
+		return true
+	default:
+	}
+	switch sub.policy {
+	case DropOldest:
+		// We are the only sender so taking a message makes room unless the channel is unbuffered
+		select {
+		case <-sub.out:
+			state.drop()
+		default:
+		}
+		select {
+		case sub.out <- msg:
+		default:
+			state.drop()
+		}
+	case Block:
+		timer := time.NewTimer(sub.blockTimeout)
+		defer timer.Stop()
+		select {
+		case sub.out <- msg:
+		case <-timer.C:
+			state.drop()
+			return false
+		}
+	case Disconnect:
+		state.drop()
+		return false
+	default:
+		state.drop()
+	}
+	return true
+}
+
+func (state *state) drop() {
+	atomic.AddUint64(&state.server.dropped, 1)
 }
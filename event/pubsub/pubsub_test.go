@@ -194,7 +194,7 @@ func benchmarkNClients(n int, b *testing.B) {
 	b.ReportAllocs()
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
-		s.PublishWithTags(ctx, "Gamora", query.TagMap{"abci.Account.Owner": "Ivan", "abci.Invoices.Number": string(i)})
+		s.PublishWithTags(ctx, "Gamora", query.TagMap{"abci.Account.Owner": "Ivan", "abci.Invoices.Number": fmt.Sprint(i)})
 	}
 }
 
@@ -225,6 +225,104 @@ func benchmarkNClientsOneQuery(n int, b *testing.B) {
 /// HELPERS
 ///////////////////////////////////////////////////////////////////////////////
 
+func TestSlowSubscriberPolicies(t *testing.T) {
+	ctx := context.Background()
+	qry := query.MustParse("type = 'msg'")
+	tags := query.TagMap{"type": "msg"}
+	publish := func(s *pubsub.Server, msgs ...interface{}) {
+		for _, msg := range msgs {
+			require.NoError(t, s.PublishWithTags(ctx, msg, tags))
+		}
+		// The server queue is unbuffered so once it accepts this (unmatched) message it has handled those before it
+		require.NoError(t, s.Publish(ctx, "sync"))
+	}
+	subscribe := func(options ...pubsub.SubscribeOption) (*pubsub.Server, <-chan interface{}) {
+		s := pubsub.NewServer()
+		s.Start()
+		ch, err := s.Subscribe(ctx, clientID, qry, 2, options...)
+		require.NoError(t, err)
+		return s, ch
+	}
+
+	t.Run("DropNewest", func(t *testing.T) {
+		s, ch := subscribe()
+		defer s.Stop()
+		publish(s, 1, 2, 3)
+		assert.Equal(t, pubsub.Stats{Subscriptions: 1, Lagging: 1, Buffered: 2, Capacity: 2, Dropped: 1}, s.Stats())
+		assertReceive(t, 1, ch)
+		assertReceive(t, 2, ch)
+		assert.Equal(t, 0, s.Stats().Lagging)
+	})
+
+	t.Run("DropOldest", func(t *testing.T) {
+		s, ch := subscribe(pubsub.WithPolicy(pubsub.DropOldest))
+		defer s.Stop()
+		publish(s, 1, 2, 3, 4)
+		assert.Equal(t, uint64(2), s.Stats().Dropped)
+		assertReceive(t, 3, ch)
+		assertReceive(t, 4, ch)
+	})
+
+	t.Run("Disconnect", func(t *testing.T) {
+		s, ch := subscribe(pubsub.WithPolicy(pubsub.Disconnect))
+		defer s.Stop()
+		publish(s, 1, 2)
+		require.NoError(t, s.Err(clientID))
+		publish(s, 3)
+		for range ch {
+		}
+		assert.Equal(t, pubsub.ErrSlowSubscriber, s.Err(clientID))
+		assert.Equal(t, pubsub.Stats{Dropped: 1, Disconnected: 1}, s.Stats())
+		require.NoError(t, s.UnsubscribeAll(ctx, clientID))
+		assert.NoError(t, s.Err(clientID))
+	})
+
+	t.Run("Block", func(t *testing.T) {
+		s, ch := subscribe(pubsub.WithPolicy(pubsub.Block), pubsub.WithBlockTimeout(time.Second))
+		defer s.Stop()
+		publish(s, 1, 2)
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			<-ch
+		}()
+		// Waits for room
+		publish(s, 3)
+		assertReceive(t, 2, ch)
+		assertReceive(t, 3, ch)
+		assert.Equal(t, uint64(0), s.Stats().Dropped)
+	})
+
+	t.Run("BlockTimeout", func(t *testing.T) {
+		s, ch := subscribe(pubsub.WithPolicy(pubsub.Block), pubsub.WithBlockTimeout(10*time.Millisecond))
+		defer s.Stop()
+		publish(s, 1, 2, 3)
+		for range ch {
+		}
+		assert.Equal(t, pubsub.ErrSlowSubscriber, s.Err(clientID))
+	})
+}
+
+func TestMessageBudget(t *testing.T) {
+	s := pubsub.NewServer(pubsub.MessageBudget(3))
+	s.Start()
+	defer s.Stop()
+
+	ctx := context.Background()
+	_, err := s.Subscribe(ctx, "client-1", query.Empty{}, 2)
+	require.NoError(t, err)
+	_, err = s.Subscribe(ctx, "client-2", query.Empty{}, 2)
+	assert.Equal(t, pubsub.ErrMessageBudgetExceeded, err)
+	_, err = s.Subscribe(ctx, "client-2", query.Empty{}, 1)
+	require.NoError(t, err)
+	assert.Equal(t, 3, s.Stats().Capacity)
+
+	require.NoError(t, s.UnsubscribeAll(ctx, "client-1"))
+	// Wait for the unsubscription to be handled
+	require.NoError(t, s.Publish(ctx, "sync"))
+	_, err = s.Subscribe(ctx, "client-3", query.Empty{}, 2)
+	require.NoError(t, err)
+}
+
 func assertReceive(t *testing.T, expected interface{}, ch <-chan interface{}, msgAndArgs ...interface{}) {
 	select {
 	case actual := <-ch:
@@ -0,0 +1,78 @@
+package pubsub
+
+import (
+	"fmt"
+	"time"
+)
+
+// Policy determines what happens to a message published to a subscription whose out buffer is full
+type Policy int
+
+const (
+	// Drop the message being published
+	DropNewest Policy = iota
+	// Drop the oldest buffered message to make room for the message being published
+	DropOldest
+	// Wait for room for up to the subscription's block timeout, delaying delivery to every other subscription, then
+	// disconnect the subscription
+	Block
+	// Disconnect the subscription by closing its out channel, after which Err returns ErrSlowSubscriber
+	Disconnect
+)
+
+// DefaultBlockTimeout is the longest a subscription with the Block policy may hold up publishing by default
+const DefaultBlockTimeout = time.Second
+
+func (p Policy) String() string {
+	switch p {
+	case DropNewest:
+		return "DropNewest"
+	case DropOldest:
+		return "DropOldest"
+	case Block:
+		return "Block"
+	case Disconnect:
+		return "Disconnect"
+	default:
+		return fmt.Sprintf("Policy(%d)", int(p))
+	}
+}
+
+// SubscribeOption sets a parameter for a subscription.
+type SubscribeOption func(*subscription)
+
+// WithPolicy sets the policy of the subscription for when its out buffer is full. The default is DropNewest.
+func WithPolicy(policy Policy) SubscribeOption {
+	return func(sub *subscription) {
+		sub.policy = policy
+	}
+}
+
+// WithBlockTimeout sets how long a subscription with the Block policy may hold up publishing before it is
+// disconnected.
+func WithBlockTimeout(timeout time.Duration) SubscribeOption {
+	return func(sub *subscription) {
+		sub.blockTimeout = timeout
+	}
+}
+
+type subscription struct {
+	out          chan interface{}
+	policy       Policy
+	blockTimeout time.Duration
+}
+
+// Stats describes the subscriptions of a Server and how well they are keeping up.
+type Stats struct {
+	Subscriptions int
+	// Subscriptions whose out buffers are full
+	Lagging int
+	// Messages waiting in out buffers
+	Buffered int
+	// Out buffer capacity in messages reserved by subscriptions (bounded by MessageBudget)
+	Capacity int
+	// Messages dropped for want of buffer room since the server started
+	Dropped uint64
+	// Subscriptions disconnected for being too slow since the server started
+	Disconnected uint64
+}
@@ -21,6 +21,11 @@ const (
 	greaterOrEqualString = ">="
 	lessOrEqualString    = "<="
 	containsString       = "CONTAINS"
+	startsWithString     = "STARTS_WITH"
+	matchesString        = "MATCHES"
+	inString             = "IN"
+	existsString         = "EXISTS"
+	notString            = "NOT"
 	andString            = "AND"
 
 	// Values
@@ -159,6 +164,49 @@ func (qb *Builder) AndContains(tag string, operand interface{}) *Builder {
 	return NewBuilder(qb.and(stringIterator(qb.conditionString())))
 }
 
+// Creates the conjunction of Builder and the condition that tag starts with prefix
+func (qb *Builder) AndStartsWith(tag string, prefix string) *Builder {
+	qb.condition.Tag = tag
+	qb.condition.Op = startsWithString
+	qb.condition.Operand = operandString(prefix)
+	return NewBuilder(qb.and(stringIterator(qb.conditionString())))
+}
+
+// Creates the conjunction of Builder and the condition that tag matches the regular expression pattern
+func (qb *Builder) AndMatches(tag string, pattern string) *Builder {
+	qb.condition.Tag = tag
+	qb.condition.Op = matchesString
+	qb.condition.Operand = operandString(pattern)
+	return NewBuilder(qb.and(stringIterator(qb.conditionString())))
+}
+
+// Creates the conjunction of Builder and the condition that tag is equal to one of operands
+func (qb *Builder) AndIn(tag string, operands ...interface{}) *Builder {
+	strs := make([]string, len(operands))
+	for i, operand := range operands {
+		strs[i] = operandString(operand)
+	}
+	qb.condition.Tag = tag
+	qb.condition.Op = inString
+	qb.condition.Operand = "(" + strings.Join(strs, ", ") + ")"
+	return NewBuilder(qb.and(stringIterator(qb.conditionString())))
+}
+
+// Creates the conjunction of Builder and the condition that tag is present
+func (qb *Builder) AndExists(tag string) *Builder {
+	return NewBuilder(qb.and(stringIterator(existsString + " " + tag)))
+}
+
+// Creates the negation of Builder
+func (qb *Builder) Not() *Builder {
+	if isEmpty(qb.queryString) {
+		nb := NewBuilder()
+		nb.error = fmt.Errorf("cannot negate the empty query")
+		return nb
+	}
+	return NewBuilder(notString + " (" + qb.queryString + ")")
+}
+
 func (qb *Builder) and(queryIterator func(func(string))) string {
 	defer qb.Buffer.Reset()
 	qb.Buffer.WriteString(qb.queryString)
@@ -41,6 +41,21 @@ func TestQueryBuilder(t *testing.T) {
 	qry, err = qb.Query()
 	require.NoError(t, err)
 	assert.Equal(t, "foo = 'bar' AND frogs >= 4", qry.String())
+
+	qb = NewBuilder().AndIn("bar.name", "marmot", "vole").AndExists("bar.desc").
+		AndStartsWith("bar.desc", "lives").And(NewBuilder().AndMatches("bar.desc", "bur+ow$").Not())
+	qry, err = qb.Query()
+	require.NoError(t, err)
+	assert.Equal(t, "bar.name IN ('marmot', 'vole') AND EXISTS bar.desc AND bar.desc STARTS_WITH 'lives' AND "+
+		"NOT (bar.desc MATCHES 'bur+ow$')", qry.String())
+
+	assert.True(t, qry.Matches(makeTagMap("bar.name", "vole", "bar.desc", "lives in a shoe")))
+	assert.False(t, qry.Matches(makeTagMap("bar.name", "vole", "bar.desc", "lives in a burrow")))
+	assert.False(t, qry.Matches(makeTagMap("bar.name", "mole", "bar.desc", "lives in a shoe")))
+	assert.False(t, qry.Matches(makeTagMap("bar.name", "vole")))
+
+	_, err = NewBuilder().Not().Query()
+	require.Error(t, err)
 }
 
 func makeTagMap(keyvals ...interface{}) TagMap {
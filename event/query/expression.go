@@ -3,6 +3,7 @@ package query
 import (
 	"fmt"
 	"math/big"
+	"regexp"
 	"strings"
 	"time"
 
@@ -14,6 +15,8 @@ const (
 	DateLayout = "2006-01-02"
 	// TimeLayout defines a layout for all times (`TIME time`)
 	TimeLayout = time.RFC3339
+	// MaxRegexpLength is the maximum length of a regular expression pattern (`MATCHES 'pattern'`)
+	MaxRegexpLength = 256
 )
 
 // Operator is an operator that defines some kind of relation between tag and
@@ -30,6 +33,11 @@ const (
 	OpGreater
 	OpEqual
 	OpContains
+	OpNot
+	OpIn
+	OpExists
+	OpStartsWith
+	OpMatches
 )
 
 var opNames = map[Operator]string{
@@ -41,12 +49,22 @@ var opNames = map[Operator]string{
 	OpGreater:      ">",
 	OpEqual:        "=",
 	OpContains:     "CONTAINS",
+	OpNot:          "NOT",
+	OpIn:           "IN",
+	OpExists:       "EXISTS",
+	OpStartsWith:   "STARTS_WITH",
+	OpMatches:      "MATCHES",
 }
 
 func (op Operator) String() string {
 	return opNames[op]
 }
 
+// Whether the operator takes a single operand
+func (op Operator) unary() bool {
+	return op == OpNot || op == OpExists
+}
+
 // Instruction is a container suitable for the code tape and the stack to hold values an operations
 type instruction struct {
 	op     Operator
@@ -54,6 +72,8 @@ type instruction struct {
 	string *string
 	time   *time.Time
 	number *big.Float
+	list   []*instruction
+	regexp *regexp.Regexp
 	match  bool
 }
 
@@ -69,6 +89,12 @@ func (in *instruction) String() string {
 		return in.time.String()
 	case in.number != nil:
 		return in.number.String()
+	case in.list != nil:
+		strs := make([]string, len(in.list))
+		for i, el := range in.list {
+			strs[i] = el.String()
+		}
+		return "(" + strings.Join(strs, ", ") + ")"
 	default:
 		if in.match {
 			return "true"
@@ -100,6 +126,23 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 			continue
 		}
 
+		if in.op.unary() {
+			if len(stack) < 1 {
+				return false, fmt.Errorf("cannot pop from stack for query expression [%v] because stack is "+
+					"empty", e)
+			}
+			stack, right = stack[:len(stack)-1], stack[len(stack)-1]
+			ins := &instruction{}
+			switch in.op {
+			case OpNot:
+				ins.match = !right.match
+			case OpExists:
+				_, ins.match = getTagValue(*right.tag)
+			}
+			stack = append(stack, ins)
+			continue
+		}
+
 		if len(stack) < 2 {
 			return false, fmt.Errorf("cannot pop from stack for query expression [%v] because stack has "+
 				"fewer than 2 elements", e)
@@ -117,6 +160,10 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 			// No match if we can't get tag value
 			if ok {
 				switch {
+				case right.regexp != nil:
+					ins.match = right.regexp.MatchString(StringFromValue(tagValue))
+				case right.list != nil:
+					ins.match = compareList(tagValue, right.list)
 				case right.string != nil:
 					ins.match = compareString(in.op, tagValue, *right.string)
 				case right.number != nil:
@@ -141,6 +188,76 @@ func (e *Expression) Evaluate(getTagValue func(tag string) (interface{}, bool))
 	return stack[0].match, nil
 }
 
+// Conjuncts returns the conditions that must all hold for the expression to match, that is those not beneath an OR or
+// NOT. Operands are returned as a string, *big.Float, time.Time, or for IN a []interface{} of these. EXISTS conditions
+// have a nil operand.
+func (e *Expression) Conjuncts() []Condition {
+	if len(e.errors) > 0 {
+		return nil
+	}
+	// Rebuild the expression tree from the code tape
+	type node struct {
+		in       *instruction
+		children []*node
+	}
+	stack := make([]*node, 0, len(e.code))
+	for _, in := range e.code {
+		n := &node{in: in}
+		arity := 2
+		if in.op == OpTerminal {
+			arity = 0
+		} else if in.op.unary() {
+			arity = 1
+		}
+		if len(stack) < arity {
+			return nil
+		}
+		n.children = stack[len(stack)-arity:]
+		stack = append(stack[:len(stack)-arity:len(stack)-arity], n)
+	}
+	if len(stack) != 1 {
+		return nil
+	}
+	var conditions []Condition
+	var walk func(n *node)
+	walk = func(n *node) {
+		switch n.in.op {
+		case OpAnd:
+			walk(n.children[0])
+			walk(n.children[1])
+		case OpOr, OpNot, OpTerminal:
+		case OpExists:
+			conditions = append(conditions, Condition{Tag: *n.children[0].in.tag, Op: OpExists})
+		default:
+			conditions = append(conditions, Condition{
+				Tag:     *n.children[0].in.tag,
+				Op:      n.in.op,
+				Operand: n.children[1].in.operand(),
+			})
+		}
+	}
+	walk(stack[0])
+	return conditions
+}
+
+func (in *instruction) operand() interface{} {
+	switch {
+	case in.string != nil:
+		return *in.string
+	case in.number != nil:
+		return in.number
+	case in.time != nil:
+		return *in.time
+	case in.list != nil:
+		list := make([]interface{}, len(in.list))
+		for i, el := range in.list {
+			list[i] = el.operand()
+		}
+		return list
+	}
+	return nil
+}
+
 func (e *Expression) explainf(fmt string, args ...interface{}) {
 	if e.explainer != nil {
 		e.explainer(fmt, args...)
@@ -156,12 +273,31 @@ func compareString(op Operator, tagValue interface{}, value string) bool {
 	switch op {
 	case OpContains:
 		return strings.Contains(tagString, value)
+	case OpStartsWith:
+		return strings.HasPrefix(tagString, value)
 	case OpEqual:
 		return tagString == value
 	}
 	return false
 }
 
+// Returns whether the tag is equal to any value in list
+func compareList(tagValue interface{}, list []*instruction) bool {
+	for _, in := range list {
+		switch {
+		case in.string != nil:
+			if compareString(OpEqual, tagValue, *in.string) {
+				return true
+			}
+		case in.number != nil:
+			if compareNumber(OpEqual, tagValue, in.number) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
 func compareNumber(op Operator, tagValue interface{}, value *big.Float) bool {
 	tagNumber := new(big.Float)
 	switch n := tagValue.(type) {
@@ -257,11 +393,42 @@ func (e *Expression) String() string {
 }
 
 func (e *Expression) Operator(operator Operator) {
+	if operator == OpMatches {
+		e.compileRegexp()
+	}
 	e.code = append(e.code, &instruction{
 		op: operator,
 	})
 }
 
+// Compiles the pattern in the operand on the top of the stack
+func (e *Expression) compileRegexp() {
+	operand := e.code[len(e.code)-1]
+	if len(*operand.string) > MaxRegexpLength {
+		e.pushErr(fmt.Errorf("regular expression '%s' is longer than the maximum of %d characters",
+			*operand.string, MaxRegexpLength))
+		return
+	}
+	var err error
+	operand.regexp, err = regexp.Compile(*operand.string)
+	e.pushErr(err)
+}
+
+// Starts a list operand to which subsequent values are moved by Append
+func (e *Expression) List() {
+	e.code = append(e.code, &instruction{
+		list: []*instruction{},
+	})
+}
+
+// Moves the value on top of the stack to the list beneath it
+func (e *Expression) Append() {
+	last := len(e.code) - 1
+	list := e.code[last-1]
+	list.list = append(list.list, e.code[last])
+	e.code = e.code[:last]
+}
+
 // Terminals...
 
 func (e *Expression) Tag(value string) {
@@ -30,4 +30,24 @@ func TestExpression(t *testing.T) {
 		require.NoError(t, err)
 		require.True(t, matches)
 	})
+
+	t.Run("NOT IN EXISTS", func(t *testing.T) {
+		qry, err := New("NOT something IN ('awful', 2) AND EXISTS another_thing")
+		require.NoError(t, err)
+		out := qry.parser.String()
+		require.Equal(t, "something, ('awful', 2), IN, NOT, another_thing, EXISTS, AND", out)
+
+		matches, err := qry.parser.Evaluate(func(key string) (interface{}, bool) {
+			switch key {
+			case "something":
+				return "nice", true
+			case "another_thing":
+				return "", true
+			default:
+				return "", false
+			}
+		})
+		require.NoError(t, err)
+		require.True(t, matches)
+	})
 }
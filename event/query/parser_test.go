@@ -1,6 +1,8 @@
 package query
 
 import (
+	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,6 +79,32 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"NOT tm.events.type='NewBlock'", true},
+		{"NOT(tm.events.type='NewBlock' OR tm.events.type='Tx')", true},
+		{"NOT NOT tm.events.type='NewBlock'", true},
+		{"not = 'NewBlock'", true},
+		{"NOT tm.events.type", false},
+		{"NOT", false},
+
+		{"account.name IN ('Igor', 'Ivan')", true},
+		{"account.balance IN (1, 2.5,3)", true},
+		{"account.name IN ('Igor')", true},
+		{"account.name IN ()", false},
+		{"account.name IN ('Igor',)", false},
+		{"account.name IN 'Igor'", false},
+
+		{"EXISTS account.name", true},
+		{"EXISTS account.name AND NOT EXISTS account.balance", true},
+		{"EXISTS", false},
+		{"EXISTSaccount.name", false},
+
+		{"account.name STARTS_WITH 'Ig'", true},
+		{"account.name STARTS_WITH Ig", false},
+
+		{"account.name MATCHES '^Ig[a-z]+$'", true},
+		{"account.name MATCHES '(Ig'", false},
+		{fmt.Sprintf("account.name MATCHES '%s'", strings.Repeat("a", MaxRegexpLength+1)), false},
 	}
 
 	for _, c := range cases {
@@ -63,6 +63,9 @@ func New(s string) (*PegQuery, error) {
 		return nil, err
 	}
 	p.Execute()
+	if len(p.errors) > 0 {
+		return nil, p.errors
+	}
 	return &PegQuery{str: s, parser: p}, nil
 }
 
@@ -106,6 +109,11 @@ func (q *PegQuery) MatchError() error {
 	return q.error
 }
 
+// Conjuncts returns the conditions that every tagged matched by the query must satisfy, see Expression.Conjuncts
+func (q *PegQuery) Conjuncts() []Condition {
+	return q.parser.Conjuncts()
+}
+
 func (q *PegQuery) ExplainTo(explainer func(fmt string, args ...interface{})) {
 	q.parser.explainer = explainer
 }
@@ -8,6 +8,7 @@ import (
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 )
 
 const endSymbol rune = 1114112
@@ -20,7 +21,10 @@ const (
 	rulee
 	ruleeor
 	ruleeand
+	ruleenot
 	rulecondition
+	rulelist
+	rulelistValue
 	ruletag
 	ruleqvalue
 	rulevalue
@@ -35,13 +39,20 @@ const (
 	ruleor
 	ruleequal
 	rulecontains
+	rulestartsWith
+	rulematches
+	rulein
+	rulenot
+	ruleexists
 	rulele
 	rulege
 	rulel
 	ruleg
 	ruleopen
 	ruleclose
+	rulecomma
 	rulesp
+	rulesp1
 	ruleAction0
 	ruleAction1
 	ruleAction2
@@ -50,12 +61,19 @@ const (
 	ruleAction5
 	ruleAction6
 	ruleAction7
-	rulePegText
 	ruleAction8
 	ruleAction9
 	ruleAction10
 	ruleAction11
 	ruleAction12
+	ruleAction13
+	ruleAction14
+	rulePegText
+	ruleAction15
+	ruleAction16
+	ruleAction17
+	ruleAction18
+	ruleAction19
 )
 
 var rul3s = [...]string{
@@ -63,7 +81,10 @@ var rul3s = [...]string{
 	"e",
 	"eor",
 	"eand",
+	"enot",
 	"condition",
+	"list",
+	"listValue",
 	"tag",
 	"qvalue",
 	"value",
@@ -78,13 +99,20 @@ var rul3s = [...]string{
 	"or",
 	"equal",
 	"contains",
+	"startsWith",
+	"matches",
+	"in",
+	"not",
+	"exists",
 	"le",
 	"ge",
 	"l",
 	"g",
 	"open",
 	"close",
+	"comma",
 	"sp",
+	"sp1",
 	"Action0",
 	"Action1",
 	"Action2",
@@ -93,12 +121,19 @@ var rul3s = [...]string{
 	"Action5",
 	"Action6",
 	"Action7",
-	"PegText",
 	"Action8",
 	"Action9",
 	"Action10",
 	"Action11",
 	"Action12",
+	"Action13",
+	"Action14",
+	"PegText",
+	"Action15",
+	"Action16",
+	"Action17",
+	"Action18",
+	"Action19",
 }
 
 type token32 struct {
@@ -127,7 +162,7 @@ func (node *node32) print(w io.Writer, pretty bool, buffer string) {
 			if !pretty {
 				fmt.Fprintf(w, "%v %v\n", rule, quote)
 			} else {
-				fmt.Fprintf(w, "\x1B[34m%v\x1B[m %v\n", rule, quote)
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
 			}
 			if node.up != nil {
 				print(node.up, depth+1)
@@ -215,7 +250,7 @@ type QueryParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [40]func() bool
+	rules  [57]func() bool
 	parse  func(rule ...int) error
 	reset  func()
 	Pretty bool
@@ -302,6 +337,12 @@ func (p *QueryParser) WriteSyntaxTree(w io.Writer) {
 	p.tokens32.WriteSyntaxTree(w, p.Buffer)
 }
 
+func (p *QueryParser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
+}
+
 func (p *QueryParser) Execute() {
 	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
 	for _, token := range p.Tokens() {
@@ -316,26 +357,40 @@ func (p *QueryParser) Execute() {
 		case ruleAction1:
 			p.Operator(OpAnd)
 		case ruleAction2:
-			p.Operator(OpLessEqual)
+			p.Operator(OpNot)
 		case ruleAction3:
-			p.Operator(OpGreaterEqual)
+			p.Operator(OpExists)
 		case ruleAction4:
-			p.Operator(OpLess)
+			p.Operator(OpLessEqual)
 		case ruleAction5:
-			p.Operator(OpGreater)
+			p.Operator(OpGreaterEqual)
 		case ruleAction6:
-			p.Operator(OpEqual)
+			p.Operator(OpLess)
 		case ruleAction7:
-			p.Operator(OpContains)
+			p.Operator(OpGreater)
 		case ruleAction8:
-			p.Tag(buffer[begin:end])
+			p.Operator(OpEqual)
 		case ruleAction9:
-			p.Value(buffer[begin:end])
+			p.Operator(OpContains)
 		case ruleAction10:
-			p.Number(buffer[begin:end])
+			p.Operator(OpStartsWith)
 		case ruleAction11:
-			p.Time(buffer[begin:end])
+			p.Operator(OpMatches)
 		case ruleAction12:
+			p.Operator(OpIn)
+		case ruleAction13:
+			p.List()
+		case ruleAction14:
+			p.Append()
+		case ruleAction15:
+			p.Tag(buffer[begin:end])
+		case ruleAction16:
+			p.Value(buffer[begin:end])
+		case ruleAction17:
+			p.Number(buffer[begin:end])
+		case ruleAction18:
+			p.Time(buffer[begin:end])
+		case ruleAction19:
 			p.Date(buffer[begin:end])
 
 		}
@@ -485,12 +540,12 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position3, tokenIndex3
 			return false
 		},
-		/* 2 eand <- <(condition (and condition Action1)*)> */
+		/* 2 eand <- <(enot (and enot Action1)*)> */
 		func() bool {
 			position7, tokenIndex7 := position, tokenIndex
 			{
 				position8 := position
-				if !_rules[rulecondition]() {
+				if !_rules[ruleenot]() {
 					goto l7
 				}
 			l9:
@@ -499,7 +554,7 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 					if !_rules[ruleand]() {
 						goto l10
 					}
-					if !_rules[rulecondition]() {
+					if !_rules[ruleenot]() {
 						goto l10
 					}
 					if !_rules[ruleAction1]() {
@@ -516,1461 +571,2236 @@ func (p *QueryParser) Init(options ...func(*QueryParser) error) error {
 			position, tokenIndex = position7, tokenIndex7
 			return false
 		},
-		/* 3 condition <- <((tag sp ((le (number / time / date) Action2) / (ge (number / time / date) Action3) / (l (number / time / date) Action4) / (g (number / time / date) Action5) / (equal (number / time / date / qvalue) Action6) / (contains qvalue Action7)) sp) / (open eor close))> */
+		/* 3 enot <- <((not enot Action2) / condition)> */
 		func() bool {
 			position11, tokenIndex11 := position, tokenIndex
 			{
 				position12 := position
 				{
 					position13, tokenIndex13 := position, tokenIndex
-					if !_rules[ruletag]() {
+					if !_rules[rulenot]() {
 						goto l14
 					}
-					if !_rules[rulesp]() {
+					if !_rules[ruleenot]() {
+						goto l14
+					}
+					if !_rules[ruleAction2]() {
 						goto l14
 					}
+					goto l13
+				l14:
+					position, tokenIndex = position13, tokenIndex13
+					if !_rules[rulecondition]() {
+						goto l11
+					}
+				}
+			l13:
+				add(ruleenot, position12)
+			}
+			return true
+		l11:
+			position, tokenIndex = position11, tokenIndex11
+			return false
+		},
+		/* 4 condition <- <((exists tag Action3) / (tag sp ((le (number / time / date) Action4) / (ge (number / time / date) Action5) / (l (number / time / date) Action6) / (g (number / time / date) Action7) / (equal (number / time / date / qvalue) Action8) / (contains qvalue Action9) / (startsWith qvalue Action10) / (matches qvalue Action11) / (in list Action12)) sp) / (open eor close))> */
+		func() bool {
+			position15, tokenIndex15 := position, tokenIndex
+			{
+				position16 := position
+				{
+					position17, tokenIndex17 := position, tokenIndex
+					if !_rules[ruleexists]() {
+						goto l18
+					}
+					if !_rules[ruletag]() {
+						goto l18
+					}
+					if !_rules[ruleAction3]() {
+						goto l18
+					}
+					goto l17
+				l18:
+					position, tokenIndex = position17, tokenIndex17
+					if !_rules[ruletag]() {
+						goto l19
+					}
+					if !_rules[rulesp]() {
+						goto l19
+					}
 					{
-						position15, tokenIndex15 := position, tokenIndex
+						position20, tokenIndex20 := position, tokenIndex
 						if !_rules[rulele]() {
-							goto l16
+							goto l21
 						}
 						{
-							position17, tokenIndex17 := position, tokenIndex
+							position22, tokenIndex22 := position, tokenIndex
 							if !_rules[rulenumber]() {
-								goto l18
+								goto l23
 							}
-							goto l17
-						l18:
-							position, tokenIndex = position17, tokenIndex17
+							goto l22
+						l23:
+							position, tokenIndex = position22, tokenIndex22
 							if !_rules[ruletime]() {
-								goto l19
+								goto l24
 							}
-							goto l17
-						l19:
-							position, tokenIndex = position17, tokenIndex17
+							goto l22
+						l24:
+							position, tokenIndex = position22, tokenIndex22
 							if !_rules[ruledate]() {
-								goto l16
+								goto l21
 							}
 						}
-					l17:
-						if !_rules[ruleAction2]() {
-							goto l16
+					l22:
+						if !_rules[ruleAction4]() {
+							goto l21
 						}
-						goto l15
-					l16:
-						position, tokenIndex = position15, tokenIndex15
+						goto l20
+					l21:
+						position, tokenIndex = position20, tokenIndex20
 						if !_rules[rulege]() {
-							goto l20
+							goto l25
 						}
 						{
-							position21, tokenIndex21 := position, tokenIndex
+							position26, tokenIndex26 := position, tokenIndex
 							if !_rules[rulenumber]() {
-								goto l22
+								goto l27
 							}
-							goto l21
-						l22:
-							position, tokenIndex = position21, tokenIndex21
+							goto l26
+						l27:
+							position, tokenIndex = position26, tokenIndex26
 							if !_rules[ruletime]() {
-								goto l23
+								goto l28
 							}
-							goto l21
-						l23:
-							position, tokenIndex = position21, tokenIndex21
+							goto l26
+						l28:
+							position, tokenIndex = position26, tokenIndex26
 							if !_rules[ruledate]() {
-								goto l20
+								goto l25
 							}
 						}
-					l21:
-						if !_rules[ruleAction3]() {
-							goto l20
+					l26:
+						if !_rules[ruleAction5]() {
+							goto l25
 						}
-						goto l15
-					l20:
-						position, tokenIndex = position15, tokenIndex15
+						goto l20
+					l25:
+						position, tokenIndex = position20, tokenIndex20
 						if !_rules[rulel]() {
-							goto l24
+							goto l29
 						}
 						{
-							position25, tokenIndex25 := position, tokenIndex
+							position30, tokenIndex30 := position, tokenIndex
 							if !_rules[rulenumber]() {
-								goto l26
+								goto l31
 							}
-							goto l25
-						l26:
-							position, tokenIndex = position25, tokenIndex25
+							goto l30
+						l31:
+							position, tokenIndex = position30, tokenIndex30
 							if !_rules[ruletime]() {
-								goto l27
+								goto l32
 							}
-							goto l25
-						l27:
-							position, tokenIndex = position25, tokenIndex25
+							goto l30
+						l32:
+							position, tokenIndex = position30, tokenIndex30
 							if !_rules[ruledate]() {
-								goto l24
+								goto l29
 							}
 						}
-					l25:
-						if !_rules[ruleAction4]() {
-							goto l24
+					l30:
+						if !_rules[ruleAction6]() {
+							goto l29
 						}
-						goto l15
-					l24:
-						position, tokenIndex = position15, tokenIndex15
+						goto l20
+					l29:
+						position, tokenIndex = position20, tokenIndex20
 						if !_rules[ruleg]() {
-							goto l28
+							goto l33
 						}
 						{
-							position29, tokenIndex29 := position, tokenIndex
+							position34, tokenIndex34 := position, tokenIndex
 							if !_rules[rulenumber]() {
-								goto l30
+								goto l35
 							}
-							goto l29
-						l30:
-							position, tokenIndex = position29, tokenIndex29
+							goto l34
+						l35:
+							position, tokenIndex = position34, tokenIndex34
 							if !_rules[ruletime]() {
-								goto l31
+								goto l36
 							}
-							goto l29
-						l31:
-							position, tokenIndex = position29, tokenIndex29
+							goto l34
+						l36:
+							position, tokenIndex = position34, tokenIndex34
 							if !_rules[ruledate]() {
-								goto l28
+								goto l33
 							}
 						}
-					l29:
-						if !_rules[ruleAction5]() {
-							goto l28
+					l34:
+						if !_rules[ruleAction7]() {
+							goto l33
 						}
-						goto l15
-					l28:
-						position, tokenIndex = position15, tokenIndex15
+						goto l20
+					l33:
+						position, tokenIndex = position20, tokenIndex20
 						if !_rules[ruleequal]() {
-							goto l32
+							goto l37
 						}
 						{
-							position33, tokenIndex33 := position, tokenIndex
+							position38, tokenIndex38 := position, tokenIndex
 							if !_rules[rulenumber]() {
-								goto l34
+								goto l39
 							}
-							goto l33
-						l34:
-							position, tokenIndex = position33, tokenIndex33
+							goto l38
+						l39:
+							position, tokenIndex = position38, tokenIndex38
 							if !_rules[ruletime]() {
-								goto l35
+								goto l40
 							}
-							goto l33
-						l35:
-							position, tokenIndex = position33, tokenIndex33
+							goto l38
+						l40:
+							position, tokenIndex = position38, tokenIndex38
 							if !_rules[ruledate]() {
-								goto l36
+								goto l41
 							}
-							goto l33
-						l36:
-							position, tokenIndex = position33, tokenIndex33
+							goto l38
+						l41:
+							position, tokenIndex = position38, tokenIndex38
 							if !_rules[ruleqvalue]() {
-								goto l32
+								goto l37
 							}
 						}
-					l33:
-						if !_rules[ruleAction6]() {
-							goto l32
+					l38:
+						if !_rules[ruleAction8]() {
+							goto l37
 						}
-						goto l15
-					l32:
-						position, tokenIndex = position15, tokenIndex15
+						goto l20
+					l37:
+						position, tokenIndex = position20, tokenIndex20
 						if !_rules[rulecontains]() {
-							goto l14
+							goto l42
 						}
 						if !_rules[ruleqvalue]() {
-							goto l14
+							goto l42
 						}
-						if !_rules[ruleAction7]() {
-							goto l14
+						if !_rules[ruleAction9]() {
+							goto l42
+						}
+						goto l20
+					l42:
+						position, tokenIndex = position20, tokenIndex20
+						if !_rules[rulestartsWith]() {
+							goto l43
+						}
+						if !_rules[ruleqvalue]() {
+							goto l43
+						}
+						if !_rules[ruleAction10]() {
+							goto l43
+						}
+						goto l20
+					l43:
+						position, tokenIndex = position20, tokenIndex20
+						if !_rules[rulematches]() {
+							goto l44
+						}
+						if !_rules[ruleqvalue]() {
+							goto l44
+						}
+						if !_rules[ruleAction11]() {
+							goto l44
+						}
+						goto l20
+					l44:
+						position, tokenIndex = position20, tokenIndex20
+						if !_rules[rulein]() {
+							goto l19
+						}
+						if !_rules[rulelist]() {
+							goto l19
+						}
+						if !_rules[ruleAction12]() {
+							goto l19
 						}
 					}
-				l15:
+				l20:
 					if !_rules[rulesp]() {
-						goto l14
+						goto l19
 					}
-					goto l13
-				l14:
-					position, tokenIndex = position13, tokenIndex13
+					goto l17
+				l19:
+					position, tokenIndex = position17, tokenIndex17
 					if !_rules[ruleopen]() {
-						goto l11
+						goto l15
 					}
 					if !_rules[ruleeor]() {
-						goto l11
+						goto l15
 					}
 					if !_rules[ruleclose]() {
-						goto l11
+						goto l15
 					}
 				}
-			l13:
-				add(rulecondition, position12)
+			l17:
+				add(rulecondition, position16)
 			}
 			return true
-		l11:
-			position, tokenIndex = position11, tokenIndex11
+		l15:
+			position, tokenIndex = position15, tokenIndex15
+			return false
+		},
+		/* 5 list <- <(open Action13 listValue (comma listValue)* close)> */
+		func() bool {
+			position45, tokenIndex45 := position, tokenIndex
+			{
+				position46 := position
+				if !_rules[ruleopen]() {
+					goto l45
+				}
+				if !_rules[ruleAction13]() {
+					goto l45
+				}
+				if !_rules[rulelistValue]() {
+					goto l45
+				}
+			l47:
+				{
+					position48, tokenIndex48 := position, tokenIndex
+					if !_rules[rulecomma]() {
+						goto l48
+					}
+					if !_rules[rulelistValue]() {
+						goto l48
+					}
+					goto l47
+				l48:
+					position, tokenIndex = position48, tokenIndex48
+				}
+				if !_rules[ruleclose]() {
+					goto l45
+				}
+				add(rulelist, position46)
+			}
+			return true
+		l45:
+			position, tokenIndex = position45, tokenIndex45
+			return false
+		},
+		/* 6 listValue <- <((number / qvalue) Action14)> */
+		func() bool {
+			position49, tokenIndex49 := position, tokenIndex
+			{
+				position50 := position
+				{
+					position51, tokenIndex51 := position, tokenIndex
+					if !_rules[rulenumber]() {
+						goto l52
+					}
+					goto l51
+				l52:
+					position, tokenIndex = position51, tokenIndex51
+					if !_rules[ruleqvalue]() {
+						goto l49
+					}
+				}
+			l51:
+				if !_rules[ruleAction14]() {
+					goto l49
+				}
+				add(rulelistValue, position50)
+			}
+			return true
+		l49:
+			position, tokenIndex = position49, tokenIndex49
 			return false
 		},
-		/* 4 tag <- <(<(!(' ' / '\t' / '\n' / '\r' / '\\' / '(' / ')' / '"' / '\'' / '=' / '>' / '<') .)+> sp Action8)> */
+		/* 7 tag <- <(<(!(' ' / '\t' / '\n' / '\r' / '\\' / '(' / ')' / '"' / '\'' / '=' / '>' / '<') .)+> sp Action15)> */
 		func() bool {
-			position37, tokenIndex37 := position, tokenIndex
+			position53, tokenIndex53 := position, tokenIndex
 			{
-				position38 := position
+				position54 := position
 				{
-					position39 := position
+					position55 := position
 					{
-						position42, tokenIndex42 := position, tokenIndex
+						position58, tokenIndex58 := position, tokenIndex
 						{
-							position43, tokenIndex43 := position, tokenIndex
+							position59, tokenIndex59 := position, tokenIndex
 							if buffer[position] != rune(' ') {
-								goto l44
+								goto l60
 							}
 							position++
-							goto l43
-						l44:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l60:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('\t') {
-								goto l45
+								goto l61
 							}
 							position++
-							goto l43
-						l45:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l61:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('\n') {
-								goto l46
+								goto l62
 							}
 							position++
-							goto l43
-						l46:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l62:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('\r') {
-								goto l47
+								goto l63
 							}
 							position++
-							goto l43
-						l47:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l63:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('\\') {
-								goto l48
+								goto l64
 							}
 							position++
-							goto l43
-						l48:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l64:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('(') {
-								goto l49
+								goto l65
 							}
 							position++
-							goto l43
-						l49:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l65:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune(')') {
-								goto l50
+								goto l66
 							}
 							position++
-							goto l43
-						l50:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l66:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('"') {
-								goto l51
+								goto l67
 							}
 							position++
-							goto l43
-						l51:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l67:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('\'') {
-								goto l52
+								goto l68
 							}
 							position++
-							goto l43
-						l52:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l68:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('=') {
-								goto l53
+								goto l69
 							}
 							position++
-							goto l43
-						l53:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l69:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('>') {
-								goto l54
+								goto l70
 							}
 							position++
-							goto l43
-						l54:
-							position, tokenIndex = position43, tokenIndex43
+							goto l59
+						l70:
+							position, tokenIndex = position59, tokenIndex59
 							if buffer[position] != rune('<') {
-								goto l42
+								goto l58
 							}
 							position++
 						}
-					l43:
-						goto l37
-					l42:
-						position, tokenIndex = position42, tokenIndex42
+					l59:
+						goto l53
+					l58:
+						position, tokenIndex = position58, tokenIndex58
 					}
 					if !matchDot() {
-						goto l37
+						goto l53
 					}
-				l40:
+				l56:
 					{
-						position41, tokenIndex41 := position, tokenIndex
+						position57, tokenIndex57 := position, tokenIndex
 						{
-							position55, tokenIndex55 := position, tokenIndex
+							position71, tokenIndex71 := position, tokenIndex
 							{
-								position56, tokenIndex56 := position, tokenIndex
+								position72, tokenIndex72 := position, tokenIndex
 								if buffer[position] != rune(' ') {
-									goto l57
+									goto l73
 								}
 								position++
-								goto l56
-							l57:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l73:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('\t') {
-									goto l58
+									goto l74
 								}
 								position++
-								goto l56
-							l58:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l74:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('\n') {
-									goto l59
+									goto l75
 								}
 								position++
-								goto l56
-							l59:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l75:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('\r') {
-									goto l60
+									goto l76
 								}
 								position++
-								goto l56
-							l60:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l76:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('\\') {
-									goto l61
+									goto l77
 								}
 								position++
-								goto l56
-							l61:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l77:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('(') {
-									goto l62
+									goto l78
 								}
 								position++
-								goto l56
-							l62:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l78:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune(')') {
-									goto l63
+									goto l79
 								}
 								position++
-								goto l56
-							l63:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l79:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('"') {
-									goto l64
+									goto l80
 								}
 								position++
-								goto l56
-							l64:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l80:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('\'') {
-									goto l65
+									goto l81
 								}
 								position++
-								goto l56
-							l65:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l81:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('=') {
-									goto l66
+									goto l82
 								}
 								position++
-								goto l56
-							l66:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l82:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('>') {
-									goto l67
+									goto l83
 								}
 								position++
-								goto l56
-							l67:
-								position, tokenIndex = position56, tokenIndex56
+								goto l72
+							l83:
+								position, tokenIndex = position72, tokenIndex72
 								if buffer[position] != rune('<') {
-									goto l55
+									goto l71
 								}
 								position++
 							}
-						l56:
-							goto l41
-						l55:
-							position, tokenIndex = position55, tokenIndex55
+						l72:
+							goto l57
+						l71:
+							position, tokenIndex = position71, tokenIndex71
 						}
 						if !matchDot() {
-							goto l41
+							goto l57
 						}
-						goto l40
-					l41:
-						position, tokenIndex = position41, tokenIndex41
+						goto l56
+					l57:
+						position, tokenIndex = position57, tokenIndex57
 					}
-					add(rulePegText, position39)
+					add(rulePegText, position55)
 				}
 				if !_rules[rulesp]() {
-					goto l37
+					goto l53
 				}
-				if !_rules[ruleAction8]() {
-					goto l37
+				if !_rules[ruleAction15]() {
+					goto l53
 				}
-				add(ruletag, position38)
+				add(ruletag, position54)
 			}
 			return true
-		l37:
-			position, tokenIndex = position37, tokenIndex37
+		l53:
+			position, tokenIndex = position53, tokenIndex53
 			return false
 		},
-		/* 5 qvalue <- <('\'' value '\'' sp)> */
+		/* 8 qvalue <- <('\'' value '\'' sp)> */
 		func() bool {
-			position68, tokenIndex68 := position, tokenIndex
+			position84, tokenIndex84 := position, tokenIndex
 			{
-				position69 := position
+				position85 := position
 				if buffer[position] != rune('\'') {
-					goto l68
+					goto l84
 				}
 				position++
 				if !_rules[rulevalue]() {
-					goto l68
+					goto l84
 				}
 				if buffer[position] != rune('\'') {
-					goto l68
+					goto l84
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l68
+					goto l84
 				}
-				add(ruleqvalue, position69)
+				add(ruleqvalue, position85)
 			}
 			return true
-		l68:
-			position, tokenIndex = position68, tokenIndex68
+		l84:
+			position, tokenIndex = position84, tokenIndex84
 			return false
 		},
-		/* 6 value <- <(<(!('"' / '\'') .)*> Action9)> */
+		/* 9 value <- <(<(!('"' / '\'') .)*> Action16)> */
 		func() bool {
-			position70, tokenIndex70 := position, tokenIndex
+			position86, tokenIndex86 := position, tokenIndex
 			{
-				position71 := position
+				position87 := position
 				{
-					position72 := position
-				l73:
+					position88 := position
+				l89:
 					{
-						position74, tokenIndex74 := position, tokenIndex
+						position90, tokenIndex90 := position, tokenIndex
 						{
-							position75, tokenIndex75 := position, tokenIndex
+							position91, tokenIndex91 := position, tokenIndex
 							{
-								position76, tokenIndex76 := position, tokenIndex
+								position92, tokenIndex92 := position, tokenIndex
 								if buffer[position] != rune('"') {
-									goto l77
+									goto l93
 								}
 								position++
-								goto l76
-							l77:
-								position, tokenIndex = position76, tokenIndex76
+								goto l92
+							l93:
+								position, tokenIndex = position92, tokenIndex92
 								if buffer[position] != rune('\'') {
-									goto l75
+									goto l91
 								}
 								position++
 							}
-						l76:
-							goto l74
-						l75:
-							position, tokenIndex = position75, tokenIndex75
+						l92:
+							goto l90
+						l91:
+							position, tokenIndex = position91, tokenIndex91
 						}
 						if !matchDot() {
-							goto l74
+							goto l90
 						}
-						goto l73
-					l74:
-						position, tokenIndex = position74, tokenIndex74
+						goto l89
+					l90:
+						position, tokenIndex = position90, tokenIndex90
 					}
-					add(rulePegText, position72)
+					add(rulePegText, position88)
 				}
-				if !_rules[ruleAction9]() {
-					goto l70
+				if !_rules[ruleAction16]() {
+					goto l86
 				}
-				add(rulevalue, position71)
+				add(rulevalue, position87)
 			}
 			return true
-		l70:
-			position, tokenIndex = position70, tokenIndex70
+		l86:
+			position, tokenIndex = position86, tokenIndex86
 			return false
 		},
-		/* 7 number <- <(<('0' / ([1-9] digit* ('.' digit*)?))> Action10)> */
+		/* 10 number <- <(<('0' / ([1-9] digit* ('.' digit*)?))> Action17)> */
 		func() bool {
-			position78, tokenIndex78 := position, tokenIndex
+			position94, tokenIndex94 := position, tokenIndex
 			{
-				position79 := position
+				position95 := position
 				{
-					position80 := position
+					position96 := position
 					{
-						position81, tokenIndex81 := position, tokenIndex
+						position97, tokenIndex97 := position, tokenIndex
 						if buffer[position] != rune('0') {
-							goto l82
+							goto l98
 						}
 						position++
-						goto l81
-					l82:
-						position, tokenIndex = position81, tokenIndex81
+						goto l97
+					l98:
+						position, tokenIndex = position97, tokenIndex97
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l78
+							goto l94
 						}
 						position++
-					l83:
+					l99:
 						{
-							position84, tokenIndex84 := position, tokenIndex
+							position100, tokenIndex100 := position, tokenIndex
 							if !_rules[ruledigit]() {
-								goto l84
+								goto l100
 							}
-							goto l83
-						l84:
-							position, tokenIndex = position84, tokenIndex84
+							goto l99
+						l100:
+							position, tokenIndex = position100, tokenIndex100
 						}
 						{
-							position85, tokenIndex85 := position, tokenIndex
+							position101, tokenIndex101 := position, tokenIndex
 							if buffer[position] != rune('.') {
-								goto l85
+								goto l101
 							}
 							position++
-						l87:
+						l103:
 							{
-								position88, tokenIndex88 := position, tokenIndex
+								position104, tokenIndex104 := position, tokenIndex
 								if !_rules[ruledigit]() {
-									goto l88
+									goto l104
 								}
-								goto l87
-							l88:
-								position, tokenIndex = position88, tokenIndex88
+								goto l103
+							l104:
+								position, tokenIndex = position104, tokenIndex104
 							}
-							goto l86
-						l85:
-							position, tokenIndex = position85, tokenIndex85
+							goto l102
+						l101:
+							position, tokenIndex = position101, tokenIndex101
 						}
-					l86:
+					l102:
 					}
-				l81:
-					add(rulePegText, position80)
+				l97:
+					add(rulePegText, position96)
 				}
-				if !_rules[ruleAction10]() {
-					goto l78
+				if !_rules[ruleAction17]() {
+					goto l94
 				}
-				add(rulenumber, position79)
+				add(rulenumber, position95)
 			}
 			return true
-		l78:
-			position, tokenIndex = position78, tokenIndex78
+		l94:
+			position, tokenIndex = position94, tokenIndex94
 			return false
 		},
-		/* 8 digit <- <[0-9]> */
+		/* 11 digit <- <[0-9]> */
 		func() bool {
-			position89, tokenIndex89 := position, tokenIndex
+			position105, tokenIndex105 := position, tokenIndex
 			{
-				position90 := position
+				position106 := position
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l89
+					goto l105
 				}
 				position++
-				add(ruledigit, position90)
+				add(ruledigit, position106)
 			}
 			return true
-		l89:
-			position, tokenIndex = position89, tokenIndex89
+		l105:
+			position, tokenIndex = position105, tokenIndex105
 			return false
 		},
-		/* 9 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))> Action11)> */
+		/* 12 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))> Action18)> */
 		func() bool {
-			position91, tokenIndex91 := position, tokenIndex
+			position107, tokenIndex107 := position, tokenIndex
 			{
-				position92 := position
+				position108 := position
 				{
-					position93, tokenIndex93 := position, tokenIndex
+					position109, tokenIndex109 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l94
+						goto l110
 					}
 					position++
-					goto l93
-				l94:
-					position, tokenIndex = position93, tokenIndex93
+					goto l109
+				l110:
+					position, tokenIndex = position109, tokenIndex109
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l107
 					}
 					position++
 				}
-			l93:
+			l109:
 				{
-					position95, tokenIndex95 := position, tokenIndex
+					position111, tokenIndex111 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l96
+						goto l112
 					}
 					position++
-					goto l95
-				l96:
-					position, tokenIndex = position95, tokenIndex95
+					goto l111
+				l112:
+					position, tokenIndex = position111, tokenIndex111
 					if buffer[position] != rune('I') {
-						goto l91
+						goto l107
 					}
 					position++
 				}
-			l95:
+			l111:
 				{
-					position97, tokenIndex97 := position, tokenIndex
+					position113, tokenIndex113 := position, tokenIndex
 					if buffer[position] != rune('m') {
-						goto l98
+						goto l114
 					}
 					position++
-					goto l97
-				l98:
-					position, tokenIndex = position97, tokenIndex97
+					goto l113
+				l114:
+					position, tokenIndex = position113, tokenIndex113
 					if buffer[position] != rune('M') {
-						goto l91
+						goto l107
 					}
 					position++
 				}
-			l97:
+			l113:
 				{
-					position99, tokenIndex99 := position, tokenIndex
+					position115, tokenIndex115 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l100
+						goto l116
 					}
 					position++
-					goto l99
-				l100:
-					position, tokenIndex = position99, tokenIndex99
+					goto l115
+				l116:
+					position, tokenIndex = position115, tokenIndex115
 					if buffer[position] != rune('E') {
-						goto l91
+						goto l107
 					}
 					position++
 				}
-			l99:
+			l115:
 				if buffer[position] != rune(' ') {
-					goto l91
+					goto l107
 				}
 				position++
 				{
-					position101 := position
+					position117 := position
 					if !_rules[ruleyear]() {
-						goto l91
+						goto l107
 					}
 					if buffer[position] != rune('-') {
-						goto l91
+						goto l107
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l91
+						goto l107
 					}
 					if buffer[position] != rune('-') {
-						goto l91
+						goto l107
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l91
+						goto l107
 					}
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l107
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					if buffer[position] != rune(':') {
-						goto l91
+						goto l107
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					if buffer[position] != rune(':') {
-						goto l91
+						goto l107
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					if !_rules[ruledigit]() {
-						goto l91
+						goto l107
 					}
 					{
-						position102, tokenIndex102 := position, tokenIndex
+						position118, tokenIndex118 := position, tokenIndex
 						{
-							position104, tokenIndex104 := position, tokenIndex
+							position120, tokenIndex120 := position, tokenIndex
 							if buffer[position] != rune('-') {
-								goto l105
+								goto l121
 							}
 							position++
-							goto l104
-						l105:
-							position, tokenIndex = position104, tokenIndex104
+							goto l120
+						l121:
+							position, tokenIndex = position120, tokenIndex120
 							if buffer[position] != rune('+') {
-								goto l103
+								goto l119
 							}
 							position++
 						}
-					l104:
+					l120:
 						if !_rules[ruledigit]() {
-							goto l103
+							goto l119
 						}
 						if !_rules[ruledigit]() {
-							goto l103
+							goto l119
 						}
 						if buffer[position] != rune(':') {
-							goto l103
+							goto l119
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l103
+							goto l119
 						}
 						if !_rules[ruledigit]() {
-							goto l103
+							goto l119
 						}
-						goto l102
-					l103:
-						position, tokenIndex = position102, tokenIndex102
+						goto l118
+					l119:
+						position, tokenIndex = position118, tokenIndex118
 						if buffer[position] != rune('Z') {
-							goto l91
+							goto l107
 						}
 						position++
 					}
-				l102:
-					add(rulePegText, position101)
+				l118:
+					add(rulePegText, position117)
 				}
-				if !_rules[ruleAction11]() {
-					goto l91
+				if !_rules[ruleAction18]() {
+					goto l107
 				}
-				add(ruletime, position92)
+				add(ruletime, position108)
 			}
 			return true
-		l91:
-			position, tokenIndex = position91, tokenIndex91
+		l107:
+			position, tokenIndex = position107, tokenIndex107
 			return false
 		},
-		/* 10 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)> Action12)> */
+		/* 13 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)> Action19)> */
 		func() bool {
-			position106, tokenIndex106 := position, tokenIndex
+			position122, tokenIndex122 := position, tokenIndex
 			{
-				position107 := position
+				position123 := position
 				{
-					position108, tokenIndex108 := position, tokenIndex
+					position124, tokenIndex124 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l109
+						goto l125
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex = position108, tokenIndex108
+					goto l124
+				l125:
+					position, tokenIndex = position124, tokenIndex124
 					if buffer[position] != rune('D') {
-						goto l106
+						goto l122
 					}
 					position++
 				}
-			l108:
+			l124:
 				{
-					position110, tokenIndex110 := position, tokenIndex
+					position126, tokenIndex126 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l111
+						goto l127
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex = position110, tokenIndex110
+					goto l126
+				l127:
+					position, tokenIndex = position126, tokenIndex126
 					if buffer[position] != rune('A') {
-						goto l106
+						goto l122
 					}
 					position++
 				}
-			l110:
+			l126:
 				{
-					position112, tokenIndex112 := position, tokenIndex
+					position128, tokenIndex128 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l113
+						goto l129
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex = position112, tokenIndex112
+					goto l128
+				l129:
+					position, tokenIndex = position128, tokenIndex128
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l122
 					}
 					position++
 				}
-			l112:
+			l128:
 				{
-					position114, tokenIndex114 := position, tokenIndex
+					position130, tokenIndex130 := position, tokenIndex
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l131
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex = position114, tokenIndex114
+					goto l130
+				l131:
+					position, tokenIndex = position130, tokenIndex130
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l122
 					}
 					position++
 				}
-			l114:
+			l130:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l122
 				}
 				position++
 				{
-					position116 := position
+					position132 := position
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l122
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l122
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l122
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l122
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l122
 					}
-					add(rulePegText, position116)
+					add(rulePegText, position132)
 				}
-				if !_rules[ruleAction12]() {
-					goto l106
+				if !_rules[ruleAction19]() {
+					goto l122
 				}
-				add(ruledate, position107)
+				add(ruledate, position123)
 			}
 			return true
-		l106:
-			position, tokenIndex = position106, tokenIndex106
+		l122:
+			position, tokenIndex = position122, tokenIndex122
 			return false
 		},
-		/* 11 year <- <(('1' / '2') digit digit digit)> */
+		/* 14 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position117, tokenIndex117 := position, tokenIndex
+			position133, tokenIndex133 := position, tokenIndex
 			{
-				position118 := position
+				position134 := position
 				{
-					position119, tokenIndex119 := position, tokenIndex
+					position135, tokenIndex135 := position, tokenIndex
 					if buffer[position] != rune('1') {
-						goto l120
+						goto l136
 					}
 					position++
-					goto l119
-				l120:
-					position, tokenIndex = position119, tokenIndex119
+					goto l135
+				l136:
+					position, tokenIndex = position135, tokenIndex135
 					if buffer[position] != rune('2') {
-						goto l117
+						goto l133
 					}
 					position++
 				}
-			l119:
+			l135:
 				if !_rules[ruledigit]() {
-					goto l117
+					goto l133
 				}
 				if !_rules[ruledigit]() {
-					goto l117
+					goto l133
 				}
 				if !_rules[ruledigit]() {
-					goto l117
+					goto l133
 				}
-				add(ruleyear, position118)
+				add(ruleyear, position134)
 			}
 			return true
-		l117:
-			position, tokenIndex = position117, tokenIndex117
+		l133:
+			position, tokenIndex = position133, tokenIndex133
 			return false
 		},
-		/* 12 month <- <(('0' / '1') digit)> */
+		/* 15 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position121, tokenIndex121 := position, tokenIndex
+			position137, tokenIndex137 := position, tokenIndex
 			{
-				position122 := position
+				position138 := position
 				{
-					position123, tokenIndex123 := position, tokenIndex
+					position139, tokenIndex139 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l124
+						goto l140
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex = position123, tokenIndex123
+					goto l139
+				l140:
+					position, tokenIndex = position139, tokenIndex139
 					if buffer[position] != rune('1') {
-						goto l121
+						goto l137
 					}
 					position++
 				}
-			l123:
+			l139:
 				if !_rules[ruledigit]() {
-					goto l121
+					goto l137
 				}
-				add(rulemonth, position122)
+				add(rulemonth, position138)
 			}
 			return true
-		l121:
-			position, tokenIndex = position121, tokenIndex121
+		l137:
+			position, tokenIndex = position137, tokenIndex137
 			return false
 		},
-		/* 13 day <- <(('0' / '1' / '2' / '3') digit)> */
+		/* 16 day <- <(('0' / '1' / '2' / '3') digit)> */
 		func() bool {
-			position125, tokenIndex125 := position, tokenIndex
+			position141, tokenIndex141 := position, tokenIndex
 			{
-				position126 := position
+				position142 := position
 				{
-					position127, tokenIndex127 := position, tokenIndex
+					position143, tokenIndex143 := position, tokenIndex
 					if buffer[position] != rune('0') {
-						goto l128
+						goto l144
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex = position127, tokenIndex127
+					goto l143
+				l144:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('1') {
-						goto l129
+						goto l145
 					}
 					position++
-					goto l127
-				l129:
-					position, tokenIndex = position127, tokenIndex127
+					goto l143
+				l145:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('2') {
-						goto l130
+						goto l146
 					}
 					position++
-					goto l127
-				l130:
-					position, tokenIndex = position127, tokenIndex127
+					goto l143
+				l146:
+					position, tokenIndex = position143, tokenIndex143
 					if buffer[position] != rune('3') {
-						goto l125
+						goto l141
 					}
 					position++
 				}
-			l127:
+			l143:
 				if !_rules[ruledigit]() {
-					goto l125
+					goto l141
 				}
-				add(ruleday, position126)
+				add(ruleday, position142)
 			}
 			return true
-		l125:
-			position, tokenIndex = position125, tokenIndex125
+		l141:
+			position, tokenIndex = position141, tokenIndex141
 			return false
 		},
-		/* 14 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') sp)> */
+		/* 17 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D') sp)> */
 		func() bool {
-			position131, tokenIndex131 := position, tokenIndex
+			position147, tokenIndex147 := position, tokenIndex
 			{
-				position132 := position
+				position148 := position
 				{
-					position133, tokenIndex133 := position, tokenIndex
+					position149, tokenIndex149 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l134
+						goto l150
 					}
 					position++
-					goto l133
-				l134:
-					position, tokenIndex = position133, tokenIndex133
+					goto l149
+				l150:
+					position, tokenIndex = position149, tokenIndex149
 					if buffer[position] != rune('A') {
-						goto l131
+						goto l147
 					}
 					position++
 				}
-			l133:
+			l149:
 				{
-					position135, tokenIndex135 := position, tokenIndex
+					position151, tokenIndex151 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l136
+						goto l152
 					}
 					position++
-					goto l135
-				l136:
-					position, tokenIndex = position135, tokenIndex135
+					goto l151
+				l152:
+					position, tokenIndex = position151, tokenIndex151
 					if buffer[position] != rune('N') {
-						goto l131
+						goto l147
 					}
 					position++
 				}
-			l135:
+			l151:
 				{
-					position137, tokenIndex137 := position, tokenIndex
+					position153, tokenIndex153 := position, tokenIndex
 					if buffer[position] != rune('d') {
-						goto l138
+						goto l154
 					}
 					position++
-					goto l137
-				l138:
-					position, tokenIndex = position137, tokenIndex137
+					goto l153
+				l154:
+					position, tokenIndex = position153, tokenIndex153
 					if buffer[position] != rune('D') {
-						goto l131
+						goto l147
 					}
 					position++
 				}
-			l137:
+			l153:
 				if !_rules[rulesp]() {
-					goto l131
+					goto l147
 				}
-				add(ruleand, position132)
+				add(ruleand, position148)
 			}
 			return true
-		l131:
-			position, tokenIndex = position131, tokenIndex131
+		l147:
+			position, tokenIndex = position147, tokenIndex147
 			return false
 		},
-		/* 15 or <- <(('o' / 'O') ('r' / 'R') sp)> */
+		/* 18 or <- <(('o' / 'O') ('r' / 'R') sp)> */
 		func() bool {
-			position139, tokenIndex139 := position, tokenIndex
+			position155, tokenIndex155 := position, tokenIndex
 			{
-				position140 := position
+				position156 := position
 				{
-					position141, tokenIndex141 := position, tokenIndex
+					position157, tokenIndex157 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l142
+						goto l158
 					}
 					position++
-					goto l141
-				l142:
-					position, tokenIndex = position141, tokenIndex141
+					goto l157
+				l158:
+					position, tokenIndex = position157, tokenIndex157
 					if buffer[position] != rune('O') {
-						goto l139
+						goto l155
 					}
 					position++
 				}
-			l141:
+			l157:
 				{
-					position143, tokenIndex143 := position, tokenIndex
+					position159, tokenIndex159 := position, tokenIndex
 					if buffer[position] != rune('r') {
-						goto l144
+						goto l160
 					}
 					position++
-					goto l143
-				l144:
-					position, tokenIndex = position143, tokenIndex143
+					goto l159
+				l160:
+					position, tokenIndex = position159, tokenIndex159
 					if buffer[position] != rune('R') {
-						goto l139
+						goto l155
 					}
 					position++
 				}
-			l143:
+			l159:
 				if !_rules[rulesp]() {
-					goto l139
+					goto l155
 				}
-				add(ruleor, position140)
+				add(ruleor, position156)
 			}
 			return true
-		l139:
-			position, tokenIndex = position139, tokenIndex139
+		l155:
+			position, tokenIndex = position155, tokenIndex155
 			return false
 		},
-		/* 16 equal <- <('=' sp)> */
+		/* 19 equal <- <('=' sp)> */
 		func() bool {
-			position145, tokenIndex145 := position, tokenIndex
+			position161, tokenIndex161 := position, tokenIndex
 			{
-				position146 := position
+				position162 := position
 				if buffer[position] != rune('=') {
-					goto l145
+					goto l161
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l145
+					goto l161
 				}
-				add(ruleequal, position146)
+				add(ruleequal, position162)
 			}
 			return true
-		l145:
-			position, tokenIndex = position145, tokenIndex145
+		l161:
+			position, tokenIndex = position161, tokenIndex161
 			return false
 		},
-		/* 17 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') sp)> */
+		/* 20 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S') sp)> */
 		func() bool {
-			position147, tokenIndex147 := position, tokenIndex
+			position163, tokenIndex163 := position, tokenIndex
 			{
-				position148 := position
+				position164 := position
 				{
-					position149, tokenIndex149 := position, tokenIndex
+					position165, tokenIndex165 := position, tokenIndex
 					if buffer[position] != rune('c') {
-						goto l150
+						goto l166
 					}
 					position++
-					goto l149
-				l150:
-					position, tokenIndex = position149, tokenIndex149
+					goto l165
+				l166:
+					position, tokenIndex = position165, tokenIndex165
 					if buffer[position] != rune('C') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l149:
+			l165:
 				{
-					position151, tokenIndex151 := position, tokenIndex
+					position167, tokenIndex167 := position, tokenIndex
 					if buffer[position] != rune('o') {
-						goto l152
+						goto l168
 					}
 					position++
-					goto l151
-				l152:
-					position, tokenIndex = position151, tokenIndex151
+					goto l167
+				l168:
+					position, tokenIndex = position167, tokenIndex167
 					if buffer[position] != rune('O') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l151:
+			l167:
 				{
-					position153, tokenIndex153 := position, tokenIndex
+					position169, tokenIndex169 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l154
+						goto l170
 					}
 					position++
-					goto l153
-				l154:
-					position, tokenIndex = position153, tokenIndex153
+					goto l169
+				l170:
+					position, tokenIndex = position169, tokenIndex169
 					if buffer[position] != rune('N') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l153:
+			l169:
 				{
-					position155, tokenIndex155 := position, tokenIndex
+					position171, tokenIndex171 := position, tokenIndex
 					if buffer[position] != rune('t') {
-						goto l156
+						goto l172
 					}
 					position++
-					goto l155
-				l156:
-					position, tokenIndex = position155, tokenIndex155
+					goto l171
+				l172:
+					position, tokenIndex = position171, tokenIndex171
 					if buffer[position] != rune('T') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l155:
+			l171:
 				{
-					position157, tokenIndex157 := position, tokenIndex
+					position173, tokenIndex173 := position, tokenIndex
 					if buffer[position] != rune('a') {
-						goto l158
+						goto l174
 					}
 					position++
-					goto l157
-				l158:
-					position, tokenIndex = position157, tokenIndex157
+					goto l173
+				l174:
+					position, tokenIndex = position173, tokenIndex173
 					if buffer[position] != rune('A') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l157:
+			l173:
 				{
-					position159, tokenIndex159 := position, tokenIndex
+					position175, tokenIndex175 := position, tokenIndex
 					if buffer[position] != rune('i') {
-						goto l160
+						goto l176
 					}
 					position++
-					goto l159
-				l160:
-					position, tokenIndex = position159, tokenIndex159
+					goto l175
+				l176:
+					position, tokenIndex = position175, tokenIndex175
 					if buffer[position] != rune('I') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l159:
+			l175:
 				{
-					position161, tokenIndex161 := position, tokenIndex
+					position177, tokenIndex177 := position, tokenIndex
 					if buffer[position] != rune('n') {
-						goto l162
+						goto l178
 					}
 					position++
-					goto l161
-				l162:
-					position, tokenIndex = position161, tokenIndex161
+					goto l177
+				l178:
+					position, tokenIndex = position177, tokenIndex177
 					if buffer[position] != rune('N') {
-						goto l147
+						goto l163
 					}
 					position++
 				}
-			l161:
+			l177:
 				{
-					position163, tokenIndex163 := position, tokenIndex
+					position179, tokenIndex179 := position, tokenIndex
 					if buffer[position] != rune('s') {
-						goto l164
+						goto l180
+					}
+					position++
+					goto l179
+				l180:
+					position, tokenIndex = position179, tokenIndex179
+					if buffer[position] != rune('S') {
+						goto l163
 					}
 					position++
+				}
+			l179:
+				if !_rules[rulesp]() {
 					goto l163
-				l164:
-					position, tokenIndex = position163, tokenIndex163
+				}
+				add(rulecontains, position164)
+			}
+			return true
+		l163:
+			position, tokenIndex = position163, tokenIndex163
+			return false
+		},
+		/* 21 startsWith <- <(('s' / 'S') ('t' / 'T') ('a' / 'A') ('r' / 'R') ('t' / 'T') ('s' / 'S') '_' ('w' / 'W') ('i' / 'I') ('t' / 'T') ('h' / 'H') sp)> */
+		func() bool {
+			position181, tokenIndex181 := position, tokenIndex
+			{
+				position182 := position
+				{
+					position183, tokenIndex183 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l184
+					}
+					position++
+					goto l183
+				l184:
+					position, tokenIndex = position183, tokenIndex183
 					if buffer[position] != rune('S') {
-						goto l147
+						goto l181
 					}
 					position++
 				}
-			l163:
+			l183:
+				{
+					position185, tokenIndex185 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l186
+					}
+					position++
+					goto l185
+				l186:
+					position, tokenIndex = position185, tokenIndex185
+					if buffer[position] != rune('T') {
+						goto l181
+					}
+					position++
+				}
+			l185:
+				{
+					position187, tokenIndex187 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l188
+					}
+					position++
+					goto l187
+				l188:
+					position, tokenIndex = position187, tokenIndex187
+					if buffer[position] != rune('A') {
+						goto l181
+					}
+					position++
+				}
+			l187:
+				{
+					position189, tokenIndex189 := position, tokenIndex
+					if buffer[position] != rune('r') {
+						goto l190
+					}
+					position++
+					goto l189
+				l190:
+					position, tokenIndex = position189, tokenIndex189
+					if buffer[position] != rune('R') {
+						goto l181
+					}
+					position++
+				}
+			l189:
+				{
+					position191, tokenIndex191 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l192
+					}
+					position++
+					goto l191
+				l192:
+					position, tokenIndex = position191, tokenIndex191
+					if buffer[position] != rune('T') {
+						goto l181
+					}
+					position++
+				}
+			l191:
+				{
+					position193, tokenIndex193 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l194
+					}
+					position++
+					goto l193
+				l194:
+					position, tokenIndex = position193, tokenIndex193
+					if buffer[position] != rune('S') {
+						goto l181
+					}
+					position++
+				}
+			l193:
+				if buffer[position] != rune('_') {
+					goto l181
+				}
+				position++
+				{
+					position195, tokenIndex195 := position, tokenIndex
+					if buffer[position] != rune('w') {
+						goto l196
+					}
+					position++
+					goto l195
+				l196:
+					position, tokenIndex = position195, tokenIndex195
+					if buffer[position] != rune('W') {
+						goto l181
+					}
+					position++
+				}
+			l195:
+				{
+					position197, tokenIndex197 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l198
+					}
+					position++
+					goto l197
+				l198:
+					position, tokenIndex = position197, tokenIndex197
+					if buffer[position] != rune('I') {
+						goto l181
+					}
+					position++
+				}
+			l197:
+				{
+					position199, tokenIndex199 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l200
+					}
+					position++
+					goto l199
+				l200:
+					position, tokenIndex = position199, tokenIndex199
+					if buffer[position] != rune('T') {
+						goto l181
+					}
+					position++
+				}
+			l199:
+				{
+					position201, tokenIndex201 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l202
+					}
+					position++
+					goto l201
+				l202:
+					position, tokenIndex = position201, tokenIndex201
+					if buffer[position] != rune('H') {
+						goto l181
+					}
+					position++
+				}
+			l201:
 				if !_rules[rulesp]() {
-					goto l147
+					goto l181
 				}
-				add(rulecontains, position148)
+				add(rulestartsWith, position182)
 			}
 			return true
-		l147:
-			position, tokenIndex = position147, tokenIndex147
+		l181:
+			position, tokenIndex = position181, tokenIndex181
 			return false
 		},
-		/* 18 le <- <('<' '=' sp)> */
+		/* 22 matches <- <(('m' / 'M') ('a' / 'A') ('t' / 'T') ('c' / 'C') ('h' / 'H') ('e' / 'E') ('s' / 'S') sp)> */
 		func() bool {
-			position165, tokenIndex165 := position, tokenIndex
+			position203, tokenIndex203 := position, tokenIndex
 			{
-				position166 := position
+				position204 := position
+				{
+					position205, tokenIndex205 := position, tokenIndex
+					if buffer[position] != rune('m') {
+						goto l206
+					}
+					position++
+					goto l205
+				l206:
+					position, tokenIndex = position205, tokenIndex205
+					if buffer[position] != rune('M') {
+						goto l203
+					}
+					position++
+				}
+			l205:
+				{
+					position207, tokenIndex207 := position, tokenIndex
+					if buffer[position] != rune('a') {
+						goto l208
+					}
+					position++
+					goto l207
+				l208:
+					position, tokenIndex = position207, tokenIndex207
+					if buffer[position] != rune('A') {
+						goto l203
+					}
+					position++
+				}
+			l207:
+				{
+					position209, tokenIndex209 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l210
+					}
+					position++
+					goto l209
+				l210:
+					position, tokenIndex = position209, tokenIndex209
+					if buffer[position] != rune('T') {
+						goto l203
+					}
+					position++
+				}
+			l209:
+				{
+					position211, tokenIndex211 := position, tokenIndex
+					if buffer[position] != rune('c') {
+						goto l212
+					}
+					position++
+					goto l211
+				l212:
+					position, tokenIndex = position211, tokenIndex211
+					if buffer[position] != rune('C') {
+						goto l203
+					}
+					position++
+				}
+			l211:
+				{
+					position213, tokenIndex213 := position, tokenIndex
+					if buffer[position] != rune('h') {
+						goto l214
+					}
+					position++
+					goto l213
+				l214:
+					position, tokenIndex = position213, tokenIndex213
+					if buffer[position] != rune('H') {
+						goto l203
+					}
+					position++
+				}
+			l213:
+				{
+					position215, tokenIndex215 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l216
+					}
+					position++
+					goto l215
+				l216:
+					position, tokenIndex = position215, tokenIndex215
+					if buffer[position] != rune('E') {
+						goto l203
+					}
+					position++
+				}
+			l215:
+				{
+					position217, tokenIndex217 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l218
+					}
+					position++
+					goto l217
+				l218:
+					position, tokenIndex = position217, tokenIndex217
+					if buffer[position] != rune('S') {
+						goto l203
+					}
+					position++
+				}
+			l217:
+				if !_rules[rulesp]() {
+					goto l203
+				}
+				add(rulematches, position204)
+			}
+			return true
+		l203:
+			position, tokenIndex = position203, tokenIndex203
+			return false
+		},
+		/* 23 in <- <(('i' / 'I') ('n' / 'N') sp)> */
+		func() bool {
+			position219, tokenIndex219 := position, tokenIndex
+			{
+				position220 := position
+				{
+					position221, tokenIndex221 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l222
+					}
+					position++
+					goto l221
+				l222:
+					position, tokenIndex = position221, tokenIndex221
+					if buffer[position] != rune('I') {
+						goto l219
+					}
+					position++
+				}
+			l221:
+				{
+					position223, tokenIndex223 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l224
+					}
+					position++
+					goto l223
+				l224:
+					position, tokenIndex = position223, tokenIndex223
+					if buffer[position] != rune('N') {
+						goto l219
+					}
+					position++
+				}
+			l223:
+				if !_rules[rulesp]() {
+					goto l219
+				}
+				add(rulein, position220)
+			}
+			return true
+		l219:
+			position, tokenIndex = position219, tokenIndex219
+			return false
+		},
+		/* 24 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T') (sp1 / &'('))> */
+		func() bool {
+			position225, tokenIndex225 := position, tokenIndex
+			{
+				position226 := position
+				{
+					position227, tokenIndex227 := position, tokenIndex
+					if buffer[position] != rune('n') {
+						goto l228
+					}
+					position++
+					goto l227
+				l228:
+					position, tokenIndex = position227, tokenIndex227
+					if buffer[position] != rune('N') {
+						goto l225
+					}
+					position++
+				}
+			l227:
+				{
+					position229, tokenIndex229 := position, tokenIndex
+					if buffer[position] != rune('o') {
+						goto l230
+					}
+					position++
+					goto l229
+				l230:
+					position, tokenIndex = position229, tokenIndex229
+					if buffer[position] != rune('O') {
+						goto l225
+					}
+					position++
+				}
+			l229:
+				{
+					position231, tokenIndex231 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l232
+					}
+					position++
+					goto l231
+				l232:
+					position, tokenIndex = position231, tokenIndex231
+					if buffer[position] != rune('T') {
+						goto l225
+					}
+					position++
+				}
+			l231:
+				{
+					position233, tokenIndex233 := position, tokenIndex
+					if !_rules[rulesp1]() {
+						goto l234
+					}
+					goto l233
+				l234:
+					position, tokenIndex = position233, tokenIndex233
+					{
+						position235, tokenIndex235 := position, tokenIndex
+						if buffer[position] != rune('(') {
+							goto l225
+						}
+						position++
+						position, tokenIndex = position235, tokenIndex235
+					}
+				}
+			l233:
+				add(rulenot, position226)
+			}
+			return true
+		l225:
+			position, tokenIndex = position225, tokenIndex225
+			return false
+		},
+		/* 25 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S') sp1)> */
+		func() bool {
+			position236, tokenIndex236 := position, tokenIndex
+			{
+				position237 := position
+				{
+					position238, tokenIndex238 := position, tokenIndex
+					if buffer[position] != rune('e') {
+						goto l239
+					}
+					position++
+					goto l238
+				l239:
+					position, tokenIndex = position238, tokenIndex238
+					if buffer[position] != rune('E') {
+						goto l236
+					}
+					position++
+				}
+			l238:
+				{
+					position240, tokenIndex240 := position, tokenIndex
+					if buffer[position] != rune('x') {
+						goto l241
+					}
+					position++
+					goto l240
+				l241:
+					position, tokenIndex = position240, tokenIndex240
+					if buffer[position] != rune('X') {
+						goto l236
+					}
+					position++
+				}
+			l240:
+				{
+					position242, tokenIndex242 := position, tokenIndex
+					if buffer[position] != rune('i') {
+						goto l243
+					}
+					position++
+					goto l242
+				l243:
+					position, tokenIndex = position242, tokenIndex242
+					if buffer[position] != rune('I') {
+						goto l236
+					}
+					position++
+				}
+			l242:
+				{
+					position244, tokenIndex244 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l245
+					}
+					position++
+					goto l244
+				l245:
+					position, tokenIndex = position244, tokenIndex244
+					if buffer[position] != rune('S') {
+						goto l236
+					}
+					position++
+				}
+			l244:
+				{
+					position246, tokenIndex246 := position, tokenIndex
+					if buffer[position] != rune('t') {
+						goto l247
+					}
+					position++
+					goto l246
+				l247:
+					position, tokenIndex = position246, tokenIndex246
+					if buffer[position] != rune('T') {
+						goto l236
+					}
+					position++
+				}
+			l246:
+				{
+					position248, tokenIndex248 := position, tokenIndex
+					if buffer[position] != rune('s') {
+						goto l249
+					}
+					position++
+					goto l248
+				l249:
+					position, tokenIndex = position248, tokenIndex248
+					if buffer[position] != rune('S') {
+						goto l236
+					}
+					position++
+				}
+			l248:
+				if !_rules[rulesp1]() {
+					goto l236
+				}
+				add(ruleexists, position237)
+			}
+			return true
+		l236:
+			position, tokenIndex = position236, tokenIndex236
+			return false
+		},
+		/* 26 le <- <('<' '=' sp)> */
+		func() bool {
+			position250, tokenIndex250 := position, tokenIndex
+			{
+				position251 := position
 				if buffer[position] != rune('<') {
-					goto l165
+					goto l250
 				}
 				position++
 				if buffer[position] != rune('=') {
-					goto l165
+					goto l250
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l165
+					goto l250
 				}
-				add(rulele, position166)
+				add(rulele, position251)
 			}
 			return true
-		l165:
-			position, tokenIndex = position165, tokenIndex165
+		l250:
+			position, tokenIndex = position250, tokenIndex250
 			return false
 		},
-		/* 19 ge <- <('>' '=' sp)> */
+		/* 27 ge <- <('>' '=' sp)> */
 		func() bool {
-			position167, tokenIndex167 := position, tokenIndex
+			position252, tokenIndex252 := position, tokenIndex
 			{
-				position168 := position
+				position253 := position
 				if buffer[position] != rune('>') {
-					goto l167
+					goto l252
 				}
 				position++
 				if buffer[position] != rune('=') {
-					goto l167
+					goto l252
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l167
+					goto l252
 				}
-				add(rulege, position168)
+				add(rulege, position253)
 			}
 			return true
-		l167:
-			position, tokenIndex = position167, tokenIndex167
+		l252:
+			position, tokenIndex = position252, tokenIndex252
 			return false
 		},
-		/* 20 l <- <('<' sp)> */
+		/* 28 l <- <('<' sp)> */
 		func() bool {
-			position169, tokenIndex169 := position, tokenIndex
+			position254, tokenIndex254 := position, tokenIndex
 			{
-				position170 := position
+				position255 := position
 				if buffer[position] != rune('<') {
-					goto l169
+					goto l254
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l169
+					goto l254
 				}
-				add(rulel, position170)
+				add(rulel, position255)
 			}
 			return true
-		l169:
-			position, tokenIndex = position169, tokenIndex169
+		l254:
+			position, tokenIndex = position254, tokenIndex254
 			return false
 		},
-		/* 21 g <- <('>' sp)> */
+		/* 29 g <- <('>' sp)> */
 		func() bool {
-			position171, tokenIndex171 := position, tokenIndex
+			position256, tokenIndex256 := position, tokenIndex
 			{
-				position172 := position
+				position257 := position
 				if buffer[position] != rune('>') {
-					goto l171
+					goto l256
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l171
+					goto l256
 				}
-				add(ruleg, position172)
+				add(ruleg, position257)
 			}
 			return true
-		l171:
-			position, tokenIndex = position171, tokenIndex171
+		l256:
+			position, tokenIndex = position256, tokenIndex256
 			return false
 		},
-		/* 22 open <- <('(' sp)> */
+		/* 30 open <- <('(' sp)> */
 		func() bool {
-			position173, tokenIndex173 := position, tokenIndex
+			position258, tokenIndex258 := position, tokenIndex
 			{
-				position174 := position
+				position259 := position
 				if buffer[position] != rune('(') {
-					goto l173
+					goto l258
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l173
+					goto l258
 				}
-				add(ruleopen, position174)
+				add(ruleopen, position259)
 			}
 			return true
-		l173:
-			position, tokenIndex = position173, tokenIndex173
+		l258:
+			position, tokenIndex = position258, tokenIndex258
 			return false
 		},
-		/* 23 close <- <(')' sp)> */
+		/* 31 close <- <(')' sp)> */
 		func() bool {
-			position175, tokenIndex175 := position, tokenIndex
+			position260, tokenIndex260 := position, tokenIndex
 			{
-				position176 := position
+				position261 := position
 				if buffer[position] != rune(')') {
-					goto l175
+					goto l260
 				}
 				position++
 				if !_rules[rulesp]() {
-					goto l175
+					goto l260
 				}
-				add(ruleclose, position176)
+				add(ruleclose, position261)
 			}
 			return true
-		l175:
-			position, tokenIndex = position175, tokenIndex175
+		l260:
+			position, tokenIndex = position260, tokenIndex260
 			return false
 		},
-		/* 24 sp <- <(' ' / '\t')*> */
+		/* 32 comma <- <(',' sp)> */
 		func() bool {
+			position262, tokenIndex262 := position, tokenIndex
 			{
-				position178 := position
-			l179:
+				position263 := position
+				if buffer[position] != rune(',') {
+					goto l262
+				}
+				position++
+				if !_rules[rulesp]() {
+					goto l262
+				}
+				add(rulecomma, position263)
+			}
+			return true
+		l262:
+			position, tokenIndex = position262, tokenIndex262
+			return false
+		},
+		/* 33 sp <- <(' ' / '\t')*> */
+		func() bool {
+			{
+				position265 := position
+			l266:
 				{
-					position180, tokenIndex180 := position, tokenIndex
+					position267, tokenIndex267 := position, tokenIndex
 					{
-						position181, tokenIndex181 := position, tokenIndex
+						position268, tokenIndex268 := position, tokenIndex
 						if buffer[position] != rune(' ') {
-							goto l182
+							goto l269
 						}
 						position++
-						goto l181
-					l182:
-						position, tokenIndex = position181, tokenIndex181
+						goto l268
+					l269:
+						position, tokenIndex = position268, tokenIndex268
 						if buffer[position] != rune('\t') {
-							goto l180
+							goto l267
 						}
 						position++
 					}
-				l181:
-					goto l179
-				l180:
-					position, tokenIndex = position180, tokenIndex180
+				l268:
+					goto l266
+				l267:
+					position, tokenIndex = position267, tokenIndex267
+				}
+				add(rulesp, position265)
+			}
+			return true
+		},
+		/* 34 sp1 <- <(' ' / '\t')+> */
+		func() bool {
+			position270, tokenIndex270 := position, tokenIndex
+			{
+				position271 := position
+				{
+					position274, tokenIndex274 := position, tokenIndex
+					if buffer[position] != rune(' ') {
+						goto l275
+					}
+					position++
+					goto l274
+				l275:
+					position, tokenIndex = position274, tokenIndex274
+					if buffer[position] != rune('\t') {
+						goto l270
+					}
+					position++
+				}
+			l274:
+			l272:
+				{
+					position273, tokenIndex273 := position, tokenIndex
+					{
+						position276, tokenIndex276 := position, tokenIndex
+						if buffer[position] != rune(' ') {
+							goto l277
+						}
+						position++
+						goto l276
+					l277:
+						position, tokenIndex = position276, tokenIndex276
+						if buffer[position] != rune('\t') {
+							goto l273
+						}
+						position++
+					}
+				l276:
+					goto l272
+				l273:
+					position, tokenIndex = position273, tokenIndex273
 				}
-				add(rulesp, position178)
+				add(rulesp1, position271)
 			}
 			return true
+		l270:
+			position, tokenIndex = position270, tokenIndex270
+			return false
 		},
-		/* 26 Action0 <- <{ p.Operator(OpOr) }> */
+		/* 36 Action0 <- <{ p.Operator(OpOr) }> */
 		func() bool {
 			{
 				add(ruleAction0, position)
 			}
 			return true
 		},
-		/* 27 Action1 <- <{ p.Operator(OpAnd) }> */
+		/* 37 Action1 <- <{ p.Operator(OpAnd) }> */
 		func() bool {
 			{
 				add(ruleAction1, position)
 			}
 			return true
 		},
-		/* 28 Action2 <- <{ p.Operator(OpLessEqual) }> */
+		/* 38 Action2 <- <{ p.Operator(OpNot) }> */
 		func() bool {
 			{
 				add(ruleAction2, position)
 			}
 			return true
 		},
-		/* 29 Action3 <- <{ p.Operator(OpGreaterEqual) }> */
+		/* 39 Action3 <- <{ p.Operator(OpExists) }> */
 		func() bool {
 			{
 				add(ruleAction3, position)
 			}
 			return true
 		},
-		/* 30 Action4 <- <{ p.Operator(OpLess) }> */
+		/* 40 Action4 <- <{ p.Operator(OpLessEqual) }> */
 		func() bool {
 			{
 				add(ruleAction4, position)
 			}
 			return true
 		},
-		/* 31 Action5 <- <{ p.Operator(OpGreater) }> */
+		/* 41 Action5 <- <{ p.Operator(OpGreaterEqual) }> */
 		func() bool {
 			{
 				add(ruleAction5, position)
 			}
 			return true
 		},
-		/* 32 Action6 <- <{ p.Operator(OpEqual) }> */
+		/* 42 Action6 <- <{ p.Operator(OpLess) }> */
 		func() bool {
 			{
 				add(ruleAction6, position)
 			}
 			return true
 		},
-		/* 33 Action7 <- <{ p.Operator(OpContains) }> */
+		/* 43 Action7 <- <{ p.Operator(OpGreater) }> */
 		func() bool {
 			{
 				add(ruleAction7, position)
 			}
 			return true
 		},
-		nil,
-		/* 35 Action8 <- <{ p.Tag(buffer[begin:end]) }> */
+		/* 44 Action8 <- <{ p.Operator(OpEqual) }> */
 		func() bool {
 			{
 				add(ruleAction8, position)
 			}
 			return true
 		},
-		/* 36 Action9 <- <{ p.Value(buffer[begin:end]) }> */
+		/* 45 Action9 <- <{ p.Operator(OpContains) }> */
 		func() bool {
 			{
 				add(ruleAction9, position)
 			}
 			return true
 		},
-		/* 37 Action10 <- <{ p.Number(buffer[begin:end]) }> */
+		/* 46 Action10 <- <{ p.Operator(OpStartsWith) }> */
 		func() bool {
 			{
 				add(ruleAction10, position)
 			}
 			return true
 		},
-		/* 38 Action11 <- <{ p.Time(buffer[begin:end]) }> */
+		/* 47 Action11 <- <{ p.Operator(OpMatches) }> */
 		func() bool {
 			{
 				add(ruleAction11, position)
 			}
 			return true
 		},
-		/* 39 Action12 <- <{ p.Date(buffer[begin:end]) }> */
+		/* 48 Action12 <- <{ p.Operator(OpIn) }> */
 		func() bool {
 			{
 				add(ruleAction12, position)
 			}
 			return true
 		},
+		/* 49 Action13 <- <{ p.List() }> */
+		func() bool {
+			{
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 50 Action14 <- <{ p.Append() }> */
+		func() bool {
+			{
+				add(ruleAction14, position)
+			}
+			return true
+		},
+		nil,
+		/* 52 Action15 <- <{ p.Tag(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction15, position)
+			}
+			return true
+		},
+		/* 53 Action16 <- <{ p.Value(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction16, position)
+			}
+			return true
+		},
+		/* 54 Action17 <- <{ p.Number(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction17, position)
+			}
+			return true
+		},
+		/* 55 Action18 <- <{ p.Time(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction18, position)
+			}
+			return true
+		},
+		/* 56 Action19 <- <{ p.Date(buffer[begin:end]) }> */
+		func() bool {
+			{
+				add(ruleAction19, position)
+			}
+			return true
+		},
 	}
 	p.rules = _rules
 	return nil
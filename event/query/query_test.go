@@ -2,6 +2,7 @@ package query
 
 import (
 	"fmt"
+	"math/big"
 	"testing"
 	"time"
 
@@ -58,6 +59,22 @@ func TestMatches(t *testing.T) {
 
 		{"abci.owner.name CONTAINS 'Igor'", map[string]interface{}{"abci.owner.name": "Igor,Ivan"}, false, true},
 		{"abci.owner.name CONTAINS 'Igor'", map[string]interface{}{"abci.owner.name": "Pavel,Ivan"}, false, false},
+
+		{"NOT foo = 'bar'", map[string]interface{}{"foo": "baz"}, false, true},
+		{"NOT foo = 'bar'", map[string]interface{}{"foo": "bar"}, false, false},
+		{"NOT foo = 'bar'", map[string]interface{}{}, false, true},
+		{"NOT foo = 'bar' AND baz = 'qux'", map[string]interface{}{"foo": "bar", "baz": "qux"}, false, false},
+		{"NOT (foo = 'bar' AND baz = 'qux')", map[string]interface{}{"foo": "bar", "baz": "quux"}, false, true},
+		{"foo IN ('bar', 'baz')", map[string]interface{}{"foo": "baz"}, false, true},
+		{"foo IN ('bar', 'baz')", map[string]interface{}{"foo": "qux"}, false, false},
+		{"Height IN (10, 12)", map[string]interface{}{"Height": uint64(12)}, false, true},
+		{"Height IN (10, 12)", map[string]interface{}{"Height": uint64(11)}, false, false},
+		{"EXISTS foo", map[string]interface{}{"foo": ""}, false, true},
+		{"EXISTS foo", map[string]interface{}{"bar": "foo"}, false, false},
+		{"foo STARTS_WITH 'ba'", map[string]interface{}{"foo": "bar"}, false, true},
+		{"foo STARTS_WITH 'ba'", map[string]interface{}{"foo": "abar"}, false, false},
+		{"foo MATCHES '^[0-9A-F]{4}$'", map[string]interface{}{"foo": "0A3F"}, false, true},
+		{"foo MATCHES '^[0-9A-F]{4}$'", map[string]interface{}{"foo": "0A3FF"}, false, false},
 	}
 
 	for _, tc := range testCases {
@@ -86,3 +103,19 @@ func TestMustParse(t *testing.T) {
 	assert.Panics(t, func() { MustParse("=") })
 	assert.NotPanics(t, func() { MustParse("tm.events.type='NewBlock'") })
 }
+
+func TestConjuncts(t *testing.T) {
+	qry := MustParse("Address = 'AB' AND (Log0 IN ('01', '02') AND NOT TxType = 'CallTx') AND " +
+		"(Height > 3 OR Height < 2) AND EXISTS Log1 AND Height >= 5")
+	conditions := qry.Conjuncts()
+	require.Len(t, conditions, 4)
+	assert.Equal(t, Condition{Tag: "Address", Op: OpEqual, Operand: "AB"}, conditions[0])
+	assert.Equal(t, Condition{Tag: "Log0", Op: OpIn, Operand: []interface{}{"01", "02"}}, conditions[1])
+	assert.Equal(t, Condition{Tag: "Log1", Op: OpExists}, conditions[2])
+	assert.Equal(t, "Height", conditions[3].Tag)
+	assert.Equal(t, OpGreaterEqual, conditions[3].Op)
+	assert.Equal(t, "5", conditions[3].Operand.(*big.Float).String())
+
+	assert.Empty(t, MustParse("Address = 'AB' OR Log0 = '01'").Conjuncts())
+	assert.Len(t, MustParse("Address = 'AB'").Conjuncts(), 1)
+}
@@ -27,8 +27,10 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type Exception struct {
-	CodeNumber           uint32   `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
-	Exception            string   `protobuf:"bytes,2,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	CodeNumber uint32 `protobuf:"varint,1,opt,name=Code,proto3" json:"Code,omitempty"`
+	Exception  string `protobuf:"bytes,2,opt,name=Exception,proto3" json:"Exception,omitempty"`
+	// Reason given by the contract to revert() or require() if the call was reverted with one
+	RevertReason         string   `protobuf:"bytes,3,opt,name=RevertReason,proto3" json:"RevertReason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -76,6 +78,13 @@ func (m *Exception) GetException() string {
 	return ""
 }
 
+func (m *Exception) GetRevertReason() string {
+	if m != nil {
+		return m.RevertReason
+	}
+	return ""
+}
+
 func (*Exception) XXX_MessageName() string {
 	return "errors.Exception"
 }
@@ -88,20 +97,21 @@ func init() { proto.RegisterFile("errors.proto", fileDescriptor_24fe73c7f0ddb19c
 func init() { golang_proto.RegisterFile("errors.proto", fileDescriptor_24fe73c7f0ddb19c) }
 
 var fileDescriptor_24fe73c7f0ddb19c = []byte{
-	// 202 bytes of a gzipped FileDescriptorProto
+	// 218 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x49, 0x2d, 0x2a, 0xca,
 	0x2f, 0x2a, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0x83, 0xf0, 0xa4, 0x74, 0xd3, 0x33,
 	0x4b, 0x32, 0x4a, 0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0xd3, 0xf3, 0xd3, 0xf3, 0xf5, 0xc1, 0xd2,
-	0x49, 0xa5, 0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0xb4, 0x29, 0x05, 0x73, 0x71, 0xba, 0x56,
+	0x49, 0xa5, 0x69, 0x60, 0x1e, 0x98, 0x03, 0x66, 0x41, 0xb4, 0x29, 0x95, 0x73, 0x71, 0xba, 0x56,
 	0x24, 0xa7, 0x16, 0x94, 0x64, 0xe6, 0xe7, 0x09, 0x29, 0x71, 0xb1, 0x38, 0xe7, 0xa7, 0xa4, 0x4a,
 	0x30, 0x2a, 0x30, 0x6a, 0xf0, 0x3a, 0xf1, 0x3d, 0xba, 0x27, 0xcf, 0x05, 0xe2, 0xfb, 0x95, 0xe6,
 	0x26, 0xa5, 0x16, 0x05, 0x81, 0xe5, 0x84, 0x64, 0x90, 0x34, 0x48, 0x30, 0x29, 0x30, 0x6a, 0x70,
-	0x06, 0x21, 0x04, 0xac, 0x58, 0x66, 0x2c, 0x90, 0x67, 0x70, 0xf2, 0x38, 0xf1, 0x48, 0x8e, 0xf1,
-	0xc2, 0x23, 0x39, 0xc6, 0x1b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x3c, 0xf0, 0x58, 0x8e,
-	0xf1, 0xc4, 0x63, 0x39, 0xc6, 0x28, 0x3d, 0x24, 0xd7, 0x65, 0x54, 0x16, 0xa4, 0x16, 0xe5, 0xa4,
-	0xa6, 0xa4, 0xa7, 0x16, 0xe9, 0x27, 0x95, 0x16, 0x15, 0xe5, 0x97, 0xeb, 0xa7, 0x56, 0xa4, 0x26,
-	0x97, 0x82, 0x0c, 0xd2, 0x87, 0xf8, 0x26, 0x89, 0x0d, 0xec, 0x4a, 0x63, 0x40, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x43, 0x4f, 0x55, 0x84, 0xec, 0x00, 0x00, 0x00,
+	0x06, 0xa1, 0x98, 0xc0, 0x13, 0x94, 0x5a, 0x96, 0x5a, 0x54, 0x12, 0x94, 0x9a, 0x58, 0x9c, 0x9f,
+	0x27, 0xc1, 0x0c, 0x56, 0x80, 0x22, 0x66, 0xc5, 0x32, 0x63, 0x81, 0x3c, 0x83, 0x93, 0xc7, 0x89,
+	0x47, 0x72, 0x8c, 0x17, 0x1e, 0xc9, 0x31, 0xde, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3,
+	0x81, 0xc7, 0x72, 0x8c, 0x27, 0x1e, 0xcb, 0x31, 0x46, 0xe9, 0x21, 0xf9, 0x20, 0xa3, 0xb2, 0x20,
+	0xb5, 0x28, 0x27, 0x35, 0x25, 0x3d, 0xb5, 0x48, 0x3f, 0xa9, 0xb4, 0xa8, 0x28, 0xbf, 0x5c, 0x3f,
+	0xb5, 0x22, 0x35, 0xb9, 0x14, 0x64, 0x99, 0x3e, 0xc4, 0xc7, 0x49, 0x6c, 0x60, 0x9f, 0x18, 0x03,
+	0x06, 0x00, 0xe1, 0x48, 0xf2, 0xa8, 0x10, 0x01, 0x00, 0x00,
 }
 
 func (m *Exception) Marshal() (dAtA []byte, err error) {
@@ -128,6 +138,13 @@ func (m *Exception) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if len(m.RevertReason) > 0 {
+		i -= len(m.RevertReason)
+		copy(dAtA[i:], m.RevertReason)
+		i = encodeVarintErrors(dAtA, i, uint64(len(m.RevertReason)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Exception) > 0 {
 		i -= len(m.Exception)
 		copy(dAtA[i:], m.Exception)
@@ -167,6 +184,10 @@ func (m *Exception) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovErrors(uint64(l))
 	}
+	l = len(m.RevertReason)
+	if l > 0 {
+		n += 1 + l + sovErrors(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -259,6 +280,38 @@ func (m *Exception) Unmarshal(dAtA []byte) error {
 			}
 			m.Exception = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevertReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErrors
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErrors
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErrors
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevertReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErrors(dAtA[iNdEx:])
@@ -25,3 +25,24 @@ func TestCode_String(t *testing.T) {
 	err := Codes.CodeOutOfBounds
 	fmt.Println(err.Error())
 }
+
+func TestRevertReason(t *testing.T) {
+	reverted := Reverted("not enough tokens")
+	assert.Equal(t, "not enough tokens", RevertReason(reverted))
+	assert.Equal(t, "not enough tokens", RevertReason(Wrap(reverted, "calling token")))
+
+	callErr := &CallError{
+		CodedError: NewException(Codes.InsufficientGas, "ran out"),
+		NestedErrors: []NestedCallError{
+			{CodedError: NewException(Codes.ExecutionAborted, "aborted")},
+			{CodedError: reverted},
+		},
+	}
+	assert.Equal(t, "not enough tokens", RevertReason(callErr))
+	ex := AsException(callErr)
+	assert.Equal(t, Codes.InsufficientGas.Number, ex.CodeNumber)
+	assert.Equal(t, "not enough tokens", ex.RevertReason)
+
+	assert.Equal(t, "", RevertReason(Codes.ExecutionReverted))
+	assert.Equal(t, "", RevertReason(fmt.Errorf("plain")))
+}
@@ -21,7 +21,11 @@ func AsException(err error) *Exception {
 	case *Exception:
 		return e
 	case CodedError:
-		return NewException(e.ErrorCode(), e.ErrorMessage())
+		ex := NewException(e.ErrorCode(), e.ErrorMessage())
+		if ex != nil {
+			ex.RevertReason = RevertReason(e)
+		}
+		return ex
 	default:
 		return NewException(Codes.Generic, err.Error())
 	}
@@ -29,18 +33,60 @@ func AsException(err error) *Exception {
 
 func Wrapf(err error, format string, a ...interface{}) *Exception {
 	ex := AsException(err)
-	return NewException(Codes.Get(ex.CodeNumber), fmt.Sprintf(format, a...))
+	return ex.wrap(fmt.Sprintf(format, a...))
 }
 
 func Wrap(err error, message string) *Exception {
 	ex := AsException(err)
-	return NewException(Codes.Get(ex.CodeNumber), message+": "+ex.Exception)
+	return ex.wrap(message + ": " + ex.Exception)
 }
 
 func Errorf(code *Code, format string, a ...interface{}) *Exception {
 	return NewException(code, fmt.Sprintf(format, a...))
 }
 
+// Returns an ExecutionReverted Exception carrying the reason the contract gave for reverting
+func Reverted(reason string) *Exception {
+	ex := Errorf(Codes.ExecutionReverted, "with reason '%s'", reason)
+	ex.RevertReason = reason
+	return ex
+}
+
+// Returns the reason given to revert() or require() by the call that failed with err, or by a call nested within it,
+// or the empty string if there is none
+func RevertReason(err error) string {
+	switch e := err.(type) {
+	case *Exception:
+		return e.GetRevertReason()
+	case *CallError:
+		if e == nil {
+			return ""
+		}
+		return RevertReason(*e)
+	case CallError:
+		reason := RevertReason(e.CodedError)
+		for _, nestedErr := range e.NestedErrors {
+			if reason != "" {
+				break
+			}
+			reason = RevertReason(nestedErr.CodedError)
+		}
+		return reason
+	case NestedCallError:
+		return RevertReason(e.CodedError)
+	default:
+		return ""
+	}
+}
+
+func (e *Exception) wrap(message string) *Exception {
+	ex := NewException(Codes.Get(e.CodeNumber), message)
+	if ex != nil {
+		ex.RevertReason = e.RevertReason
+	}
+	return ex
+}
+
 func (e *Exception) AsError() error {
 	// We need to return a bare untyped error here so that err == nil downstream
 	if e == nil {
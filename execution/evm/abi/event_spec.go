@@ -2,6 +2,7 @@ package abi
 
 import (
 	"encoding/json"
+	"fmt"
 	"reflect"
 
 	"github.com/hyperledger/burrow/event/query"
@@ -19,6 +20,21 @@ type Argument struct {
 	ArrayLength uint64
 }
 
+// TypeSignature returns the Solidity type of the argument, e.g. uint256 or address[2]
+func (a Argument) TypeSignature() string {
+	return a.EVM.GetSignature() + a.arraySuffix()
+}
+
+func (a Argument) arraySuffix() string {
+	if !a.IsArray {
+		return ""
+	}
+	if a.ArrayLength > 0 {
+		return fmt.Sprintf("[%d]", a.ArrayLength)
+	}
+	return "[]"
+}
+
 type argumentJSON struct {
 	Name       string
 	Type       string
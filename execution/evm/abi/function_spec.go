@@ -1,8 +1,6 @@
 package abi
 
 import (
-	"fmt"
-
 	"golang.org/x/crypto/sha3"
 )
 
@@ -66,13 +64,7 @@ func argsToSignature(args []Argument, addIndexedName bool) (str string) {
 		if addIndexedName && a.Indexed {
 			str += " indexed"
 		}
-		if a.IsArray {
-			if a.ArrayLength > 0 {
-				str += fmt.Sprintf("[%d]", a.ArrayLength)
-			} else {
-				str += "[]"
-			}
-		}
+		str += a.arraySuffix()
 		if addIndexedName && a.Name != "" {
 			str += " " + a.Name
 		}
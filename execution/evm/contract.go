@@ -58,6 +58,9 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 	stack := NewStack(maybe, c.options.DataStackInitialCapacity, c.options.DataStackMaxDepth, params.Gas)
 	memory := c.options.MemoryProvider(maybe)
 
+	var opCounts opCodeCounts
+	defer opCounts.flush()
+
 	for {
 		// Check for any error in this frame.
 		if maybe.Error() != nil {
@@ -65,6 +68,7 @@ func (c *Contract) execute(st engine.State, params engine.CallParams) ([]byte, e
 		}
 
 		var op = c.GetSymbol(pc)
+		opCounts[op]++
 		c.debugf("(pc) %-3d (op) %-14s (st) %-4d (gas) %d", pc, op.String(), stack.Len(), *params.Gas)
 		// Use BaseOp gas.
 		maybe.PushError(useGasNegative(params.Gas, native.GasBaseOp))
@@ -973,7 +977,7 @@ func newRevertException(ret []byte) errors.CodedError {
 		// Attempt decode
 		reason, err := abi.UnpackRevert(ret)
 		if err == nil {
-			return errors.Errorf(code, "with reason '%s'", *reason)
+			return errors.Reverted(*reason)
 		}
 	}
 	return code
@@ -9,6 +9,7 @@ import (
 	"math/big"
 	"reflect"
 	"testing"
+	"sync/atomic"
 	"time"
 
 	"github.com/hyperledger/burrow/acm"
@@ -64,6 +65,20 @@ func TestEVM(t *testing.T) {
 		require.NoError(t, err)
 	})
 
+	t.Run("CountsOpcodes", func(t *testing.T) {
+		st := acmstate.NewMemoryState()
+		account1 := newAccount(t, st, "1")
+		account2 := newAccount(t, st, "101")
+		var gas uint64 = 100000
+		shl := atomic.LoadUint64(&opCodesExecuted[SHL])
+		push1 := atomic.LoadUint64(&opCodesExecuted[PUSH1])
+
+		_, err := call(vm, st, account1, account2, MustSplice(PUSH1, 0x01, PUSH1, 0x00, SHL, return1()), nil, &gas)
+		require.NoError(t, err)
+		assert.Equal(t, shl+1, atomic.LoadUint64(&opCodesExecuted[SHL]))
+		assert.Equal(t, push1+5, atomic.LoadUint64(&opCodesExecuted[PUSH1]))
+	})
+
 	t.Run("SHL", func(t *testing.T) {
 		st := acmstate.NewMemoryState()
 		account1 := newAccount(t, st, "1")
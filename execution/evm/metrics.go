@@ -0,0 +1,48 @@
+package evm
+
+import (
+	"sync/atomic"
+
+	"github.com/hyperledger/burrow/execution/evm/asm"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// Opcodes executed by all EVMs, each call frame counts its own and adds them here when it returns to avoid
+	// contention in the execution loop
+	opCodesExecuted [256]uint64
+
+	opCodesDesc = prometheus.NewDesc(prometheus.BuildFQName("burrow", "evm", "opcodes_total"),
+		"Opcodes executed by the EVM", []string{"opcode"}, nil)
+)
+
+type opCodeCounts [256]uint32
+
+func (counts *opCodeCounts) flush() {
+	for op, count := range counts {
+		if count > 0 {
+			atomic.AddUint64(&opCodesExecuted[op], uint64(count))
+		}
+	}
+}
+
+type opCodeCollector struct{}
+
+func (opCodeCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- opCodesDesc
+}
+
+func (opCodeCollector) Collect(ch chan<- prometheus.Metric) {
+	for op := range opCodesExecuted {
+		count := atomic.LoadUint64(&opCodesExecuted[op])
+		if count > 0 {
+			ch <- prometheus.MustNewConstMetric(opCodesDesc, prometheus.CounterValue, float64(count),
+				asm.OpCode(op).Name())
+		}
+	}
+}
+
+// Collectors returns the metrics recorded by the EVM (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{opCodeCollector{}}
+}
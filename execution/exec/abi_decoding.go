@@ -0,0 +1,37 @@
+package exec
+
+import (
+	"strings"
+)
+
+// Tags of events decoded according to a contract ABI
+const (
+	EventNameKey    = "EventName"
+	FunctionNameKey = "FunctionName"
+	// Prefix of the tag for a named argument, e.g. Args.to
+	ArgsKeyPrefix = "Args."
+)
+
+// Get the value of a named argument as tagged by ArgsKeyPrefix
+func (dec *ABIDecoding) Get(key string) (interface{}, bool) {
+	if dec == nil || !strings.HasPrefix(key, ArgsKeyPrefix) {
+		return nil, false
+	}
+	name := key[len(ArgsKeyPrefix):]
+	for _, arg := range dec.Args {
+		if arg.Name == name {
+			return arg.Value, true
+		}
+	}
+	return nil, false
+}
+
+func (call *CallEvent) Get(key string) (interface{}, bool) {
+	if call == nil || call.Decoded == nil {
+		return nil, false
+	}
+	if key == FunctionNameKey {
+		return call.Decoded.Name, true
+	}
+	return call.Decoded.Get(key)
+}
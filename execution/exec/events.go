@@ -106,6 +106,10 @@ func (ev *Event) Get(key string) (value interface{}, ok bool) {
 	if ok {
 		return v, true
 	}
+	v, ok = ev.Call.Get(key)
+	if ok {
+		return v, true
+	}
 	v, ok = query.GetReflect(reflect.ValueOf(ev.Header), key)
 	if ok {
 		return v, true
@@ -89,15 +89,18 @@ func (*StreamEvents) XXX_MessageName() string {
 }
 
 type StreamEvent struct {
-	BeginBlock           *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock,proto3" json:"BeginBlock,omitempty"`
-	BeginTx              *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx,proto3" json:"BeginTx,omitempty"`
-	Envelope             *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
-	Event                *Event                                      `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
-	EndTx                *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx,proto3" json:"EndTx,omitempty"`
-	EndBlock             *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                    `json:"-"`
-	XXX_unrecognized     []byte                                      `json:"-"`
-	XXX_sizecache        int32                                       `json:"-"`
+	BeginBlock *BeginBlock                                 `protobuf:"bytes,1,opt,name=BeginBlock,proto3" json:"BeginBlock,omitempty"`
+	BeginTx    *BeginTx                                    `protobuf:"bytes,2,opt,name=BeginTx,proto3" json:"BeginTx,omitempty"`
+	Envelope   *github_com_hyperledger_burrow_txs.Envelope `protobuf:"bytes,3,opt,name=Envelope,proto3,customtype=github.com/hyperledger/burrow/txs.Envelope" json:"Envelope,omitempty"`
+	Event      *Event                                      `protobuf:"bytes,4,opt,name=Event,proto3" json:"Event,omitempty"`
+	EndTx      *EndTx                                      `protobuf:"bytes,5,opt,name=EndTx,proto3" json:"EndTx,omitempty"`
+	EndBlock   *EndBlock                                   `protobuf:"bytes,6,opt,name=EndBlock,proto3" json:"EndBlock,omitempty"`
+	// Opaque position of this event in the stream, which can be passed back as a CURSOR start bound to resume streaming
+	// immediately after it (only set by ExecutionEvents.Stream)
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,7,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *StreamEvent) Reset()         { *m = StreamEvent{} }
@@ -1030,12 +1033,14 @@ func (*Result) XXX_MessageName() string {
 }
 
 type LogEvent struct {
-	Address              github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Data                 github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
-	Topics               []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
-	XXX_NoUnkeyedLiteral struct{}                                       `json:"-"`
-	XXX_unrecognized     []byte                                         `json:"-"`
-	XXX_sizecache        int32                                          `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address   `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Data    github_com_hyperledger_burrow_binary.HexBytes  `protobuf:"bytes,2,opt,name=Data,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Data"`
+	Topics  []github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,3,rep,name=Topics,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Topics"`
+	// The event decoded according to the ABI of the emitting contract (only populated when requested)
+	Decoded              *ABIDecoding `protobuf:"bytes,4,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *LogEvent) Reset()         { *m = LogEvent{} }
@@ -1067,19 +1072,28 @@ func (m *LogEvent) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_LogEvent proto.InternalMessageInfo
 
+func (m *LogEvent) GetDecoded() *ABIDecoding {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
 func (*LogEvent) XXX_MessageName() string {
 	return "exec.LogEvent"
 }
 
 type CallEvent struct {
-	CallType             CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
-	CallData             *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
-	Origin               github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
-	StackDepth           uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
-	Return               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
-	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
-	XXX_unrecognized     []byte                                        `json:"-"`
-	XXX_sizecache        int32                                         `json:"-"`
+	CallType   CallType                                      `protobuf:"varint,5,opt,name=CallType,proto3,casttype=CallType" json:"CallType,omitempty"`
+	CallData   *CallData                                     `protobuf:"bytes,1,opt,name=CallData,proto3" json:"CallData,omitempty"`
+	Origin     github_com_hyperledger_burrow_crypto.Address  `protobuf:"bytes,2,opt,name=Origin,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Origin"`
+	StackDepth uint64                                        `protobuf:"varint,3,opt,name=StackDepth,proto3" json:"StackDepth,omitempty"`
+	Return     github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,4,opt,name=Return,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Return"`
+	// The function call decoded according to the ABI of the callee (only populated when requested)
+	Decoded              *ABIDecoding `protobuf:"bytes,6,opt,name=Decoded,proto3" json:"Decoded,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *CallEvent) Reset()         { *m = CallEvent{} }
@@ -1132,10 +1146,148 @@ func (m *CallEvent) GetStackDepth() uint64 {
 	return 0
 }
 
+func (m *CallEvent) GetDecoded() *ABIDecoding {
+	if m != nil {
+		return m.Decoded
+	}
+	return nil
+}
+
 func (*CallEvent) XXX_MessageName() string {
 	return "exec.CallEvent"
 }
 
+// The name and arguments of an event or function call decoded according to a contract ABI
+type ABIDecoding struct {
+	// Name of the event or function
+	Name                 string         `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	Args                 []*ABIArgument `protobuf:"bytes,2,rep,name=Args,proto3" json:"Args,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ABIDecoding) Reset()         { *m = ABIDecoding{} }
+func (m *ABIDecoding) String() string { return proto.CompactTextString(m) }
+func (*ABIDecoding) ProtoMessage()    {}
+func (*ABIDecoding) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{16}
+}
+func (m *ABIDecoding) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABIDecoding) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ABIDecoding) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABIDecoding.Merge(m, src)
+}
+func (m *ABIDecoding) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABIDecoding) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABIDecoding.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABIDecoding proto.InternalMessageInfo
+
+func (m *ABIDecoding) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ABIDecoding) GetArgs() []*ABIArgument {
+	if m != nil {
+		return m.Args
+	}
+	return nil
+}
+
+func (*ABIDecoding) XXX_MessageName() string {
+	return "exec.ABIDecoding"
+}
+
+type ABIArgument struct {
+	Name string `protobuf:"bytes,1,opt,name=Name,proto3" json:"Name,omitempty"`
+	// Solidity type of the argument
+	Type string `protobuf:"bytes,2,opt,name=Type,proto3" json:"Type,omitempty"`
+	// The value formatted as a string (numbers in decimal, addresses and bytes in hex)
+	Value string `protobuf:"bytes,3,opt,name=Value,proto3" json:"Value,omitempty"`
+	// Whether this is an indexed event argument
+	Indexed              bool     `protobuf:"varint,4,opt,name=Indexed,proto3" json:"Indexed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ABIArgument) Reset()         { *m = ABIArgument{} }
+func (m *ABIArgument) String() string { return proto.CompactTextString(m) }
+func (*ABIArgument) ProtoMessage()    {}
+func (*ABIArgument) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d737c7315c25422, []int{17}
+}
+func (m *ABIArgument) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ABIArgument) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ABIArgument) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ABIArgument.Merge(m, src)
+}
+func (m *ABIArgument) XXX_Size() int {
+	return m.Size()
+}
+func (m *ABIArgument) XXX_DiscardUnknown() {
+	xxx_messageInfo_ABIArgument.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ABIArgument proto.InternalMessageInfo
+
+func (m *ABIArgument) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ABIArgument) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ABIArgument) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *ABIArgument) GetIndexed() bool {
+	if m != nil {
+		return m.Indexed
+	}
+	return false
+}
+
+func (*ABIArgument) XXX_MessageName() string {
+	return "exec.ABIArgument"
+}
+
 type GovernAccountEvent struct {
 	AccountUpdate        *spec.TemplateAccount `protobuf:"bytes,1,opt,name=AccountUpdate,proto3" json:"AccountUpdate,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
@@ -1147,7 +1299,7 @@ func (m *GovernAccountEvent) Reset()         { *m = GovernAccountEvent{} }
 func (m *GovernAccountEvent) String() string { return proto.CompactTextString(m) }
 func (*GovernAccountEvent) ProtoMessage()    {}
 func (*GovernAccountEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{16}
+	return fileDescriptor_4d737c7315c25422, []int{18}
 }
 func (m *GovernAccountEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1194,7 +1346,7 @@ func (m *InputEvent) Reset()         { *m = InputEvent{} }
 func (m *InputEvent) String() string { return proto.CompactTextString(m) }
 func (*InputEvent) ProtoMessage()    {}
 func (*InputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{17}
+	return fileDescriptor_4d737c7315c25422, []int{19}
 }
 func (m *InputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1234,7 +1386,7 @@ func (m *OutputEvent) Reset()         { *m = OutputEvent{} }
 func (m *OutputEvent) String() string { return proto.CompactTextString(m) }
 func (*OutputEvent) ProtoMessage()    {}
 func (*OutputEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{18}
+	return fileDescriptor_4d737c7315c25422, []int{20}
 }
 func (m *OutputEvent) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1278,7 +1430,7 @@ func (m *CallData) Reset()         { *m = CallData{} }
 func (m *CallData) String() string { return proto.CompactTextString(m) }
 func (*CallData) ProtoMessage()    {}
 func (*CallData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4d737c7315c25422, []int{19}
+	return fileDescriptor_4d737c7315c25422, []int{21}
 }
 func (m *CallData) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1353,6 +1505,10 @@ func init() {
 	golang_proto.RegisterType((*LogEvent)(nil), "exec.LogEvent")
 	proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
 	golang_proto.RegisterType((*CallEvent)(nil), "exec.CallEvent")
+	proto.RegisterType((*ABIDecoding)(nil), "exec.ABIDecoding")
+	golang_proto.RegisterType((*ABIDecoding)(nil), "exec.ABIDecoding")
+	proto.RegisterType((*ABIArgument)(nil), "exec.ABIArgument")
+	golang_proto.RegisterType((*ABIArgument)(nil), "exec.ABIArgument")
 	proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
 	golang_proto.RegisterType((*GovernAccountEvent)(nil), "exec.GovernAccountEvent")
 	proto.RegisterType((*InputEvent)(nil), "exec.InputEvent")
@@ -1367,90 +1523,96 @@ func init() { proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422)
 func init() { golang_proto.RegisterFile("exec.proto", fileDescriptor_4d737c7315c25422) }
 
 var fileDescriptor_4d737c7315c25422 = []byte{
-	// 1318 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xef, 0xda, 0x6b, 0xc7, 0x7e, 0x76, 0xfa, 0x6d, 0x47, 0xfd, 0x22, 0xab, 0x42, 0x76, 0xd9,
-	0x42, 0x29, 0xa5, 0x5d, 0x57, 0x81, 0x02, 0x2a, 0x12, 0xa2, 0x6e, 0x42, 0x1b, 0x28, 0x69, 0x99,
-	0xba, 0x45, 0x20, 0x38, 0x6c, 0x76, 0xa7, 0xce, 0xaa, 0xf6, 0xee, 0x6a, 0x77, 0xb6, 0xac, 0xff,
-	0x05, 0x4e, 0xf4, 0x56, 0x2e, 0xa8, 0x67, 0xce, 0xdc, 0xb8, 0x70, 0xcc, 0x8d, 0x1e, 0x51, 0x0f,
-	0x06, 0xa5, 0x7f, 0x01, 0xe2, 0x44, 0x4f, 0x68, 0x66, 0xde, 0xac, 0x77, 0x49, 0x9a, 0x54, 0x24,
-	0x48, 0x5c, 0xa2, 0x79, 0xef, 0x7d, 0xf6, 0xf9, 0xfd, 0xf8, 0xbc, 0x37, 0x13, 0x00, 0x96, 0x31,
-	0xd7, 0x8e, 0xe2, 0x90, 0x87, 0xc4, 0x14, 0xe7, 0xe3, 0xe7, 0x46, 0x3e, 0xdf, 0x48, 0xd7, 0x6d,
-	0x37, 0x9c, 0xf4, 0x47, 0xe1, 0x28, 0xec, 0x4b, 0xe3, 0x7a, 0x7a, 0x47, 0x4a, 0x52, 0x90, 0x27,
-	0xf5, 0xd1, 0xf1, 0xb7, 0x0b, 0x70, 0xce, 0x02, 0x8f, 0xc5, 0x13, 0x3f, 0xe0, 0xc5, 0xa3, 0xb3,
-	0xee, 0xfa, 0x7d, 0x3e, 0x8d, 0x58, 0xa2, 0xfe, 0xe2, 0x87, 0xbd, 0x51, 0x18, 0x8e, 0xc6, 0x6c,
-	0xee, 0x9e, 0xfb, 0x13, 0x96, 0x70, 0x67, 0x12, 0x21, 0xa0, 0xcd, 0xe2, 0x38, 0x8c, 0x35, 0xbc,
-	0x15, 0x38, 0x93, 0xfc, 0xdb, 0x26, 0xcf, 0xf4, 0xf1, 0x48, 0x24, 0x7e, 0x26, 0x49, 0xfc, 0x30,
-	0x40, 0x0d, 0x24, 0x91, 0x4e, 0xc9, 0x5a, 0x81, 0xf6, 0x4d, 0x1e, 0x33, 0x67, 0xb2, 0x72, 0x8f,
-	0x05, 0x3c, 0x21, 0x17, 0xca, 0x72, 0xc7, 0x38, 0x51, 0x3d, 0xdd, 0x5a, 0x3a, 0x6a, 0xcb, 0x2a,
-	0x14, 0x2c, 0xb4, 0x04, 0xb3, 0x7e, 0xac, 0x40, 0xab, 0xa0, 0x20, 0xe7, 0x01, 0x06, 0x6c, 0xe4,
-	0x07, 0x83, 0x71, 0xe8, 0xde, 0xed, 0x18, 0x27, 0x8c, 0xd3, 0xad, 0xa5, 0x23, 0xca, 0xc9, 0x5c,
-	0x4f, 0x0b, 0x18, 0xf2, 0x2a, 0x2c, 0x48, 0x69, 0x98, 0x75, 0x2a, 0x12, 0xbe, 0x58, 0x80, 0x0f,
-	0x33, 0xaa, 0xad, 0xe4, 0x33, 0x68, 0xac, 0x04, 0xf7, 0xd8, 0x38, 0x8c, 0x58, 0xa7, 0x8a, 0x48,
-	0x91, 0xad, 0x56, 0x0e, 0xec, 0xc7, 0xb3, 0xde, 0x99, 0x42, 0xd1, 0x37, 0xa6, 0x11, 0x8b, 0xc7,
-	0xcc, 0x1b, 0xb1, 0xb8, 0xbf, 0x9e, 0xc6, 0x71, 0xf8, 0x55, 0xbf, 0x88, 0xa7, 0xb9, 0x3b, 0xf2,
-	0x12, 0xd4, 0x64, 0xf8, 0x1d, 0x53, 0xfa, 0x6d, 0xa9, 0x08, 0x54, 0xbe, 0xca, 0x22, 0x21, 0x81,
-	0x37, 0xcc, 0x3a, 0xb5, 0x12, 0x44, 0xa8, 0xa8, 0xb2, 0x90, 0x33, 0x22, 0x40, 0x4f, 0x65, 0x5e,
-	0x97, 0xa8, 0xc3, 0x39, 0x4a, 0xe5, 0x9d, 0xdb, 0x2f, 0x9a, 0x9b, 0x0f, 0x7b, 0x86, 0x75, 0xdf,
-	0x28, 0x96, 0x8b, 0xbc, 0x00, 0xf5, 0xab, 0xcc, 0x1f, 0x6d, 0x70, 0x59, 0x38, 0x93, 0xa2, 0x24,
-	0xf4, 0x6b, 0xe9, 0x64, 0x98, 0x25, 0x32, 0x6f, 0x93, 0xa2, 0x44, 0xce, 0xc2, 0xd1, 0x1b, 0x31,
-	0xf3, 0x98, 0xcb, 0x92, 0x24, 0x8c, 0xf1, 0x53, 0x53, 0x42, 0xb6, 0x1b, 0xc8, 0x2b, 0xc2, 0xbb,
-	0xe3, 0xb1, 0x38, 0xaf, 0xb3, 0x22, 0x9d, 0x52, 0x52, 0x34, 0x5a, 0xd6, 0x3c, 0x8b, 0x67, 0x05,
-	0x64, 0x7d, 0x6f, 0xe4, 0x4d, 0x13, 0x59, 0x0f, 0x33, 0x74, 0x6c, 0x14, 0xb3, 0xd6, 0x5a, 0x9a,
-	0xdb, 0xc9, 0x8b, 0xd0, 0x5c, 0x4b, 0x35, 0xc3, 0x6a, 0xd2, 0xe5, 0x5c, 0x41, 0x5e, 0x86, 0x3a,
-	0x65, 0x49, 0x3a, 0xe6, 0x18, 0x60, 0x5b, 0xf9, 0x51, 0x3a, 0x8a, 0x36, 0xd2, 0x87, 0xe6, 0x4a,
-	0xe6, 0xb2, 0x88, 0xfb, 0x61, 0x80, 0xfd, 0x3a, 0x6a, 0xe3, 0x40, 0xe4, 0x06, 0x3a, 0xc7, 0x58,
-	0xb7, 0xb1, 0x73, 0xe4, 0x63, 0xa8, 0x0f, 0xb3, 0xab, 0x4e, 0xb2, 0x21, 0xcb, 0xd8, 0x1e, 0x5c,
-	0xd8, 0x9c, 0xf5, 0x0e, 0x3d, 0x9e, 0xf5, 0xce, 0xed, 0xce, 0x99, 0x75, 0x3f, 0x70, 0xe2, 0xa9,
-	0x7d, 0x95, 0x65, 0x83, 0x29, 0x67, 0x09, 0x45, 0x27, 0xd6, 0x9f, 0xc6, 0x3c, 0x73, 0xf2, 0xa1,
-	0xf0, 0x3d, 0x9c, 0x46, 0x4c, 0xd6, 0x60, 0x71, 0xb0, 0xf4, 0x74, 0xd6, 0xb3, 0xf7, 0xe4, 0x62,
-	0x3f, 0x72, 0xa6, 0xe3, 0xd0, 0xf1, 0x6c, 0xf1, 0x25, 0x45, 0x0f, 0x85, 0x38, 0x2b, 0x07, 0x10,
-	0x67, 0xa1, 0x89, 0xd5, 0x12, 0xab, 0x8e, 0x41, 0x6d, 0x35, 0xf0, 0x58, 0x86, 0x8c, 0x51, 0x82,
-	0x68, 0xc2, 0xf5, 0xd8, 0x1f, 0xf9, 0x01, 0x12, 0x1d, 0x9b, 0xa0, 0x74, 0x14, 0x6d, 0xd6, 0x0f,
-	0x06, 0x1c, 0x96, 0x14, 0x59, 0xc9, 0x98, 0x9b, 0x8a, 0x32, 0x3f, 0x93, 0xbc, 0xff, 0x06, 0x49,
-	0xc5, 0xb6, 0x1a, 0x66, 0xf9, 0x6f, 0x8b, 0xb9, 0x28, 0x6c, 0xab, 0x82, 0x85, 0x96, 0x60, 0xd6,
-	0xfb, 0x70, 0xb8, 0x20, 0x7f, 0xc4, 0xa6, 0xbb, 0x8d, 0xdc, 0xf5, 0x3b, 0x77, 0x12, 0xa6, 0xb8,
-	0x68, 0x52, 0x94, 0xac, 0xdf, 0x2b, 0xd0, 0x2a, 0xb8, 0x20, 0x67, 0xf3, 0x78, 0x77, 0xe4, 0xfe,
-	0xc0, 0x7c, 0x34, 0xeb, 0x19, 0x79, 0xd8, 0xc5, 0x15, 0x56, 0x3f, 0xd8, 0x15, 0x76, 0x12, 0xea,
-	0x38, 0x57, 0x0b, 0xb2, 0x16, 0xa5, 0x1d, 0x56, 0xdf, 0x36, 0x61, 0x8d, 0x5d, 0x26, 0xec, 0x14,
-	0x2c, 0x50, 0xe6, 0x32, 0x3f, 0xe2, 0x9d, 0x26, 0xc2, 0xc4, 0x8f, 0xa2, 0x8e, 0x6a, 0x63, 0x79,
-	0x12, 0x61, 0xef, 0x49, 0xdc, 0xd6, 0xb5, 0xd6, 0xf3, 0x75, 0xed, 0x6b, 0x43, 0x73, 0x92, 0x74,
-	0x60, 0xe1, 0xf2, 0x86, 0xe3, 0x07, 0xab, 0xcb, 0xb2, 0xde, 0x4d, 0xaa, 0xc5, 0x42, 0x23, 0x2b,
-	0x3b, 0xb3, 0xbc, 0x5a, 0x64, 0xf9, 0x3b, 0x60, 0x0e, 0xfd, 0x09, 0xc3, 0xfd, 0x71, 0xdc, 0x56,
-	0x37, 0xae, 0xad, 0x6f, 0x5c, 0x7b, 0xa8, 0x6f, 0xdc, 0x41, 0x43, 0x0c, 0xdf, 0x37, 0xbf, 0xf6,
-	0x0c, 0x2a, 0xbf, 0xb0, 0x7e, 0xae, 0xe8, 0x8e, 0xff, 0x97, 0x67, 0xfe, 0x75, 0x68, 0xca, 0x96,
-	0xcb, 0xe8, 0xaa, 0x32, 0xba, 0xc5, 0xa7, 0xb3, 0xde, 0x5c, 0x49, 0xe7, 0x47, 0x51, 0x54, 0x29,
-	0xac, 0x2e, 0xcb, 0x7a, 0x34, 0xa9, 0x16, 0x0b, 0x45, 0xad, 0xed, 0x5c, 0xd4, 0x7a, 0xb1, 0xa8,
-	0x25, 0x3e, 0x2c, 0xec, 0xcd, 0x87, 0x8b, 0xe6, 0x83, 0x87, 0xbd, 0x43, 0xd6, 0xfd, 0x0a, 0xde,
-	0xbe, 0x82, 0x9e, 0xa5, 0x61, 0x42, 0x7a, 0xfe, 0x6d, 0xf6, 0x4f, 0x89, 0x1f, 0x8f, 0x52, 0x7d,
-	0x4b, 0xe0, 0xeb, 0x42, 0xaa, 0xf0, 0xc6, 0x96, 0x67, 0xf2, 0x1a, 0xd4, 0xaf, 0xa7, 0x5c, 0x00,
-	0xab, 0x3a, 0x16, 0xb9, 0xc9, 0xa4, 0x0e, 0xe7, 0x42, 0x09, 0xe4, 0x24, 0x98, 0x97, 0x9d, 0xf1,
-	0x18, 0xe9, 0xf0, 0x3f, 0x05, 0x14, 0x1a, 0x05, 0x93, 0x46, 0x72, 0x02, 0xaa, 0xd7, 0xc2, 0x11,
-	0xae, 0x45, 0x9c, 0xf3, 0x6b, 0xe1, 0x48, 0x41, 0x84, 0x89, 0xbc, 0x07, 0x8b, 0x57, 0xc2, 0x7b,
-	0x2c, 0x0e, 0x2e, 0xb9, 0x6e, 0x98, 0x06, 0x1c, 0x67, 0xbc, 0xa3, 0xb0, 0x25, 0x93, 0xfa, 0xaa,
-	0x0c, 0xbf, 0xd8, 0x10, 0xf5, 0x90, 0x0f, 0x83, 0x07, 0x86, 0x9e, 0x54, 0xd1, 0x03, 0xca, 0x78,
-	0x1a, 0x07, 0xb2, 0x28, 0x6d, 0x8a, 0x92, 0xe8, 0xda, 0x15, 0x27, 0xb9, 0x95, 0x30, 0x0f, 0x19,
-	0xaf, 0x45, 0x72, 0x06, 0x9a, 0x6b, 0xce, 0x84, 0xad, 0x04, 0x3c, 0x9e, 0x62, 0xee, 0x6d, 0x5b,
-	0x3d, 0x12, 0xa5, 0x8e, 0xce, 0xcd, 0xe4, 0x3c, 0x34, 0x6e, 0xb0, 0x78, 0x72, 0x29, 0x1e, 0x25,
-	0x98, 0xfd, 0x31, 0xbb, 0xf0, 0x6e, 0xd4, 0x36, 0x9a, 0xa3, 0xac, 0x3f, 0x0c, 0x68, 0xe8, 0xb4,
-	0xc9, 0x1a, 0x2c, 0x5c, 0xf2, 0xbc, 0x98, 0x25, 0x89, 0x8a, 0x6e, 0xf0, 0x26, 0xf2, 0xf6, 0xec,
-	0xee, 0xbc, 0x75, 0xe3, 0x69, 0xc4, 0x43, 0x1b, 0xbf, 0xa5, 0xda, 0x09, 0x59, 0x05, 0x73, 0xd9,
-	0xe1, 0xce, 0xfe, 0x86, 0x40, 0xba, 0x20, 0xd7, 0xa0, 0x3e, 0x0c, 0x23, 0xdf, 0x55, 0x97, 0xc3,
-	0x73, 0x47, 0x86, 0xce, 0x3e, 0x0d, 0x63, 0x6f, 0xe9, 0xc2, 0x5b, 0x14, 0x7d, 0x58, 0xdf, 0x55,
-	0xa0, 0x99, 0x13, 0x82, 0x9c, 0x86, 0x86, 0x10, 0xe4, 0x74, 0xd5, 0xe4, 0x74, 0xb5, 0x9f, 0xce,
-	0x7a, 0xb9, 0x8e, 0xe6, 0x27, 0xf1, 0x3a, 0x12, 0x67, 0x99, 0x54, 0xe9, 0x86, 0xd0, 0x5a, 0x9a,
-	0xdb, 0x45, 0xc4, 0x78, 0xf5, 0x56, 0xf6, 0x51, 0x4b, 0xbd, 0x2a, 0xbb, 0x00, 0x37, 0xb9, 0xe3,
-	0xde, 0x5d, 0x66, 0x11, 0xdf, 0xc0, 0xed, 0x57, 0xd0, 0x88, 0x8d, 0x83, 0xbc, 0x32, 0xf7, 0xb5,
-	0x71, 0x94, 0x13, 0xeb, 0x13, 0x20, 0xdb, 0x09, 0x4e, 0xde, 0x85, 0x45, 0x94, 0x6f, 0x45, 0x9e,
-	0xc3, 0x19, 0xd6, 0xe0, 0xff, 0xb6, 0xfc, 0x4f, 0x64, 0xc8, 0x26, 0xd1, 0xd8, 0xe1, 0x0c, 0x21,
-	0xb4, 0x8c, 0xb5, 0xbe, 0x00, 0x98, 0x4f, 0xf5, 0x41, 0x53, 0xcd, 0xfa, 0x12, 0x5a, 0x85, 0x55,
-	0x70, 0xe0, 0xee, 0xbf, 0xad, 0x40, 0xa9, 0xb3, 0xe2, 0x8c, 0x8b, 0xed, 0x1f, 0x77, 0x56, 0xf9,
-	0xc8, 0xbd, 0xb1, 0xfd, 0xf1, 0x44, 0xf9, 0xc8, 0x47, 0xae, 0xba, 0xff, 0x91, 0x3b, 0x06, 0xb5,
-	0xdb, 0xce, 0x38, 0x65, 0xfa, 0x45, 0x29, 0x05, 0x72, 0x04, 0xaa, 0x57, 0x1c, 0xfd, 0xdc, 0x17,
-	0xc7, 0xc1, 0x07, 0x9b, 0x5b, 0x5d, 0xe3, 0xd1, 0x56, 0xd7, 0xf8, 0x65, 0xab, 0x6b, 0xfc, 0xb6,
-	0xd5, 0x35, 0x7e, 0x7a, 0xd2, 0x35, 0x36, 0x9f, 0x74, 0x8d, 0xcf, 0xf7, 0x48, 0x81, 0xe9, 0x47,
-	0x81, 0x3c, 0xad, 0xd7, 0xe5, 0x7d, 0xfd, 0xc6, 0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0xc0, 0xf0,
-	0x3b, 0xe7, 0xab, 0x0f, 0x00, 0x00,
+	// 1421 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x57, 0x4b, 0x6f, 0x1c, 0xc5,
+	0x13, 0xcf, 0xec, 0xce, 0xbe, 0x6a, 0xd7, 0xf9, 0x27, 0xad, 0xfc, 0xd1, 0x28, 0x42, 0xbb, 0x66,
+	0xf2, 0xc0, 0xe4, 0x31, 0x1b, 0x19, 0x02, 0x28, 0x48, 0x08, 0x6f, 0x6c, 0x62, 0x83, 0x71, 0x42,
+	0x67, 0x13, 0x04, 0x82, 0xc3, 0x78, 0xa6, 0x33, 0x1e, 0x65, 0x77, 0x66, 0xd4, 0x33, 0x13, 0x76,
+	0xbf, 0x42, 0x4e, 0xe4, 0x16, 0x6e, 0x39, 0x73, 0xe6, 0x03, 0x70, 0xb4, 0xc4, 0x81, 0x1c, 0x51,
+	0x0e, 0x0b, 0x72, 0x3e, 0x01, 0x47, 0x72, 0x42, 0xfd, 0x9a, 0xed, 0xc5, 0x8e, 0x1d, 0x61, 0x23,
+	0x71, 0x59, 0x75, 0x55, 0xfd, 0xba, 0xa6, 0xba, 0xea, 0x57, 0xd5, 0xbd, 0x00, 0x64, 0x44, 0x3c,
+	0x27, 0xa1, 0x71, 0x16, 0x23, 0x93, 0xad, 0x4f, 0x5f, 0x0e, 0xc2, 0x6c, 0x2b, 0xdf, 0x74, 0xbc,
+	0x78, 0xd8, 0x0d, 0xe2, 0x20, 0xee, 0x72, 0xe3, 0x66, 0x7e, 0x8f, 0x4b, 0x5c, 0xe0, 0x2b, 0xb1,
+	0xe9, 0xf4, 0x7b, 0x1a, 0x3c, 0x23, 0x91, 0x4f, 0xe8, 0x30, 0x8c, 0x32, 0x7d, 0xe9, 0x6e, 0x7a,
+	0x61, 0x37, 0x1b, 0x27, 0x24, 0x15, 0xbf, 0x72, 0x63, 0x27, 0x88, 0xe3, 0x60, 0x40, 0xa6, 0xee,
+	0xb3, 0x70, 0x48, 0xd2, 0xcc, 0x1d, 0x26, 0x12, 0xd0, 0x22, 0x94, 0xc6, 0x54, 0xc1, 0x9b, 0x91,
+	0x3b, 0x2c, 0xf6, 0x36, 0xb2, 0x91, 0x5a, 0x9e, 0x48, 0xd8, 0x67, 0xd2, 0x34, 0x8c, 0x23, 0xa9,
+	0x81, 0x34, 0x51, 0x47, 0xb2, 0x57, 0xa0, 0x75, 0x3b, 0xa3, 0xc4, 0x1d, 0xae, 0x3c, 0x20, 0x51,
+	0x96, 0xa2, 0xab, 0xb3, 0xb2, 0x65, 0xcc, 0x97, 0x17, 0x9a, 0x8b, 0x27, 0x1d, 0x9e, 0x05, 0xcd,
+	0x82, 0x67, 0x60, 0xf6, 0xc3, 0x32, 0x34, 0x35, 0x05, 0xba, 0x02, 0xd0, 0x23, 0x41, 0x18, 0xf5,
+	0x06, 0xb1, 0x77, 0xdf, 0x32, 0xe6, 0x8d, 0x85, 0xe6, 0xe2, 0x09, 0xe1, 0x64, 0xaa, 0xc7, 0x1a,
+	0x06, 0xbd, 0x09, 0x35, 0x2e, 0xf5, 0x47, 0x56, 0x89, 0xc3, 0xe7, 0x34, 0x78, 0x7f, 0x84, 0x95,
+	0x15, 0x7d, 0x09, 0xf5, 0x95, 0xe8, 0x01, 0x19, 0xc4, 0x09, 0xb1, 0xca, 0x12, 0xc9, 0x4e, 0xab,
+	0x94, 0x3d, 0xe7, 0xd9, 0xa4, 0x73, 0x41, 0x4b, 0xfa, 0xd6, 0x38, 0x21, 0x74, 0x40, 0xfc, 0x80,
+	0xd0, 0xee, 0x66, 0x4e, 0x69, 0xfc, 0x6d, 0x57, 0xc7, 0xe3, 0xc2, 0x1d, 0x7a, 0x03, 0x2a, 0x3c,
+	0x7c, 0xcb, 0xe4, 0x7e, 0x9b, 0x22, 0x02, 0x71, 0x5e, 0x61, 0xe1, 0x90, 0xc8, 0xef, 0x8f, 0xac,
+	0xca, 0x0c, 0x84, 0xa9, 0xb0, 0xb0, 0xa0, 0x0b, 0x2c, 0x40, 0x5f, 0x9c, 0xbc, 0xca, 0x51, 0xc7,
+	0x0b, 0x94, 0x38, 0x77, 0x61, 0x47, 0x9f, 0x41, 0xf5, 0x7a, 0x4e, 0xd3, 0x98, 0x5a, 0xb5, 0x79,
+	0x63, 0xa1, 0xd5, 0xbb, 0xba, 0x3d, 0xe9, 0x1c, 0x7b, 0x36, 0xe9, 0x5c, 0xde, 0x3f, 0xfe, 0xcd,
+	0x30, 0x72, 0xe9, 0xd8, 0x59, 0x25, 0xa3, 0xde, 0x38, 0x23, 0x29, 0x96, 0x4e, 0xae, 0x99, 0xdb,
+	0x4f, 0x3a, 0x86, 0xfd, 0xc8, 0xd0, 0xb3, 0x8f, 0x5e, 0x83, 0xea, 0x2a, 0x09, 0x83, 0xad, 0x8c,
+	0xd7, 0xc1, 0xc4, 0x52, 0x62, 0xfa, 0x8d, 0x7c, 0xd8, 0x1f, 0xa5, 0x3c, 0x8d, 0x26, 0x96, 0x12,
+	0xba, 0x04, 0x27, 0x6f, 0x51, 0xe2, 0x13, 0x8f, 0xa4, 0x69, 0x4c, 0xe5, 0x56, 0x93, 0x43, 0x76,
+	0x1b, 0xd0, 0x39, 0xe6, 0xdd, 0xf5, 0x09, 0x2d, 0xca, 0x26, 0x38, 0x2c, 0x94, 0x58, 0x1a, 0x6d,
+	0x7b, 0x9a, 0x94, 0x97, 0x05, 0x64, 0xff, 0x60, 0x14, 0x1c, 0x60, 0x49, 0xec, 0x8f, 0xa4, 0x63,
+	0x43, 0x4f, 0xa2, 0xd2, 0xe2, 0xc2, 0x8e, 0x5e, 0x87, 0xc6, 0x46, 0xae, 0x08, 0x5b, 0xe1, 0x2e,
+	0xa7, 0x0a, 0x74, 0x16, 0xaa, 0x98, 0xa4, 0xf9, 0x20, 0x93, 0x01, 0xb6, 0x84, 0x1f, 0xa1, 0xc3,
+	0xd2, 0x86, 0xba, 0xd0, 0x58, 0x19, 0x79, 0x24, 0xc9, 0xc2, 0x38, 0x92, 0xe5, 0x3f, 0xe9, 0xc8,
+	0xfe, 0x2a, 0x0c, 0x78, 0x8a, 0xb1, 0xef, 0x4a, 0x22, 0xb0, 0x12, 0xf6, 0x47, 0xab, 0x6e, 0xba,
+	0x65, 0x95, 0x0f, 0x55, 0x42, 0xe1, 0xc4, 0xfe, 0xd3, 0x98, 0x9e, 0x1c, 0x7d, 0xc2, 0x7c, 0xf7,
+	0xc7, 0x09, 0xe1, 0x39, 0x98, 0xeb, 0x2d, 0xbe, 0x98, 0x74, 0x9c, 0x03, 0xa9, 0xdd, 0x4d, 0xdc,
+	0xf1, 0x20, 0x76, 0x7d, 0x87, 0xed, 0xc4, 0xd2, 0x83, 0x16, 0x67, 0xe9, 0x08, 0xe2, 0xd4, 0x8a,
+	0x58, 0x9e, 0x61, 0xd5, 0x29, 0xa8, 0xac, 0x45, 0x3e, 0x19, 0x49, 0xc6, 0x08, 0x81, 0x15, 0xe1,
+	0x26, 0x0d, 0x83, 0x30, 0xb2, 0x2a, 0x7a, 0x11, 0x84, 0x0e, 0x4b, 0x9b, 0xfd, 0xa3, 0x01, 0xc7,
+	0x39, 0x45, 0x56, 0x46, 0xc4, 0xcb, 0x59, 0x9a, 0x5f, 0x4a, 0xde, 0x7f, 0x83, 0xa4, 0x6c, 0xf8,
+	0xf5, 0x47, 0xc5, 0xb7, 0x59, 0x5f, 0x68, 0xc3, 0x4f, 0xb3, 0xe0, 0x19, 0x98, 0xfd, 0x11, 0x1c,
+	0xd7, 0xe4, 0x4f, 0xc9, 0x78, 0xbf, 0x96, 0xbb, 0x79, 0xef, 0x5e, 0x4a, 0x04, 0x17, 0x4d, 0x2c,
+	0x25, 0xfb, 0x8f, 0x12, 0x34, 0x35, 0x17, 0xe8, 0x52, 0x11, 0xef, 0x9e, 0xdc, 0xef, 0x99, 0x4f,
+	0x27, 0x1d, 0xa3, 0x08, 0x5b, 0x9f, 0x88, 0xd5, 0xa3, 0x9d, 0x88, 0x67, 0xa0, 0x2a, 0xfb, 0xaa,
+	0x36, 0x5f, 0xd6, 0xe6, 0x1d, 0xd3, 0xe1, 0xea, 0xae, 0x0e, 0xab, 0xef, 0xd3, 0x61, 0xe7, 0xa1,
+	0x86, 0x89, 0x47, 0xc2, 0x24, 0xb3, 0x1a, 0x12, 0xc6, 0x3e, 0x2a, 0x75, 0x58, 0x19, 0x67, 0x3b,
+	0x11, 0x0e, 0xee, 0xc4, 0x5d, 0x55, 0x6b, 0xbe, 0x5a, 0xd5, 0x1e, 0x1a, 0x8a, 0x93, 0xc8, 0x82,
+	0xda, 0xf5, 0x2d, 0x37, 0x8c, 0xd6, 0x96, 0x79, 0xbe, 0x1b, 0x58, 0x89, 0x5a, 0x21, 0x4b, 0x7b,
+	0xb3, 0xbc, 0xac, 0xb3, 0xfc, 0x7d, 0x30, 0xfb, 0xe1, 0x90, 0xc8, 0xf9, 0x71, 0xda, 0x11, 0x17,
+	0xb8, 0xa3, 0x2e, 0x70, 0xa7, 0xaf, 0x2e, 0xf0, 0x5e, 0x9d, 0x35, 0xdf, 0x77, 0xbf, 0x75, 0x0c,
+	0xcc, 0x77, 0xd8, 0xbf, 0x94, 0xa0, 0xfa, 0xdf, 0xef, 0xf9, 0x8b, 0xd0, 0xe0, 0x25, 0xe7, 0xd1,
+	0x95, 0x79, 0x74, 0x73, 0x2f, 0x26, 0x9d, 0xa9, 0x12, 0x4f, 0x97, 0x2c, 0xa9, 0x5c, 0x58, 0x5b,
+	0xe6, 0xf9, 0x68, 0x60, 0x25, 0x6a, 0x49, 0xad, 0xec, 0x9d, 0xd4, 0xaa, 0x9e, 0xd4, 0x19, 0x3e,
+	0xd4, 0x0e, 0xe6, 0xc3, 0x35, 0xf3, 0xf1, 0x93, 0xce, 0x31, 0xfb, 0x51, 0x49, 0x5e, 0xe6, 0xe8,
+	0xac, 0x4a, 0xad, 0x65, 0xe8, 0xf4, 0xfc, 0x5b, 0xef, 0x9f, 0x67, 0x1f, 0x4f, 0x72, 0x75, 0x4b,
+	0xc8, 0xc7, 0x0a, 0x57, 0xc9, 0x07, 0x00, 0x5f, 0xa3, 0xb7, 0xa0, 0x7a, 0x33, 0xcf, 0x18, 0xb0,
+	0xac, 0x62, 0xe1, 0x93, 0x2c, 0xcf, 0x0a, 0xa4, 0x04, 0xa0, 0x33, 0x60, 0x5e, 0x77, 0x07, 0x03,
+	0x49, 0x87, 0xff, 0x09, 0x20, 0xd3, 0x08, 0x18, 0x37, 0xa2, 0x79, 0x28, 0xaf, 0xc7, 0x81, 0x55,
+	0xd1, 0xfb, 0x7c, 0x3d, 0x0e, 0x04, 0x84, 0x99, 0xd0, 0x87, 0x30, 0x77, 0x23, 0x7e, 0x40, 0x68,
+	0xb4, 0xe4, 0x79, 0x71, 0x1e, 0x65, 0xb2, 0xc7, 0x2d, 0x81, 0x9d, 0x31, 0x89, 0x5d, 0xb3, 0xf0,
+	0x6b, 0x75, 0x96, 0x0f, 0xfe, 0x30, 0x78, 0x6c, 0xa8, 0x4e, 0x65, 0x35, 0xc0, 0x24, 0xcb, 0x69,
+	0xc4, 0x93, 0xd2, 0xc2, 0x52, 0x62, 0x55, 0xbb, 0xe1, 0xa6, 0x77, 0x52, 0xe2, 0x4b, 0xc6, 0x2b,
+	0x11, 0x5d, 0x80, 0xc6, 0x86, 0x3b, 0x24, 0x2b, 0x51, 0x46, 0xc7, 0xf2, 0xec, 0x2d, 0x47, 0xbc,
+	0x39, 0xb9, 0x0e, 0x4f, 0xcd, 0xe8, 0x0a, 0xd4, 0x6f, 0x11, 0x3a, 0x5c, 0xa2, 0x41, 0x2a, 0x4f,
+	0x7f, 0xca, 0xd1, 0x9e, 0xa1, 0xca, 0x86, 0x0b, 0x94, 0xfd, 0xa4, 0x04, 0x75, 0x75, 0x6c, 0xb4,
+	0x01, 0xb5, 0x25, 0xdf, 0xa7, 0x24, 0x4d, 0x45, 0x74, 0xbd, 0x77, 0x24, 0x6f, 0x2f, 0xed, 0xcf,
+	0x5b, 0x8f, 0x8e, 0x93, 0x2c, 0x76, 0xe4, 0x5e, 0xac, 0x9c, 0xa0, 0x35, 0x30, 0x97, 0xdd, 0xcc,
+	0x3d, 0x5c, 0x13, 0x70, 0x17, 0x68, 0x1d, 0xaa, 0xfd, 0x38, 0x09, 0x3d, 0x71, 0x39, 0xbc, 0x72,
+	0x64, 0xd2, 0xd9, 0x17, 0x31, 0xf5, 0x17, 0xaf, 0xbe, 0x8b, 0xa5, 0x0f, 0x74, 0x11, 0x6a, 0xcb,
+	0xc4, 0x8b, 0x7d, 0xe2, 0x4f, 0xdf, 0x1c, 0xac, 0xa8, 0x4b, 0xbd, 0x35, 0xae, 0x0f, 0xa3, 0x00,
+	0x2b, 0x84, 0xfd, 0x73, 0x09, 0x1a, 0x05, 0x7b, 0xd0, 0x02, 0xd4, 0x99, 0xc0, 0x5b, 0xb1, 0xc2,
+	0x5b, 0xb1, 0xf5, 0x62, 0xd2, 0x29, 0x74, 0xb8, 0x58, 0xb1, 0xa7, 0x14, 0x5b, 0xf3, 0x0c, 0xcc,
+	0x5c, 0x27, 0x4a, 0x8b, 0x0b, 0x3b, 0x5a, 0x57, 0x33, 0x51, 0xe6, 0xea, 0x9f, 0x25, 0x5e, 0xcd,
+	0xd5, 0x36, 0xc0, 0xed, 0xcc, 0xf5, 0xee, 0x2f, 0x93, 0x24, 0xdb, 0x92, 0xa3, 0x52, 0xd3, 0xb0,
+	0xf1, 0x24, 0x49, 0x68, 0x1e, 0x6a, 0x3c, 0x49, 0xee, 0x6a, 0xd9, 0xac, 0x1e, 0x98, 0xcd, 0x55,
+	0x68, 0x6a, 0x7a, 0x84, 0xc0, 0x64, 0xf4, 0x95, 0xf3, 0x9f, 0xaf, 0xd1, 0x39, 0x30, 0x39, 0x83,
+	0x4b, 0xfa, 0x85, 0xb2, 0xd4, 0x5b, 0x5b, 0xa2, 0x41, 0x3e, 0xe4, 0x1d, 0xcc, 0xa9, 0x4b, 0xa0,
+	0xa9, 0x29, 0xf7, 0xf4, 0x84, 0xc0, 0xe4, 0x85, 0x2a, 0x09, 0x1d, 0x2f, 0xcb, 0x29, 0xa8, 0xdc,
+	0x75, 0x07, 0xb9, 0x18, 0xa4, 0x0d, 0x2c, 0x04, 0xd6, 0x7f, 0x7c, 0xec, 0x49, 0x46, 0xd4, 0xb1,
+	0x12, 0xed, 0xcf, 0x01, 0xed, 0xee, 0x75, 0xf4, 0x01, 0xcc, 0x49, 0xf9, 0x4e, 0xe2, 0xbb, 0x19,
+	0x91, 0x15, 0xfe, 0xbf, 0xc3, 0xff, 0xe3, 0xf5, 0xc9, 0x30, 0x19, 0xb8, 0x19, 0x91, 0x10, 0x3c,
+	0x8b, 0xb5, 0xbf, 0x06, 0x98, 0x0e, 0xb8, 0xa3, 0xee, 0x3a, 0xfb, 0x1b, 0x68, 0x6a, 0x53, 0xf1,
+	0xc8, 0xdd, 0x7f, 0x5f, 0x82, 0x19, 0xde, 0xb2, 0x35, 0xa1, 0x87, 0xf2, 0x2d, 0x7d, 0x14, 0xde,
+	0xc8, 0xe1, 0xba, 0x40, 0xf8, 0x28, 0xa6, 0x4f, 0xf9, 0xf0, 0xd3, 0xa7, 0xe0, 0x8c, 0x7c, 0x5c,
+	0x73, 0x01, 0x9d, 0x80, 0xf2, 0x0d, 0x57, 0xfd, 0xf3, 0x61, 0xcb, 0xde, 0xc7, 0xdb, 0x3b, 0x6d,
+	0xe3, 0xe9, 0x4e, 0xdb, 0xf8, 0x75, 0xa7, 0x6d, 0xfc, 0xbe, 0xd3, 0x36, 0x7e, 0x7a, 0xde, 0x36,
+	0xb6, 0x9f, 0xb7, 0x8d, 0xaf, 0x0e, 0x38, 0x02, 0x51, 0xef, 0x23, 0xbe, 0xda, 0xac, 0xf2, 0xa7,
+	0xcb, 0xdb, 0x7f, 0x0d, 0x00, 0x14, 0x89, 0x35, 0x33, 0x05, 0x11, 0x00, 0x00,
 }
 
 func (m *StreamEvents) Marshal() (dAtA []byte, err error) {
@@ -1518,6 +1680,16 @@ func (m *StreamEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintExec(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.EndBlock != nil {
 		{
 			size, err := m.EndBlock.MarshalToSizedBuffer(dAtA[:i])
@@ -2377,6 +2549,18 @@ func (m *LogEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
 	if len(m.Topics) > 0 {
 		for iNdEx := len(m.Topics) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -2438,6 +2622,18 @@ func (m *CallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decoded != nil {
+		{
+			size, err := m.Decoded.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintExec(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.CallType != 0 {
 		i = encodeVarintExec(dAtA, i, uint64(m.CallType))
 		i--
@@ -2483,6 +2679,112 @@ func (m *CallEvent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ABIDecoding) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABIDecoding) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABIDecoding) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintExec(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ABIArgument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ABIArgument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ABIArgument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XXX_unrecognized != nil {
+		i -= len(m.XXX_unrecognized)
+		copy(dAtA[i:], m.XXX_unrecognized)
+	}
+	if m.Indexed {
+		i--
+		if m.Indexed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintExec(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GovernAccountEvent) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2722,6 +3024,8 @@ func (m *StreamEvent) Size() (n int) {
 		l = m.EndBlock.Size()
 		n += 1 + l + sovExec(uint64(l))
 	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovExec(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3063,6 +3367,10 @@ func (m *LogEvent) Size() (n int) {
 			n += 1 + l + sovExec(uint64(l))
 		}
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3089,6 +3397,59 @@ func (m *CallEvent) Size() (n int) {
 	if m.CallType != 0 {
 		n += 1 + sovExec(uint64(m.CallType))
 	}
+	if m.Decoded != nil {
+		l = m.Decoded.Size()
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ABIDecoding) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovExec(uint64(l))
+		}
+	}
+	if m.XXX_unrecognized != nil {
+		n += len(m.XXX_unrecognized)
+	}
+	return n
+}
+
+func (m *ABIArgument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovExec(uint64(l))
+	}
+	if m.Indexed {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -3188,6 +3549,9 @@ func (this *StreamEvent) GetValue() interface{} {
 	if this.EndBlock != nil {
 		return this.EndBlock
 	}
+	if this.Cursor != nil {
+		return this.Cursor
+	}
 	return nil
 }
 
@@ -3205,6 +3569,8 @@ func (this *StreamEvent) SetValue(value interface{}) bool {
 		this.EndTx = vt
 	case *EndBlock:
 		this.EndBlock = vt
+	case github_com_hyperledger_burrow_binary.HexBytes:
+		this.Cursor = vt
 	default:
 		this.Event = new(Event)
 		if set := this.Event.SetValue(value); set {
@@ -3589,14 +3955,47 @@ func (m *StreamEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipExec(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
 			}
-			if skippy < 0 {
-				return ErrInvalidLengthExec
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
 			}
 			if (iNdEx + skippy) < 0 {
 				return ErrInvalidLengthExec
@@ -5805,6 +6204,42 @@ func (m *LogEvent) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decoded == nil {
+				m.Decoded = &ABIDecoding{}
+			}
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -5999,6 +6434,332 @@ func (m *CallEvent) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Decoded == nil {
+				m.Decoded = &ABIDecoding{}
+			}
+			if err := m.Decoded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABIDecoding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABIDecoding: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABIDecoding: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, &ABIArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipExec(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if skippy < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) < 0 {
+				return ErrInvalidLengthExec
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XXX_unrecognized = append(m.XXX_unrecognized, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ABIArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowExec
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ABIArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ABIArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthExec
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthExec
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Indexed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowExec
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Indexed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipExec(dAtA[iNdEx:])
@@ -0,0 +1,78 @@
+package exec
+
+import (
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/query"
+)
+
+// Tags (in addition to event.AddressKey and the LogN topics) for which state keeps an index of the heights at which
+// they take each value
+const (
+	TxTypeKey     = "TxType"
+	CallOriginKey = "Call.Origin"
+	CallCallerKey = "Call.CallData.Caller"
+	CallCalleeKey = "Call.CallData.Callee"
+)
+
+var indexedEventTags []string
+var indexedTags = make(map[string]bool)
+
+func init() {
+	indexedEventTags = []string{event.AddressKey, CallOriginKey, CallCallerKey, CallCalleeKey}
+	for i := 0; i <= 4; i++ {
+		indexedEventTags = append(indexedEventTags, LogNKey(i))
+	}
+	for _, tag := range append(indexedEventTags, TxTypeKey) {
+		indexedTags[tag] = true
+	}
+}
+
+// The string value of a nil pointer which is not indexed since reflected tags of absent sub-messages take this value
+const nilValue = "nil"
+
+// IsIndexed returns whether the heights at which tag takes value are indexed
+func IsIndexed(tag, value string) bool {
+	return indexedTags[tag] && value != nilValue
+}
+
+// IndexedTags calls consumer with every indexed tag and value of the TxExecution, its events, and any nested
+// TxExecutions. Values are formatted as they are when compared with a string query operand so a query condition
+// tag = 'value' can match only if consumer is called with tag and value.
+func (txe *TxExecution) IndexedTags(consumer func(tag, value string) error) error {
+	if txe.TxHeader != nil {
+		err := consumer(TxTypeKey, query.StringFromValue(txe.TxType))
+		if err != nil {
+			return err
+		}
+	}
+	for _, ev := range txe.Events {
+		for _, tag := range indexedEventTags {
+			value, ok := ev.Get(tag)
+			if !ok {
+				continue
+			}
+			str := query.StringFromValue(value)
+			if str == nilValue {
+				continue
+			}
+			err := consumer(tag, str)
+			if err != nil {
+				return err
+			}
+		}
+		// The header of an event carries the type of the tx that generated it
+		if ev.Header != nil {
+			err := consumer(TxTypeKey, query.StringFromValue(ev.Header.TxType))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	for _, nested := range txe.TxExecutions {
+		err := nested.IndexedTags(consumer)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -48,7 +48,15 @@ func (log *LogEvent) Get(key string) (interface{}, bool) {
 	switch key {
 	case event.AddressKey:
 		value = log.Address
+	case EventNameKey:
+		if log.Decoded == nil {
+			return "", false
+		}
+		value = log.Decoded.Name
 	default:
+		if strings.HasPrefix(key, ArgsKeyPrefix) {
+			return log.Decoded.Get(key)
+		}
 		if i, ok := logNTopicIndex[key]; ok {
 			return hex.EncodeUpperToString(log.GetTopic(i).Bytes()), true
 		}
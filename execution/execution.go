@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"runtime/debug"
 	"sync"
+	"time"
 
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
@@ -243,6 +244,12 @@ func (exe *executor) Execute(txEnv *txs.Envelope) (txe *exec.TxExecution, err er
 	if txExecutor, ok := exe.contexts[txEnv.Tx.Type()]; ok {
 		// Establish new TxExecution
 		txe := exe.block.Tx(txEnv)
+		if exe.runCall {
+			start := time.Now()
+			defer func() {
+				observeTx(txe, time.Since(start))
+			}()
+		}
 		defer func() {
 			if r := recover(); r != nil {
 				err = fmt.Errorf("recovered from panic in executor.Execute(%s): %v\n%s", txEnv.String(), r,
@@ -359,6 +366,7 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	}
 	// First commit the app state, this app hash will not get checkpointed until the next block when we are sure
 	// that nothing in the downstream commit process could have failed. At worst we go back one block.
+	start := time.Now()
 	hash, version, err := exe.state.Update(func(ws state.Updatable) error {
 		// flush the caches
 		err := exe.stateCache.Sync(ws)
@@ -394,6 +402,7 @@ func (exe *executor) Commit(header *abciTypes.Header) (stateHash []byte, err err
 	if err != nil {
 		return nil, err
 	}
+	observeBlock(blockExecution, time.Since(start))
 	// Complete flushing of caches by resetting them to the state we have just committed
 	err = exe.Reset()
 	if err != nil {
@@ -0,0 +1,63 @@
+package execution
+
+import (
+	"time"
+
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	txExecutionSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "tx_seconds",
+		Help:      "Time taken to execute committed transactions by type",
+		Buckets:   prometheus.ExponentialBuckets(0.0001, 4, 10),
+	}, []string{"tx_type"})
+	txExceptions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "exceptions_total",
+		Help:      "Committed transactions that ended in an exception by error code",
+	}, []string{"code"})
+	blockGasUsed = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "block_gas_used",
+		Help:      "Gas used by the transactions of each committed block",
+		Buckets:   prometheus.ExponentialBuckets(1000, 4, 10),
+	})
+	commitSeconds = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "execution",
+		Name:      "commit_seconds",
+		Help:      "Time taken to commit each block to state",
+		Buckets:   prometheus.ExponentialBuckets(0.001, 2, 12),
+	})
+)
+
+// Collectors returns the metrics recorded by execution (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{txExecutionSeconds, txExceptions, blockGasUsed, commitSeconds}
+}
+
+func observeTx(txe *exec.TxExecution, duration time.Duration) {
+	txExecutionSeconds.WithLabelValues(txe.GetTxType().String()).Observe(duration.Seconds())
+	if txe.Exception != nil {
+		code := "Unknown"
+		if ec := txe.Exception.ErrorCode(); ec != nil {
+			code = ec.Name
+		}
+		txExceptions.WithLabelValues(code).Inc()
+	}
+}
+
+func observeBlock(be *exec.BlockExecution, commitDuration time.Duration) {
+	var gasUsed uint64
+	for _, txe := range be.TxExecutions {
+		gasUsed += txe.Result.GetGasUsed()
+	}
+	blockGasUsed.Observe(float64(gasUsed))
+	commitSeconds.Observe(commitDuration.Seconds())
+}
@@ -76,6 +76,7 @@ func (f *Function) Call(state engine.State, params engine.CallParams) ([]byte, e
 }
 
 func (f *Function) execute(state engine.State, params engine.CallParams) ([]byte, error) {
+	nativeCalls.WithLabelValues(f.FullName()).Inc()
 	// check if we have permission to call this function
 	hasPermission, err := HasPermission(state.CallFrame, params.Caller, f.PermFlag)
 	if err != nil {
@@ -0,0 +1,15 @@
+package native
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var nativeCalls = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: "burrow",
+	Subsystem: "evm",
+	Name:      "native_calls_total",
+	Help:      "Calls to precompiles and native contract functions",
+}, []string{"function"})
+
+// Collectors returns the metrics recorded by native contracts (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{nativeCalls}
+}
@@ -92,11 +92,17 @@ func (ws *writeState) RemoveAccount(address crypto.Address) error {
 }
 
 func (s *ReadState) IterateAccounts(consumer func(*acm.Account) error) error {
+	return s.IterateAccountsInRange(nil, nil, consumer)
+}
+
+// Iterate over accounts whose addresses lie in [start, end) in ascending order, where start and end may be any
+// prefix of an address and a nil start or end leaves the range unbounded on that side
+func (s *ReadState) IterateAccountsInRange(start, end []byte, consumer func(*acm.Account) error) error {
 	tree, err := s.Forest.Reader(keys.Account.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+	return tree.Iterate(start, end, true, func(key []byte, value []byte) error {
 		account := new(acm.Account)
 		err := encoding.Decode(value, account)
 		if err != nil {
@@ -122,24 +128,37 @@ func (s *ReadState) GetStorage(address crypto.Address, key binary.Word256) ([]by
 }
 
 func (ws *writeState) SetStorage(address crypto.Address, key binary.Word256, value []byte) error {
+	setStorage, err := ws.StorageSetter(address)
+	if err != nil {
+		return err
+	}
+	setStorage(key, value)
+	return nil
+}
+
+// StorageSetter returns a function that sets storage for address as SetStorage does. Calls to StorageSetter must be
+// serialised with other writes but the returned function only writes to the storage tree of address so may be called
+// concurrently with the setters of other addresses.
+func (ws *writeState) StorageSetter(address crypto.Address) (func(key binary.Word256, value []byte), error) {
 	keyFormat := keys.Storage.Fix(address)
 	tree, err := ws.forest.Writer(keyFormat.Prefix())
 	if err != nil {
-		return err
+		return nil, err
 	}
-	zero := true
-	for _, b := range value {
-		if b != 0 {
-			zero = false
-			break
+	return func(key binary.Word256, value []byte) {
+		zero := true
+		for _, b := range value {
+			if b != 0 {
+				zero = false
+				break
+			}
 		}
-	}
-	if zero {
-		tree.Delete(keyFormat.KeyNoPrefix(key))
-	} else {
-		tree.Set(keyFormat.KeyNoPrefix(key), value)
-	}
-	return nil
+		if zero {
+			tree.Delete(keyFormat.KeyNoPrefix(key))
+		} else {
+			tree.Set(keyFormat.KeyNoPrefix(key), value)
+		}
+	}, nil
 }
 
 func (s *ReadState) IterateStorage(address crypto.Address, consumer func(key binary.Word256, value []byte) error) error {
@@ -2,8 +2,10 @@ package state
 
 import (
 	"bytes"
+	"crypto/sha256"
 	"fmt"
 	"io"
+	"math"
 
 	"github.com/hyperledger/burrow/encoding"
 	"github.com/hyperledger/burrow/execution/exec"
@@ -23,7 +25,8 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	}
 	buf := new(bytes.Buffer)
 	var offset int
-	for _, ev := range be.StreamEvents() {
+	streamEvents := be.StreamEvents()
+	for _, ev := range streamEvents {
 		switch {
 		case ev.BeginTx != nil:
 			val := &exec.TxExecutionKey{Height: be.Height, Offset: uint64(offset)}
@@ -52,6 +55,42 @@ func (ws *writeState) AddBlock(be *exec.BlockExecution) error {
 	key := keys.Event.KeyNoPrefix(be.Height)
 	tree.Set(key, buf.Bytes())
 
+	err = ws.indexBlock(be)
+	if err != nil {
+		return err
+	}
+	blocksStored.Inc()
+	eventsStored.Add(float64(len(streamEvents)))
+	return nil
+}
+
+// Index the heights at which tags take values so that queries over them need only read matching blocks
+func (ws *writeState) indexBlock(be *exec.BlockExecution) error {
+	// Blocks stored before the index was introduced are not indexed so record the first height that is
+	has, err := ws.plain.Has(keys.IndexStart.Key())
+	if err != nil {
+		return err
+	}
+	if !has {
+		err = ws.plain.Set(keys.IndexStart.Key(), keys.Event.KeyNoPrefix(be.Height))
+		if err != nil {
+			return err
+		}
+	}
+	indexed := make(map[string]bool)
+	for _, txe := range be.TxExecutions {
+		err = txe.IndexedTags(func(tag, value string) error {
+			key := keys.EventIndex.Key(indexHash(tag, value), be.Height)
+			if indexed[string(key)] {
+				return nil
+			}
+			indexed[string(key)] = true
+			return ws.plain.Set(key, []byte{})
+		})
+		if err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -91,6 +130,57 @@ func (s *ReadState) IterateStreamEvents(startHeight, endHeight *uint64, sortOrde
 	})
 }
 
+// IndexStart returns the first height from which stored events are indexed, if no events are indexed it returns false
+func (s *ReadState) IndexStart() (uint64, bool, error) {
+	bs, err := s.Plain.Get(keys.IndexStart.Key())
+	if err != nil || len(bs) == 0 {
+		return 0, false, err
+	}
+	var height uint64
+	err = keys.Event.ScanNoPrefix(bs, &height)
+	if err != nil {
+		return 0, false, err
+	}
+	return height, true, nil
+}
+
+// IterateIndexedHeights iterates in ascending order over the heights in the closed interval [startHeight, endHeight]
+// (from IndexStart) at which the stored events include tag with value, see exec.TxExecution.IndexedTags
+func (s *ReadState) IterateIndexedHeights(tag, value string, startHeight, endHeight uint64,
+	consumer func(height uint64) error) error {
+	keyFormat := keys.EventIndex.Fix(indexHash(tag, value))
+	var endKey []byte
+	if endHeight < math.MaxUint64 {
+		endKey = keyFormat.KeyNoPrefix(endHeight + 1)
+	}
+	it, err := keyFormat.Iterator(s.Plain, keyFormat.KeyNoPrefix(startHeight), endKey)
+	if err != nil {
+		return err
+	}
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		var height uint64
+		err = keyFormat.ScanNoPrefix(it.Key(), &height)
+		if err != nil {
+			return err
+		}
+		err = consumer(height)
+		if err != nil {
+			return err
+		}
+	}
+	return it.Error()
+}
+
+func indexHash(tag, value string) []byte {
+	hash := sha256.New()
+	hash.Write([]byte(tag))
+	// Tags do not contain null bytes
+	hash.Write([]byte{0})
+	hash.Write([]byte(value))
+	return hash.Sum(nil)
+}
+
 func (s *ReadState) TxsAtHeight(height uint64) ([]*exec.TxExecution, error) {
 	const errHeader = "TxAtHeight():"
 	var stack exec.TxStack
@@ -3,17 +3,20 @@ package state
 import (
 	bin "encoding/binary"
 	"fmt"
+	"math"
 	"testing"
 
 	"github.com/hyperledger/burrow/binary"
 	"github.com/hyperledger/burrow/config/source"
 	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/hyperledger/burrow/txs/payload"
 	"github.com/stretchr/testify/require"
 	dbm "github.com/tendermint/tm-db"
+	"github.com/tmthrgd/go-hex"
 )
 
 func TestWriteState_AddBlock(t *testing.T) {
@@ -238,3 +241,37 @@ func mkEvent(height, tx, index uint64) *exec.Event {
 		},
 	}
 }
+
+func TestReadState_IterateIndexedHeights(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	_, ok, err := s.IndexStart()
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	for height := uint64(3); height < 8; height++ {
+		addBlock(t, s, height, 2, 3)
+	}
+	start, ok, err := s.IndexStart()
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, uint64(3), start)
+
+	heights := func(tag, value string, startHeight, endHeight uint64) []uint64 {
+		var hs []uint64
+		err := s.IterateIndexedHeights(tag, value, startHeight, endHeight, func(height uint64) error {
+			hs = append(hs, height)
+			return nil
+		})
+		require.NoError(t, err)
+		return hs
+	}
+	// mkEvent emits logs from an address made of height and event index
+	address := crypto.Address{5, 2}
+	require.Equal(t, []uint64{5}, heights(event.AddressKey, address.String(), 0, math.MaxUint64))
+	require.Empty(t, heights(event.AddressKey, address.String(), 6, 7))
+	require.Empty(t, heights(event.AddressKey, crypto.Address{5, 3}.String(), 0, 10))
+
+	topic := hex.EncodeUpperToString(binary.Word256{1, 2, 3}.Bytes())
+	require.Equal(t, []uint64{4, 5, 6}, heights(exec.LogNKey(0), topic, 4, 6))
+	require.Equal(t, []uint64{3, 4, 5, 6, 7}, heights(exec.TxTypeKey, payload.TypeUnknown.String(), 0, 100))
+}
@@ -0,0 +1,23 @@
+package state
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	blocksStored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "blocks_stored_total",
+		Help:      "Blocks (with transactions) stored",
+	})
+	eventsStored = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "state",
+		Name:      "events_stored_total",
+		Help:      "Stream events stored",
+	})
+)
+
+// Collectors returns the metrics recorded by state (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{blocksStored, eventsStored}
+}
@@ -48,11 +48,17 @@ func (ws *writeState) RemoveName(name string) error {
 }
 
 func (s *ReadState) IterateNames(consumer func(*names.Entry) error) error {
+	return s.IterateNamesInRange(nil, nil, consumer)
+}
+
+// Iterate over names in [start, end) in ascending order, where a nil start or end leaves the range unbounded on that
+// side
+func (s *ReadState) IterateNamesInRange(start, end []byte, consumer func(*names.Entry) error) error {
 	tree, err := s.Forest.Reader(keys.Name.Prefix())
 	if err != nil {
 		return err
 	}
-	return tree.Iterate(nil, nil, true, func(key []byte, value []byte) error {
+	return tree.Iterate(start, end, true, func(key []byte, value []byte) error {
 		entry := new(names.Entry)
 		err := encoding.Decode(value, entry)
 		if err != nil {
@@ -42,15 +42,17 @@ var _ names.IterableReader = &State{}
 var _ Updatable = &writeState{}
 
 type KeyFormatStore struct {
-	Account   *storage.MustKeyFormat
-	Storage   *storage.MustKeyFormat
-	Name      *storage.MustKeyFormat
-	Proposal  *storage.MustKeyFormat
-	Validator *storage.MustKeyFormat
-	Event     *storage.MustKeyFormat
-	Registry  *storage.MustKeyFormat
-	TxHash    *storage.MustKeyFormat
-	Abi       *storage.MustKeyFormat
+	Account    *storage.MustKeyFormat
+	Storage    *storage.MustKeyFormat
+	Name       *storage.MustKeyFormat
+	Proposal   *storage.MustKeyFormat
+	Validator  *storage.MustKeyFormat
+	Event      *storage.MustKeyFormat
+	Registry   *storage.MustKeyFormat
+	TxHash     *storage.MustKeyFormat
+	Abi        *storage.MustKeyFormat
+	EventIndex *storage.MustKeyFormat
+	IndexStart *storage.MustKeyFormat
 }
 
 var keys = KeyFormatStore{
@@ -75,6 +77,10 @@ var keys = KeyFormatStore{
 	TxHash: storage.NewMustKeyFormat("th", txs.HashLength),
 	// CodeHash -> Abi
 	Abi: storage.NewMustKeyFormat("abi", sha256.Size),
+	// Hash(Tag, Value), Height -> nil
+	EventIndex: storage.NewMustKeyFormat("ix", sha256.Size, uint64Length),
+	// -> Height of first block indexed
+	IndexStart: storage.NewMustKeyFormat("is"),
 }
 
 var Prefixes [][]byte
@@ -94,6 +100,7 @@ type Updatable interface {
 	registry.Writer
 	validator.Writer
 	acmstate.MetadataWriter
+	StorageSetter(address crypto.Address) (func(key binary.Word256, value []byte), error)
 	AddBlock(blockExecution *exec.BlockExecution) error
 }
 
@@ -247,19 +254,29 @@ func (s *State) Hash() []byte {
 	return s.writeState.forest.Hash()
 }
 
+// LoadHeight returns the state as it was after the block at height along with the validator history up to it
 func (s *State) LoadHeight(height uint64) (*ReadState, error) {
-	version := VersionAtHeight(height)
-	forest, err := s.writeState.forest.GetImmutable(version)
+	st, err := s.LoadForestAtHeight(height)
 	if err != nil {
 		return nil, err
 	}
-	ring, err := LoadValidatorRing(version, DefaultValidatorsWindowSize, s.writeState.forest.GetImmutable)
+	st.History, err = LoadValidatorRing(VersionAtHeight(height), DefaultValidatorsWindowSize,
+		s.writeState.forest.GetImmutable)
+	if err != nil {
+		return nil, err
+	}
+	return st, nil
+}
+
+// LoadForestAtHeight returns the state as it was after the block at height without the validator history, which takes
+// loading a version of the forest for each block in the validator window. The returned state has no validators.
+func (s *State) LoadForestAtHeight(height uint64) (*ReadState, error) {
+	forest, err := s.writeState.forest.GetImmutable(VersionAtHeight(height))
 	if err != nil {
 		return nil, err
 	}
 	return &ReadState{
-		Forest:  forest,
-		History: ring,
+		Forest: forest,
 	}, nil
 }
 
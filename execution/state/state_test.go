@@ -40,3 +40,22 @@ func TestState_UpdateAccount(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, source.JSONString(account), source.JSONString(accountOut))
 }
+
+func TestState_LoadForestAtHeight(t *testing.T) {
+	s := NewState(dbm.NewMemDB())
+	account := acm.NewAccountFromSecret("Foo")
+	// The first commit is at the version of height zero
+	for balance := uint64(1); balance <= 3; balance++ {
+		account.Balance = balance
+		_, _, err := s.Update(func(ws Updatable) error {
+			return ws.UpdateAccount(account)
+		})
+		require.NoError(t, err)
+	}
+	st, err := s.LoadForestAtHeight(1)
+	require.NoError(t, err)
+	accountOut, err := st.GetAccount(account.Address)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(2), accountOut.Balance)
+	assert.Nil(t, st.History)
+}
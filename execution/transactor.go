@@ -226,6 +226,14 @@ func (trans *Transactor) CheckTxSyncRaw(ctx context.Context, txBytes []byte) (*t
 				return nil, fmt.Errorf("could not deserialise transaction receipt: %s", err)
 			}
 			return receipt, nil
+		case codes.TxExecutionErrorCode:
+			ex := new(errors.Exception)
+			err := ex.Unmarshal(checkTxResponse.Data)
+			if err == nil && ex.CodeNumber != 0 {
+				return nil, errors.Wrapf(ex, "error %d returned by Tendermint in BroadcastTxSync ABCI log: %v",
+					checkTxResponse.Code, checkTxResponse.Log)
+			}
+			fallthrough
 		default:
 			return nil, fmt.Errorf("error %d returned by Tendermint in BroadcastTxSync ABCI log: %v",
 				checkTxResponse.Code, checkTxResponse.Log)
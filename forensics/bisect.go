@@ -0,0 +1,325 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/forensics/storage"
+	burrowStorage "github.com/hyperledger/burrow/storage"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/pkg/errors"
+)
+
+// Divergence describes the first height at which two chains sharing the same blocks disagree on state
+type Divergence struct {
+	// The first height after which the state hashes differ
+	Height uint64
+	// State hashes after Height
+	ExpectedHash binary.HexBytes
+	ActualHash   binary.HexBytes
+	// Index within the block at Height of the first tx whose recorded execution differs, or -1 if every tx execution
+	// matches (in which case the divergence is in block-level state)
+	TxIndex int
+	TxHash  binary.HexBytes
+	// The recorded executions of the differing tx and the execution obtained by replaying it locally on the (common)
+	// state at Height - 1
+	ExpectedTx *exec.TxExecution
+	ActualTx   *exec.TxExecution
+	ReplayedTx *exec.TxExecution
+	// Every key whose value differs between the two states after Height
+	Keys []KeyDiff
+}
+
+// KeyDiff is a single key in a forest tree whose value differs between two states, a nil value indicates the key is
+// absent
+type KeyDiff struct {
+	Prefix   []byte
+	Key      binary.HexBytes
+	Expected binary.HexBytes
+	Actual   binary.HexBytes
+}
+
+func (kd KeyDiff) String() string {
+	return fmt.Sprintf("%q/%v: %v -> %v", kd.Prefix, kd.Key, kd.Expected, kd.Actual)
+}
+
+func (dv *Divergence) String() string {
+	sb := new(strings.Builder)
+	fmt.Fprintf(sb, "State diverges at height %d: expected hash %v but actual hash %v\n",
+		dv.Height, dv.ExpectedHash, dv.ActualHash)
+	if dv.TxIndex < 0 {
+		fmt.Fprintf(sb, "All tx executions match so divergence is in block-level state\n")
+	} else {
+		fmt.Fprintf(sb, "First differing tx is %v at index %d", dv.TxHash, dv.TxIndex)
+		switch {
+		case dv.ReplayedTx == nil:
+		case txExecutionsEqual(dv.ReplayedTx, dv.ExpectedTx):
+			fmt.Fprintf(sb, " (local replay agrees with expected)")
+		case txExecutionsEqual(dv.ReplayedTx, dv.ActualTx):
+			fmt.Fprintf(sb, " (local replay agrees with actual)")
+		default:
+			fmt.Fprintf(sb, " (local replay agrees with neither)")
+		}
+		fmt.Fprintln(sb)
+	}
+	fmt.Fprintf(sb, "%d state key(s) differ:\n", len(dv.Keys))
+	for _, kd := range dv.Keys {
+		fmt.Fprintf(sb, "  %v\n", kd)
+	}
+	return sb.String()
+}
+
+// Bisect binary searches for the first height at which the states of exp and act differ. Both sources must have been
+// built from the same blocks (i.e. be nodes of the same chain) and agree on state at genesis. The txs of the block at
+// the diverging height are then re-executed one by one against the expected state from the previous height and
+// compared with the tx executions recorded by each source to find the first differing tx.
+func Bisect(exp, act *Source) (*Divergence, error) {
+	expHeight, err := exp.LatestHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get expected height")
+	}
+	actHeight, err := act.LatestHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get actual height")
+	}
+	high := expHeight
+	if actHeight < high {
+		high = actHeight
+	}
+
+	diverges := func(height uint64) (bool, error) {
+		expHash, err := exp.HashAt(height)
+		if err != nil {
+			return false, errors.Wrap(err, "could not load expected state")
+		}
+		actHash, err := act.HashAt(height)
+		if err != nil {
+			return false, errors.Wrap(err, "could not load actual state")
+		}
+		return !bytes.Equal(expHash, actHash), nil
+	}
+
+	div, err := diverges(0)
+	if err != nil {
+		return nil, err
+	}
+	if div {
+		return nil, fmt.Errorf("states differ at genesis, are they from the same chain?")
+	}
+	div, err = diverges(high)
+	if err != nil {
+		return nil, err
+	}
+	if !div {
+		return nil, fmt.Errorf("states agree up to last common height %d", high)
+	}
+
+	// Invariant: states agree at low and differ at high
+	low := uint64(0)
+	for high-low > 1 {
+		mid := low + (high-low)/2
+		div, err = diverges(mid)
+		if err != nil {
+			return nil, err
+		}
+		if div {
+			high = mid
+		} else {
+			low = mid
+		}
+	}
+
+	return DivergenceAt(exp, act, high)
+}
+
+// DivergenceAt describes how the states of exp and act differ after the block at height, given that they agree at
+// the previous height
+func DivergenceAt(exp, act *Source, height uint64) (*Divergence, error) {
+	dv := &Divergence{
+		Height:  height,
+		TxIndex: -1,
+	}
+	err := exp.LoadAt(height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load expected state")
+	}
+	err = act.LoadAt(height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load actual state")
+	}
+	dv.ExpectedHash = exp.State.Hash()
+	dv.ActualHash = act.State.Hash()
+
+	dv.Keys, err = DiffKeys(&exp.State.ReadState, &act.State.ReadState)
+	if err != nil {
+		return nil, err
+	}
+
+	expTxs, err := exp.State.TxsAtHeight(height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read expected txs")
+	}
+	actTxs, err := act.State.TxsAtHeight(height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read actual txs")
+	}
+
+	replayed, err := exp.ReplayTxs(height)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := 0; i < len(expTxs) || i < len(actTxs); i++ {
+		var expTx, actTx *exec.TxExecution
+		if i < len(expTxs) {
+			expTx = expTxs[i]
+		}
+		if i < len(actTxs) {
+			actTx = actTxs[i]
+		}
+		if !txExecutionsEqual(expTx, actTx) {
+			dv.TxIndex = i
+			dv.ExpectedTx = expTx
+			dv.ActualTx = actTx
+			if i < len(replayed) {
+				dv.ReplayedTx = replayed[i]
+			}
+			if expTx != nil {
+				dv.TxHash = expTx.TxHash
+			} else {
+				dv.TxHash = actTx.TxHash
+			}
+			break
+		}
+	}
+	return dv, nil
+}
+
+// HashAt returns the state hash after the block at height has been committed
+func (src *Source) HashAt(height uint64) ([]byte, error) {
+	// Loading state discards later versions so use a fresh cache to leave them available for subsequent loads
+	st, err := state.LoadState(storage.NewCacheDB(src.db), execution.VersionAtHeight(height))
+	if err != nil {
+		return nil, err
+	}
+	return st.Hash(), nil
+}
+
+// ReplayTxs re-executes the txs of the block at height one by one against the state at the previous height without
+// committing them
+func (src *Source) ReplayTxs(height uint64) ([]*exec.TxExecution, error) {
+	if height < 1 {
+		return nil, fmt.Errorf("cannot replay txs at height 0")
+	}
+	if err := src.LoadAt(height - 1); err != nil {
+		return nil, err
+	}
+	block, err := src.Explorer.Block(int64(height))
+	if err != nil {
+		return nil, errors.Wrap(err, "explorer.Block()")
+	}
+	var txes []*exec.TxExecution
+	err = block.Transactions(func(txEnv *txs.Envelope) error {
+		txe, err := src.committer.Execute(txEnv)
+		if err != nil {
+			return errors.Wrap(err, "committer.Execute()")
+		}
+		txes = append(txes, txe)
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "block.Transactions()")
+	}
+	return txes, src.committer.Reset()
+}
+
+// DiffKeys returns every key in the forests of exp and act whose value differs, including those in the tree of each
+// contract's storage
+func DiffKeys(exp, act *state.ReadState) ([]KeyDiff, error) {
+	prefixes, err := forestPrefixes(exp.Forest, act.Forest)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []KeyDiff
+	for _, prefix := range prefixes {
+		// A tree missing from one forest reads as empty
+		expReader, err := exp.Forest.Reader(prefix)
+		if err != nil {
+			return nil, err
+		}
+		actReader, err := act.Forest.Reader(prefix)
+		if err != nil {
+			return nil, err
+		}
+		err = expReader.Iterate(nil, nil, true, func(key, value []byte) error {
+			actual, err := actReader.Get(key)
+			if err != nil {
+				return err
+			}
+			if !bytes.Equal(actual, value) {
+				diffs = append(diffs, KeyDiff{Prefix: prefix, Key: key, Expected: value, Actual: actual})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+		err = actReader.Iterate(nil, nil, true, func(key, value []byte) error {
+			has, err := expReader.Has(key)
+			if err != nil {
+				return err
+			}
+			if !has {
+				diffs = append(diffs, KeyDiff{Prefix: prefix, Key: key, Actual: value})
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return diffs, nil
+}
+
+// forestPrefixes returns the prefix of every tree committed to any of forests in order
+func forestPrefixes(forests ...burrowStorage.ForestReader) ([][]byte, error) {
+	seen := make(map[string]bool)
+	var prefixes [][]byte
+	for _, forest := range forests {
+		err := forest.Iterate(nil, nil, true, func(prefix []byte, _ burrowStorage.KVCallbackIterableReader) error {
+			if !seen[string(prefix)] {
+				seen[string(prefix)] = true
+				prefixes = append(prefixes, []byte(string(prefix)))
+			}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(prefixes, func(i, j int) bool {
+		return bytes.Compare(prefixes[i], prefixes[j]) < 0
+	})
+	return prefixes, nil
+}
+
+func txExecutionsEqual(exp, act *exec.TxExecution) bool {
+	if exp == nil || act == nil {
+		return exp == act
+	}
+	expBs, err := exp.Marshal()
+	if err != nil {
+		return false
+	}
+	actBs, err := act.Marshal()
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(expBs, actBs)
+}
@@ -0,0 +1,142 @@
+package forensics
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/store"
+	"github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBisect(t *testing.T) {
+	const height = 20
+	const divergeHeight = 13
+	genesisDoc, tmDB, burrowDB := makeChain(t, height)
+
+	_, _, validators := genesis.NewDeterministicGenesis(0).GenesisDoc(0, 1)
+
+	// Re-execute the same blocks but send a different amount at divergeHeight
+	badDB := executeChain(t, genesisDoc, tmDB, func(blockHeight uint64, txEnv *txs.Envelope) *txs.Envelope {
+		if blockHeight != divergeHeight {
+			return txEnv
+		}
+		sendTx := payload.NewSendTx()
+		sendTx.AddInputWithSequence(validators[0].GetPublicKey(), blockHeight+1, blockHeight)
+		sendTx.AddOutput(acm.NewAccountFromSecret("other").GetAddress(), blockHeight+1)
+		tampered := txs.Enclose(genesisDoc.ChainID(), sendTx)
+		require.NoError(t, tampered.Sign(validators[0]))
+		return tampered
+	})
+
+	exp := NewSource(burrowDB, tmDB, genesisDoc)
+	act := NewSource(badDB, tmDB, genesisDoc)
+	dv, err := Bisect(exp, act)
+	require.NoError(t, err)
+
+	assert.Equal(t, uint64(divergeHeight), dv.Height)
+	assert.NotEqual(t, dv.ExpectedHash, dv.ActualHash)
+	assert.Equal(t, 0, dv.TxIndex)
+	require.NotNil(t, dv.ExpectedTx)
+	require.NotNil(t, dv.ActualTx)
+	assert.Equal(t, dv.TxHash, dv.ExpectedTx.TxHash)
+	assert.True(t, txExecutionsEqual(dv.ReplayedTx, dv.ExpectedTx))
+	assert.False(t, txExecutionsEqual(dv.ReplayedTx, dv.ActualTx))
+	assert.NotEmpty(t, dv.Keys)
+	assert.Contains(t, dv.String(), "local replay agrees with expected")
+
+	// Same state
+	_, err = Bisect(exp, NewSource(burrowDB, tmDB, genesisDoc))
+	require.Error(t, err)
+}
+
+func TestDiffKeysStorage(t *testing.T) {
+	address := acm.NewAccountFromSecret("contract").GetAddress()
+	diverged := binary.LeftPadWord256([]byte{1})
+	extra := binary.LeftPadWord256([]byte{2})
+
+	exp := makeStorageState(t, address, map[binary.Word256][]byte{diverged: []byte("one")})
+	act := makeStorageState(t, address, map[binary.Word256][]byte{diverged: []byte("two"), extra: []byte("three")})
+
+	diffs, err := DiffKeys(&exp.ReadState, &act.ReadState)
+	require.NoError(t, err)
+
+	var storageDiffs []KeyDiff
+	for _, diff := range diffs {
+		if bytes.HasSuffix(diff.Prefix, address.Bytes()) {
+			storageDiffs = append(storageDiffs, diff)
+		}
+	}
+	require.Len(t, storageDiffs, 2)
+	assert.Equal(t, []byte("one"), []byte(storageDiffs[0].Expected))
+	assert.Equal(t, []byte("two"), []byte(storageDiffs[0].Actual))
+	assert.Empty(t, storageDiffs[1].Expected)
+	assert.Equal(t, []byte("three"), []byte(storageDiffs[1].Actual))
+
+	// Same storage
+	diffs, err = DiffKeys(&exp.ReadState, &makeStorageState(t, address,
+		map[binary.Word256][]byte{diverged: []byte("one")}).ReadState)
+	require.NoError(t, err)
+	assert.Empty(t, diffs)
+}
+
+func makeStorageState(t *testing.T, address crypto.Address, storage map[binary.Word256][]byte) *state.State {
+	st := state.NewState(dbm.NewMemDB())
+	_, _, err := st.Update(func(up state.Updatable) error {
+		err := up.UpdateAccount(&acm.Account{Address: address, EVMCode: []byte{0x60}})
+		if err != nil {
+			return err
+		}
+		for key, value := range storage {
+			err = up.SetStorage(address, key, value)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	return st
+}
+
+// Executes the blocks in tmDB into a new burrow DB executing the tx returned by tamper in place of each tx
+func executeChain(t *testing.T, genesisDoc *genesis.GenesisDoc, tmDB dbm.DB,
+	tamper func(height uint64, txEnv *txs.Envelope) *txs.Envelope) dbm.DB {
+
+	burrowDB, burrowState, burrowChain, err := initBurrow(genesisDoc)
+	require.NoError(t, err)
+
+	committer, err := execution.NewBatchCommitter(burrowState, execution.ParamsFromGenesis(genesisDoc),
+		burrowChain, event.NewEmitter(), logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	explorer := bcm.NewBlockStore(store.NewBlockStore(tmDB))
+	err = explorer.Blocks(1, explorer.Height(), func(block *bcm.Block) error {
+		err := block.Transactions(func(txEnv *txs.Envelope) error {
+			_, err := committer.Execute(tamper(uint64(block.Height), txEnv))
+			return err
+		})
+		require.NoError(t, err)
+		abciHeader := types.TM2PB.Header(&block.Header)
+		stateHash, err := committer.Commit(&abciHeader)
+		if err != nil {
+			return err
+		}
+		return burrowChain.CommitBlockAtHeight(block.Time, block.Hash(), stateHash, uint64(block.Height))
+	})
+	require.NoError(t, err)
+	return burrowDB
+}
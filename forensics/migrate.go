@@ -0,0 +1,179 @@
+package forensics
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+)
+
+// TendermintDBNames are the databases Tendermint may keep in the data directory of a Burrow directory
+var TendermintDBNames = []string{"blockstore", "state", "evidence", "tx_index"}
+
+// Migration records the outcome of MigrateDB
+type Migration struct {
+	DBs []MigratedDB
+	// The last height and the state hash at that height shared by the original and migrated state
+	Height  uint64
+	AppHash binary.HexBytes
+}
+
+type MigratedDB struct {
+	Dir  string
+	Name string
+	Keys uint64
+}
+
+func (mdb MigratedDB) String() string {
+	return fmt.Sprintf("%s in %s (%d keys)", mdb.Name, mdb.Dir, mdb.Keys)
+}
+
+// MigrateDB copies the Burrow state database and Tendermint stores of the Burrow directory srcDir using srcBackend to
+// dstDir using dstBackend. The migrated state is then reopened and checked against the original state hash.
+func MigrateDB(genesisDoc *genesis.GenesisDoc, srcDir string, srcBackend dbm.BackendType, dstDir string,
+	dstBackend dbm.BackendType) (*Migration, error) {
+	if path.Clean(srcDir) == path.Clean(dstDir) {
+		return nil, fmt.Errorf("cannot migrate databases in %s in place", srcDir)
+	}
+	for _, backend := range []dbm.BackendType{srcBackend, dstBackend} {
+		err := checkBackend(backend)
+		if err != nil {
+			return nil, err
+		}
+	}
+	// Relative to the Burrow directory
+	dirs := map[string]string{core.BurrowDBName: ""}
+	names := []string{core.BurrowDBName}
+	for _, name := range TendermintDBNames {
+		if dbExists(path.Join(srcDir, "data"), name) {
+			dirs[name] = "data"
+			names = append(names, name)
+		}
+	}
+	if !dbExists(srcDir, core.BurrowDBName) {
+		return nil, fmt.Errorf("could not find Burrow state database in %s", srcDir)
+	}
+
+	migration := new(Migration)
+	for _, name := range names {
+		mdb, err := migrateDB(name, path.Join(srcDir, dirs[name]), srcBackend, path.Join(dstDir, dirs[name]),
+			dstBackend)
+		if err != nil {
+			return nil, err
+		}
+		migration.DBs = append(migration.DBs, *mdb)
+	}
+
+	src, err := NewSourceFromDir(genesisDoc, srcDir, srcBackend)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	dst, err := NewSourceFromDir(genesisDoc, dstDir, dstBackend)
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	migration.Height, err = src.LatestHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get original height")
+	}
+	dstHeight, err := dst.LatestHeight()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not get migrated height")
+	}
+	if dstHeight != migration.Height {
+		return nil, fmt.Errorf("migrated height %d does not match original height %d", dstHeight,
+			migration.Height)
+	}
+	migration.AppHash, err = src.HashAt(migration.Height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load original state")
+	}
+	dstHash, err := dst.HashAt(migration.Height)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not load migrated state")
+	}
+	if !bytes.Equal(dstHash, migration.AppHash) {
+		return nil, fmt.Errorf("migrated app hash %v does not match original app hash %v at height %d",
+			binary.HexBytes(dstHash), migration.AppHash, migration.Height)
+	}
+	return migration, nil
+}
+
+// Checks that backend is compiled in and can hold migrated state
+func checkBackend(backend dbm.BackendType) error {
+	switch backend {
+	case dbm.MemDBBackend:
+		return fmt.Errorf("cannot migrate to or from %s since it does not persist databases", backend)
+	case dbm.FSDBBackend:
+		return fmt.Errorf("cannot migrate to or from %s since it does not support batches", backend)
+	}
+	return storage.CheckBackend(backend)
+}
+
+func migrateDB(name, srcDir string, srcBackend dbm.BackendType, dstDir string,
+	dstBackend dbm.BackendType) (*MigratedDB, error) {
+	if dbExists(dstDir, name) {
+		return nil, fmt.Errorf("refusing to overwrite existing database %s in %s", name, dstDir)
+	}
+	err := os.MkdirAll(dstDir, 0700)
+	if err != nil {
+		return nil, err
+	}
+	src, err := storage.OpenDB(name, srcBackend, srcDir)
+	if err != nil {
+		return nil, err
+	}
+	defer src.Close()
+	dst, err := storage.OpenDB(name, dstBackend, dstDir)
+	if err != nil {
+		return nil, err
+	}
+	defer dst.Close()
+
+	n, err := storage.CopyDB(dst, src)
+	if err != nil {
+		return nil, errors.Wrapf(err, "could not copy database %s", name)
+	}
+	// Read back through the new backend
+	copied, err := countKeys(dst)
+	if err != nil {
+		return nil, err
+	}
+	if copied != n {
+		return nil, fmt.Errorf("migrated database %s has %d keys but %d were copied", name, copied, n)
+	}
+	return &MigratedDB{
+		Dir:  dstDir,
+		Name: name,
+		Keys: n,
+	}, nil
+}
+
+func countKeys(db dbm.DB) (uint64, error) {
+	it, err := db.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+	var n uint64
+	for ; it.Valid(); it.Next() {
+		n++
+	}
+	return n, it.Error()
+}
+
+// All tm-db backends store the database called name in a file or directory called name.db
+func dbExists(dir, name string) bool {
+	_, err := os.Stat(path.Join(dir, name+".db"))
+	return err == nil
+}
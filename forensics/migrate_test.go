@@ -0,0 +1,80 @@
+package forensics
+
+import (
+	"io/ioutil"
+	"os"
+	"path"
+	"testing"
+
+	"github.com/hyperledger/burrow/core"
+	"github.com/hyperledger/burrow/genesis"
+	"github.com/hyperledger/burrow/storage"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestMigrateDB(t *testing.T) {
+	t.Run("GoLevelDB", func(t *testing.T) {
+		testMigrateDB(t, dbm.GoLevelDBBackend)
+	})
+
+	t.Run("BoltDB", func(t *testing.T) {
+		testMigrateDB(t, dbm.BoltDBBackend)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		dir, genesisDoc := makeChainDir(t)
+		defer os.RemoveAll(dir)
+		for _, backend := range []dbm.BackendType{dbm.FSDBBackend, dbm.MemDBBackend, "notadb"} {
+			_, err := MigrateDB(genesisDoc, path.Join(dir, "src"), dbm.GoLevelDBBackend, path.Join(dir, "dst"),
+				backend)
+			require.Error(t, err)
+			_, err = MigrateDB(genesisDoc, path.Join(dir, "src"), backend, path.Join(dir, "dst"),
+				dbm.GoLevelDBBackend)
+			require.Error(t, err)
+		}
+		_, err := os.Stat(path.Join(dir, "dst"))
+		require.True(t, os.IsNotExist(err), "should check backends before migrating anything")
+	})
+}
+
+// Migrates the state of a chain from goleveldb to backend and back again
+func testMigrateDB(t *testing.T, backend dbm.BackendType) {
+	dir, genesisDoc := makeChainDir(t)
+	defer os.RemoveAll(dir)
+	srcDir := path.Join(dir, "src")
+	dstDir := path.Join(dir, "dst")
+
+	migration, err := MigrateDB(genesisDoc, srcDir, dbm.GoLevelDBBackend, dstDir, backend)
+	require.NoError(t, err)
+	require.Len(t, migration.DBs, 2)
+	assert.Equal(t, uint64(8), migration.Height)
+	assert.NotEmpty(t, migration.AppHash)
+
+	back, err := MigrateDB(genesisDoc, dstDir, backend, path.Join(dir, "back"), dbm.GoLevelDBBackend)
+	require.NoError(t, err)
+	assert.Equal(t, migration.AppHash, back.AppHash)
+
+	_, err = MigrateDB(genesisDoc, srcDir, dbm.GoLevelDBBackend, dstDir, backend)
+	require.Error(t, err, "should not overwrite existing databases")
+}
+
+// Writes a chain to the Burrow directory 'src' in a new temporary directory using goleveldb
+func makeChainDir(t *testing.T) (string, *genesis.GenesisDoc) {
+	dir, err := ioutil.TempDir("", "TestMigrateDB")
+	require.NoError(t, err)
+	genesisDoc, tmDB, burrowDB := makeChain(t, 10)
+	srcDir := path.Join(dir, "src")
+	writeDB(t, burrowDB, core.BurrowDBName, srcDir)
+	writeDB(t, tmDB, "blockstore", path.Join(srcDir, "data"))
+	return dir, genesisDoc
+}
+
+func writeDB(t *testing.T, src dbm.DB, name, dir string) {
+	db, err := storage.OpenDB(name, dbm.GoLevelDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	_, err = storage.CopyDB(db, src)
+	require.NoError(t, err)
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/hyperledger/burrow/forensics/storage"
 	"github.com/hyperledger/burrow/genesis"
 	"github.com/hyperledger/burrow/logging"
+	burrowStorage "github.com/hyperledger/burrow/storage"
 	"github.com/hyperledger/burrow/txs"
 	"github.com/pkg/errors"
 	sm "github.com/tendermint/tendermint/state"
@@ -36,6 +37,7 @@ type Source struct {
 	Explorer   *bcm.BlockStore
 	State      *state.State
 	db         dbm.DB
+	tmDB       dbm.DB
 	cacheDB    dbm.DB
 	blockchain *bcm.Blockchain
 	genesisDoc *genesis.GenesisDoc
@@ -49,6 +51,7 @@ func NewSource(burrowDB, tmDB dbm.DB, genesisDoc *genesis.GenesisDoc) *Source {
 	return &Source{
 		Explorer:   bcm.NewBlockStore(store.NewBlockStore(tmDB)),
 		db:         burrowDB,
+		tmDB:       tmDB,
 		cacheDB:    cacheDB,
 		blockchain: bcm.NewBlockchain(cacheDB, genesisDoc),
 		genesisDoc: genesisDoc,
@@ -56,10 +59,17 @@ func NewSource(burrowDB, tmDB dbm.DB, genesisDoc *genesis.GenesisDoc) *Source {
 	}
 }
 
-func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string) *Source {
-	burrowDB := dbm.NewDB(core.BurrowDBName, dbm.GoLevelDBBackend, dbDir)
-	tmDB := dbm.NewDB("blockstore", dbm.GoLevelDBBackend, path.Join(dbDir, "data"))
-	return NewSource(burrowDB, tmDB, genesisDoc)
+func NewSourceFromDir(genesisDoc *genesis.GenesisDoc, dbDir string, dbBackend dbm.BackendType) (*Source, error) {
+	burrowDB, err := burrowStorage.OpenDB(core.BurrowDBName, dbBackend, dbDir)
+	if err != nil {
+		return nil, err
+	}
+	tmDB, err := burrowStorage.OpenDB("blockstore", dbBackend, path.Join(dbDir, "data"))
+	if err != nil {
+		burrowDB.Close()
+		return nil, err
+	}
+	return NewSource(burrowDB, tmDB, genesisDoc), nil
 }
 
 func NewSourceFromGenesis(genesisDoc *genesis.GenesisDoc) *Source {
@@ -143,6 +153,15 @@ func (src *Source) LatestBlockchain() (*bcm.Blockchain, error) {
 	return blockchain, nil
 }
 
+// Close the underlying databases
+func (src *Source) Close() error {
+	err := src.db.Close()
+	if err != nil {
+		return err
+	}
+	return src.tmDB.Close()
+}
+
 // Replay is a kernel for state replaying
 type Replay struct {
 	Src *Source
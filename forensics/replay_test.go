@@ -103,6 +103,8 @@ func makeChain(t *testing.T, max uint64) (*genesis.GenesisDoc, dbm.DB, dbm.DB) {
 			abciHeader := types.TM2PB.Header(&block.Header)
 			stateHash, err = committer.Commit(&abciHeader)
 			require.NoError(t, err)
+			err = burrowChain.CommitBlockAtHeight(block.Time, block.Hash(), stateHash, uint64(block.Height))
+			require.NoError(t, err)
 
 		}, validators[0])
 		require.Equal(t, int64(i), bs.Height())
@@ -115,6 +117,7 @@ func makeBlock(t *testing.T, st sm.State, bs *store.BlockStore, commit func(*typ
 	tx := makeTx(t, st.ChainID, height, val)
 	block, _ := st.MakeBlock(height, []types.Tx{tx}, new(types.Commit), nil,
 		st.Validators.GetProposer().Address)
+	block.Time = st.LastBlockTime.Add(time.Duration(height) * time.Second)
 
 	commit(block)
 	partSet := block.MakePartSet(2)
@@ -94,6 +94,8 @@ func (mi *MultiIterator) Next() {
 	it.Next()
 	if it.Valid() {
 		heap.Push(mi, it)
+	} else {
+		it.Close()
 	}
 }
 
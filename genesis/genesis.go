@@ -53,12 +53,23 @@ type params struct {
 	ProposalThreshold uint64
 }
 
+// Fork records the parent chain from which a chain was hard-forked by restoring a dump of the parent's state
+type Fork struct {
+	// The ChainID of the parent chain
+	ParentChainID string
+	// The height at which the parent chain was halted and its state dumped
+	HaltHeight uint64
+	// The AppHash of the parent chain after the block at HaltHeight
+	ParentAppHash binary.HexBytes
+}
+
 type GenesisDoc struct {
 	GenesisTime       time.Time
 	ChainName         string
 	AppHash           binary.HexBytes `json:",omitempty" toml:",omitempty"`
 	Params            params          `json:",omitempty" toml:",omitempty"`
 	Salt              []byte          `json:",omitempty" toml:",omitempty"`
+	Fork              *Fork           `json:",omitempty" toml:",omitempty"`
 	GlobalPermissions permission.AccountPermissions
 	Accounts          []Account
 	Validators        []Validator
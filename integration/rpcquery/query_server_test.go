@@ -85,6 +85,14 @@ func TestQueryServer(t *testing.T) {
 			t.Fatalf("unexpected error: %v", err)
 		}
 		assert.Len(t, accs, len(rpctest.GenesisDoc.Accounts)+1)
+
+		stream, err = cli.ListAccounts(context.Background(), &rpcquery.ListAccountsParam{
+			After: accs[0].Address.Bytes(),
+		})
+		require.NoError(t, err)
+		acc, err = stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, accs[1].Address, acc.Address)
 	})
 
 	t.Run("ListNames", func(t *testing.T) {
@@ -102,12 +110,16 @@ func TestQueryServer(t *testing.T) {
 			}
 		}
 		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
-		entries := receiveNames(t, qcli, "")
+		entries := receiveNames(t, qcli, "", "")
 		assert.Len(t, entries, n)
-		entries = receiveNames(t, qcli, query.NewBuilder().AndEquals("Data", dataA).String())
+		entries = receiveNames(t, qcli, query.NewBuilder().AndEquals("Data", dataA).String(), "")
 		if assert.Len(t, entries, n/2) {
 			assert.Equal(t, dataA, entries[0].Data)
 		}
+		entries = receiveNames(t, qcli, "", "Flub/3")
+		if assert.Len(t, entries, n-4) {
+			assert.Equal(t, "Flub/4", entries[0].Name)
+		}
 	})
 
 	t.Run("GetBlockHeader", func(t *testing.T) {
@@ -122,11 +134,40 @@ func TestQueryServer(t *testing.T) {
 		assert.Equal(t, int64(height), header.Height)
 		assert.Len(t, header.AppHash, tmhash.Size)
 	})
+
+	t.Run("GetAccountAtHeight", func(t *testing.T) {
+		tcli := rpctest.NewTransactClient(t, kern.GRPCListenAddress().String())
+		qcli := rpctest.NewQueryClient(t, kern.GRPCListenAddress().String())
+		ecli := rpctest.NewExecutionEventsClient(t, kern.GRPCListenAddress().String())
+		// Make sure there is an earlier block to read (height 0 reads the latest state)
+		require.NoError(t, rpctest.WaitNBlocks(ecli, 1))
+		address := rpctest.PrivateAccounts[3].GetAddress()
+		txe, err := rpctest.UpdateName(tcli, address, "Historical", "data", 200)
+		require.NoError(t, err)
+		before, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height - 1,
+		})
+		require.NoError(t, err)
+		after, err := qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height,
+		})
+		require.NoError(t, err)
+		assert.Equal(t, before.Sequence+1, after.Sequence)
+
+		_, err = qcli.GetAccount(context.Background(), &rpcquery.GetAccountParam{
+			Address: address,
+			Height:  txe.Height + 1000,
+		})
+		require.Error(t, err)
+	})
 }
 
-func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query string) []*names.Entry {
+func receiveNames(t testing.TB, qcli rpcquery.QueryClient, query, after string) []*names.Entry {
 	stream, err := qcli.ListNames(context.Background(), &rpcquery.ListNamesParam{
 		Query: query,
+		After: after,
 	})
 	require.NoError(t, err)
 	var entries []*names.Entry
@@ -14,6 +14,9 @@ export class Exception extends jspb.Message {
     getException(): string;
     setException(value: string): void;
 
+    getRevertreason(): string;
+    setRevertreason(value: string): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Exception.AsObject;
@@ -29,5 +32,6 @@ export namespace Exception {
     export type AsObject = {
         code: number,
         exception: string,
+        revertreason: string,
     }
 }
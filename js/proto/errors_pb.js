@@ -62,7 +62,8 @@ proto.errors.Exception.prototype.toObject = function(opt_includeInstance) {
 proto.errors.Exception.toObject = function(includeInstance, msg) {
   var f, obj = {
     code: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    exception: jspb.Message.getFieldWithDefault(msg, 2, "")
+    exception: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    revertreason: jspb.Message.getFieldWithDefault(msg, 3, "")
   };
 
   if (includeInstance) {
@@ -107,6 +108,10 @@ proto.errors.Exception.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {string} */ (reader.readString());
       msg.setException(value);
       break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setRevertreason(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -150,6 +155,13 @@ proto.errors.Exception.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getRevertreason();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -182,5 +194,20 @@ proto.errors.Exception.prototype.setException = function(value) {
   jspb.Message.setProto3StringField(this, 2, value);
 };
 
+/**
+ * optional string RevertReason = 3;
+ * @return {string}
+ */
+proto.errors.Exception.prototype.getRevertreason = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.errors.Exception.prototype.setRevertreason = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
 
 goog.object.extend(exports, proto.errors);
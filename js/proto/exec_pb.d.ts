@@ -74,6 +74,11 @@ export class StreamEvent extends jspb.Message {
     getEndblock(): EndBlock | undefined;
     setEndblock(value?: EndBlock): void;
 
+    getCursor(): Uint8Array | string;
+    getCursor_asU8(): Uint8Array;
+    getCursor_asB64(): string;
+    setCursor(value: Uint8Array | string): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): StreamEvent.AsObject;
@@ -93,6 +98,7 @@ export namespace StreamEvent {
         event?: Event.AsObject,
         endtx?: EndTx.AsObject,
         endblock?: EndBlock.AsObject,
+        cursor: Uint8Array | string,
     }
 }
 
@@ -592,6 +598,12 @@ export class LogEvent extends jspb.Message {
     addTopics(value: Uint8Array | string, index?: number): Uint8Array | string;
 
 
+    hasDecoded(): boolean;
+    clearDecoded(): void;
+    getDecoded(): ABIDecoding | undefined;
+    setDecoded(value?: ABIDecoding): void;
+
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): LogEvent.AsObject;
     static toObject(includeInstance: boolean, msg: LogEvent): LogEvent.AsObject;
@@ -607,6 +619,7 @@ export namespace LogEvent {
         address: Uint8Array | string,
         data: Uint8Array | string,
         topicsList: Array<Uint8Array | string>,
+        decoded?: ABIDecoding.AsObject,
     }
 }
 
@@ -634,6 +647,12 @@ export class CallEvent extends jspb.Message {
     setReturn(value: Uint8Array | string): void;
 
 
+    hasDecoded(): boolean;
+    clearDecoded(): void;
+    getDecoded(): ABIDecoding | undefined;
+    setDecoded(value?: ABIDecoding): void;
+
+
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): CallEvent.AsObject;
     static toObject(includeInstance: boolean, msg: CallEvent): CallEvent.AsObject;
@@ -651,6 +670,67 @@ export namespace CallEvent {
         origin: Uint8Array | string,
         stackdepth: number,
         pb_return: Uint8Array | string,
+        decoded?: ABIDecoding.AsObject,
+    }
+}
+
+export class ABIDecoding extends jspb.Message { 
+    getName(): string;
+    setName(value: string): void;
+
+    clearArgsList(): void;
+    getArgsList(): Array<ABIArgument>;
+    setArgsList(value: Array<ABIArgument>): void;
+    addArgs(value?: ABIArgument, index?: number): ABIArgument;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ABIDecoding.AsObject;
+    static toObject(includeInstance: boolean, msg: ABIDecoding): ABIDecoding.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ABIDecoding, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ABIDecoding;
+    static deserializeBinaryFromReader(message: ABIDecoding, reader: jspb.BinaryReader): ABIDecoding;
+}
+
+export namespace ABIDecoding {
+    export type AsObject = {
+        name: string,
+        argsList: Array<ABIArgument.AsObject>,
+    }
+}
+
+export class ABIArgument extends jspb.Message { 
+    getName(): string;
+    setName(value: string): void;
+
+    getType(): string;
+    setType(value: string): void;
+
+    getValue(): string;
+    setValue(value: string): void;
+
+    getIndexed(): boolean;
+    setIndexed(value: boolean): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ABIArgument.AsObject;
+    static toObject(includeInstance: boolean, msg: ABIArgument): ABIArgument.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ABIArgument, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ABIArgument;
+    static deserializeBinaryFromReader(message: ABIArgument, reader: jspb.BinaryReader): ABIArgument;
+}
+
+export namespace ABIArgument {
+    export type AsObject = {
+        name: string,
+        type: string,
+        value: string,
+        indexed: boolean,
     }
 }
 
@@ -27,6 +27,8 @@ var permission_pb = require('./permission_pb.js');
 goog.object.extend(proto, permission_pb);
 var spec_pb = require('./spec_pb.js');
 goog.object.extend(proto, spec_pb);
+goog.exportSymbol('proto.exec.ABIArgument', null, global);
+goog.exportSymbol('proto.exec.ABIDecoding', null, global);
 goog.exportSymbol('proto.exec.BeginBlock', null, global);
 goog.exportSymbol('proto.exec.BeginTx', null, global);
 goog.exportSymbol('proto.exec.BlockExecution', null, global);
@@ -267,7 +269,8 @@ proto.exec.StreamEvent.toObject = function(includeInstance, msg) {
     envelope: (f = msg.getEnvelope()) && txs_pb.Envelope.toObject(includeInstance, f),
     event: (f = msg.getEvent()) && proto.exec.Event.toObject(includeInstance, f),
     endtx: (f = msg.getEndtx()) && proto.exec.EndTx.toObject(includeInstance, f),
-    endblock: (f = msg.getEndblock()) && proto.exec.EndBlock.toObject(includeInstance, f)
+    endblock: (f = msg.getEndblock()) && proto.exec.EndBlock.toObject(includeInstance, f),
+    cursor: msg.getCursor_asB64()
   };
 
   if (includeInstance) {
@@ -334,6 +337,10 @@ proto.exec.StreamEvent.deserializeBinaryFromReader = function(msg, reader) {
       reader.readMessage(value,proto.exec.EndBlock.deserializeBinaryFromReader);
       msg.setEndblock(value);
       break;
+    case 7:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setCursor(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -411,6 +418,13 @@ proto.exec.StreamEvent.serializeBinaryToWriter = function(message, writer) {
       proto.exec.EndBlock.serializeBinaryToWriter
     );
   }
+  f = message.getCursor_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      7,
+      f
+    );
+  }
 };
 
 
@@ -594,6 +608,45 @@ proto.exec.StreamEvent.prototype.hasEndblock = function() {
 };
 
 
+/**
+ * optional bytes Cursor = 7;
+ * @return {!(string|Uint8Array)}
+ */
+proto.exec.StreamEvent.prototype.getCursor = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 7, ""));
+};
+
+
+/**
+ * optional bytes Cursor = 7;
+ * This is a type-conversion wrapper around `getCursor()`
+ * @return {string}
+ */
+proto.exec.StreamEvent.prototype.getCursor_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getCursor()));
+};
+
+
+/**
+ * optional bytes Cursor = 7;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getCursor()`
+ * @return {!Uint8Array}
+ */
+proto.exec.StreamEvent.prototype.getCursor_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getCursor()));
+};
+
+
+/** @param {!(string|Uint8Array)} value */
+proto.exec.StreamEvent.prototype.setCursor = function(value) {
+  jspb.Message.setProto3BytesField(this, 7, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -3877,7 +3930,8 @@ proto.exec.LogEvent.toObject = function(includeInstance, msg) {
   var f, obj = {
     address: msg.getAddress_asB64(),
     data: msg.getData_asB64(),
-    topicsList: msg.getTopicsList_asB64()
+    topicsList: msg.getTopicsList_asB64(),
+    decoded: (f = msg.getDecoded()) && proto.exec.ABIDecoding.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -3926,6 +3980,11 @@ proto.exec.LogEvent.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.addTopics(value);
       break;
+    case 4:
+      var value = new proto.exec.ABIDecoding;
+      reader.readMessage(value,proto.exec.ABIDecoding.deserializeBinaryFromReader);
+      msg.setDecoded(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -3976,6 +4035,14 @@ proto.exec.LogEvent.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getDecoded();
+  if (f != null) {
+    writer.writeMessage(
+      4,
+      f,
+      proto.exec.ABIDecoding.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4110,6 +4177,36 @@ proto.exec.LogEvent.prototype.clearTopicsList = function() {
 };
 
 
+/**
+ * optional ABIDecoding Decoded = 4;
+ * @return {?proto.exec.ABIDecoding}
+ */
+proto.exec.LogEvent.prototype.getDecoded = function() {
+  return /** @type{?proto.exec.ABIDecoding} */ (
+    jspb.Message.getWrapperField(this, proto.exec.ABIDecoding, 4));
+};
+
+
+/** @param {?proto.exec.ABIDecoding|undefined} value */
+proto.exec.LogEvent.prototype.setDecoded = function(value) {
+  jspb.Message.setWrapperField(this, 4, value);
+};
+
+
+proto.exec.LogEvent.prototype.clearDecoded = function() {
+  this.setDecoded(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.exec.LogEvent.prototype.hasDecoded = function() {
+  return jspb.Message.getField(this, 4) != null;
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -4161,7 +4258,8 @@ proto.exec.CallEvent.toObject = function(includeInstance, msg) {
     calldata: (f = msg.getCalldata()) && proto.exec.CallData.toObject(includeInstance, f),
     origin: msg.getOrigin_asB64(),
     stackdepth: jspb.Message.getFieldWithDefault(msg, 3, 0),
-    pb_return: msg.getReturn_asB64()
+    pb_return: msg.getReturn_asB64(),
+    decoded: (f = msg.getDecoded()) && proto.exec.ABIDecoding.toObject(includeInstance, f)
   };
 
   if (includeInstance) {
@@ -4219,6 +4317,11 @@ proto.exec.CallEvent.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setReturn(value);
       break;
+    case 6:
+      var value = new proto.exec.ABIDecoding;
+      reader.readMessage(value,proto.exec.ABIDecoding.deserializeBinaryFromReader);
+      msg.setDecoded(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -4284,6 +4387,14 @@ proto.exec.CallEvent.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getDecoded();
+  if (f != null) {
+    writer.writeMessage(
+      6,
+      f,
+      proto.exec.ABIDecoding.serializeBinaryToWriter
+    );
+  }
 };
 
 
@@ -4425,6 +4536,454 @@ proto.exec.CallEvent.prototype.setReturn = function(value) {
 };
 
 
+/**
+ * optional ABIDecoding Decoded = 6;
+ * @return {?proto.exec.ABIDecoding}
+ */
+proto.exec.CallEvent.prototype.getDecoded = function() {
+  return /** @type{?proto.exec.ABIDecoding} */ (
+    jspb.Message.getWrapperField(this, proto.exec.ABIDecoding, 6));
+};
+
+
+/** @param {?proto.exec.ABIDecoding|undefined} value */
+proto.exec.CallEvent.prototype.setDecoded = function(value) {
+  jspb.Message.setWrapperField(this, 6, value);
+};
+
+
+proto.exec.CallEvent.prototype.clearDecoded = function() {
+  this.setDecoded(undefined);
+};
+
+
+/**
+ * Returns whether this field is set.
+ * @return {boolean}
+ */
+proto.exec.CallEvent.prototype.hasDecoded = function() {
+  return jspb.Message.getField(this, 6) != null;
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.exec.ABIDecoding = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, proto.exec.ABIDecoding.repeatedFields_, null);
+};
+goog.inherits(proto.exec.ABIDecoding, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.exec.ABIDecoding.displayName = 'proto.exec.ABIDecoding';
+}
+/**
+ * List of repeated fields within this message type.
+ * @private {!Array<number>}
+ * @const
+ */
+proto.exec.ABIDecoding.repeatedFields_ = [2];
+
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.exec.ABIDecoding.prototype.toObject = function(opt_includeInstance) {
+  return proto.exec.ABIDecoding.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.exec.ABIDecoding} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.exec.ABIDecoding.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    argsList: jspb.Message.toObjectList(msg.getArgsList(),
+    proto.exec.ABIArgument.toObject, includeInstance)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.exec.ABIDecoding}
+ */
+proto.exec.ABIDecoding.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.exec.ABIDecoding;
+  return proto.exec.ABIDecoding.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.exec.ABIDecoding} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.exec.ABIDecoding}
+ */
+proto.exec.ABIDecoding.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = new proto.exec.ABIArgument;
+      reader.readMessage(value,proto.exec.ABIArgument.deserializeBinaryFromReader);
+      msg.addArgs(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.exec.ABIDecoding.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.exec.ABIDecoding.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.exec.ABIDecoding} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.exec.ABIDecoding.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getArgsList();
+  if (f.length > 0) {
+    writer.writeRepeatedMessage(
+      2,
+      f,
+      proto.exec.ABIArgument.serializeBinaryToWriter
+    );
+  }
+};
+
+
+/**
+ * optional string Name = 1;
+ * @return {string}
+ */
+proto.exec.ABIDecoding.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.exec.ABIDecoding.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * repeated ABIArgument Args = 2;
+ * @return {!Array<!proto.exec.ABIArgument>}
+ */
+proto.exec.ABIDecoding.prototype.getArgsList = function() {
+  return /** @type{!Array<!proto.exec.ABIArgument>} */ (
+    jspb.Message.getRepeatedWrapperField(this, proto.exec.ABIArgument, 2));
+};
+
+
+/** @param {!Array<!proto.exec.ABIArgument>} value */
+proto.exec.ABIDecoding.prototype.setArgsList = function(value) {
+  jspb.Message.setRepeatedWrapperField(this, 2, value);
+};
+
+
+/**
+ * @param {!proto.exec.ABIArgument=} opt_value
+ * @param {number=} opt_index
+ * @return {!proto.exec.ABIArgument}
+ */
+proto.exec.ABIDecoding.prototype.addArgs = function(opt_value, opt_index) {
+  return jspb.Message.addToRepeatedWrapperField(this, 2, opt_value, proto.exec.ABIArgument, opt_index);
+};
+
+
+proto.exec.ABIDecoding.prototype.clearArgsList = function() {
+  this.setArgsList([]);
+};
+
+
+
+/**
+ * Generated by JsPbCodeGenerator.
+ * @param {Array=} opt_data Optional initial data array, typically from a
+ * server response, or constructed directly in Javascript. The array is used
+ * in place and becomes part of the constructed object. It is not cloned.
+ * If no data is provided, the constructed object will be empty, but still
+ * valid.
+ * @extends {jspb.Message}
+ * @constructor
+ */
+proto.exec.ABIArgument = function(opt_data) {
+  jspb.Message.initialize(this, opt_data, 0, -1, null, null);
+};
+goog.inherits(proto.exec.ABIArgument, jspb.Message);
+if (goog.DEBUG && !COMPILED) {
+  proto.exec.ABIArgument.displayName = 'proto.exec.ABIArgument';
+}
+
+
+if (jspb.Message.GENERATE_TO_OBJECT) {
+/**
+ * Creates an object representation of this proto suitable for use in Soy templates.
+ * Field names that are reserved in JavaScript and will be renamed to pb_name.
+ * To access a reserved field use, foo.pb_<name>, eg, foo.pb_default.
+ * For the list of reserved names please see:
+ *     com.google.apps.jspb.JsClassTemplate.JS_RESERVED_WORDS.
+ * @param {boolean=} opt_includeInstance Whether to include the JSPB instance
+ *     for transitional soy proto support: http://goto/soy-param-migration
+ * @return {!Object}
+ */
+proto.exec.ABIArgument.prototype.toObject = function(opt_includeInstance) {
+  return proto.exec.ABIArgument.toObject(opt_includeInstance, this);
+};
+
+
+/**
+ * Static version of the {@see toObject} method.
+ * @param {boolean|undefined} includeInstance Whether to include the JSPB
+ *     instance for transitional soy proto support:
+ *     http://goto/soy-param-migration
+ * @param {!proto.exec.ABIArgument} msg The msg instance to transform.
+ * @return {!Object}
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.exec.ABIArgument.toObject = function(includeInstance, msg) {
+  var f, obj = {
+    name: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    type: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    value: jspb.Message.getFieldWithDefault(msg, 3, ""),
+    indexed: jspb.Message.getFieldWithDefault(msg, 4, false)
+  };
+
+  if (includeInstance) {
+    obj.$jspbMessageInstance = msg;
+  }
+  return obj;
+};
+}
+
+
+/**
+ * Deserializes binary data (in protobuf wire format).
+ * @param {jspb.ByteSource} bytes The bytes to deserialize.
+ * @return {!proto.exec.ABIArgument}
+ */
+proto.exec.ABIArgument.deserializeBinary = function(bytes) {
+  var reader = new jspb.BinaryReader(bytes);
+  var msg = new proto.exec.ABIArgument;
+  return proto.exec.ABIArgument.deserializeBinaryFromReader(msg, reader);
+};
+
+
+/**
+ * Deserializes binary data (in protobuf wire format) from the
+ * given reader into the given message object.
+ * @param {!proto.exec.ABIArgument} msg The message object to deserialize into.
+ * @param {!jspb.BinaryReader} reader The BinaryReader to use.
+ * @return {!proto.exec.ABIArgument}
+ */
+proto.exec.ABIArgument.deserializeBinaryFromReader = function(msg, reader) {
+  while (reader.nextField()) {
+    if (reader.isEndGroup()) {
+      break;
+    }
+    var field = reader.getFieldNumber();
+    switch (field) {
+    case 1:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setName(value);
+      break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setType(value);
+      break;
+    case 3:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setValue(value);
+      break;
+    case 4:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setIndexed(value);
+      break;
+    default:
+      reader.skipField();
+      break;
+    }
+  }
+  return msg;
+};
+
+
+/**
+ * Serializes the message to binary data (in protobuf wire format).
+ * @return {!Uint8Array}
+ */
+proto.exec.ABIArgument.prototype.serializeBinary = function() {
+  var writer = new jspb.BinaryWriter();
+  proto.exec.ABIArgument.serializeBinaryToWriter(this, writer);
+  return writer.getResultBuffer();
+};
+
+
+/**
+ * Serializes the given message to binary data (in protobuf wire
+ * format), writing to the given BinaryWriter.
+ * @param {!proto.exec.ABIArgument} message
+ * @param {!jspb.BinaryWriter} writer
+ * @suppress {unusedLocalVariables} f is only used for nested messages
+ */
+proto.exec.ABIArgument.serializeBinaryToWriter = function(message, writer) {
+  var f = undefined;
+  f = message.getName();
+  if (f.length > 0) {
+    writer.writeString(
+      1,
+      f
+    );
+  }
+  f = message.getType();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
+  f = message.getValue();
+  if (f.length > 0) {
+    writer.writeString(
+      3,
+      f
+    );
+  }
+  f = message.getIndexed();
+  if (f) {
+    writer.writeBool(
+      4,
+      f
+    );
+  }
+};
+
+
+/**
+ * optional string Name = 1;
+ * @return {string}
+ */
+proto.exec.ABIArgument.prototype.getName = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 1, ""));
+};
+
+
+/** @param {string} value */
+proto.exec.ABIArgument.prototype.setName = function(value) {
+  jspb.Message.setProto3StringField(this, 1, value);
+};
+
+
+/**
+ * optional string Type = 2;
+ * @return {string}
+ */
+proto.exec.ABIArgument.prototype.getType = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.exec.ABIArgument.prototype.setType = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
+/**
+ * optional string Value = 3;
+ * @return {string}
+ */
+proto.exec.ABIArgument.prototype.getValue = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/** @param {string} value */
+proto.exec.ABIArgument.prototype.setValue = function(value) {
+  jspb.Message.setProto3StringField(this, 3, value);
+};
+
+
+/**
+ * optional bool Indexed = 4;
+ * @return {boolean}
+ */
+proto.exec.ABIArgument.prototype.getIndexed = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 4, false));
+};
+
+
+/** @param {boolean} value */
+proto.exec.ABIArgument.prototype.setIndexed = function(value) {
+  jspb.Message.setProto3BooleanField(this, 4, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -42,6 +42,9 @@ export class TxRequest extends jspb.Message {
     getWait(): boolean;
     setWait(value: boolean): void;
 
+    getDecode(): boolean;
+    setDecode(value: boolean): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): TxRequest.AsObject;
@@ -57,6 +60,7 @@ export namespace TxRequest {
     export type AsObject = {
         txhash: Uint8Array | string,
         wait: boolean,
+        decode: boolean,
     }
 }
 
@@ -70,6 +74,9 @@ export class BlocksRequest extends jspb.Message {
     getQuery(): string;
     setQuery(value: string): void;
 
+    getDecode(): boolean;
+    setDecode(value: boolean): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): BlocksRequest.AsObject;
@@ -85,6 +92,7 @@ export namespace BlocksRequest {
     export type AsObject = {
         blockrange?: BlockRange.AsObject,
         query: string,
+        decode: boolean,
     }
 }
 
@@ -178,6 +186,11 @@ export class Bound extends jspb.Message {
     getIndex(): number;
     setIndex(value: number): void;
 
+    getCursor(): Uint8Array | string;
+    getCursor_asU8(): Uint8Array;
+    getCursor_asB64(): string;
+    setCursor(value: Uint8Array | string): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): Bound.AsObject;
@@ -193,6 +206,7 @@ export namespace Bound {
     export type AsObject = {
         type: Bound.BoundType,
         index: number,
+        cursor: Uint8Array | string,
     }
 
     export enum BoundType {
@@ -201,6 +215,7 @@ export namespace Bound {
     FIRST = 2,
     LATEST = 3,
     STREAM = 4,
+    CURSOR = 5,
     }
 
 }
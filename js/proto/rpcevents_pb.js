@@ -243,7 +243,8 @@ proto.rpcevents.TxRequest.prototype.toObject = function(opt_includeInstance) {
 proto.rpcevents.TxRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     txhash: msg.getTxhash_asB64(),
-    wait: jspb.Message.getFieldWithDefault(msg, 2, false)
+    wait: jspb.Message.getFieldWithDefault(msg, 2, false),
+    decode: jspb.Message.getFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -288,6 +289,10 @@ proto.rpcevents.TxRequest.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {boolean} */ (reader.readBool());
       msg.setWait(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDecode(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -331,6 +336,13 @@ proto.rpcevents.TxRequest.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getDecode();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -390,6 +402,21 @@ proto.rpcevents.TxRequest.prototype.setWait = function(value) {
 };
 
 
+/**
+ * optional bool Decode = 3;
+ * @return {boolean}
+ */
+proto.rpcevents.TxRequest.prototype.getDecode = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.rpcevents.TxRequest.prototype.setDecode = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -438,7 +465,8 @@ proto.rpcevents.BlocksRequest.prototype.toObject = function(opt_includeInstance)
 proto.rpcevents.BlocksRequest.toObject = function(includeInstance, msg) {
   var f, obj = {
     blockrange: (f = msg.getBlockrange()) && proto.rpcevents.BlockRange.toObject(includeInstance, f),
-    query: jspb.Message.getFieldWithDefault(msg, 2, "")
+    query: jspb.Message.getFieldWithDefault(msg, 2, ""),
+    decode: jspb.Message.getFieldWithDefault(msg, 3, false)
   };
 
   if (includeInstance) {
@@ -484,6 +512,10 @@ proto.rpcevents.BlocksRequest.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setQuery(value);
       break;
+    case 3:
+      var value = /** @type {boolean} */ (reader.readBool());
+      msg.setDecode(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -528,6 +560,13 @@ proto.rpcevents.BlocksRequest.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getDecode();
+  if (f) {
+    writer.writeBool(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -576,6 +615,21 @@ proto.rpcevents.BlocksRequest.prototype.setQuery = function(value) {
 };
 
 
+/**
+ * optional bool Decode = 3;
+ * @return {boolean}
+ */
+proto.rpcevents.BlocksRequest.prototype.getDecode = function() {
+  return /** @type {boolean} */ (jspb.Message.getFieldWithDefault(this, 3, false));
+};
+
+
+/** @param {boolean} value */
+proto.rpcevents.BlocksRequest.prototype.setDecode = function(value) {
+  jspb.Message.setProto3BooleanField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1210,7 +1264,8 @@ proto.rpcevents.Bound.prototype.toObject = function(opt_includeInstance) {
 proto.rpcevents.Bound.toObject = function(includeInstance, msg) {
   var f, obj = {
     type: jspb.Message.getFieldWithDefault(msg, 1, 0),
-    index: jspb.Message.getFieldWithDefault(msg, 2, 0)
+    index: jspb.Message.getFieldWithDefault(msg, 2, 0),
+    cursor: msg.getCursor_asB64()
   };
 
   if (includeInstance) {
@@ -1255,6 +1310,10 @@ proto.rpcevents.Bound.deserializeBinaryFromReader = function(msg, reader) {
       var value = /** @type {number} */ (reader.readUint64());
       msg.setIndex(value);
       break;
+    case 3:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setCursor(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1298,6 +1357,13 @@ proto.rpcevents.Bound.serializeBinaryToWriter = function(message, writer) {
       f
     );
   }
+  f = message.getCursor_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -1309,7 +1375,8 @@ proto.rpcevents.Bound.BoundType = {
   RELATIVE: 1,
   FIRST: 2,
   LATEST: 3,
-  STREAM: 4
+  STREAM: 4,
+  CURSOR: 5
 };
 
 /**
@@ -1342,6 +1409,45 @@ proto.rpcevents.Bound.prototype.setIndex = function(value) {
 };
 
 
+/**
+ * optional bytes Cursor = 3;
+ * @return {!(string|Uint8Array)}
+ */
+proto.rpcevents.Bound.prototype.getCursor = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 3, ""));
+};
+
+
+/**
+ * optional bytes Cursor = 3;
+ * This is a type-conversion wrapper around `getCursor()`
+ * @return {string}
+ */
+proto.rpcevents.Bound.prototype.getCursor_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getCursor()));
+};
+
+
+/**
+ * optional bytes Cursor = 3;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getCursor()`
+ * @return {!Uint8Array}
+ */
+proto.rpcevents.Bound.prototype.getCursor_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getCursor()));
+};
+
+
+/** @param {!(string|Uint8Array)} value */
+proto.rpcevents.Bound.prototype.setCursor = function(value) {
+  jspb.Message.setProto3BytesField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
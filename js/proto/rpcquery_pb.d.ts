@@ -45,6 +45,9 @@ export class GetAccountParam extends jspb.Message {
     getAddress_asB64(): string;
     setAddress(value: Uint8Array | string): void;
 
+    getHeight(): number;
+    setHeight(value: number): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetAccountParam.AsObject;
@@ -59,6 +62,7 @@ export class GetAccountParam extends jspb.Message {
 export namespace GetAccountParam {
     export type AsObject = {
         address: Uint8Array | string,
+        height: number,
     }
 }
 
@@ -123,6 +127,9 @@ export class GetStorageParam extends jspb.Message {
     getKey_asB64(): string;
     setKey(value: Uint8Array | string): void;
 
+    getHeight(): number;
+    setHeight(value: number): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): GetStorageParam.AsObject;
@@ -138,6 +145,7 @@ export namespace GetStorageParam {
     export type AsObject = {
         address: Uint8Array | string,
         key: Uint8Array | string,
+        height: number,
     }
 }
 
@@ -168,6 +176,11 @@ export class ListAccountsParam extends jspb.Message {
     getQuery(): string;
     setQuery(value: string): void;
 
+    getAfter(): Uint8Array | string;
+    getAfter_asU8(): Uint8Array;
+    getAfter_asB64(): string;
+    setAfter(value: Uint8Array | string): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ListAccountsParam.AsObject;
@@ -182,6 +195,7 @@ export class ListAccountsParam extends jspb.Message {
 export namespace ListAccountsParam {
     export type AsObject = {
         query: string,
+        after: Uint8Array | string,
     }
 }
 
@@ -210,6 +224,9 @@ export class ListNamesParam extends jspb.Message {
     getQuery(): string;
     setQuery(value: string): void;
 
+    getAfter(): string;
+    setAfter(value: string): void;
+
 
     serializeBinary(): Uint8Array;
     toObject(includeInstance?: boolean): ListNamesParam.AsObject;
@@ -224,6 +241,7 @@ export class ListNamesParam extends jspb.Message {
 export namespace ListNamesParam {
     export type AsObject = {
         query: string,
+        after: string,
     }
 }
 
@@ -265,7 +265,8 @@ proto.rpcquery.GetAccountParam.prototype.toObject = function(opt_includeInstance
  */
 proto.rpcquery.GetAccountParam.toObject = function(includeInstance, msg) {
   var f, obj = {
-    address: msg.getAddress_asB64()
+    address: msg.getAddress_asB64(),
+    height: jspb.Message.getFieldWithDefault(msg, 2, 0)
   };
 
   if (includeInstance) {
@@ -306,6 +307,10 @@ proto.rpcquery.GetAccountParam.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setAddress(value);
       break;
+    case 2:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHeight(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -342,6 +347,13 @@ proto.rpcquery.GetAccountParam.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint64(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -384,6 +396,21 @@ proto.rpcquery.GetAccountParam.prototype.setAddress = function(value) {
 };
 
 
+/**
+ * optional uint64 Height = 2;
+ * @return {number}
+ */
+proto.rpcquery.GetAccountParam.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 2, 0));
+};
+
+
+/** @param {number} value */
+proto.rpcquery.GetAccountParam.prototype.setHeight = function(value) {
+  jspb.Message.setProto3IntField(this, 2, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -791,7 +818,8 @@ proto.rpcquery.GetStorageParam.prototype.toObject = function(opt_includeInstance
 proto.rpcquery.GetStorageParam.toObject = function(includeInstance, msg) {
   var f, obj = {
     address: msg.getAddress_asB64(),
-    key: msg.getKey_asB64()
+    key: msg.getKey_asB64(),
+    height: jspb.Message.getFieldWithDefault(msg, 3, 0)
   };
 
   if (includeInstance) {
@@ -836,6 +864,10 @@ proto.rpcquery.GetStorageParam.deserializeBinaryFromReader = function(msg, reade
       var value = /** @type {!Uint8Array} */ (reader.readBytes());
       msg.setKey(value);
       break;
+    case 3:
+      var value = /** @type {number} */ (reader.readUint64());
+      msg.setHeight(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -879,6 +911,13 @@ proto.rpcquery.GetStorageParam.serializeBinaryToWriter = function(message, write
       f
     );
   }
+  f = message.getHeight();
+  if (f !== 0) {
+    writer.writeUint64(
+      3,
+      f
+    );
+  }
 };
 
 
@@ -960,6 +999,21 @@ proto.rpcquery.GetStorageParam.prototype.setKey = function(value) {
 };
 
 
+/**
+ * optional uint64 Height = 3;
+ * @return {number}
+ */
+proto.rpcquery.GetStorageParam.prototype.getHeight = function() {
+  return /** @type {number} */ (jspb.Message.getFieldWithDefault(this, 3, 0));
+};
+
+
+/** @param {number} value */
+proto.rpcquery.GetStorageParam.prototype.setHeight = function(value) {
+  jspb.Message.setProto3IntField(this, 3, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1173,7 +1227,8 @@ proto.rpcquery.ListAccountsParam.prototype.toObject = function(opt_includeInstan
  */
 proto.rpcquery.ListAccountsParam.toObject = function(includeInstance, msg) {
   var f, obj = {
-    query: jspb.Message.getFieldWithDefault(msg, 1, "")
+    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    after: msg.getAfter_asB64()
   };
 
   if (includeInstance) {
@@ -1214,6 +1269,10 @@ proto.rpcquery.ListAccountsParam.deserializeBinaryFromReader = function(msg, rea
       var value = /** @type {string} */ (reader.readString());
       msg.setQuery(value);
       break;
+    case 2:
+      var value = /** @type {!Uint8Array} */ (reader.readBytes());
+      msg.setAfter(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1250,6 +1309,13 @@ proto.rpcquery.ListAccountsParam.serializeBinaryToWriter = function(message, wri
       f
     );
   }
+  f = message.getAfter_asU8();
+  if (f.length > 0) {
+    writer.writeBytes(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -1268,6 +1334,45 @@ proto.rpcquery.ListAccountsParam.prototype.setQuery = function(value) {
 };
 
 
+/**
+ * optional bytes After = 2;
+ * @return {!(string|Uint8Array)}
+ */
+proto.rpcquery.ListAccountsParam.prototype.getAfter = function() {
+  return /** @type {!(string|Uint8Array)} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/**
+ * optional bytes After = 2;
+ * This is a type-conversion wrapper around `getAfter()`
+ * @return {string}
+ */
+proto.rpcquery.ListAccountsParam.prototype.getAfter_asB64 = function() {
+  return /** @type {string} */ (jspb.Message.bytesAsB64(
+      this.getAfter()));
+};
+
+
+/**
+ * optional bytes After = 2;
+ * Note that Uint8Array is not supported on all browsers.
+ * @see http://caniuse.com/Uint8Array
+ * This is a type-conversion wrapper around `getAfter()`
+ * @return {!Uint8Array}
+ */
+proto.rpcquery.ListAccountsParam.prototype.getAfter_asU8 = function() {
+  return /** @type {!Uint8Array} */ (jspb.Message.bytesAsU8(
+      this.getAfter()));
+};
+
+
+/** @param {!(string|Uint8Array)} value */
+proto.rpcquery.ListAccountsParam.prototype.setAfter = function(value) {
+  jspb.Message.setProto3BytesField(this, 2, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -1457,7 +1562,8 @@ proto.rpcquery.ListNamesParam.prototype.toObject = function(opt_includeInstance)
  */
 proto.rpcquery.ListNamesParam.toObject = function(includeInstance, msg) {
   var f, obj = {
-    query: jspb.Message.getFieldWithDefault(msg, 1, "")
+    query: jspb.Message.getFieldWithDefault(msg, 1, ""),
+    after: jspb.Message.getFieldWithDefault(msg, 2, "")
   };
 
   if (includeInstance) {
@@ -1498,6 +1604,10 @@ proto.rpcquery.ListNamesParam.deserializeBinaryFromReader = function(msg, reader
       var value = /** @type {string} */ (reader.readString());
       msg.setQuery(value);
       break;
+    case 2:
+      var value = /** @type {string} */ (reader.readString());
+      msg.setAfter(value);
+      break;
     default:
       reader.skipField();
       break;
@@ -1534,6 +1644,13 @@ proto.rpcquery.ListNamesParam.serializeBinaryToWriter = function(message, writer
       f
     );
   }
+  f = message.getAfter();
+  if (f.length > 0) {
+    writer.writeString(
+      2,
+      f
+    );
+  }
 };
 
 
@@ -1552,6 +1669,21 @@ proto.rpcquery.ListNamesParam.prototype.setQuery = function(value) {
 };
 
 
+/**
+ * optional string After = 2;
+ * @return {string}
+ */
+proto.rpcquery.ListNamesParam.prototype.getAfter = function() {
+  return /** @type {string} */ (jspb.Message.getFieldWithDefault(this, 2, ""));
+};
+
+
+/** @param {string} value */
+proto.rpcquery.ListNamesParam.prototype.setAfter = function(value) {
+  jspb.Message.setProto3StringField(this, 2, value);
+};
+
+
 
 /**
  * Generated by JsPbCodeGenerator.
@@ -0,0 +1,75 @@
+// package: ventquery
+// file: ventquery.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as grpc from "@grpc/grpc-js";
+import {handleClientStreamingCall} from "@grpc/grpc-js/build/src/server-call";
+import * as ventquery_pb from "./ventquery_pb";
+import * as github_com_gogo_protobuf_gogoproto_gogo_pb from "./github.com/gogo/protobuf/gogoproto/gogo_pb";
+
+interface IQueryService extends grpc.ServiceDefinition<grpc.UntypedServiceImplementation> {
+    tables: IQueryService_ITables;
+    rows: IQueryService_IRows;
+    changes: IQueryService_IChanges;
+}
+
+interface IQueryService_ITables extends grpc.MethodDefinition<ventquery_pb.TablesRequest, ventquery_pb.TablesResponse> {
+    path: string; // "/ventquery.Query/Tables"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<ventquery_pb.TablesRequest>;
+    requestDeserialize: grpc.deserialize<ventquery_pb.TablesRequest>;
+    responseSerialize: grpc.serialize<ventquery_pb.TablesResponse>;
+    responseDeserialize: grpc.deserialize<ventquery_pb.TablesResponse>;
+}
+interface IQueryService_IRows extends grpc.MethodDefinition<ventquery_pb.RowsRequest, ventquery_pb.RowsResponse> {
+    path: string; // "/ventquery.Query/Rows"
+    requestStream: boolean; // false
+    responseStream: boolean; // false
+    requestSerialize: grpc.serialize<ventquery_pb.RowsRequest>;
+    requestDeserialize: grpc.deserialize<ventquery_pb.RowsRequest>;
+    responseSerialize: grpc.serialize<ventquery_pb.RowsResponse>;
+    responseDeserialize: grpc.deserialize<ventquery_pb.RowsResponse>;
+}
+interface IQueryService_IChanges extends grpc.MethodDefinition<ventquery_pb.ChangesRequest, ventquery_pb.Change> {
+    path: string; // "/ventquery.Query/Changes"
+    requestStream: boolean; // false
+    responseStream: boolean; // true
+    requestSerialize: grpc.serialize<ventquery_pb.ChangesRequest>;
+    requestDeserialize: grpc.deserialize<ventquery_pb.ChangesRequest>;
+    responseSerialize: grpc.serialize<ventquery_pb.Change>;
+    responseDeserialize: grpc.deserialize<ventquery_pb.Change>;
+}
+
+export const QueryService: IQueryService;
+
+export interface IQueryServer {
+    tables: grpc.handleUnaryCall<ventquery_pb.TablesRequest, ventquery_pb.TablesResponse>;
+    rows: grpc.handleUnaryCall<ventquery_pb.RowsRequest, ventquery_pb.RowsResponse>;
+    changes: grpc.handleServerStreamingCall<ventquery_pb.ChangesRequest, ventquery_pb.Change>;
+}
+
+export interface IQueryClient {
+    tables(request: ventquery_pb.TablesRequest, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    tables(request: ventquery_pb.TablesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    tables(request: ventquery_pb.TablesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    rows(request: ventquery_pb.RowsRequest, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    rows(request: ventquery_pb.RowsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    rows(request: ventquery_pb.RowsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    changes(request: ventquery_pb.ChangesRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ventquery_pb.Change>;
+    changes(request: ventquery_pb.ChangesRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ventquery_pb.Change>;
+}
+
+export class QueryClient extends grpc.Client implements IQueryClient {
+    constructor(address: string, credentials: grpc.ChannelCredentials, options?: object);
+    public tables(request: ventquery_pb.TablesRequest, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    public tables(request: ventquery_pb.TablesRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    public tables(request: ventquery_pb.TablesRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ventquery_pb.TablesResponse) => void): grpc.ClientUnaryCall;
+    public rows(request: ventquery_pb.RowsRequest, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    public rows(request: ventquery_pb.RowsRequest, metadata: grpc.Metadata, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    public rows(request: ventquery_pb.RowsRequest, metadata: grpc.Metadata, options: Partial<grpc.CallOptions>, callback: (error: grpc.ServiceError | null, response: ventquery_pb.RowsResponse) => void): grpc.ClientUnaryCall;
+    public changes(request: ventquery_pb.ChangesRequest, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ventquery_pb.Change>;
+    public changes(request: ventquery_pb.ChangesRequest, metadata?: grpc.Metadata, options?: Partial<grpc.CallOptions>): grpc.ClientReadableStream<ventquery_pb.Change>;
+}
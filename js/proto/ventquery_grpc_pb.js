@@ -0,0 +1,114 @@
+// GENERATED CODE -- DO NOT EDIT!
+
+'use strict';
+var ventquery_pb = require('./ventquery_pb.js');
+var github_com_gogo_protobuf_gogoproto_gogo_pb = require('./github.com/gogo/protobuf/gogoproto/gogo_pb.js');
+
+function serialize_ventquery_Change(arg) {
+  if (!(arg instanceof ventquery_pb.Change)) {
+    throw new Error('Expected argument of type ventquery.Change');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_Change(buffer_arg) {
+  return ventquery_pb.Change.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ventquery_ChangesRequest(arg) {
+  if (!(arg instanceof ventquery_pb.ChangesRequest)) {
+    throw new Error('Expected argument of type ventquery.ChangesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_ChangesRequest(buffer_arg) {
+  return ventquery_pb.ChangesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ventquery_RowsRequest(arg) {
+  if (!(arg instanceof ventquery_pb.RowsRequest)) {
+    throw new Error('Expected argument of type ventquery.RowsRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_RowsRequest(buffer_arg) {
+  return ventquery_pb.RowsRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ventquery_RowsResponse(arg) {
+  if (!(arg instanceof ventquery_pb.RowsResponse)) {
+    throw new Error('Expected argument of type ventquery.RowsResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_RowsResponse(buffer_arg) {
+  return ventquery_pb.RowsResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ventquery_TablesRequest(arg) {
+  if (!(arg instanceof ventquery_pb.TablesRequest)) {
+    throw new Error('Expected argument of type ventquery.TablesRequest');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_TablesRequest(buffer_arg) {
+  return ventquery_pb.TablesRequest.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+function serialize_ventquery_TablesResponse(arg) {
+  if (!(arg instanceof ventquery_pb.TablesResponse)) {
+    throw new Error('Expected argument of type ventquery.TablesResponse');
+  }
+  return Buffer.from(arg.serializeBinary());
+}
+
+function deserialize_ventquery_TablesResponse(buffer_arg) {
+  return ventquery_pb.TablesResponse.deserializeBinary(new Uint8Array(buffer_arg));
+}
+
+
+// --------------------------------------------------
+// Read-only access to the tables projected by Vent
+var QueryService = exports['ventquery.Query'] = {
+  // List the projected tables and their columns
+tables: {
+    path: '/ventquery.Query/Tables',
+    requestStream: false,
+    responseStream: false,
+    requestType: ventquery_pb.TablesRequest,
+    responseType: ventquery_pb.TablesResponse,
+    requestSerialize: serialize_ventquery_TablesRequest,
+    requestDeserialize: deserialize_ventquery_TablesRequest,
+    responseSerialize: serialize_ventquery_TablesResponse,
+    responseDeserialize: deserialize_ventquery_TablesResponse,
+  },
+  // Get a page of the rows of a table
+rows: {
+    path: '/ventquery.Query/Rows',
+    requestStream: false,
+    responseStream: false,
+    requestType: ventquery_pb.RowsRequest,
+    responseType: ventquery_pb.RowsResponse,
+    requestSerialize: serialize_ventquery_RowsRequest,
+    requestDeserialize: deserialize_ventquery_RowsRequest,
+    responseSerialize: serialize_ventquery_RowsResponse,
+    responseDeserialize: deserialize_ventquery_RowsResponse,
+  },
+  // Stream the changes made to the projected tables from the Vent log, following new changes as they are made
+changes: {
+    path: '/ventquery.Query/Changes',
+    requestStream: false,
+    responseStream: true,
+    requestType: ventquery_pb.ChangesRequest,
+    responseType: ventquery_pb.Change,
+    requestSerialize: serialize_ventquery_ChangesRequest,
+    requestDeserialize: deserialize_ventquery_ChangesRequest,
+    responseSerialize: serialize_ventquery_Change,
+    responseDeserialize: deserialize_ventquery_Change,
+  },
+};
+
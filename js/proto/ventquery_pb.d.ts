@@ -0,0 +1,335 @@
+// package: ventquery
+// file: ventquery.proto
+
+/* tslint:disable */
+/* eslint-disable */
+
+import * as jspb from "google-protobuf";
+import * as github_com_gogo_protobuf_gogoproto_gogo_pb from "./github.com/gogo/protobuf/gogoproto/gogo_pb";
+
+export class TablesRequest extends jspb.Message { 
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): TablesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: TablesRequest): TablesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: TablesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): TablesRequest;
+    static deserializeBinaryFromReader(message: TablesRequest, reader: jspb.BinaryReader): TablesRequest;
+}
+
+export namespace TablesRequest {
+    export type AsObject = {
+    }
+}
+
+export class TablesResponse extends jspb.Message { 
+    clearTablesList(): void;
+    getTablesList(): Array<Table>;
+    setTablesList(value: Array<Table>): void;
+    addTables(value?: Table, index?: number): Table;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): TablesResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: TablesResponse): TablesResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: TablesResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): TablesResponse;
+    static deserializeBinaryFromReader(message: TablesResponse, reader: jspb.BinaryReader): TablesResponse;
+}
+
+export namespace TablesResponse {
+    export type AsObject = {
+        tablesList: Array<Table.AsObject>,
+    }
+}
+
+export class Table extends jspb.Message { 
+    getName(): string;
+    setName(value: string): void;
+
+    clearColumnsList(): void;
+    getColumnsList(): Array<Column>;
+    setColumnsList(value: Array<Column>): void;
+    addColumns(value?: Column, index?: number): Column;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Table.AsObject;
+    static toObject(includeInstance: boolean, msg: Table): Table.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Table, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Table;
+    static deserializeBinaryFromReader(message: Table, reader: jspb.BinaryReader): Table;
+}
+
+export namespace Table {
+    export type AsObject = {
+        name: string,
+        columnsList: Array<Column.AsObject>,
+    }
+}
+
+export class Column extends jspb.Message { 
+    getName(): string;
+    setName(value: string): void;
+
+    getType(): string;
+    setType(value: string): void;
+
+    getPrimary(): boolean;
+    setPrimary(value: boolean): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Column.AsObject;
+    static toObject(includeInstance: boolean, msg: Column): Column.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Column, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Column;
+    static deserializeBinaryFromReader(message: Column, reader: jspb.BinaryReader): Column;
+}
+
+export namespace Column {
+    export type AsObject = {
+        name: string,
+        type: string,
+        primary: boolean,
+    }
+}
+
+export class Filter extends jspb.Message { 
+    getColumn(): string;
+    setColumn(value: string): void;
+
+    getOp(): Filter.Operator;
+    setOp(value: Filter.Operator): void;
+
+    getValue(): string;
+    setValue(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Filter.AsObject;
+    static toObject(includeInstance: boolean, msg: Filter): Filter.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Filter, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Filter;
+    static deserializeBinaryFromReader(message: Filter, reader: jspb.BinaryReader): Filter;
+}
+
+export namespace Filter {
+    export type AsObject = {
+        column: string,
+        op: Filter.Operator,
+        value: string,
+    }
+
+    export enum Operator {
+    EQ = 0,
+    NE = 1,
+    LT = 2,
+    LE = 3,
+    GT = 4,
+    GE = 5,
+    }
+
+}
+
+export class Order extends jspb.Message { 
+    getColumn(): string;
+    setColumn(value: string): void;
+
+    getDescending(): boolean;
+    setDescending(value: boolean): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Order.AsObject;
+    static toObject(includeInstance: boolean, msg: Order): Order.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Order, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Order;
+    static deserializeBinaryFromReader(message: Order, reader: jspb.BinaryReader): Order;
+}
+
+export namespace Order {
+    export type AsObject = {
+        column: string,
+        descending: boolean,
+    }
+}
+
+export class RowsRequest extends jspb.Message { 
+    getTable(): string;
+    setTable(value: string): void;
+
+    clearFiltersList(): void;
+    getFiltersList(): Array<Filter>;
+    setFiltersList(value: Array<Filter>): void;
+    addFilters(value?: Filter, index?: number): Filter;
+
+    clearOrderbyList(): void;
+    getOrderbyList(): Array<Order>;
+    setOrderbyList(value: Array<Order>): void;
+    addOrderby(value?: Order, index?: number): Order;
+
+    getLimit(): number;
+    setLimit(value: number): void;
+
+    getOffset(): number;
+    setOffset(value: number): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RowsRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: RowsRequest): RowsRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RowsRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RowsRequest;
+    static deserializeBinaryFromReader(message: RowsRequest, reader: jspb.BinaryReader): RowsRequest;
+}
+
+export namespace RowsRequest {
+    export type AsObject = {
+        table: string,
+        filtersList: Array<Filter.AsObject>,
+        orderbyList: Array<Order.AsObject>,
+        limit: number,
+        offset: number,
+    }
+}
+
+export class RowsResponse extends jspb.Message { 
+    clearRowsList(): void;
+    getRowsList(): Array<Row>;
+    setRowsList(value: Array<Row>): void;
+    addRows(value?: Row, index?: number): Row;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): RowsResponse.AsObject;
+    static toObject(includeInstance: boolean, msg: RowsResponse): RowsResponse.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: RowsResponse, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): RowsResponse;
+    static deserializeBinaryFromReader(message: RowsResponse, reader: jspb.BinaryReader): RowsResponse;
+}
+
+export namespace RowsResponse {
+    export type AsObject = {
+        rowsList: Array<Row.AsObject>,
+    }
+}
+
+export class Row extends jspb.Message { 
+
+    getValuesMap(): jspb.Map<string, string>;
+    clearValuesMap(): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Row.AsObject;
+    static toObject(includeInstance: boolean, msg: Row): Row.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Row, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Row;
+    static deserializeBinaryFromReader(message: Row, reader: jspb.BinaryReader): Row;
+}
+
+export namespace Row {
+    export type AsObject = {
+
+        valuesMap: Array<[string, string]>,
+    }
+}
+
+export class ChangesRequest extends jspb.Message { 
+    getSince(): number;
+    setSince(value: number): void;
+
+    getAfter(): number;
+    setAfter(value: number): void;
+
+    getChainid(): string;
+    setChainid(value: string): void;
+
+    getTable(): string;
+    setTable(value: string): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): ChangesRequest.AsObject;
+    static toObject(includeInstance: boolean, msg: ChangesRequest): ChangesRequest.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: ChangesRequest, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): ChangesRequest;
+    static deserializeBinaryFromReader(message: ChangesRequest, reader: jspb.BinaryReader): ChangesRequest;
+}
+
+export namespace ChangesRequest {
+    export type AsObject = {
+        since: number,
+        after: number,
+        chainid: string,
+        table: string,
+    }
+}
+
+export class Change extends jspb.Message { 
+    getId(): number;
+    setId(value: number): void;
+
+    getChainid(): string;
+    setChainid(value: string): void;
+
+    getTable(): string;
+    setTable(value: string): void;
+
+    getHeight(): number;
+    setHeight(value: number): void;
+
+    getTxhash(): string;
+    setTxhash(value: string): void;
+
+    getAction(): string;
+    setAction(value: string): void;
+
+
+    getValuesMap(): jspb.Map<string, string>;
+    clearValuesMap(): void;
+
+
+    serializeBinary(): Uint8Array;
+    toObject(includeInstance?: boolean): Change.AsObject;
+    static toObject(includeInstance: boolean, msg: Change): Change.AsObject;
+    static extensions: {[key: number]: jspb.ExtensionFieldInfo<jspb.Message>};
+    static extensionsBinary: {[key: number]: jspb.ExtensionFieldBinaryInfo<jspb.Message>};
+    static serializeBinaryToWriter(message: Change, writer: jspb.BinaryWriter): void;
+    static deserializeBinary(bytes: Uint8Array): Change;
+    static deserializeBinaryFromReader(message: Change, reader: jspb.BinaryReader): Change;
+}
+
+export namespace Change {
+    export type AsObject = {
+        id: number,
+        chainid: string,
+        table: string,
+        height: number,
+        txhash: string,
+        action: string,
+
+        valuesMap: Array<[string, string]>,
+    }
+}
@@ -10,17 +10,21 @@ const LocalHost = "127.0.0.1"
 const AnyLocal = "0.0.0.0"
 
 type RPCConfig struct {
-	Info     *ServerConfig  `json:",omitempty" toml:",omitempty"`
-	Profiler *ServerConfig  `json:",omitempty" toml:",omitempty"`
-	GRPC     *ServerConfig  `json:",omitempty" toml:",omitempty"`
-	Metrics  *MetricsConfig `json:",omitempty" toml:",omitempty"`
-	Web3     *ServerConfig  `json:",omitempty" toml:",omitempty"`
+	Info      *ServerConfig    `json:",omitempty" toml:",omitempty"`
+	Profiler  *ServerConfig    `json:",omitempty" toml:",omitempty"`
+	GRPC      *ServerConfig    `json:",omitempty" toml:",omitempty"`
+	Metrics   *MetricsConfig   `json:",omitempty" toml:",omitempty"`
+	Web3      *ServerConfig    `json:",omitempty" toml:",omitempty"`
+	GraphQL   *GraphQLConfig   `json:",omitempty" toml:",omitempty"`
+	RateLimit *RateLimitConfig `json:",omitempty" toml:",omitempty"`
 }
 
 type ServerConfig struct {
 	Enabled    bool
 	ListenHost string
 	ListenPort string
+	// Serve TLS rather than plaintext
+	TLS *TLSConfig `json:",omitempty" toml:",omitempty"`
 }
 
 func (sc *ServerConfig) ListenAddress() string {
@@ -33,13 +37,22 @@ type MetricsConfig struct {
 	BlockSampleSize int
 }
 
+type GraphQLConfig struct {
+	ServerConfig
+	// Origins (e.g. "https://explorer.example.com") of the web pages from which browsers may query and subscribe,
+	// "*" allows any. Requests without an Origin header and from the server's own origin are always allowed.
+	AllowedOrigins []string
+}
+
 func DefaultRPCConfig() *RPCConfig {
 	return &RPCConfig{
-		Info:     DefaultInfoConfig(),
-		Profiler: DefaultProfilerConfig(),
-		GRPC:     DefaultGRPCConfig(),
-		Metrics:  DefaultMetricsConfig(),
-		Web3:     DefaultWeb3Config(),
+		Info:      DefaultInfoConfig(),
+		Profiler:  DefaultProfilerConfig(),
+		GRPC:      DefaultGRPCConfig(),
+		Metrics:   DefaultMetricsConfig(),
+		Web3:      DefaultWeb3Config(),
+		GraphQL:   DefaultGraphQLConfig(),
+		RateLimit: DefaultRateLimitConfig(),
 	}
 }
 
@@ -86,3 +99,13 @@ func DefaultWeb3Config() *ServerConfig {
 		ListenPort: "26660",
 	}
 }
+
+func DefaultGraphQLConfig() *GraphQLConfig {
+	return &GraphQLConfig{
+		ServerConfig: ServerConfig{
+			Enabled:    false,
+			ListenHost: AnyLocal,
+			ListenPort: "26661",
+		},
+	}
+}
@@ -3,19 +3,32 @@ package rpc
 import (
 	"fmt"
 	"runtime/debug"
+	"time"
 
+	"github.com/hyperledger/burrow/execution/errors"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"golang.org/x/net/context"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
 )
 
-func NewGRPCServer(logger *logging.Logger) *grpc.Server {
-	return grpc.NewServer(grpc.UnaryInterceptor(unaryInterceptor(logger)),
-		grpc.StreamInterceptor(streamInterceptor(logger.WithScope("NewGRPCServer"))))
+// NewGRPCServer returns a server whose calls are limited by limiter and that serves TLS and authorises its clients'
+// calls with tlsConf, either of which may be nil
+func NewGRPCServer(limiter *RateLimiter, tlsConf *TLS, logger *logging.Logger) *grpc.Server {
+	options := []grpc.ServerOption{
+		grpc.UnaryInterceptor(unaryInterceptor(limiter, tlsConf, logger)),
+		grpc.StreamInterceptor(streamInterceptor(limiter, tlsConf, logger.WithScope("NewGRPCServer"))),
+	}
+	if tlsConf != nil {
+		options = append(options, grpc.Creds(credentials.NewTLS(tlsConf.Config())))
+	}
+	return grpc.NewServer(options...)
 }
 
-func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
+func unaryInterceptor(limiter *RateLimiter, tlsConf *TLS, logger *logging.Logger) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
 		handler grpc.UnaryHandler) (resp interface{}, err error) {
 
@@ -24,15 +37,26 @@ func unaryInterceptor(logger *logging.Logger) grpc.UnaryServerInterceptor {
 		defer func() {
 			if r := recover(); r != nil {
 				logger.InfoMsg("panic in GRPC unary call", structure.ErrorKey, fmt.Sprintf("%v", r))
-				err = fmt.Errorf("panic in GRPC unary call %s: %v: %s", info.FullMethod, r, debug.Stack())
+				err = status.Errorf(codes.Internal, "panic in GRPC unary call %s: %v: %s", info.FullMethod, r,
+					debug.Stack())
 			}
 		}()
 		logger.TraceMsg("GRPC unary call")
-		return handler(ctx, req)
+		err = tlsConf.authorizeGRPC(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		err = limiter.allowGRPC(ctx, tlsConf, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		defer observeGRPC(info.FullMethod, time.Now())
+		resp, err = handler(ctx, req)
+		return resp, StatusError(err)
 	}
 }
 
-func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
+func streamInterceptor(limiter *RateLimiter, tlsConf *TLS, logger *logging.Logger) grpc.StreamServerInterceptor {
 	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
 		handler grpc.StreamHandler) (err error) {
 		logger = logger.With("method", info.FullMethod,
@@ -42,10 +66,65 @@ func streamInterceptor(logger *logging.Logger) grpc.StreamServerInterceptor {
 		defer func() {
 			if r := recover(); r != nil {
 				logger.InfoMsg("panic in GRPC stream", structure.ErrorKey, fmt.Sprintf("%v", r))
-				err = fmt.Errorf("panic in GRPC stream %s: %v: %s", info.FullMethod, r, debug.Stack())
+				err = status.Errorf(codes.Internal, "panic in GRPC stream %s: %v: %s", info.FullMethod, r,
+					debug.Stack())
 			}
 		}()
 		logger.TraceMsg("GRPC stream call")
-		return handler(srv, ss)
+		err = tlsConf.authorizeGRPC(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		err = limiter.allowGRPC(ss.Context(), tlsConf, info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return StatusError(handler(srv, ss))
+	}
+}
+
+// StatusError converts an error carrying an execution error code into a gRPC status error with the code and any revert
+// reason attached as an errors.Exception detail. Other errors are returned as they are.
+func StatusError(err error) error {
+	codedErr, ok := err.(errors.CodedError)
+	if !ok {
+		return err
+	}
+	ex := errors.AsException(codedErr)
+	if ex == nil {
+		// A code without a message
+		ex = &errors.Exception{CodeNumber: codedErr.ErrorCode().Number, Exception: codedErr.Error()}
+	}
+	st, detailErr := status.New(StatusCode(ex.ErrorCode()), err.Error()).WithDetails(ex)
+	if detailErr != nil {
+		return err
+	}
+	return st.Err()
+}
+
+// StatusCode maps an execution error code to the closest gRPC status code
+func StatusCode(code *errors.Code) codes.Code {
+	switch code {
+	case errors.Codes.PermissionDenied, errors.Codes.NoInputPermission:
+		return codes.PermissionDenied
+	case errors.Codes.UnknownAddress, errors.Codes.NonExistentAccount:
+		return codes.NotFound
+	case errors.Codes.InvalidAddress, errors.Codes.InvalidString, errors.Codes.InvalidSequence,
+		errors.Codes.InvalidProposal, errors.Codes.InvalidBlockNumber, errors.Codes.UnresolvedSymbols,
+		errors.Codes.InvalidContractCode, errors.Codes.ReservedAddress, errors.Codes.ZeroPayment,
+		errors.Codes.Overpayment:
+		return codes.InvalidArgument
+	case errors.Codes.DuplicateAddress:
+		return codes.AlreadyExists
+	case errors.Codes.BlockNumberOutOfRange:
+		return codes.OutOfRange
+	case errors.Codes.InsufficientBalance, errors.Codes.InsufficientFunds, errors.Codes.InsufficientGas,
+		errors.Codes.ExecutionReverted, errors.Codes.ExecutionAborted, errors.Codes.ExpiredProposal,
+		errors.Codes.ProposalExecuted, errors.Codes.AlreadyVoted, errors.Codes.IllegalWrite:
+		return codes.FailedPrecondition
+	case errors.Codes.None, errors.Codes.Generic:
+		return codes.Unknown
+	default:
+		return codes.Aborted
 	}
 }
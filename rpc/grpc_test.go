@@ -0,0 +1,62 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/status"
+)
+
+func TestStatusError(t *testing.T) {
+	err := StatusError(errors.Wrap(errors.Reverted("not yours"), "exception during transaction execution"))
+	st, ok := status.FromError(err)
+	require.True(t, ok)
+	assert.Equal(t, codes.FailedPrecondition, st.Code())
+	details := st.Details()
+	require.Len(t, details, 1)
+	ex, ok := details[0].(*errors.Exception)
+	require.True(t, ok, "detail should be an Exception but is %T", details[0])
+	assert.Equal(t, errors.Codes.ExecutionReverted, ex.ErrorCode())
+	assert.Equal(t, "not yours", ex.RevertReason)
+
+	st = status.Convert(StatusError(errors.Codes.UnknownAddress))
+	assert.Equal(t, codes.NotFound, st.Code())
+	require.Len(t, st.Details(), 1)
+	assert.Equal(t, errors.Codes.UnknownAddress.Number, st.Details()[0].(*errors.Exception).CodeNumber)
+
+	plain := fmt.Errorf("not coded")
+	assert.Equal(t, plain, StatusError(plain))
+	assert.Nil(t, StatusError(nil))
+}
+
+func TestHealth(t *testing.T) {
+	ready := false
+	health := NewHealth(func() bool { return ready }, logging.NewNoopLogger(), "rpcquery.Query")
+	check := func(service string) grpc_health_v1.HealthCheckResponse_ServingStatus {
+		res, err := health.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: service})
+		require.NoError(t, err)
+		return res.Status
+	}
+
+	assert.False(t, health.Update())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check(""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check("rpcquery.Query"))
+
+	ready = true
+	assert.True(t, health.Update())
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, check(""))
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_SERVING, check("rpcquery.Query"))
+
+	_, err := health.Check(context.Background(), &grpc_health_v1.HealthCheckRequest{Service: "unknown"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	health.Stop()
+	assert.Equal(t, grpc_health_v1.HealthCheckResponse_NOT_SERVING, check(""))
+}
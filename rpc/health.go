@@ -0,0 +1,86 @@
+package rpc
+
+import (
+	"sync"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+// How often Health re-evaluates whether the node can serve requests
+const DefaultHealthCheckInterval = time.Second
+
+// Health serves the standard grpc.health.v1 service. The overall server status (the empty service name) and that of
+// each named service is SERVING while the node is able to serve requests according to its ready function (for example
+// once it has caught up with the network and is taking part in consensus) and NOT_SERVING otherwise.
+type Health struct {
+	*health.Server
+	ready    func() bool
+	services []string
+	logger   *logging.Logger
+	mtx      sync.Mutex
+	serving  *bool
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func NewHealth(ready func() bool, logger *logging.Logger, services ...string) *Health {
+	return &Health{
+		Server:   health.NewServer(),
+		ready:    ready,
+		services: services,
+		logger:   logger.WithScope("Health"),
+		stopCh:   make(chan struct{}),
+	}
+}
+
+// Update evaluates the ready function and updates the serving status of all services, returning whether they are
+// serving
+func (h *Health) Update() bool {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	serving := h.ready()
+	if h.serving != nil && *h.serving == serving {
+		return serving
+	}
+	h.serving = &serving
+	status := grpc_health_v1.HealthCheckResponse_NOT_SERVING
+	if serving {
+		status = grpc_health_v1.HealthCheckResponse_SERVING
+	}
+	h.logger.InfoMsg("Health status changed", "status", status.String())
+	h.SetServingStatus("", status)
+	for _, service := range h.services {
+		h.SetServingStatus(service, status)
+	}
+	return serving
+}
+
+// Start updates health immediately and then every interval until Stop is called
+func (h *Health) Start(interval time.Duration) {
+	h.Update()
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				h.Update()
+			case <-h.stopCh:
+				return
+			}
+		}
+	}()
+}
+
+// Stop checking health and report NOT_SERVING for every service from now on
+func (h *Health) Stop() {
+	h.stopOnce.Do(func() {
+		close(h.stopCh)
+		h.mtx.Lock()
+		defer h.mtx.Unlock()
+		h.Shutdown()
+	})
+}
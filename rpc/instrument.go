@@ -0,0 +1,87 @@
+package rpc
+
+import (
+	"net/http"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+const (
+	// Methods beyond the first MaxMethodLabels seen by a server are recorded as OtherMethod so that clients cannot
+	// create unbounded metrics by calling made-up methods
+	MaxMethodLabels = 256
+	OtherMethod     = "other"
+	// A JSON-RPC request calling more than one method
+	BatchMethod = "batch"
+)
+
+var (
+	requestSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "burrow",
+		Subsystem: "rpc",
+		Name:      "request_seconds",
+		Help:      "Time taken to serve RPC requests by server and method",
+		Buckets:   prometheus.ExponentialBuckets(0.0005, 4, 10),
+	}, []string{"server", "method"})
+
+	grpcMethods = newMethodLabels()
+)
+
+// Collectors returns the metrics recorded by the RPC servers (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{requestSeconds}
+}
+
+// InstrumentHandler records how long requests to handler take by method (named as for RateLimitHandler), websocket
+// connections are not recorded. Requests whose methods cannot be found are recorded as OtherMethod and still served.
+func InstrumentHandler(server string, handler http.Handler) http.Handler {
+	methods := newMethodLabels()
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if strings.EqualFold(r.Header.Get("Upgrade"), "websocket") {
+			handler.ServeHTTP(w, r)
+			return
+		}
+		r, names, err := httpMethods(r)
+		method := BatchMethod
+		if err != nil {
+			method = OtherMethod
+		} else if len(names) == 1 {
+			method = methods.Label(names[0])
+		}
+		start := time.Now()
+		handler.ServeHTTP(w, r)
+		requestSeconds.WithLabelValues(server, method).Observe(time.Since(start).Seconds())
+	})
+}
+
+func observeGRPC(fullMethod string, start time.Time) {
+	requestSeconds.WithLabelValues("grpc", grpcMethods.Label(path.Base(fullMethod))).
+		Observe(time.Since(start).Seconds())
+}
+
+type methodLabels struct {
+	seen map[string]struct{}
+	sync.Mutex
+}
+
+func newMethodLabels() *methodLabels {
+	return &methodLabels{seen: make(map[string]struct{})}
+}
+
+// Label returns method if it is one of the first MaxMethodLabels methods seen, otherwise OtherMethod
+func (ml *methodLabels) Label(method string) string {
+	ml.Lock()
+	defer ml.Unlock()
+	if _, ok := ml.seen[method]; ok {
+		return method
+	}
+	if len(ml.seen) >= MaxMethodLabels {
+		return OtherMethod
+	}
+	ml.seen[method] = struct{}{}
+	return method
+}
@@ -0,0 +1,84 @@
+package rpc
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstrumentHandler(t *testing.T) {
+	// The collector is shared by the package so only count what this test adds
+	before := requestCounts(t, "test")
+	var sizes []int
+	handler := InstrumentHandler("test", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		sizes = append(sizes, len(body))
+	}))
+	serve := func(r *http.Request) {
+		handler.ServeHTTP(httptest.NewRecorder(), r)
+	}
+	serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"eth_call"}`)))
+	serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`[{"method":"eth_call"},{"method":"net_version"}]`)))
+	serve(httptest.NewRequest(http.MethodGet, "/status?foo=bar", nil))
+	serve(httptest.NewRequest(http.MethodGet, "/status", nil))
+	// A body too large to look for methods in is still served whole
+	big := `{"method":"eth_sendRawTransaction","params":["` + strings.Repeat("a", maxRateLimitBodyBytes) + `"]}`
+	serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(big)))
+	assert.Equal(t, len(big), sizes[len(sizes)-1])
+
+	after := requestCounts(t, "test")
+	for method, count := range before {
+		after[method] -= count
+	}
+	assert.Equal(t, map[string]uint64{"eth_call": 1, "batch": 1, "status": 2, OtherMethod: 1}, after)
+}
+
+func TestHTTPMethodsParsedOnce(t *testing.T) {
+	rl := NewRateLimiter(&RateLimitConfig{Enabled: true, Rate: 0, Burst: 10})
+	var methods interface{}
+	handler := RateLimitHandler(rl, nil, InstrumentHandler("test", http.HandlerFunc(func(w http.ResponseWriter,
+		r *http.Request) {
+		methods = r.Context().Value(httpMethodsKey{})
+	})))
+	handler.ServeHTTP(httptest.NewRecorder(),
+		httptest.NewRequest(http.MethodPost, "/", strings.NewReader(`{"method":"eth_call"}`)))
+	// The methods found by the rate limiter are passed on with the request
+	assert.Equal(t, []string{"eth_call"}, methods)
+}
+
+func TestMethodLabels(t *testing.T) {
+	ml := newMethodLabels()
+	for i := 0; i < MaxMethodLabels; i++ {
+		assert.Equal(t, fmt.Sprint(i), ml.Label(fmt.Sprint(i)))
+	}
+	assert.Equal(t, OtherMethod, ml.Label("made_up"))
+	assert.Equal(t, "0", ml.Label("0"))
+}
+
+func requestCounts(t *testing.T, server string) map[string]uint64 {
+	registry := prometheus.NewRegistry()
+	require.NoError(t, registry.Register(requestSeconds))
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	counts := make(map[string]uint64)
+	for _, family := range families {
+		for _, metric := range family.Metric {
+			labels := make(map[string]string)
+			for _, label := range metric.Label {
+				labels[label.GetName()] = label.GetValue()
+			}
+			if labels["server"] == server {
+				counts[labels["method"]] = metric.GetHistogram().GetSampleCount()
+			}
+		}
+	}
+	return counts
+}
@@ -0,0 +1,264 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/gorilla/websocket"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+)
+
+const (
+	// The websocket subprotocol of the graphql-ws library
+	transportWS = "graphql-transport-ws"
+	// The websocket subprotocol of the older subscriptions-transport-ws library
+	legacyWS = "graphql-ws"
+	// Limit on the size of a request body
+	maxRequestBytes = 1 << 20
+)
+
+// Handler serves GraphQL queries over HTTP GET and POST and queries and subscriptions over websocket using either the
+// graphql-transport-ws or (legacy) graphql-ws subprotocol
+type Handler struct {
+	schema         *Schema
+	allowedOrigins map[string]bool
+	upgrader       websocket.Upgrader
+	logger         *logging.Logger
+}
+
+// NewHandler returns a Handler that serves browsers on pages from the server's own origin or from allowedOrigins, where
+// "*" allows any origin
+func NewHandler(schema *Schema, allowedOrigins []string, logger *logging.Logger) *Handler {
+	h := &Handler{
+		schema:         schema,
+		allowedOrigins: make(map[string]bool, len(allowedOrigins)),
+		logger:         logger,
+	}
+	for _, origin := range allowedOrigins {
+		h.allowedOrigins[strings.ToLower(strings.TrimSuffix(origin, "/"))] = true
+	}
+	h.upgrader = websocket.Upgrader{
+		Subprotocols: []string{transportWS, legacyWS},
+		CheckOrigin:  h.checkOrigin,
+	}
+	return h
+}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if websocket.IsWebSocketUpgrade(r) {
+		h.serveWebsocket(w, r)
+		return
+	}
+	if origin := r.Header.Get("Origin"); origin != "" {
+		if !h.checkOrigin(r) {
+			// Drop the permissive CORS headers set by the server
+			w.Header().Del("Access-Control-Allow-Origin")
+			w.Header().Del("Access-Control-Allow-Credentials")
+			http.Error(w, fmt.Sprintf("origin %s is not allowed", origin), http.StatusForbidden)
+			return
+		}
+		w.Header().Set("Access-Control-Allow-Origin", origin)
+		w.Header().Add("Vary", "Origin")
+	}
+	request := new(Request)
+	switch r.Method {
+	case http.MethodOptions:
+		// CORS preflight
+		w.Header().Set("Access-Control-Allow-Methods", "GET, POST")
+		w.Header().Set("Access-Control-Allow-Headers", "Content-Type")
+		w.WriteHeader(http.StatusNoContent)
+		return
+	case http.MethodGet:
+		values := r.URL.Query()
+		request.Query = values.Get("query")
+		request.OperationName = values.Get("operationName")
+		if variables := values.Get("variables"); variables != "" {
+			err := json.Unmarshal([]byte(variables), &request.Variables)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("could not parse variables: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+	case http.MethodPost:
+		body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, maxRequestBytes))
+		if err != nil {
+			http.Error(w, fmt.Sprintf("could not read request: %v", err), http.StatusBadRequest)
+			return
+		}
+		if r.Header.Get("Content-Type") == "application/graphql" {
+			request.Query = string(body)
+		} else {
+			err = json.Unmarshal(body, request)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("could not parse request: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST, OPTIONS")
+		http.Error(w, "GraphQL requests must be GET or POST", http.StatusMethodNotAllowed)
+		return
+	}
+	bs, err := json.Marshal(h.schema.Execute(r.Context(), request))
+	if err != nil {
+		http.Error(w, fmt.Sprintf("could not serialise response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write(bs) // nolint: errcheck
+}
+
+// checkOrigin allows requests without an Origin header (which do not come from a browser), from the server's own origin,
+// and from the allowed origins
+func (h *Handler) checkOrigin(r *http.Request) bool {
+	origin := r.Header.Get("Origin")
+	if origin == "" || h.allowedOrigins["*"] || h.allowedOrigins[strings.ToLower(origin)] {
+		return true
+	}
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	return strings.EqualFold(u.Host, r.Host)
+}
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// A websocket connection on which any number of operations may be running
+type wsConnection struct {
+	conn   *websocket.Conn
+	legacy bool
+	logger *logging.Logger
+	// Cancels running operations by id
+	operations map[string]context.CancelFunc
+	mtx        sync.Mutex
+}
+
+func (h *Handler) serveWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := h.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade has replied with an error
+		h.logger.InfoMsg("Could not upgrade GraphQL websocket", structure.ErrorKey, err)
+		return
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	ws := &wsConnection{
+		conn:       conn,
+		legacy:     conn.Subprotocol() == legacyWS,
+		logger:     h.logger.With("remote_address", r.RemoteAddr),
+		operations: make(map[string]context.CancelFunc),
+	}
+	defer conn.Close()
+	conn.SetReadLimit(maxRequestBytes)
+	for {
+		msg := new(wsMessage)
+		err = conn.ReadJSON(msg)
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				ws.logger.TraceMsg("GraphQL websocket closed", structure.ErrorKey, err)
+			}
+			return
+		}
+		switch msg.Type {
+		case "connection_init":
+			ws.send(&wsMessage{Type: "connection_ack"})
+		case "ping":
+			ws.send(&wsMessage{Type: "pong"})
+		case "pong", "ka":
+		case "subscribe", "start":
+			ws.start(ctx, h.schema, msg)
+		case "complete", "stop":
+			ws.stop(msg.ID)
+		case "connection_terminate":
+			return
+		default:
+			ws.logger.TraceMsg("Unknown GraphQL websocket message", "type", msg.Type)
+		}
+	}
+}
+
+func (ws *wsConnection) start(ctx context.Context, schema *Schema, msg *wsMessage) {
+	request := new(Request)
+	err := json.Unmarshal(msg.Payload, request)
+	if err != nil {
+		ws.sendErrors(msg.ID, fmt.Errorf("could not parse request: %v", err))
+		return
+	}
+	ws.mtx.Lock()
+	if _, ok := ws.operations[msg.ID]; ok {
+		ws.mtx.Unlock()
+		ws.sendErrors(msg.ID, fmt.Errorf("operation %s is already running", msg.ID))
+		return
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	ws.operations[msg.ID] = cancel
+	ws.mtx.Unlock()
+
+	responses, err := schema.Subscribe(ctx, request)
+	if err != nil {
+		ws.stop(msg.ID)
+		ws.sendErrors(msg.ID, err)
+		return
+	}
+	go func() {
+		for res := range responses {
+			payload, err := json.Marshal(res)
+			if err != nil {
+				ws.logger.InfoMsg("Could not serialise GraphQL response", structure.ErrorKey, err)
+				continue
+			}
+			msgType := "next"
+			if ws.legacy {
+				msgType = "data"
+			}
+			ws.send(&wsMessage{ID: msg.ID, Type: msgType, Payload: payload})
+		}
+		// Only tell the client we have finished if it did not ask us to stop
+		if ws.stop(msg.ID) {
+			ws.send(&wsMessage{ID: msg.ID, Type: "complete"})
+		}
+	}()
+}
+
+// Cancels the operation, returning whether it was running
+func (ws *wsConnection) stop(id string) bool {
+	ws.mtx.Lock()
+	defer ws.mtx.Unlock()
+	cancel, ok := ws.operations[id]
+	if ok {
+		cancel()
+		delete(ws.operations, id)
+	}
+	return ok
+}
+
+func (ws *wsConnection) sendErrors(id string, err error) {
+	errs := formatErrors(err)
+	payload, _ := json.Marshal(errs)
+	if ws.legacy {
+		// The legacy protocol sends a single error object
+		payload, _ = json.Marshal(errs[0])
+	}
+	ws.send(&wsMessage{ID: id, Type: "error", Payload: payload})
+}
+
+func (ws *wsConnection) send(msg *wsMessage) {
+	ws.mtx.Lock()
+	defer ws.mtx.Unlock()
+	err := ws.conn.WriteJSON(msg)
+	if err != nil {
+		ws.logger.TraceMsg("Could not write to GraphQL websocket", structure.ErrorKey, err)
+	}
+}
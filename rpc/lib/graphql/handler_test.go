@@ -0,0 +1,149 @@
+package graphql
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHandler(t *testing.T) {
+	blocks := make(chan interface{})
+	server := httptest.NewServer(NewHandler(testSchema(t, blocks), nil, logging.NewNoopLogger()))
+	defer server.Close()
+
+	t.Run("GET", func(t *testing.T) {
+		res, err := http.Get(server.URL + "?query=" + url.QueryEscape(`query Q($h: Int!) { block(height: $h) { height } }`) +
+			"&variables=" + url.QueryEscape(`{"h": 2}`))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"block":{"height":2}}}`, readBody(t, res))
+	})
+
+	t.Run("POST", func(t *testing.T) {
+		res, err := http.Post(server.URL, "application/json",
+			strings.NewReader(`{"query": "{ block(height: 1) { height } }"}`))
+		require.NoError(t, err)
+		assert.Equal(t, "application/json", res.Header.Get("Content-Type"))
+		assert.JSONEq(t, `{"data":{"block":{"height":1}}}`, readBody(t, res))
+
+		res, err = http.Post(server.URL, "application/graphql", strings.NewReader(`{ block(height: 2) { height } }`))
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"data":{"block":{"height":2}}}`, readBody(t, res))
+
+		res, err = http.Post(server.URL, "application/json", strings.NewReader(`{"query": `))
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode)
+	})
+
+	t.Run("Websocket", func(t *testing.T) {
+		dialer := websocket.Dialer{Subprotocols: []string{transportWS}}
+		conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		require.NoError(t, conn.WriteJSON(&wsMessage{Type: "connection_init"}))
+		assert.Equal(t, "connection_ack", readMessage(t, conn).Type)
+
+		require.NoError(t, conn.WriteJSON(&wsMessage{ID: "1", Type: "subscribe",
+			Payload: json.RawMessage(`{"query": "subscription { blocks { height } }"}`)}))
+		blocks <- &testBlock{Height: 7}
+		msg := readMessage(t, conn)
+		assert.Equal(t, "next", msg.Type)
+		assert.Equal(t, "1", msg.ID)
+		assert.JSONEq(t, `{"data":{"blocks":{"height":7}}}`, string(msg.Payload))
+
+		close(blocks)
+		msg = readMessage(t, conn)
+		assert.Equal(t, "complete", msg.Type)
+		assert.Equal(t, "1", msg.ID)
+
+		require.NoError(t, conn.WriteJSON(&wsMessage{ID: "2", Type: "subscribe",
+			Payload: json.RawMessage(`{"query": "{ block(height: 1) { height } }"}`)}))
+		msg = readMessage(t, conn)
+		assert.Equal(t, "next", msg.Type)
+		assert.JSONEq(t, `{"data":{"block":{"height":1}}}`, string(msg.Payload))
+		assert.Equal(t, "complete", readMessage(t, conn).Type)
+
+		require.NoError(t, conn.WriteJSON(&wsMessage{ID: "3", Type: "subscribe",
+			Payload: json.RawMessage(`{"query": "{ block("}`)}))
+		msg = readMessage(t, conn)
+		assert.Equal(t, "error", msg.Type)
+		assert.Contains(t, string(msg.Payload), "Syntax Error")
+	})
+}
+
+func TestHandlerOrigins(t *testing.T) {
+	server := httptest.NewServer(NewHandler(testSchema(t, make(chan interface{})), []string{"https://explorer.example.com"},
+		logging.NewNoopLogger()))
+	defer server.Close()
+	wsURL := "ws" + strings.TrimPrefix(server.URL, "http")
+
+	post := func(origin string) *http.Response {
+		req, err := http.NewRequest(http.MethodPost, server.URL, strings.NewReader(`{ block(height: 1) { height } }`))
+		require.NoError(t, err)
+		req.Header.Set("Content-Type", "application/graphql")
+		if origin != "" {
+			req.Header.Set("Origin", origin)
+		}
+		res, err := http.DefaultClient.Do(req)
+		require.NoError(t, err)
+		return res
+	}
+	dial := func(origin string) error {
+		header := http.Header{}
+		if origin != "" {
+			header.Set("Origin", origin)
+		}
+		conn, _, err := websocket.DefaultDialer.Dial(wsURL, header)
+		if err == nil {
+			conn.Close()
+		}
+		return err
+	}
+
+	for _, origin := range []string{"", "https://explorer.example.com", server.URL} {
+		res := post(origin)
+		assert.Equal(t, http.StatusOK, res.StatusCode, origin)
+		assert.Equal(t, origin, res.Header.Get("Access-Control-Allow-Origin"))
+		assert.JSONEq(t, `{"data":{"block":{"height":1}}}`, readBody(t, res))
+		assert.NoError(t, dial(origin), origin)
+	}
+
+	res := post("https://evil.example.com")
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	assert.Empty(t, res.Header.Get("Access-Control-Allow-Origin"))
+	readBody(t, res)
+	assert.Equal(t, websocket.ErrBadHandshake, dial("https://evil.example.com"))
+
+	req, err := http.NewRequest(http.MethodOptions, server.URL, nil)
+	require.NoError(t, err)
+	req.Header.Set("Origin", "https://explorer.example.com")
+	req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+	res, err = http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	readBody(t, res)
+	assert.Equal(t, http.StatusNoContent, res.StatusCode)
+	assert.Equal(t, "https://explorer.example.com", res.Header.Get("Access-Control-Allow-Origin"))
+	assert.Equal(t, "GET, POST", res.Header.Get("Access-Control-Allow-Methods"))
+}
+
+func readBody(t *testing.T, res *http.Response) string {
+	defer res.Body.Close()
+	bs, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func readMessage(t *testing.T, conn *websocket.Conn) *wsMessage {
+	msg := new(wsMessage)
+	require.NoError(t, conn.ReadJSON(msg))
+	return msg
+}
@@ -0,0 +1,169 @@
+package graphql
+
+import (
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+)
+
+// Checks the operation against the limits of the schema before it is run so that an expensive query is refused
+// rather than partially executed. Fields skipped by directives are counted.
+func (s *Schema) checkLimits(op *ast.OperationDefinition, fragments map[string]*ast.FragmentDefinition,
+	variables map[string]interface{}) error {
+
+	obj := s.QueryType()
+	if op.Operation == ast.OperationTypeSubscription {
+		obj = s.SubscriptionType()
+	}
+	lim := &limits{
+		schema:    s,
+		fragments: fragments,
+		variables: make(map[string]interface{}),
+	}
+	for _, def := range op.VariableDefinitions {
+		name := def.Variable.Name.Value
+		if value, ok := variables[name]; ok {
+			lim.variables[name] = value
+		} else if def.DefaultValue != nil {
+			lim.variables[name] = def.DefaultValue
+		}
+	}
+	_, err := lim.complexity(obj, op.SelectionSet, 1)
+	return err
+}
+
+type limits struct {
+	schema    *Schema
+	fragments map[string]*ast.FragmentDefinition
+	// Values of variables from the request, or their AST default values
+	variables map[string]interface{}
+}
+
+// Returns the complexity of the selection set on the type at depth, failing as soon as either the depth or the
+// complexity exceeds its limit. Validation has ensured fragments do not form cycles.
+func (lim *limits) complexity(typ graphql.Type, selectionSet *ast.SelectionSet, depth int) (int, error) {
+	if selectionSet == nil {
+		return 0, nil
+	}
+	total := 0
+	for _, selection := range selectionSet.Selections {
+		var cost int
+		var err error
+		switch sel := selection.(type) {
+		case *ast.Field:
+			cost, err = lim.fieldComplexity(typ, sel, depth)
+		case *ast.InlineFragment:
+			cost, err = lim.complexity(lim.fragmentType(typ, sel.TypeCondition), sel.SelectionSet, depth)
+		case *ast.FragmentSpread:
+			if fragment, ok := lim.fragments[sel.Name.Value]; ok {
+				cost, err = lim.complexity(lim.fragmentType(typ, fragment.TypeCondition), fragment.SelectionSet, depth)
+			}
+		}
+		if err != nil {
+			return 0, err
+		}
+		total += cost
+		if lim.schema.MaxComplexity > 0 && (cost < 0 || total > lim.schema.MaxComplexity) {
+			return 0, locatedError(fmt.Sprintf("query complexity exceeds the maximum of %d",
+				lim.schema.MaxComplexity), selection.(ast.Node))
+		}
+	}
+	return total, nil
+}
+
+func (lim *limits) fieldComplexity(typ graphql.Type, field *ast.Field, depth int) (int, error) {
+	if lim.schema.MaxDepth > 0 && depth > lim.schema.MaxDepth {
+		return 0, locatedError(fmt.Sprintf("query is nested more than %d fields deep", lim.schema.MaxDepth), field)
+	}
+	def := lim.fieldDefinition(typ, field.Name.Value)
+	if def == nil {
+		return 1, nil
+	}
+	children, err := lim.complexity(namedType(def.Type), field.SelectionSet, depth+1)
+	if err != nil {
+		return 0, err
+	}
+	if complexity := lim.schema.Complexity[typ.Name()][def.Name]; complexity != nil {
+		return complexity(lim.arguments(def.Args, field.Arguments), children), nil
+	}
+	return 1 + children, nil
+}
+
+func (lim *limits) fieldDefinition(typ graphql.Type, name string) *graphql.FieldDefinition {
+	switch name {
+	case graphql.TypeNameMetaFieldDef.Name:
+		return graphql.TypeNameMetaFieldDef
+	case graphql.SchemaMetaFieldDef.Name:
+		return graphql.SchemaMetaFieldDef
+	case graphql.TypeMetaFieldDef.Name:
+		return graphql.TypeMetaFieldDef
+	}
+	switch t := typ.(type) {
+	case *graphql.Object:
+		return t.Fields()[name]
+	case *graphql.Interface:
+		return t.Fields()[name]
+	}
+	return nil
+}
+
+func (lim *limits) fragmentType(typ graphql.Type, condition *ast.Named) graphql.Type {
+	if condition != nil {
+		if named := lim.schema.Type(condition.Name.Value); named != nil {
+			return named
+		}
+	}
+	return typ
+}
+
+// Returns the values of the scalar arguments of a field, those that cannot be parsed are reported when the field is
+// resolved
+func (lim *limits) arguments(defs []*graphql.Argument, args []*ast.Argument) map[string]interface{} {
+	values := make(map[string]interface{})
+	for _, def := range defs {
+		if def.DefaultValue != nil {
+			values[def.Name()] = def.DefaultValue
+		}
+		scalar, ok := graphql.GetNullable(def.Type).(*graphql.Scalar)
+		if !ok {
+			continue
+		}
+		for _, arg := range args {
+			if arg.Name.Value != def.Name() {
+				continue
+			}
+			var value interface{}
+			switch v := arg.Value.(type) {
+			case *ast.Variable:
+				switch variable := lim.variables[v.Name.Value].(type) {
+				case ast.Value:
+					value = scalar.ParseLiteral(variable)
+				case nil:
+				default:
+					value = scalar.ParseValue(variable)
+				}
+			default:
+				value = scalar.ParseLiteral(v)
+			}
+			if value != nil {
+				values[def.Name()] = value
+			}
+		}
+	}
+	return values
+}
+
+// Returns the named type within any list and non-null wrappers
+func namedType(typ graphql.Type) graphql.Type {
+	for {
+		switch t := typ.(type) {
+		case *graphql.List:
+			typ = t.OfType
+		case *graphql.NonNull:
+			typ = t.OfType
+		default:
+			return t
+		}
+	}
+}
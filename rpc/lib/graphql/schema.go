@@ -0,0 +1,166 @@
+package graphql
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/gqlerrors"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/graphql-go/graphql/language/parser"
+	"github.com/graphql-go/graphql/language/source"
+)
+
+const (
+	DefaultMaxQueryLength = 1 << 16
+	// Deep enough for the introspection queries of common clients
+	DefaultMaxDepth      = 15
+	DefaultMaxComplexity = 10000
+)
+
+// Request is a GraphQL request as sent over HTTP or websocket
+type Request struct {
+	Query         string                 `json:"query"`
+	OperationName string                 `json:"operationName,omitempty"`
+	Variables     map[string]interface{} `json:"variables,omitempty"`
+}
+
+// ComplexityFunc estimates the cost of a field from its arguments and the cost of its selection set. Fields returning
+// lists whose length is set by an argument should multiply.
+type ComplexityFunc func(args map[string]interface{}, childComplexity int) int
+
+// Schema is a GraphQL schema that refuses requests exceeding its limits before any field is resolved
+type Schema struct {
+	graphql.Schema
+	// Limits on the requests that will be run. Zero disables a limit.
+	MaxQueryLength int
+	MaxDepth       int
+	MaxComplexity  int
+	// Complexity functions by type and field name, fields without one cost one more than their selection set
+	Complexity map[string]map[string]ComplexityFunc
+}
+
+// NewSchema builds a schema with the default limits
+func NewSchema(config graphql.SchemaConfig, complexity map[string]map[string]ComplexityFunc) (*Schema, error) {
+	schema, err := graphql.NewSchema(config)
+	if err != nil {
+		return nil, err
+	}
+	return &Schema{
+		Schema:         schema,
+		MaxQueryLength: DefaultMaxQueryLength,
+		MaxDepth:       DefaultMaxDepth,
+		MaxComplexity:  DefaultMaxComplexity,
+		Complexity:     complexity,
+	}, nil
+}
+
+// Errors that prevented a request from being run
+type Errors []gqlerrors.FormattedError
+
+func (errs Errors) Error() string {
+	messages := make([]string, len(errs))
+	for i, err := range errs {
+		messages[i] = err.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// Execute runs a query operation. Errors in preparing the request are returned in the result rather than executing
+// it, errors in resolving fields are returned alongside the partial data.
+func (s *Schema) Execute(ctx context.Context, request *Request) *graphql.Result {
+	doc, op, err := s.prepare(request)
+	if err == nil && op.Operation != ast.OperationTypeQuery {
+		err = locatedError(fmt.Sprintf("%s operations are not supported here", op.Operation), op)
+	}
+	if err != nil {
+		return &graphql.Result{Errors: formatErrors(err)}
+	}
+	return graphql.Execute(s.params(ctx, doc, request))
+}
+
+// Subscribe runs a subscription operation returning a result for each event of its root field until the context is
+// done, the channel must be drained until it is closed. A query operation can also be passed, in which case a single
+// result is sent.
+func (s *Schema) Subscribe(ctx context.Context, request *Request) (<-chan *graphql.Result, error) {
+	doc, op, err := s.prepare(request)
+	if err != nil {
+		return nil, err
+	}
+	switch op.Operation {
+	case ast.OperationTypeQuery:
+		ch := make(chan *graphql.Result, 1)
+		ch <- graphql.Execute(s.params(ctx, doc, request))
+		close(ch)
+		return ch, nil
+	case ast.OperationTypeSubscription:
+		if len(op.SelectionSet.Selections) != 1 {
+			return nil, locatedError("subscription must select exactly one top level field", op)
+		}
+		return graphql.ExecuteSubscription(s.params(ctx, doc, request)), nil
+	default:
+		return nil, locatedError(fmt.Sprintf("%s operations are not supported", op.Operation), op)
+	}
+}
+
+func (s *Schema) params(ctx context.Context, doc *ast.Document, request *Request) graphql.ExecuteParams {
+	return graphql.ExecuteParams{
+		Schema:        s.Schema,
+		AST:           doc,
+		OperationName: request.OperationName,
+		Args:          request.Variables,
+		Context:       ctx,
+	}
+}
+
+// Parses and validates the request, returning the operation to run if it is within the limits of the schema
+func (s *Schema) prepare(request *Request) (*ast.Document, *ast.OperationDefinition, error) {
+	if s.MaxQueryLength > 0 && len(request.Query) > s.MaxQueryLength {
+		return nil, nil, fmt.Errorf("query is longer than the maximum of %d bytes", s.MaxQueryLength)
+	}
+	doc, err := parser.Parse(parser.ParseParams{
+		Source: source.NewSource(&source.Source{Body: []byte(request.Query), Name: "GraphQL request"}),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	validation := graphql.ValidateDocument(&s.Schema, doc, graphql.SpecifiedRules)
+	if !validation.IsValid {
+		return nil, nil, Errors(validation.Errors)
+	}
+	var op *ast.OperationDefinition
+	fragments := make(map[string]*ast.FragmentDefinition)
+	for _, def := range doc.Definitions {
+		switch def := def.(type) {
+		case *ast.OperationDefinition:
+			if request.OperationName == "" && op != nil {
+				return nil, nil, fmt.Errorf("must provide operation name if query contains multiple operations")
+			}
+			if request.OperationName == "" || def.Name != nil && def.Name.Value == request.OperationName {
+				op = def
+			}
+		case *ast.FragmentDefinition:
+			fragments[def.Name.Value] = def
+		}
+	}
+	if op == nil {
+		return nil, nil, fmt.Errorf("unknown operation named %q", request.OperationName)
+	}
+	err = s.checkLimits(op, fragments, request.Variables)
+	if err != nil {
+		return nil, nil, err
+	}
+	return doc, op, nil
+}
+
+func locatedError(message string, node ast.Node) error {
+	return gqlerrors.NewError(message, []ast.Node{node}, "", nil, nil, nil)
+}
+
+func formatErrors(err error) []gqlerrors.FormattedError {
+	if errs, ok := err.(Errors); ok {
+		return errs
+	}
+	return gqlerrors.FormatErrors(err)
+}
@@ -0,0 +1,290 @@
+package graphql
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBlock struct {
+	Height int
+	Txs    []*testTx
+}
+
+type testTx struct {
+	Hash   string
+	Failed bool
+}
+
+func testSchema(t *testing.T, blocks chan interface{}) *Schema {
+	var block *graphql.Object
+	tx := graphql.NewObject(graphql.ObjectConfig{Name: "Tx", Fields: graphql.FieldsThunk(func() graphql.Fields {
+		return graphql.Fields{
+			"hash": {Type: graphql.NewNonNull(graphql.String)},
+			"block": {Type: block, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return &testBlock{Height: 1}, nil
+			}},
+			"mustNotFail": {Type: graphql.NewNonNull(graphql.String),
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					if params.Source.(*testTx).Failed {
+						return nil, fmt.Errorf("tx failed")
+					}
+					return "ok", nil
+				}},
+		}
+	})})
+	block = graphql.NewObject(graphql.ObjectConfig{Name: "Block", Fields: graphql.Fields{
+		"height": {Type: graphql.NewNonNull(graphql.Int)},
+		"txs": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(tx))),
+			Args: graphql.FieldConfigArgument{"first": {Type: graphql.Int, DefaultValue: 10}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				txs := params.Source.(*testBlock).Txs
+				if first := params.Args["first"].(int); first < len(txs) {
+					txs = txs[:first]
+				}
+				return txs, nil
+			}},
+	}})
+	query := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: graphql.Fields{
+		"block": {Type: block, Description: "A block by height",
+			Args: graphql.FieldConfigArgument{"height": {Type: graphql.NewNonNull(graphql.Int)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				height := params.Args["height"].(int)
+				if height > 2 {
+					return nil, fmt.Errorf("no block at height %d", height)
+				}
+				return &testBlock{Height: height, Txs: []*testTx{{Hash: "A"}, {Hash: "B", Failed: true}}}, nil
+			}},
+		"echo": {Type: graphql.NewList(graphql.String),
+			Args: graphql.FieldConfigArgument{"values": {Type: graphql.NewList(graphql.String)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Args["values"], nil
+			}},
+	}})
+	subscription := graphql.NewObject(graphql.ObjectConfig{Name: "Subscription", Fields: graphql.Fields{
+		"blocks": {Type: graphql.NewNonNull(block),
+			Subscribe: func(params graphql.ResolveParams) (interface{}, error) {
+				return blocks, nil
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source, nil
+			}},
+	}})
+	schema, err := NewSchema(graphql.SchemaConfig{Query: query, Subscription: subscription},
+		map[string]map[string]ComplexityFunc{
+			"Block": {"txs": func(args map[string]interface{}, childComplexity int) int {
+				return args["first"].(int) * childComplexity
+			}},
+		})
+	require.NoError(t, err)
+	return schema
+}
+
+func execute(t *testing.T, schema *Schema, query string, variables map[string]interface{}) string {
+	res := schema.Execute(context.Background(), &Request{Query: query, Variables: variables})
+	bs, err := json.Marshal(res)
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func TestExecute(t *testing.T) {
+	schema := testSchema(t, nil)
+
+	assert.JSONEq(t, `{"data":{"block":{"height":2,"txs":[{"hash":"A"},{"hash":"B"}]}}}`,
+		execute(t, schema, `{ block(height: 2) { height txs { hash } } }`, nil))
+
+	// Aliases, fragments, variables, directives, and __typename
+	assert.JSONEq(t, `{"data":{"b":{"__typename":"Block","height":1,"first":[{"hash":"A"}]}}}`,
+		execute(t, schema, `
+			query Q($h: Int!, $n: Int = 1, $skip: Boolean!) {
+				b: block(height: $h) { ...B txs @skip(if: $skip) { hash } }
+			}
+			fragment B on Block { __typename height first: txs(first: $n) { ... on Tx { hash } } }`,
+			map[string]interface{}{"h": 1.0, "skip": true}))
+
+	// A single value is coerced to a list
+	assert.JSONEq(t, `{"data":{"echo":["a"]}}`, execute(t, schema, `{ echo(values: "a") }`, nil))
+
+	// Errors in nullable fields null only that field
+	assert.JSONEq(t, `{"data":{"block":null},"errors":[
+			{"message":"no block at height 3","locations":[{"line":1,"column":3}],"path":["block"]}]}`,
+		execute(t, schema, `{ block(height: 3) { height } }`, nil))
+
+	// Errors in non-null fields propagate to the nearest nullable parent
+	assert.JSONEq(t, `{"data":{"block":null},"errors":[
+			{"message":"tx failed","locations":[{"line":1,"column":33}],"path":["block","txs",1,"mustNotFail"]}]}`,
+		execute(t, schema, `{ block(height: 1) { txs { hash mustNotFail } } }`, nil))
+
+	// Request errors
+	assert.Contains(t, execute(t, schema, `{ block(}`, nil), "Syntax Error GraphQL request (1:9) Expected Name")
+	assert.JSONEq(t, `{"data":null,"errors":[
+			{"message":"Variable \"$h\" of required type \"Int!\" was not provided.","locations":[{"line":1,"column":9}]}]}`,
+		execute(t, schema, `query Q($h: Int!) { block(height: $h) { height } }`, nil))
+	assert.Contains(t, execute(t, schema, `{ block(height: 1) { nope } }`, nil),
+		`Cannot query field \"nope\" on type \"Block\".`)
+	assert.Contains(t, execute(t, schema, `subscription { blocks { height } }`, nil),
+		"subscription operations are not supported here")
+}
+
+func TestLimits(t *testing.T) {
+	schema := testSchema(t, nil)
+	schema.MaxDepth = 3
+	schema.MaxComplexity = 20
+	schema.MaxQueryLength = 100
+
+	assert.JSONEq(t, `{"data":{"block":{"txs":[{"hash":"A"}]}}}`,
+		execute(t, schema, `{ block(height: 1) { txs(first: 1) { hash } } }`, nil))
+	assert.JSONEq(t, `{"data":null,"errors":[
+			{"message":"query is nested more than 3 fields deep","locations":[{"line":1,"column":46}]}]}`,
+		execute(t, schema, `{ block(height: 1) { txs(first: 1) { block { height } } } }`, nil))
+
+	// Lists multiply the complexity of their selection
+	assert.JSONEq(t, `{"data":{"block":{"txs":[{"hash":"A"},{"hash":"B"}]}}}`,
+		execute(t, schema, `{ block(height: 1) { txs { hash } } }`, nil))
+	assert.JSONEq(t, `{"data":null,"errors":[
+			{"message":"query complexity exceeds the maximum of 20","locations":[{"line":1,"column":3}]}]}`,
+		execute(t, schema, `{ block(height: 1) { txs(first: 20) { hash } } }`, nil))
+	// Including when the length is given by a variable
+	assert.Contains(t, execute(t, schema, `query Q($n: Int) { block(height: 1) { txs(first: $n) { hash } } }`,
+		map[string]interface{}{"n": 20.0}), "query complexity exceeds the maximum of 20")
+	assert.Contains(t, execute(t, schema, `query Q($n: Int = 20) { block(height: 1) { txs(first: $n) { hash } } }`, nil),
+		"query complexity exceeds the maximum of 20")
+	assert.Contains(t, execute(t, schema, `{ a: block(height: 1) { height } b: block(height: 1) { height }
+			c: block(height: 1) { height } d: block(height: 1) { height } }`, nil),
+		"query is longer than the maximum of 100 bytes")
+
+	// Nor can fragments
+	assert.Contains(t, execute(t, schema, `{ block(height: 1) { ...B } }
+			fragment B on Block { txs(first: 1) { block { height } } }`, nil),
+		"query is nested more than 3 fields deep")
+	assert.Contains(t, execute(t, schema, `{ block(height: 1) { ...B } }
+			fragment B on Block { txs(first: 1) { block { ...B } } }`, nil),
+		`Cannot spread fragment \"B\" within itself`)
+}
+
+func TestIntrospection(t *testing.T) {
+	schema := testSchema(t, nil)
+	res := schema.Execute(context.Background(), &Request{Query: introspectionQuery})
+	require.Empty(t, res.Errors)
+
+	assert.JSONEq(t, `{"data":{"__type":{"name":"Query","fields":[
+			{"name":"block","description":"A block by height","args":[{"name":"height","type":{"kind":"NON_NULL","ofType":{"name":"Int"}}}]},
+			{"name":"echo","description":"","args":[{"name":"values","type":{"kind":"LIST","ofType":{"name":"String"}}}]}]}}}`,
+		execute(t, schema, `{ __type(name: "Query") {
+			name fields { name description args { name type { kind ofType { name } } } } } }`, nil))
+}
+
+func TestSubscribe(t *testing.T) {
+	blocks := make(chan interface{})
+	schema := testSchema(t, blocks)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	responses, err := schema.Subscribe(ctx, &Request{Query: `subscription { blocks { height } }`})
+	require.NoError(t, err)
+	for i := 1; i <= 3; i++ {
+		blocks <- &testBlock{Height: i}
+		select {
+		case res := <-responses:
+			bs, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.JSONEq(t, fmt.Sprintf(`{"data":{"blocks":{"height":%d}}}`, i), string(bs))
+		case <-time.After(time.Second):
+			t.Fatal("timed out waiting for subscription response")
+		}
+	}
+	close(blocks)
+	_, ok := <-responses
+	assert.False(t, ok)
+
+	_, err = schema.Subscribe(ctx, &Request{Query: `subscription { a: blocks { height } b: blocks { height } }`})
+	assert.Error(t, err)
+}
+
+// As sent by GraphiQL
+const introspectionQuery = `
+  query IntrospectionQuery {
+    __schema {
+      queryType { name }
+      mutationType { name }
+      subscriptionType { name }
+      types {
+        ...FullType
+      }
+      directives {
+        name
+        description
+        locations
+        args {
+          ...InputValue
+        }
+      }
+    }
+  }
+
+  fragment FullType on __Type {
+    kind
+    name
+    description
+    fields(includeDeprecated: true) {
+      name
+      description
+      args {
+        ...InputValue
+      }
+      type {
+        ...TypeRef
+      }
+      isDeprecated
+      deprecationReason
+    }
+    inputFields {
+      ...InputValue
+    }
+    interfaces {
+      ...TypeRef
+    }
+    enumValues(includeDeprecated: true) {
+      name
+      description
+      isDeprecated
+      deprecationReason
+    }
+    possibleTypes {
+      ...TypeRef
+    }
+  }
+
+  fragment InputValue on __InputValue {
+    name
+    description
+    type { ...TypeRef }
+    defaultValue
+  }
+
+  fragment TypeRef on __Type {
+    kind
+    name
+    ofType {
+      kind
+      name
+      ofType {
+        kind
+        name
+        ofType {
+          kind
+          name
+          ofType {
+            kind
+            name
+          }
+        }
+      }
+    }
+  }
+`
@@ -388,6 +388,9 @@ type wsConnection struct {
 
 	// object that is used to subscribe / unsubscribe from events
 	eventSub types.EventSubscriber
+
+	// refuses a call to a method by returning an error
+	filter func(method string) error
 }
 
 // NewWSConnection wraps websocket.Conn.
@@ -575,6 +578,12 @@ func (wsc *wsConnection) readRoutine() {
 				wsc.WriteRPCResponse(types.RPCMethodNotFoundError(request.ID))
 				continue
 			}
+			if wsc.filter != nil {
+				if err = wsc.filter(request.Method); err != nil {
+					wsc.WriteRPCResponse(types.RPCServerError(request.ID, err))
+					continue
+				}
+			}
 			var args []reflect.Value
 			if rpcFunc.ws {
 				wsCtx := types.WSRPCContext{Request: request, WSRPCConnection: wsc}
@@ -678,6 +687,7 @@ type WebsocketManager struct {
 	funcMap       map[string]*RPCFunc
 	logger        *logging.Logger
 	wsConnOptions []func(*wsConnection)
+	filter        func(r *http.Request, method string) error
 }
 
 // NewWebsocketManager returns a new WebsocketManager that routes according to
@@ -698,6 +708,13 @@ func NewWebsocketManager(funcMap map[string]*RPCFunc, logger *logging.Logger,
 	}
 }
 
+// SetCallFilter sets a function that is called with the upgrade request of a connection before each call made over
+// it, the call is refused with the error it returns if any. Calls should be checked individually since a single
+// connection can make any number of them.
+func (wm *WebsocketManager) SetCallFilter(filter func(r *http.Request, method string) error) {
+	wm.filter = filter
+}
+
 // WebsocketHandler upgrades the request/response (via http.Hijack) and starts the wsConnection.
 func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Request) {
 	wsConn, err := wm.Upgrade(w, r, nil)
@@ -709,6 +726,11 @@ func (wm *WebsocketManager) WebsocketHandler(w http.ResponseWriter, r *http.Requ
 
 	// register connection
 	con := NewWSConnection(wsConn, wm.funcMap, wm.logger, wm.wsConnOptions...)
+	if wm.filter != nil {
+		con.filter = func(method string) error {
+			return wm.filter(r, method)
+		}
+	}
 	wm.logger.InfoMsg("New websocket connection", "remote_address", con.remoteAddr)
 	err = con.Start() // Blocking
 	if err != nil {
@@ -2,12 +2,14 @@ package server
 
 import (
 	"encoding/json"
+	"fmt"
 	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
 	"strings"
 	"testing"
 
+	"github.com/gorilla/websocket"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc/lib/types"
 	"github.com/stretchr/testify/assert"
@@ -90,3 +92,47 @@ func TestRPCNotification(t *testing.T) {
 	require.Nil(t, err, "reading from the body should not give back an error")
 	require.Equal(t, len(blob), 0, "a notification SHOULD NOT be responded to by the server")
 }
+
+func TestWebsocketCallFilter(t *testing.T) {
+	funcMap := map[string]*RPCFunc{
+		"c": NewRPCFunc(func(s string, i int) (string, error) { return "foo", nil }, "s,i"),
+		"d": NewRPCFunc(func(s string, i int) (string, error) { return "bar", nil }, "s,i"),
+	}
+	wm := NewWebsocketManager(funcMap, logging.NewNoopLogger())
+	wm.SetCallFilter(func(r *http.Request, method string) error {
+		if method == r.Header.Get("X-Refuse") {
+			return fmt.Errorf("may not call %s", method)
+		}
+		return nil
+	})
+	server := httptest.NewServer(http.HandlerFunc(wm.WebsocketHandler))
+	defer server.Close()
+
+	conn, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"),
+		http.Header{"X-Refuse": []string{"d"}})
+	require.NoError(t, err)
+	defer conn.Close()
+
+	call := func(method string) *types.RPCResponse {
+		err := conn.WriteMessage(websocket.TextMessage,
+			[]byte(fmt.Sprintf(`{"jsonrpc": "2.0", "method": "%s", "id": "0", "params": ["a", 10]}`, method)))
+		require.NoError(t, err)
+		_, blob, err := conn.ReadMessage()
+		require.NoError(t, err)
+		recv := new(types.RPCResponse)
+		require.NoError(t, json.Unmarshal(blob, recv))
+		return recv
+	}
+
+	recv := call("c")
+	require.Nil(t, recv.Error)
+	assert.Equal(t, `"foo"`, string(recv.Result))
+
+	// Each call is checked rather than just the upgrade
+	recv = call("d")
+	require.NotNil(t, recv.Error)
+	assert.Contains(t, recv.Error.Message+recv.Error.Data, "may not call d")
+
+	recv = call("c")
+	assert.Nil(t, recv.Error)
+}
@@ -0,0 +1,65 @@
+package rpc
+
+import (
+	"bytes"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/deploy/compile"
+)
+
+// State from which contract metadata can be read
+type MetadataState interface {
+	acmstate.AccountGetter
+	acmstate.MetadataReader
+}
+
+// GetContractMetadata returns the metadata (including the ABI) of the contract deployed at address, or the empty string
+// if none is known
+func GetContractMetadata(st MetadataState, address crypto.Address) (string, error) {
+	acc, err := st.GetAccount(address)
+	if err != nil || acc == nil || acc.CodeHash == nil {
+		return "", err
+	}
+	codehash := acc.CodeHash
+	if acc.Forebear != nil {
+		acc, err = st.GetAccount(*acc.Forebear)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	var contractMeta *acm.ContractMeta
+	for _, m := range acc.ContractMeta {
+		if bytes.Equal(m.CodeHash, codehash) {
+			contractMeta = m
+			break
+		}
+	}
+
+	if contractMeta == nil {
+		deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, address)
+		for _, m := range acc.ContractMeta {
+			if bytes.Equal(m.CodeHash, deployCodehash) {
+				contractMeta = m
+				break
+			}
+		}
+	}
+	if contractMeta == nil {
+		return "", nil
+	}
+	return GetMetadata(st, contractMeta)
+}
+
+// GetMetadata returns the metadata of contractMeta, reading it from state by its hash unless already memoised
+func GetMetadata(st acmstate.MetadataReader, contractMeta *acm.ContractMeta) (string, error) {
+	if contractMeta.Metadata != "" {
+		// Looks like the metadata is already memoised - (e.g. by native.State)
+		return contractMeta.Metadata, nil
+	}
+	var metadataHash acmstate.MetadataHash
+	copy(metadataHash[:], contractMeta.MetadataHash)
+	return st.GetMetadata(metadataHash)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/tendermint/tendermint/types"
 
 	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/event/pubsub"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/logging/structure"
 	"github.com/hyperledger/burrow/rpc"
@@ -27,6 +28,8 @@ type HistogramBuilder func(values []float64) (buckets map[float64]uint64, sum fl
 // user defined runtime configuration when the Collect method is called.
 type Exporter struct {
 	service                      InfoService
+	events                       EventStatsGetter
+	rateLimits                   RateLimitStatsGetter
 	datum                        *Datum
 	chainID                      string
 	validatorMoniker             string
@@ -45,6 +48,16 @@ type InfoService interface {
 	Stats() acmstate.AccountStatsGetter
 }
 
+// Provides statistics on event subscriptions (see event.Emitter)
+type EventStatsGetter interface {
+	Stats() pubsub.Stats
+}
+
+// Provides counts of rejected RPC calls (see rpc.RateLimiter)
+type RateLimitStatsGetter interface {
+	Stats() rpc.RateLimitStats
+}
+
 // Datum is used to store data from all the relevant endpoints
 type Datum struct {
 	LatestBlockHeight   float64
@@ -162,10 +175,41 @@ func (e *Exporter) Collect(ch chan<- prometheus.Metric) {
 		e.chainID,
 		e.validatorMoniker,
 	)
+	if e.events != nil {
+		e.collectEventStats(ch)
+	}
+	if e.rateLimits != nil {
+		e.collectRateLimitStats(ch)
+	}
 
 	e.logger.InfoMsg("All Metrics successfully collected")
 }
 
+func (e *Exporter) collectEventStats(ch chan<- prometheus.Metric) {
+	stats := e.events.Stats()
+	for _, m := range []struct {
+		desc      *prometheus.Desc
+		valueType prometheus.ValueType
+		value     float64
+	}{
+		{EventSubscriptions, prometheus.GaugeValue, float64(stats.Subscriptions)},
+		{LaggingEventSubscriptions, prometheus.GaugeValue, float64(stats.Lagging)},
+		{BufferedEvents, prometheus.GaugeValue, float64(stats.Buffered)},
+		{DroppedEvents, prometheus.CounterValue, float64(stats.Dropped)},
+		{DisconnectedEventSubscriptions, prometheus.CounterValue, float64(stats.Disconnected)},
+	} {
+		ch <- prometheus.MustNewConstMetric(m.desc, m.valueType, m.value, e.chainID, e.validatorMoniker)
+	}
+}
+
+func (e *Exporter) collectRateLimitStats(ch chan<- prometheus.Metric) {
+	stats := e.rateLimits.Stats()
+	for _, method := range stats.Methods() {
+		ch <- prometheus.MustNewConstMetric(RateLimitedCalls, prometheus.CounterValue, float64(stats.Rejected[method]),
+			e.chainID, e.validatorMoniker, method)
+	}
+}
+
 // gatherData - Collects the data from the API and stores into struct
 func (e *Exporter) gatherData() error {
 	var err error
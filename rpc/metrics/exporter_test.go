@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/burrow/bcm"
 
 	"github.com/hyperledger/burrow/consensus/tendermint"
+	"github.com/hyperledger/burrow/event/pubsub"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/prometheus/client_golang/prometheus"
@@ -30,6 +31,8 @@ func TestExporter_Collect_Histogram(t *testing.T) {
 	sampleSize := 100
 	exporter, err := NewExporter(is, sampleSize, logging.NewNoopLogger())
 	require.NoError(t, err)
+	exporter.events = eventStats{Subscriptions: 3, Lagging: 1, Dropped: 7}
+	exporter.rateLimits = rateLimitStats{Rejected: map[string]uint64{"CallTxSim": 4}}
 
 	// Start waiting for us to push metrics to channel from Collect()
 	go func() {
@@ -73,6 +76,46 @@ func TestExporter_Collect_Histogram(t *testing.T) {
 	require.NotNil(t, timePerBlockMetric)
 	require.NotNil(t, timePerBlockMetric.Histogram)
 	verifyHistogram(t, timePerBlockMetric.Histogram, timePerBlock, significantFiguresRounder(significantFiguresForSeconds))
+
+	assert.Equal(t, float64(3), metrics[EventSubscriptions.String()].GetGauge().GetValue())
+	assert.Equal(t, float64(1), metrics[LaggingEventSubscriptions.String()].GetGauge().GetValue())
+	assert.Equal(t, float64(7), metrics[DroppedEvents.String()].GetCounter().GetValue())
+	rateLimited := metrics[RateLimitedCalls.String()]
+	require.NotNil(t, rateLimited)
+	assert.Equal(t, float64(4), rateLimited.GetCounter().GetValue())
+	// Labels are sorted by name
+	assert.Equal(t, "method", rateLimited.Label[1].GetName())
+	assert.Equal(t, "CallTxSim", rateLimited.Label[1].GetValue())
+}
+
+type eventStats pubsub.Stats
+
+func (es eventStats) Stats() pubsub.Stats {
+	return pubsub.Stats(es)
+}
+
+type rateLimitStats rpc.RateLimitStats
+
+func (rs rateLimitStats) Stats() rpc.RateLimitStats {
+	return rpc.RateLimitStats(rs)
+}
+
+func TestInstrumentedCollectors(t *testing.T) {
+	registry := prometheus.NewRegistry()
+	prometheus.WrapRegistererWith(prometheus.Labels{"chain_id": "test-chain", "moniker": "test"}, registry).
+		MustRegister(InstrumentedCollectors()...)
+	families, err := registry.Gather()
+	require.NoError(t, err)
+	names := make(map[string]bool)
+	for _, family := range families {
+		names[family.GetName()] = true
+		for _, metric := range family.Metric {
+			assert.Equal(t, "chain_id", metric.Label[0].GetName())
+			assert.Equal(t, "test-chain", metric.Label[0].GetValue())
+		}
+	}
+	assert.True(t, names["burrow_storage_forest_cache_hits_total"])
+	assert.True(t, names["burrow_execution_block_gas_used"])
 }
 
 func TestSignificantFigures(t *testing.T) {
@@ -49,6 +49,36 @@ var (
 		prometheus.BuildFQName("burrow", "accounts", "users"),
 		"Current users on the chain",
 		[]string{"chain_id", "moniker"})
+
+	EventSubscriptions = newDesc(
+		prometheus.BuildFQName("burrow", "events", "subscriptions"),
+		"Current event subscriptions",
+		[]string{"chain_id", "moniker"})
+
+	LaggingEventSubscriptions = newDesc(
+		prometheus.BuildFQName("burrow", "events", "lagging_subscriptions"),
+		"Current event subscriptions with full buffers",
+		[]string{"chain_id", "moniker"})
+
+	BufferedEvents = newDesc(
+		prometheus.BuildFQName("burrow", "events", "buffered"),
+		"Current events waiting in subscription buffers",
+		[]string{"chain_id", "moniker"})
+
+	DroppedEvents = newDesc(
+		prometheus.BuildFQName("burrow", "events", "dropped"),
+		"Events dropped for subscriptions that did not keep up",
+		[]string{"chain_id", "moniker"})
+
+	DisconnectedEventSubscriptions = newDesc(
+		prometheus.BuildFQName("burrow", "events", "disconnected_subscriptions"),
+		"Event subscriptions disconnected for not keeping up",
+		[]string{"chain_id", "moniker"})
+
+	RateLimitedCalls = newDesc(
+		prometheus.BuildFQName("burrow", "rpc", "rate_limited_calls"),
+		"RPC calls rejected for exceeding a client's rate limit",
+		[]string{"chain_id", "moniker", "method"})
 )
 
 func newDesc(fqName, help string, variableLabels []string) *prometheus.Desc {
@@ -10,23 +10,35 @@ import (
 
 	"github.com/prometheus/client_golang/prometheus"
 
+	"github.com/hyperledger/burrow/execution"
+	"github.com/hyperledger/burrow/execution/evm"
+	"github.com/hyperledger/burrow/execution/native"
+	"github.com/hyperledger/burrow/execution/state"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/rpc/lib/server"
+	"github.com/hyperledger/burrow/storage"
 )
 
-func StartServer(service *rpc.Service, pattern string, listener net.Listener, blockSampleSize int,
-	logger *logging.Logger) (*http.Server, error) {
+func StartServer(service *rpc.Service, events EventStatsGetter, rateLimits RateLimitStatsGetter, pattern string,
+	listener net.Listener, blockSampleSize int, logger *logging.Logger) (*http.Server, error) {
 
 	// instantiate metrics and variables we do not expect to change during runtime
 	exporter, err := NewExporter(service, blockSampleSize, logger)
 	if err != nil {
 		return nil, err
 	}
+	exporter.events = events
+	exporter.rateLimits = rateLimits
 
 	// Register Metrics from each of the endpoints
 	// This invokes the Collect method through the prometheus client libraries.
 	prometheus.MustRegister(exporter)
+	// Register metrics recorded from within the node labelled like those of the exporter
+	prometheus.WrapRegistererWith(prometheus.Labels{
+		"chain_id": exporter.chainID,
+		"moniker":  exporter.validatorMoniker,
+	}, prometheus.DefaultRegisterer).MustRegister(InstrumentedCollectors()...)
 
 	mux := http.NewServeMux()
 	mux.Handle(pattern, server.RecoverAndLogHandler(promhttp.Handler(), logger))
@@ -37,3 +49,19 @@ func StartServer(service *rpc.Service, pattern string, listener net.Listener, bl
 	}
 	return srv, nil
 }
+
+// InstrumentedCollectors returns the metrics recorded from within the node as it executes, stores, and serves
+func InstrumentedCollectors() []prometheus.Collector {
+	var collectors []prometheus.Collector
+	for _, cs := range [][]prometheus.Collector{
+		execution.Collectors(),
+		evm.Collectors(),
+		native.Collectors(),
+		state.Collectors(),
+		storage.Collectors(),
+		rpc.Collectors(),
+	} {
+		collectors = append(collectors, cs...)
+	}
+	return collectors
+}
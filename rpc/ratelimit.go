@@ -0,0 +1,370 @@
+package rpc
+
+import (
+	"bytes"
+	"crypto/tls"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"path"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	DefaultRateLimitAPIKeyHeader = "X-Api-Key"
+	DefaultRateLimitMaxClients   = 10000
+	// Limit on the size of a request body read to find the JSON-RPC methods it calls
+	maxRateLimitBodyBytes = 1 << 20
+)
+
+// Clients are identified by the first of these we find on a request. API keys are only honoured when they have a
+// quota and TLS common names when the certificate has been verified, so that a client cannot get a fresh bucket by
+// claiming a new identity.
+const (
+	APIKeyClientPrefix = "key:"
+	TLSClientPrefix    = "tls:"
+	IPClientPrefix     = "ip:"
+)
+
+type RateLimitConfig struct {
+	Enabled bool
+	// Tokens added to each client's bucket per second
+	Rate float64
+	// Maximum tokens a client's bucket can hold
+	Burst float64
+	// HTTP header (or GRPC metadata key) from which to read a client's API key
+	APIKeyHeader string
+	// Number of client buckets to keep, the least recently used are discarded beyond this
+	MaxClients int
+	// Tokens consumed by a call to each method (by short name, e.g. 'CallTxSim' or 'eth_call'), others cost 1
+	MethodCosts map[string]float64 `json:",omitempty" toml:",omitempty"`
+	// Rate and Burst for particular clients keyed like 'key:<api key>', 'tls:<common name>', or 'ip:<host>'
+	Quotas map[string]RateLimitQuota `json:",omitempty" toml:",omitempty"`
+}
+
+type RateLimitQuota struct {
+	Rate  float64
+	Burst float64
+}
+
+func DefaultRateLimitConfig() *RateLimitConfig {
+	return &RateLimitConfig{
+		Enabled:      false,
+		Rate:         20,
+		Burst:        40,
+		APIKeyHeader: DefaultRateLimitAPIKeyHeader,
+		MaxClients:   DefaultRateLimitMaxClients,
+		MethodCosts: map[string]float64{
+			"CallTxSim":       5,
+			"CallCodeSim":     5,
+			"ListAccounts":    10,
+			"ListNames":       10,
+			"ListProposals":   5,
+			"Stream":          5,
+			"Events":          5,
+			"eth_call":        5,
+			"eth_estimateGas": 5,
+			"eth_getLogs":     5,
+		},
+	}
+}
+
+// Per-method counts of calls rejected by a RateLimiter
+type RateLimitStats struct {
+	Rejected map[string]uint64
+}
+
+// RateLimiter keeps a token bucket for each client from which calls take tokens according to the cost of the method
+// called, calls are rejected when there are not enough tokens left. A nil RateLimiter allows every call.
+type RateLimiter struct {
+	config   *RateLimitConfig
+	buckets  *lru.Cache
+	rejected map[string]uint64
+	methods  *methodLabels
+	now      func() time.Time
+	sync.Mutex
+}
+
+type tokenBucket struct {
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+}
+
+// NewRateLimiter returns nil (allowing all calls) when conf is not enabled
+func NewRateLimiter(conf *RateLimitConfig) *RateLimiter {
+	if conf == nil || !conf.Enabled {
+		return nil
+	}
+	maxClients := conf.MaxClients
+	if maxClients <= 0 {
+		maxClients = DefaultRateLimitMaxClients
+	}
+	// Only errors on non-positive size
+	buckets, _ := lru.New(maxClients)
+	return &RateLimiter{
+		config:   conf,
+		buckets:  buckets,
+		rejected: make(map[string]uint64),
+		methods:  newMethodLabels(),
+		now:      time.Now,
+	}
+}
+
+// Allow takes the combined cost of methods from client's bucket if it has enough tokens, otherwise the call is
+// counted as rejected against each of the methods. A cost greater than the burst is capped at the burst so that
+// expensive methods can be called by a client with a full bucket.
+func (rl *RateLimiter) Allow(client string, methods ...string) bool {
+	if rl == nil {
+		return true
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	var cost float64
+	for _, method := range methods {
+		cost += rl.cost(method)
+	}
+	bucket := rl.bucket(client)
+	if cost > bucket.burst {
+		cost = bucket.burst
+	}
+	now := rl.now()
+	bucket.tokens += now.Sub(bucket.last).Seconds() * bucket.rate
+	if bucket.tokens > bucket.burst {
+		bucket.tokens = bucket.burst
+	}
+	bucket.last = now
+	if bucket.tokens < cost {
+		for _, method := range methods {
+			rl.rejected[rl.methods.Label(method)]++
+		}
+		return false
+	}
+	bucket.tokens -= cost
+	return true
+}
+
+func (rl *RateLimiter) Stats() RateLimitStats {
+	stats := RateLimitStats{Rejected: make(map[string]uint64)}
+	if rl == nil {
+		return stats
+	}
+	rl.Lock()
+	defer rl.Unlock()
+	for method, count := range rl.rejected {
+		stats.Rejected[method] = count
+	}
+	return stats
+}
+
+// Methods that have had calls rejected in order
+func (stats RateLimitStats) Methods() []string {
+	methods := make([]string, 0, len(stats.Rejected))
+	for method := range stats.Rejected {
+		methods = append(methods, method)
+	}
+	sort.Strings(methods)
+	return methods
+}
+
+func (rl *RateLimiter) cost(method string) float64 {
+	if cost, ok := rl.config.MethodCosts[method]; ok {
+		return cost
+	}
+	return 1
+}
+
+func (rl *RateLimiter) bucket(client string) *tokenBucket {
+	if value, ok := rl.buckets.Get(client); ok {
+		return value.(*tokenBucket)
+	}
+	bucket := &tokenBucket{
+		rate:  rl.config.Rate,
+		burst: rl.config.Burst,
+		last:  rl.now(),
+	}
+	if quota, ok := rl.config.Quotas[client]; ok {
+		bucket.rate = quota.Rate
+		bucket.burst = quota.Burst
+	}
+	bucket.tokens = bucket.burst
+	rl.buckets.Add(client, bucket)
+	return bucket
+}
+
+func (rl *RateLimiter) apiKeyHeader() string {
+	if rl.config.APIKeyHeader == "" {
+		return DefaultRateLimitAPIKeyHeader
+	}
+	return rl.config.APIKeyHeader
+}
+
+// Returns the client identified by an API key if the key has a quota
+func (rl *RateLimiter) apiKeyClient(key string) (string, bool) {
+	if key == "" {
+		return "", false
+	}
+	client := APIKeyClientPrefix + key
+	_, ok := rl.config.Quotas[client]
+	return client, ok
+}
+
+// Returns the client identified by a TLS client certificate if t verified it
+func tlsClient(t *TLS, state *tls.ConnectionState) (string, bool) {
+	if !t.Verified(state) {
+		return "", false
+	}
+	return TLSClientPrefix + state.PeerCertificates[0].Subject.CommonName, true
+}
+
+// GRPCClient identifies the client of a GRPC call served with t, which may be nil
+func (rl *RateLimiter) GRPCClient(ctx context.Context, t *TLS) string {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if keys := md.Get(rl.apiKeyHeader()); len(keys) > 0 {
+			if client, ok := rl.apiKeyClient(keys[0]); ok {
+				return client
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return IPClientPrefix
+	}
+	if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+		if client, ok := tlsClient(t, &tlsInfo.State); ok {
+			return client
+		}
+	}
+	return IPClientPrefix + host(p.Addr.String())
+}
+
+// HTTPClient identifies the client of an HTTP request served with t, which may be nil
+func (rl *RateLimiter) HTTPClient(r *http.Request, t *TLS) string {
+	if client, ok := rl.apiKeyClient(r.Header.Get(rl.apiKeyHeader())); ok {
+		return client
+	}
+	if client, ok := tlsClient(t, r.TLS); ok {
+		return client
+	}
+	return IPClientPrefix + host(r.RemoteAddr)
+}
+
+// allowGRPC returns a ResourceExhausted status error when a call to fullMethod is over its client's limit
+func (rl *RateLimiter) allowGRPC(ctx context.Context, t *TLS, fullMethod string) error {
+	if rl == nil {
+		return nil
+	}
+	method := path.Base(fullMethod)
+	if !rl.Allow(rl.GRPCClient(ctx, t), method) {
+		return status.Errorf(codes.ResourceExhausted, "rate limit exceeded for %s", method)
+	}
+	return nil
+}
+
+// RateLimitHandler rejects requests over a client's limit with 429 Too Many Requests. JSON-RPC requests (including
+// batches) are charged for the methods they call, other requests for the last element of their path, so a websocket
+// connection is charged once when it is opened. Clients are identified by the certificates t verified.
+func RateLimitHandler(rl *RateLimiter, t *TLS, handler http.Handler) http.Handler {
+	if rl == nil {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, methods, err := httpMethods(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !rl.Allow(rl.HTTPClient(r, t), methods...) {
+			http.Error(w, "rate limit exceeded for "+strings.Join(methods, ", "), http.StatusTooManyRequests)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+type httpMethodsKey struct{}
+
+// Returns the methods a request calls (see RateLimitHandler) and the request to pass on, which carries them so that the
+// body is only parsed once by the handlers wrapping a server. If the methods cannot be found the body is left intact so
+// that the request can still be served.
+func httpMethods(r *http.Request) (*http.Request, []string, error) {
+	if methods, ok := r.Context().Value(httpMethodsKey{}).([]string); ok {
+		return r, methods, nil
+	}
+	methods, err := readHTTPMethods(r)
+	if err != nil {
+		return r, nil, err
+	}
+	return r.WithContext(context.WithValue(r.Context(), httpMethodsKey{}, methods)), methods, nil
+}
+
+func readHTTPMethods(r *http.Request) ([]string, error) {
+	if r.Method == http.MethodPost && r.Body != nil {
+		body, err := ioutil.ReadAll(io.LimitReader(r.Body, maxRateLimitBodyBytes+1))
+		if err != nil || len(body) > maxRateLimitBodyBytes {
+			r.Body = struct {
+				io.Reader
+				io.Closer
+			}{io.MultiReader(bytes.NewReader(body), r.Body), r.Body}
+			if err != nil {
+				return nil, err
+			}
+			return nil, fmt.Errorf("request body is larger than %d bytes", maxRateLimitBodyBytes)
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+		if methods := jsonRPCMethods(body); len(methods) > 0 {
+			return methods, nil
+		}
+	}
+	return []string{path.Base("/" + strings.Trim(r.URL.Path, "/"))}, nil
+}
+
+type jsonRPCMethod struct {
+	Method string `json:"method"`
+}
+
+func jsonRPCMethods(body []byte) []string {
+	var batch []jsonRPCMethod
+	body = bytes.TrimSpace(body)
+	if len(body) > 0 && body[0] == '[' {
+		if json.Unmarshal(body, &batch) != nil {
+			return nil
+		}
+	} else {
+		req := jsonRPCMethod{}
+		if json.Unmarshal(body, &req) != nil {
+			return nil
+		}
+		batch = append(batch, req)
+	}
+	var methods []string
+	for _, req := range batch {
+		if req.Method != "" {
+			methods = append(methods, req.Method)
+		}
+	}
+	return methods
+}
+
+func host(address string) string {
+	h, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return address
+	}
+	return h
+}
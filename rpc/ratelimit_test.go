@@ -0,0 +1,192 @@
+package rpc
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	conf := DefaultRateLimitConfig()
+	conf.Enabled = true
+	conf.Rate = 1
+	conf.Burst = 10
+	conf.Quotas = map[string]RateLimitQuota{"key:gold": {Rate: 100, Burst: 100}}
+	rl := NewRateLimiter(conf)
+	now := time.Now()
+	rl.now = func() time.Time { return now }
+
+	// CallTxSim costs 5
+	assert.True(t, rl.Allow("ip:a", "CallTxSim"))
+	assert.True(t, rl.Allow("ip:a", "CallTxSim"))
+	assert.False(t, rl.Allow("ip:a", "GetAccount"))
+	// Other clients have their own buckets
+	assert.True(t, rl.Allow("ip:b", "CallTxSim", "GetAccount"))
+	// ListAccounts costs more than the burst so needs a full bucket
+	assert.False(t, rl.Allow("ip:b", "ListAccounts"))
+	for i := 0; i < 20; i++ {
+		assert.True(t, rl.Allow("key:gold", "CallTxSim"))
+	}
+
+	now = now.Add(time.Second)
+	assert.True(t, rl.Allow("ip:a", "GetAccount"))
+	assert.False(t, rl.Allow("ip:a", "GetAccount"))
+	now = now.Add(time.Minute)
+	assert.True(t, rl.Allow("ip:b", "ListAccounts"))
+
+	stats := rl.Stats()
+	assert.Equal(t, []string{"GetAccount", "ListAccounts"}, stats.Methods())
+	assert.Equal(t, uint64(2), stats.Rejected["GetAccount"])
+
+	// Disabled
+	assert.Nil(t, NewRateLimiter(DefaultRateLimitConfig()))
+	var disabled *RateLimiter
+	assert.True(t, disabled.Allow("ip:a", "ListAccounts"))
+	assert.NoError(t, disabled.allowGRPC(context.Background(), nil, "/rpcquery.Query/ListAccounts"))
+}
+
+func TestRateLimiter_GRPC(t *testing.T) {
+	rl := NewRateLimiter(&RateLimitConfig{Enabled: true, Rate: 0, Burst: 1})
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1), Port: 1234}})
+	assert.Equal(t, "ip:10.0.0.1", rl.GRPCClient(ctx, nil))
+	require.NoError(t, rl.allowGRPC(ctx, nil, "/rpcquery.Query/GetAccount"))
+	err := rl.allowGRPC(ctx, nil, "/rpcquery.Query/GetAccount")
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// Only API keys with a quota identify a client
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "made-up"))
+	assert.Equal(t, "ip:10.0.0.1", rl.GRPCClient(ctx, nil))
+	rl.config.Quotas = map[string]RateLimitQuota{"key:secret": {Rate: 0, Burst: 1}}
+	ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("x-api-key", "secret"))
+	assert.Equal(t, "key:secret", rl.GRPCClient(ctx, nil))
+	require.NoError(t, rl.allowGRPC(ctx, nil, "/rpcquery.Query/GetAccount"))
+
+}
+
+func TestRateLimiter_TLSClient(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	ca := newCertificate(t, "ca", nil)
+	conf := &TLSConfig{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+	}
+	writeCertificate(t, newCertificate(t, "server", ca), conf.CertFile, conf.KeyFile)
+	writeCertificate(t, ca, conf.ClientCAFile, filepath.Join(dir, "ca.key"))
+	tlsConf, err := NewTLS(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+
+	rl := NewRateLimiter(&RateLimitConfig{Enabled: true, Rate: 0, Burst: 1})
+	states := make(chan *tls.ConnectionState, 1)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{Handler: RateLimitHandler(rl, tlsConf, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		states <- r.TLS
+	}))}
+	go srv.Serve(tlsConf.Listener(listener))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Leaf)
+	get := func(client *tls.Certificate) int {
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: &tls.Config{
+			RootCAs:      roots,
+			ServerName:   "localhost",
+			Certificates: []tls.Certificate{*client},
+		}}}
+		res, err := httpClient.Get("https://" + listener.Addr().String() + "/status")
+		require.NoError(t, err)
+		res.Body.Close()
+		return res.StatusCode
+	}
+
+	// Each verified certificate gets its own bucket
+	assert.Equal(t, http.StatusOK, get(newCertificate(t, "alice", ca)))
+	state := <-states
+	assert.Equal(t, http.StatusOK, get(newCertificate(t, "bob", ca)))
+	<-states
+	assert.Equal(t, http.StatusTooManyRequests, get(newCertificate(t, "alice", ca)))
+
+	// The state of a GRPC connection served with the same TLS identifies the client in the same way
+	ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1)},
+		AuthInfo: credentials.TLSInfo{State: *state}})
+	assert.Equal(t, "tls:alice", rl.GRPCClient(ctx, tlsConf))
+	// But not when served without TLS that verified it
+	assert.Equal(t, "ip:10.0.0.1", rl.GRPCClient(ctx, nil))
+
+	// A certificate from another CA is not verified even if it claims the same name
+	forged := newCertificate(t, "alice", newCertificate(t, "ca", nil))
+	ctx = peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.IPv4(10, 0, 0, 1)},
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{forged.Leaf}}}})
+	assert.Equal(t, "ip:10.0.0.1", rl.GRPCClient(ctx, tlsConf))
+}
+
+func TestRateLimitHandler(t *testing.T) {
+	rl := NewRateLimiter(&RateLimitConfig{Enabled: true, Rate: 0, Burst: 3,
+		MethodCosts: map[string]float64{"eth_call": 2},
+		Quotas:      map[string]RateLimitQuota{"key:secret": {Rate: 0, Burst: 1}}})
+	var bodies []string
+	handler := RateLimitHandler(rl, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+		bodies = append(bodies, string(body))
+	}))
+	serve := func(r *http.Request) int {
+		w := httptest.NewRecorder()
+		handler.ServeHTTP(w, r)
+		return w.Code
+	}
+	call := `{"jsonrpc":"2.0","id":1,"method":"eth_call","params":[]}`
+	assert.Equal(t, http.StatusOK, serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(call))))
+	// The body is passed on intact
+	assert.Equal(t, []string{call}, bodies)
+	// A batch is charged for each call
+	batch := `[{"method":"eth_blockNumber"},{"method":"eth_call"}]`
+	assert.Equal(t, http.StatusTooManyRequests, serve(httptest.NewRequest(http.MethodPost, "/", strings.NewReader(batch))))
+	assert.Equal(t, http.StatusOK, serve(httptest.NewRequest(http.MethodGet, "/status", nil)))
+	assert.Equal(t, http.StatusTooManyRequests, serve(httptest.NewRequest(http.MethodGet, "/status", nil)))
+
+	// A key without a quota does not get a fresh bucket
+	r := httptest.NewRequest(http.MethodGet, "/status", nil)
+	r.Header.Set("X-Api-Key", "made-up")
+	assert.Equal(t, http.StatusTooManyRequests, serve(r))
+	r = httptest.NewRequest(http.MethodGet, "/status", nil)
+	r.Header.Set("X-Api-Key", "secret")
+	assert.Equal(t, http.StatusOK, serve(r))
+	assert.Equal(t, map[string]uint64{"eth_blockNumber": 1, "eth_call": 1, "status": 2}, rl.Stats().Rejected)
+
+	// Bodies are only read up to a limit
+	big := strings.NewReader(`{"method":"` + strings.Repeat("a", maxRateLimitBodyBytes) + `"}`)
+	assert.Equal(t, http.StatusBadRequest, serve(httptest.NewRequest(http.MethodPost, "/", big)))
+}
+
+func TestCallFilter(t *testing.T) {
+	rl := NewRateLimiter(&RateLimitConfig{Enabled: true, Rate: 0, Burst: 2})
+	filter := CallFilter(nil, rl)
+	r := httptest.NewRequest(http.MethodGet, "/websocket", nil)
+	// Each call over the connection is charged to the client that opened it
+	assert.NoError(t, filter(r, "status"))
+	assert.NoError(t, filter(r, "status"))
+	assert.EqualError(t, filter(r, "status"), "rate limit exceeded for status")
+	assert.Equal(t, map[string]uint64{"status": 1}, rl.Stats().Rejected)
+}
@@ -26,6 +26,13 @@ func (b *Bound) Bound(latestBlockHeight uint64) uint64 {
 		return 0
 	case Bound_LATEST, Bound_STREAM:
 		return latestBlockHeight
+	case Bound_CURSOR:
+		// Resume from the block of the cursor (see startCursor)
+		cursor, err := ParseCursor(b.Cursor)
+		if err != nil {
+			return latestBlockHeight
+		}
+		return cursor.Height
 	default:
 		return latestBlockHeight
 	}
@@ -57,6 +64,14 @@ func StreamBound() *Bound {
 	}
 }
 
+// CursorBound resumes a stream immediately after the StreamEvent with cursor
+func CursorBound(cursor []byte) *Bound {
+	return &Bound{
+		Type:   Bound_CURSOR,
+		Cursor: cursor,
+	}
+}
+
 func NewBlockRange(start, end *Bound) *BlockRange {
 	return &BlockRange{
 		Start: start,
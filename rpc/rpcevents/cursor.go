@@ -0,0 +1,101 @@
+package rpcevents
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+const cursorLength = 3 * 8
+
+// Cursor is the position of a StreamEvent within the stream of a block, StreamEvents are strictly ordered by their
+// cursors. TxIndex counts the top-level transactions of the block from 1 (BeginBlock is at 0 and EndBlock follows the
+// last transaction) and EventIndex counts the StreamEvents within a transaction (including those of nested
+// transactions) from its BeginTx at 0. Clients should treat the encoded cursor as opaque.
+type Cursor struct {
+	Height     uint64
+	TxIndex    uint64
+	EventIndex uint64
+}
+
+func ParseCursor(bs []byte) (Cursor, error) {
+	if len(bs) != cursorLength {
+		return Cursor{}, fmt.Errorf("invalid stream cursor %X, expected %d bytes but got %d",
+			bs, cursorLength, len(bs))
+	}
+	return Cursor{
+		Height:     binary.BigEndian.Uint64(bs),
+		TxIndex:    binary.BigEndian.Uint64(bs[8:]),
+		EventIndex: binary.BigEndian.Uint64(bs[16:]),
+	}, nil
+}
+
+func (c Cursor) Bytes() []byte {
+	bs := make([]byte, cursorLength)
+	binary.BigEndian.PutUint64(bs, c.Height)
+	binary.BigEndian.PutUint64(bs[8:], c.TxIndex)
+	binary.BigEndian.PutUint64(bs[16:], c.EventIndex)
+	return bs
+}
+
+// After returns whether c is a later position in the stream than o
+func (c Cursor) After(o Cursor) bool {
+	if c.Height != o.Height {
+		return c.Height > o.Height
+	}
+	if c.TxIndex != o.TxIndex {
+		return c.TxIndex > o.TxIndex
+	}
+	return c.EventIndex > o.EventIndex
+}
+
+func (c Cursor) String() string {
+	return fmt.Sprintf("Cursor{Height: %d, TxIndex: %d, EventIndex: %d}", c.Height, c.TxIndex, c.EventIndex)
+}
+
+// Assigns cursors to a sequence of StreamEvents each block of which must begin with its BeginBlock
+type cursorTracker struct {
+	cursor Cursor
+	// Depth of nested transactions
+	depth int
+}
+
+// Next returns the cursor of ev which must be the next StreamEvent in the sequence
+func (ct *cursorTracker) Next(ev *exec.StreamEvent) Cursor {
+	switch {
+	case ev.BeginBlock != nil:
+		ct.cursor = Cursor{Height: ev.BeginBlock.Height}
+		ct.depth = 0
+	case ev.EndBlock != nil:
+		ct.cursor.TxIndex++
+		ct.cursor.EventIndex = 0
+	case ev.BeginTx != nil:
+		if ct.depth == 0 {
+			ct.cursor.TxIndex++
+			ct.cursor.EventIndex = 0
+		} else {
+			ct.cursor.EventIndex++
+		}
+		ct.depth++
+	case ev.EndTx != nil:
+		ct.cursor.EventIndex++
+		ct.depth--
+	default:
+		ct.cursor.EventIndex++
+	}
+	return ct.cursor
+}
+
+// Returns the cursor from which to resume if the start bound of blockRange is a cursor
+func startCursor(blockRange *BlockRange) (*Cursor, error) {
+	start := blockRange.GetStart()
+	if start.GetType() != Bound_CURSOR {
+		return nil, nil
+	}
+	cursor, err := ParseCursor(start.Cursor)
+	if err != nil {
+		return nil, err
+	}
+	return &cursor, nil
+}
@@ -0,0 +1,129 @@
+package rpcevents
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+)
+
+func TestCursor(t *testing.T) {
+	cursor := Cursor{Height: 3, TxIndex: 2, EventIndex: 1}
+	parsed, err := ParseCursor(cursor.Bytes())
+	require.NoError(t, err)
+	assert.Equal(t, cursor, parsed)
+
+	assert.True(t, Cursor{Height: 4}.After(cursor))
+	assert.True(t, Cursor{Height: 3, TxIndex: 3}.After(cursor))
+	assert.True(t, Cursor{Height: 3, TxIndex: 2, EventIndex: 2}.After(cursor))
+	assert.False(t, cursor.After(cursor))
+	assert.False(t, Cursor{Height: 3, TxIndex: 2}.After(cursor))
+
+	_, err = ParseCursor([]byte{1, 2, 3})
+	assert.Error(t, err)
+}
+
+func TestCursorTracker(t *testing.T) {
+	be := mkBlock(5, crypto.Address{1}, crypto.Address{2})
+	be.TxExecutions[0].TxExecutions = []*exec.TxExecution{mkBlock(5, crypto.Address{3}).TxExecutions[0]}
+	var cursors cursorTracker
+	var positions [][2]uint64
+	for _, ev := range be.StreamEvents() {
+		cursor := cursors.Next(ev)
+		assert.Equal(t, uint64(5), cursor.Height)
+		positions = append(positions, [2]uint64{cursor.TxIndex, cursor.EventIndex})
+	}
+	assert.Equal(t, [][2]uint64{
+		{0, 0},
+		// BeginTx, Envelope, Event, nested BeginTx, Envelope, Event, EndTx, EndTx
+		{1, 0}, {1, 1}, {1, 2}, {1, 3}, {1, 4}, {1, 5}, {1, 6}, {1, 7},
+		// BeginTx, Envelope, Event, EndTx
+		{2, 0}, {2, 1}, {2, 2}, {2, 3},
+		{3, 0},
+	}, positions)
+}
+
+func TestStreamResume(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	addr1 := crypto.Address{1}
+	addr2 := crypto.Address{2}
+	blocks := map[uint64][]crypto.Address{
+		2: {addr1},
+		5: {addr1, addr2},
+		7: {addr2},
+	}
+	for height := uint64(1); height <= 8; height++ {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			return ws.AddBlock(mkBlock(height, blocks[height]...))
+		})
+		require.NoError(t, err)
+	}
+	ees := &executionEventsServer{
+		eventsProvider: st,
+		tip:            tip{height: 8},
+		logger:         logging.NewNoopLogger(),
+	}
+	stream := func(qry string, start *Bound) []*exec.StreamEvent {
+		ss := &streamServer{}
+		err := ees.Stream(&BlocksRequest{
+			BlockRange: NewBlockRange(start, AbsoluteBound(7)),
+			Query:      qry,
+		}, ss)
+		require.NoError(t, err)
+		return ss.events
+	}
+
+	all := stream("", AbsoluteBound(0))
+	require.Len(t, all, 22)
+	var last Cursor
+	for _, ev := range all {
+		cursor, err := ParseCursor(ev.Cursor)
+		require.NoError(t, err)
+		assert.True(t, cursor.After(last))
+		last = cursor
+	}
+
+	// Resume from every position, including mid-block, and receive exactly the events that follow it
+	for i, ev := range all {
+		assert.Equal(t, all[i+1:], nonEmpty(stream("", CursorBound(ev.Cursor))), "resuming after %v", ev)
+	}
+
+	// Resume a filtered stream
+	qry := "Address = '" + addr2.String() + "'"
+	logs := stream(qry, AbsoluteBound(0))
+	require.Len(t, logs, 2)
+	assert.Equal(t, logs[1:], stream(qry, CursorBound(logs[0].Cursor)))
+	assert.Empty(t, stream(qry, CursorBound(logs[1].Cursor)))
+
+	ss := &streamServer{}
+	err := ees.Stream(&BlocksRequest{BlockRange: NewBlockRange(CursorBound([]byte{1}), nil)}, ss)
+	assert.Error(t, err)
+}
+
+func nonEmpty(evs []*exec.StreamEvent) []*exec.StreamEvent {
+	if len(evs) == 0 {
+		return []*exec.StreamEvent{}
+	}
+	return evs
+}
+
+type streamServer struct {
+	grpc.ServerStream
+	events []*exec.StreamEvent
+}
+
+func (ss *streamServer) Context() context.Context {
+	return context.Background()
+}
+
+func (ss *streamServer) Send(ev *exec.StreamEvent) error {
+	ss.events = append(ss.events, ev)
+	return nil
+}
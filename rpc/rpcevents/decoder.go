@@ -0,0 +1,180 @@
+package rpcevents
+
+import (
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
+)
+
+// Decodes log and call events according to the ABIs of the contracts concerned. Events are shared with other
+// subscribers so rather than being modified a decoded copy is returned.
+type decoder struct {
+	state rpc.MetadataState
+	// ABIs by contract address, nil where no (valid) ABI is known, scoped to a single request
+	specs  map[crypto.Address]*abi.Spec
+	logger *logging.Logger
+}
+
+func newDecoder(state rpc.MetadataState, logger *logging.Logger) *decoder {
+	return &decoder{
+		state:  state,
+		specs:  make(map[crypto.Address]*abi.Spec),
+		logger: logger,
+	}
+}
+
+// TxExecution returns a copy of txe with its events decoded
+func (d *decoder) TxExecution(txe *exec.TxExecution) (*exec.TxExecution, error) {
+	if txe == nil {
+		return nil, nil
+	}
+	decoded := *txe
+	decoded.Events = make([]*exec.Event, len(txe.Events))
+	for i, ev := range txe.Events {
+		var err error
+		decoded.Events[i], err = d.Event(ev)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return &decoded, nil
+}
+
+// StreamEvent returns a copy of sev with its event decoded if it has one, otherwise sev
+func (d *decoder) StreamEvent(sev *exec.StreamEvent) (*exec.StreamEvent, error) {
+	if sev.Event == nil {
+		return sev, nil
+	}
+	ev, err := d.Event(sev.Event)
+	if err != nil || ev == sev.Event {
+		return sev, err
+	}
+	decoded := *sev
+	decoded.Event = ev
+	return &decoded, nil
+}
+
+// Event returns a copy of ev with its log or call decoded if the ABI of the contract is known, otherwise ev
+func (d *decoder) Event(ev *exec.Event) (*exec.Event, error) {
+	switch {
+	case ev.Log != nil:
+		spec, err := d.spec(ev.Log.Address)
+		if err != nil || spec == nil {
+			return ev, err
+		}
+		decoding := decodeLog(spec, ev.Log)
+		if decoding == nil {
+			return ev, nil
+		}
+		log := *ev.Log
+		log.Decoded = decoding
+		decoded := *ev
+		decoded.Log = &log
+		return &decoded, nil
+
+	case ev.Call != nil && ev.Call.CallData != nil:
+		spec, err := d.spec(ev.Call.CallData.Callee)
+		if err != nil || spec == nil {
+			return ev, err
+		}
+		decoding := decodeCall(spec, ev.Call.CallData.Data)
+		if decoding == nil {
+			return ev, nil
+		}
+		call := *ev.Call
+		call.Decoded = decoding
+		decoded := *ev
+		decoded.Call = &call
+		return &decoded, nil
+	}
+	return ev, nil
+}
+
+func (d *decoder) spec(address crypto.Address) (*abi.Spec, error) {
+	spec, ok := d.specs[address]
+	if ok {
+		return spec, nil
+	}
+	metadata, err := rpc.GetContractMetadata(d.state, address)
+	if err != nil {
+		return nil, err
+	}
+	if metadata != "" {
+		spec, err = abi.ReadSpec([]byte(metadata))
+		if err != nil {
+			d.logger.InfoMsg("Could not read ABI of contract", "address", address, "error", err)
+			spec = nil
+		}
+	}
+	d.specs[address] = spec
+	return spec, nil
+}
+
+// Returns nil if the event is not in the ABI or cannot be unpacked
+func decodeLog(spec *abi.Spec, log *exec.LogEvent) *exec.ABIDecoding {
+	if len(log.Topics) == 0 {
+		return nil
+	}
+	evSpec, ok := spec.EventsByID[log.SolidityEventID()]
+	if !ok || evSpec.Anonymous {
+		return nil
+	}
+	values, ptrs := stringArgs(len(evSpec.Inputs))
+	err := abi.UnpackEvent(evSpec, log.Topics, log.Data, ptrs...)
+	if err != nil {
+		return nil
+	}
+	return &exec.ABIDecoding{
+		Name: evSpec.Name,
+		Args: decodedArgs(evSpec.Inputs, values),
+	}
+}
+
+// Returns nil if the function is not in the ABI or its arguments cannot be unpacked
+func decodeCall(spec *abi.Spec, data []byte) *exec.ABIDecoding {
+	if len(data) < abi.FunctionIDSize {
+		return nil
+	}
+	var id abi.FunctionID
+	copy(id[:], data)
+	for _, fspec := range spec.Functions {
+		if fspec.FunctionID != id {
+			continue
+		}
+		values, ptrs := stringArgs(len(fspec.Inputs))
+		err := abi.Unpack(fspec.Inputs, data[abi.FunctionIDSize:], ptrs...)
+		if err != nil {
+			return nil
+		}
+		return &exec.ABIDecoding{
+			Name: fspec.Name,
+			Args: decodedArgs(fspec.Inputs, values),
+		}
+	}
+	return nil
+}
+
+// Returns n strings and pointers to them to unpack into
+func stringArgs(n int) ([]string, []interface{}) {
+	values := make([]string, n)
+	ptrs := make([]interface{}, n)
+	for i := range values {
+		ptrs[i] = &values[i]
+	}
+	return values, ptrs
+}
+
+func decodedArgs(inputs []abi.Argument, values []string) []*exec.ABIArgument {
+	args := make([]*exec.ABIArgument, len(inputs))
+	for i, input := range inputs {
+		args[i] = &exec.ABIArgument{
+			Name:    input.Name,
+			Type:    input.TypeSignature(),
+			Value:   values[i],
+			Indexed: input.Indexed,
+		}
+	}
+	return args
+}
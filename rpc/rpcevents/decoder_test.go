@@ -0,0 +1,92 @@
+package rpcevents
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const tokenABI = `[
+  {"type":"event","name":"Transfer","anonymous":false,"inputs":[
+    {"name":"from","type":"address","indexed":true},
+    {"name":"to","type":"address","indexed":true},
+    {"name":"value","type":"uint256","indexed":false}]},
+  {"type":"function","name":"transfer","inputs":[
+    {"name":"to","type":"address"},
+    {"name":"value","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`
+
+func TestDecoder(t *testing.T) {
+	token := crypto.Address{1, 2, 3}
+	from := crypto.Address{4}
+	to := crypto.Address{5}
+	st := acmstate.NewMemoryState()
+	codeHash := []byte{1, 1, 1}
+	metadataHash := acmstate.GetMetadataHash(tokenABI)
+	require.NoError(t, st.SetMetadata(metadataHash, tokenABI))
+	require.NoError(t, st.UpdateAccount(&acm.Account{
+		Address:      token,
+		CodeHash:     codeHash,
+		ContractMeta: []*acm.ContractMeta{{CodeHash: codeHash, MetadataHash: metadataHash.Bytes()}},
+	}))
+
+	spec, err := abi.ReadSpec([]byte(tokenABI))
+	require.NoError(t, err)
+	topics, data, err := abi.PackEvent(spec.EventsByName["Transfer"], from, to, 42)
+	require.NoError(t, err)
+	callData, _, err := spec.Pack("transfer", to, 42)
+	require.NoError(t, err)
+
+	logEvent := &exec.Event{
+		Header: &exec.Header{EventType: exec.TypeLog},
+		Log:    &exec.LogEvent{Address: token, Topics: topics, Data: data},
+	}
+	callEvent := &exec.Event{
+		Header: &exec.Header{EventType: exec.TypeCall},
+		Call:   &exec.CallEvent{CallData: &exec.CallData{Caller: from, Callee: token, Data: callData}},
+	}
+	unknownEvent := &exec.Event{
+		Header: &exec.Header{EventType: exec.TypeLog},
+		Log:    &exec.LogEvent{Address: crypto.Address{9}, Topics: topics, Data: data},
+	}
+
+	dec := newDecoder(st, logging.NewNoopLogger())
+	txe, err := dec.TxExecution(&exec.TxExecution{Events: []*exec.Event{logEvent, callEvent, unknownEvent}})
+	require.NoError(t, err)
+
+	decodedLog := txe.Events[0].Log.Decoded
+	require.NotNil(t, decodedLog)
+	assert.Equal(t, "Transfer", decodedLog.Name)
+	assert.Equal(t, []*exec.ABIArgument{
+		{Name: "from", Type: "address", Value: from.String(), Indexed: true},
+		{Name: "to", Type: "address", Value: to.String(), Indexed: true},
+		{Name: "value", Type: "uint256", Value: "42"},
+	}, decodedLog.Args)
+	// The original is left untouched
+	assert.Nil(t, logEvent.Log.Decoded)
+
+	decodedCall := txe.Events[1].Call.Decoded
+	require.NotNil(t, decodedCall)
+	assert.Equal(t, "transfer", decodedCall.Name)
+	assert.Equal(t, []*exec.ABIArgument{
+		{Name: "to", Type: "address", Value: to.String()},
+		{Name: "value", Type: "uint256", Value: "42"},
+	}, decodedCall.Args)
+
+	assert.Equal(t, unknownEvent, txe.Events[2])
+
+	qry := query.MustParse("EventName = 'Transfer' AND Args.to = '" + to.String() + "' AND Args.value > 40")
+	assert.True(t, qry.Matches(txe.Events[0]))
+	assert.False(t, qry.Matches(logEvent))
+	assert.True(t, query.MustParse("FunctionName = 'transfer' AND Args.to = '"+to.String()+"'").
+		Matches(txe.Events[1]))
+	assert.False(t, query.MustParse("Args.from = '"+to.String()+"'").Matches(txe.Events[0]))
+}
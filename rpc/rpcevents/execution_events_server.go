@@ -7,10 +7,14 @@ import (
 
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/event/pubsub"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/storage"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const SubscribeBufferSize = 100
@@ -21,6 +25,13 @@ type Provider interface {
 		consumer func(*exec.StreamEvent) error) (err error)
 	// Get a particular TxExecution by hash
 	TxByHash(txHash []byte) (*exec.TxExecution, error)
+	// Get the height from which events are indexed
+	IndexStart() (height uint64, ok bool, err error)
+	// Get the heights at which an indexed tag takes a value
+	IterateIndexedHeights(tag, value string, startHeight, endHeight uint64,
+		consumer func(height uint64) error) error
+	// Get contract ABIs with which to decode events
+	rpc.MetadataState
 }
 
 type executionEventsServer struct {
@@ -47,7 +58,7 @@ func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*
 		return nil, err
 	}
 	if txe != nil {
-		return txe, nil
+		return ees.decodeTx(request.Decode, txe)
 	}
 	if !request.Wait {
 		return nil, fmt.Errorf("transaction with hash %v not found in state", request.TxHash)
@@ -63,18 +74,50 @@ func (ees *executionEventsServer) Tx(ctx context.Context, request *TxRequest) (*
 		case <-ctx.Done():
 			return nil, ctx.Err()
 		default:
-			return msg.(*exec.TxExecution), nil
+			return ees.decodeTx(request.Decode, msg.(*exec.TxExecution))
 		}
 	}
 	return nil, fmt.Errorf("subscription waiting for tx %v ended prematurely", request.TxHash)
 }
 
+func (ees *executionEventsServer) decodeTx(decode bool, txe *exec.TxExecution) (*exec.TxExecution, error) {
+	if !decode {
+		return txe, nil
+	}
+	return newDecoder(ees.eventsProvider, ees.logger).TxExecution(txe)
+}
+
 func (ees *executionEventsServer) Stream(request *BlocksRequest, stream ExecutionEvents_StreamServer) error {
 	qry, err := query.NewOrEmpty(request.Query)
 	if err != nil {
 		return fmt.Errorf("could not parse TxExecution query: %v", err)
 	}
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(ev *exec.StreamEvent) error {
+	resume, err := startCursor(request.BlockRange)
+	if err != nil {
+		return err
+	}
+	var dec *decoder
+	if request.Decode {
+		dec = newDecoder(ees.eventsProvider, ees.logger)
+	}
+	var cursors cursorTracker
+	return ees.streamEvents(stream.Context(), request.BlockRange, qry, func(ev *exec.StreamEvent) error {
+		cursor := cursors.Next(ev)
+		if resume != nil {
+			// Skip the events of the block we are resuming that have already been delivered
+			if !cursor.After(*resume) {
+				return nil
+			}
+			resume = nil
+		}
+		// StreamEvents are read from state or built from the BlockExecution for each subscriber so are ours to modify
+		ev.Cursor = cursor.Bytes()
+		if dec != nil {
+			ev, err = dec.StreamEvent(ev)
+			if err != nil {
+				return err
+			}
+		}
 		if qry.Matches(ev) {
 			return stream.Send(ev)
 		}
@@ -88,9 +131,16 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	if err != nil {
 		return fmt.Errorf("could not parse Event query: %v", err)
 	}
+	if request.BlockRange.GetStart().GetType() == Bound_CURSOR {
+		return fmt.Errorf("%s: cursor start bounds are only supported by Stream", errHeader)
+	}
 	var response *EventsResponse
 	var stack exec.TxStack
-	return ees.streamEvents(stream.Context(), request.BlockRange, func(sev *exec.StreamEvent) error {
+	var dec *decoder
+	if request.Decode {
+		dec = newDecoder(ees.eventsProvider, ees.logger)
+	}
+	return ees.streamEvents(stream.Context(), request.BlockRange, qry, func(sev *exec.StreamEvent) error {
 		switch {
 		case sev.BeginBlock != nil:
 			response = &EventsResponse{
@@ -107,6 +157,12 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 				return fmt.Errorf("%s: %v", errHeader, err)
 			}
 			if txe != nil && txe.Exception == nil {
+				if dec != nil {
+					txe, err = dec.TxExecution(txe)
+					if err != nil {
+						return fmt.Errorf("%s: %v", errHeader, err)
+					}
+				}
 				for _, ev := range txe.Events {
 					if qry.Matches(ev) {
 						response.Events = append(response.Events, ev)
@@ -119,7 +175,8 @@ func (ees *executionEventsServer) Events(request *BlocksRequest, stream Executio
 	})
 }
 
-func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange,
+// Streams the events of blocks in blockRange to consumer, qry is used to skip stored blocks that cannot match it
+func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *BlockRange, qry query.Query,
 	consumer func(execution *exec.StreamEvent) error) error {
 
 	start, end, streaming := blockRange.Bounds(ees.tip.LastBlockHeight())
@@ -127,10 +184,11 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 
 	// Pull blocks from state and receive the upper bound (exclusive) on the what we were able to send
 	// Set this to start since it will be the start of next streaming batch (if needed)
-	start, err := ees.iterateStreamEvents(start, end, consumer)
+	start, err := ees.iterateStreamEvents(start, end, qry, consumer)
 
-	// If we are not streaming and all blocks requested were retrieved from state then we are done
-	if !streaming && start > end {
+	// If we are not streaming and all blocks requested were retrieved from state then we are done - empty blocks are
+	// not stored so we may not have seen the last block requested but it has been committed if it is below the tip
+	if !streaming && (start > end || end <= ees.tip.LastBlockHeight()) {
 		return err
 	}
 
@@ -149,7 +207,7 @@ func (ees *executionEventsServer) streamEvents(ctx context.Context, blockRange *
 			if catchupEnd > end {
 				catchupEnd = end
 			}
-			start, err = ees.iterateStreamEvents(start, catchupEnd, consumer)
+			start, err = ees.iterateStreamEvents(start, catchupEnd, qry, consumer)
 			if err != nil {
 				return err
 			}
@@ -174,13 +232,19 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 	consumer func(*exec.BlockExecution) error) (err error) {
 	// Otherwise we need to begin streaming blocks as they are produced
 	subID := event.GenSubID()
-	// Subscribe to BlockExecution events
-	out, err := ees.emitter.Subscribe(ctx, subID, exec.QueryForBlockExecution(), SubscribeBufferSize)
+	// Subscribe to BlockExecution events, a subscriber that falls more than a buffer behind is disconnected rather than
+	// missing blocks (which we could only catch up on when the next block arrives) or holding up the emitter
+	out, err := ees.emitter.Subscribe(ctx, subID, exec.QueryForBlockExecution(), SubscribeBufferSize,
+		pubsub.WithPolicy(pubsub.Disconnect))
 	if err != nil {
 		return err
 	}
+	var slowErr error
 	defer func() {
 		err = ees.emitter.UnsubscribeAll(context.Background(), subID)
+		if slowErr != nil {
+			err = slowErr
+		}
 		for range out {
 			// flush
 		}
@@ -197,10 +261,63 @@ func (ees *executionEventsServer) subscribeBlockExecution(ctx context.Context,
 			}
 		}
 	}
-	return nil
+	if ees.emitter.Err(subID) != nil {
+		slowErr = status.Errorf(codes.ResourceExhausted,
+			"stream disconnected for not keeping up with blocks, resume from the cursor of the last event received")
+	}
+	return slowErr
+}
+
+// Reads stored blocks using the event index to read only those that may match qry where possible
+func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64, qry query.Query,
+	consumer func(*exec.StreamEvent) error) (uint64, error) {
+	conditions := indexedConditions(qry)
+	if len(conditions) == 0 {
+		return ees.scanStreamEvents(startHeight, endHeight, consumer)
+	}
+	indexStart, ok, err := ees.eventsProvider.IndexStart()
+	if err != nil {
+		return startHeight, err
+	}
+	if !ok {
+		return ees.scanStreamEvents(startHeight, endHeight, consumer)
+	}
+	if startHeight < indexStart {
+		// Blocks stored before the index was introduced
+		if endHeight < indexStart {
+			return ees.scanStreamEvents(startHeight, endHeight, consumer)
+		}
+		_, err = ees.scanStreamEvents(startHeight, indexStart-1, consumer)
+		if err != nil {
+			return startHeight, err
+		}
+		startHeight = indexStart
+	}
+	// Blocks are only guaranteed to be stored (and indexed) up to the tip
+	if lastHeight := ees.tip.LastBlockHeight(); endHeight > lastHeight {
+		endHeight = lastHeight
+	}
+	if endHeight < startHeight {
+		return startHeight, nil
+	}
+	heights, err := planHeights(ees.eventsProvider, conditions, startHeight, endHeight)
+	if err != nil {
+		return startHeight, err
+	}
+	ees.logger.TraceMsg("Reading indexed blocks", "start", startHeight, "end", endHeight,
+		"blocks", len(heights))
+	for _, height := range heights {
+		height := height
+		err = ees.eventsProvider.IterateStreamEvents(&height, &height, storage.AscendingSort, consumer)
+		if err != nil {
+			return height, err
+		}
+	}
+	return endHeight + 1, nil
 }
 
-func (ees *executionEventsServer) iterateStreamEvents(startHeight, endHeight uint64,
+// Reads every stored block between startHeight and endHeight
+func (ees *executionEventsServer) scanStreamEvents(startHeight, endHeight uint64,
 	consumer func(*exec.StreamEvent) error) (uint64, error) {
 	// Assume that we have seen the previous block before start to have ended up here
 	// NOTE: this will underflow when start is 0 (as it often will be - and needs to be for restored chains)
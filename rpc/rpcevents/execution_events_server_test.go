@@ -0,0 +1,124 @@
+package rpcevents
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestStreamFinishesAtTip(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	_, _, err := st.Update(func(ws state.Updatable) error {
+		return ws.AddBlock(&exec.BlockExecution{Height: 2, TxExecutions: []*exec.TxExecution{{
+			TxHeader: &exec.TxHeader{TxType: payload.TypeCall, TxHash: make([]byte, 32), Height: 2},
+		}}})
+	})
+	require.NoError(t, err)
+	ees := NewExecutionEventsServer(st, event.NewEmitter(), &blockchain{height: 3}, logging.NewNoopLogger())
+
+	// Block 3 is empty so it is not stored, but it has been committed so we should not wait for block 4
+	var heights []uint64
+	done := make(chan error, 1)
+	go func() {
+		done <- ees.Stream(&BlocksRequest{BlockRange: NewBlockRange(AbsoluteBound(1), AbsoluteBound(3))},
+			streamEventServer{ctx: context.Background(), send: func(ev *exec.StreamEvent) error {
+				if ev.BeginBlock != nil {
+					heights = append(heights, ev.BeginBlock.Height)
+				}
+				return nil
+			}})
+	}()
+	select {
+	case err := <-done:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("stream of committed blocks did not finish")
+	}
+	assert.Equal(t, []uint64{2}, heights)
+}
+
+func TestSubscribeBlockExecutionSlowSubscriber(t *testing.T) {
+	emitter := event.NewEmitter()
+	defer emitter.Shutdown(context.Background())
+	ees := &executionEventsServer{emitter: emitter, logger: logging.NewNoopLogger()}
+
+	received := make(chan uint64, 1)
+	release := make(chan struct{})
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- ees.subscribeBlockExecution(context.Background(), func(block *exec.BlockExecution) error {
+			select {
+			case received <- block.Height:
+			default:
+			}
+			<-release
+			return nil
+		})
+	}()
+	waitFor(t, func() bool { return emitter.Stats().Subscriptions == 1 })
+
+	publish := func(height uint64) {
+		be := &exec.BlockExecution{Height: height}
+		require.NoError(t, emitter.Publish(context.Background(), be, be))
+	}
+	publish(1)
+	assert.Equal(t, uint64(1), <-received)
+	// The consumer is stuck on the first block so these fill the buffer and then overflow it
+	for height := uint64(2); height <= SubscribeBufferSize+2; height++ {
+		publish(height)
+	}
+	waitFor(t, func() bool { return emitter.Stats().Disconnected == 1 })
+	close(release)
+
+	err := <-errCh
+	require.Error(t, err)
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	assert.Equal(t, 0, emitter.Stats().Subscriptions)
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	for i := 0; i < 1000; i++ {
+		if condition() {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for condition")
+}
+
+type blockchain struct {
+	bcm.BlockchainInfo
+	height uint64
+}
+
+func (bc *blockchain) LastBlockHeight() uint64 {
+	return bc.height
+}
+
+type streamEventServer struct {
+	grpc.ServerStream
+	ctx  context.Context
+	send func(*exec.StreamEvent) error
+}
+
+func (ses streamEventServer) Context() context.Context {
+	return ses.ctx
+}
+
+func (ses streamEventServer) Send(ev *exec.StreamEvent) error {
+	return ses.send(ev)
+}
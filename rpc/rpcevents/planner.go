@@ -0,0 +1,79 @@
+package rpcevents
+
+import (
+	"sort"
+
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+)
+
+// An equality (or IN) conjunct of a query over an indexed tag, the query can only match at heights where tag takes one
+// of values
+type indexedCondition struct {
+	tag    string
+	values []string
+}
+
+// indexedConditions returns the conjuncts of qry that can be answered from the event index
+func indexedConditions(qry query.Query) []indexedCondition {
+	pq, ok := qry.(*query.PegQuery)
+	if !ok {
+		return nil
+	}
+	var conditions []indexedCondition
+	for _, cond := range pq.Conjuncts() {
+		var operands []interface{}
+		switch cond.Op {
+		case query.OpEqual:
+			operands = []interface{}{cond.Operand}
+		case query.OpIn:
+			operands, _ = cond.Operand.([]interface{})
+		default:
+			continue
+		}
+		ic := indexedCondition{tag: cond.Tag}
+		for _, operand := range operands {
+			// Numeric operands are compared as numbers rather than as the indexed strings
+			value, ok := operand.(string)
+			if !ok || !exec.IsIndexed(cond.Tag, value) {
+				ic.values = nil
+				break
+			}
+			ic.values = append(ic.values, value)
+		}
+		if len(ic.values) > 0 {
+			conditions = append(conditions, ic)
+		}
+	}
+	return conditions
+}
+
+// planHeights returns in ascending order the heights in [startHeight, endHeight] at which the index shows every
+// condition may hold
+func planHeights(provider Provider, conditions []indexedCondition, startHeight, endHeight uint64) ([]uint64, error) {
+	var candidates map[uint64]bool
+	for _, cond := range conditions {
+		heights := make(map[uint64]bool)
+		for _, value := range cond.values {
+			err := provider.IterateIndexedHeights(cond.tag, value, startHeight, endHeight, func(height uint64) error {
+				if candidates == nil || candidates[height] {
+					heights[height] = true
+				}
+				return nil
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+		candidates = heights
+		if len(candidates) == 0 {
+			break
+		}
+	}
+	plan := make([]uint64, 0, len(candidates))
+	for height := range candidates {
+		plan = append(plan, height)
+	}
+	sort.Slice(plan, func(i, j int) bool { return plan[i] < plan[j] })
+	return plan, nil
+}
@@ -0,0 +1,118 @@
+package rpcevents
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestIndexedConditions(t *testing.T) {
+	conditions := indexedConditions(query.MustParse("Address = '01' AND Log0 IN ('AA', 'BB') AND " +
+		"Log1 IN ('AA', 2) AND TxType = 2 AND Height = '3' AND (Log2 = 'CC' OR Log3 = 'DD')"))
+	assert.Equal(t, []indexedCondition{
+		{tag: "Address", values: []string{"01"}},
+		{tag: "Log0", values: []string{"AA", "BB"}},
+	}, conditions)
+
+	assert.Empty(t, indexedConditions(query.Empty{}))
+	assert.Empty(t, indexedConditions(query.MustParse("NOT Address = '01'")))
+}
+
+func TestIterateStreamEvents(t *testing.T) {
+	st := state.NewState(dbm.NewMemDB())
+	addr1 := crypto.Address{1}
+	addr2 := crypto.Address{2}
+	blocks := map[uint64][]crypto.Address{
+		2: {addr1},
+		3: {addr2},
+		5: {addr1, addr2},
+		8: {addr2},
+	}
+	for height := uint64(1); height <= 8; height++ {
+		_, _, err := st.Update(func(ws state.Updatable) error {
+			return ws.AddBlock(mkBlock(height, blocks[height]...))
+		})
+		require.NoError(t, err)
+	}
+	ees := &executionEventsServer{
+		eventsProvider: st,
+		tip:            tip{height: 7},
+		logger:         logging.NewNoopLogger(),
+	}
+	stream := func(qry string, start, end uint64) ([]uint64, uint64) {
+		var heights []uint64
+		next, err := ees.iterateStreamEvents(start, end, query.MustParse(qry), func(ev *exec.StreamEvent) error {
+			if ev.BeginBlock != nil {
+				heights = append(heights, ev.BeginBlock.Height)
+			}
+			return nil
+		})
+		require.NoError(t, err)
+		return heights, next
+	}
+
+	heights, next := stream("Address = '"+addr1.String()+"'", 0, 10)
+	assert.Equal(t, []uint64{2, 5}, heights)
+	// Blocks beyond the tip are left to be streamed
+	assert.Equal(t, uint64(8), next)
+
+	heights, _ = stream("Address = '"+addr2.String()+"' AND EventType = 'LogEvent'", 4, 7)
+	assert.Equal(t, []uint64{5}, heights)
+
+	heights, _ = stream("Address IN ('"+addr1.String()+"', '"+addr2.String()+"') AND TxType = 'CallTx'", 0, 7)
+	assert.Equal(t, []uint64{2, 3, 5}, heights)
+
+	heights, next = stream("Address = '"+crypto.Address{3}.String()+"'", 0, 7)
+	assert.Empty(t, heights)
+	assert.Equal(t, uint64(8), next)
+
+	// Unindexed queries scan every stored block
+	heights, _ = stream("Height > 0", 0, 7)
+	assert.Equal(t, []uint64{2, 3, 5}, heights)
+}
+
+type tip struct {
+	bcm.BlockchainInfo
+	height uint64
+}
+
+func (t tip) LastBlockHeight() uint64 {
+	return t.height
+}
+
+func mkBlock(height uint64, addresses ...crypto.Address) *exec.BlockExecution {
+	be := &exec.BlockExecution{Height: height}
+	for i, address := range addresses {
+		txHash := sha256.Sum256([]byte{byte(height), byte(i)})
+		txe := &exec.TxExecution{
+			TxHeader: &exec.TxHeader{
+				TxType: payload.TypeCall,
+				TxHash: txHash[:],
+				Height: height,
+				Index:  uint64(i),
+			},
+		}
+		txe.Append(&exec.Event{
+			Header: &exec.Header{
+				TxType:    payload.TypeCall,
+				EventType: exec.TypeLog,
+				Height:    height,
+			},
+			Log: &exec.LogEvent{Address: address},
+		})
+		be.TxExecutions = append(be.TxExecutions, txe)
+	}
+	return be
+}
+
+var _ Provider = &state.State{}
@@ -45,6 +45,8 @@ const (
 	Bound_LATEST Bound_BoundType = 3
 	// Ignore provided index and stream new objects as they are generated
 	Bound_STREAM Bound_BoundType = 4
+	// Ignore provided index and resume immediately after the StreamEvent with Cursor (Start bound of Stream only)
+	Bound_CURSOR Bound_BoundType = 5
 )
 
 var Bound_BoundType_name = map[int32]string{
@@ -53,6 +55,7 @@ var Bound_BoundType_name = map[int32]string{
 	2: "FIRST",
 	3: "LATEST",
 	4: "STREAM",
+	5: "CURSOR",
 }
 
 var Bound_BoundType_value = map[string]int32{
@@ -61,6 +64,7 @@ var Bound_BoundType_value = map[string]int32{
 	"FIRST":    2,
 	"LATEST":   3,
 	"STREAM":   4,
+	"CURSOR":   5,
 }
 
 func (x Bound_BoundType) String() string {
@@ -136,7 +140,9 @@ type TxRequest struct {
 	// Height of block required
 	TxHash github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,1,opt,name=TxHash,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"TxHash"`
 	// Whether to wait for the block to become available
-	Wait                 bool     `protobuf:"varint,2,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	Wait bool `protobuf:"varint,2,opt,name=Wait,proto3" json:"Wait,omitempty"`
+	// Whether to decode log and call events according to the ABIs of the contracts concerned
+	Decode               bool     `protobuf:"varint,3,opt,name=Decode,proto3" json:"Decode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -182,6 +188,13 @@ func (m *TxRequest) GetWait() bool {
 	return false
 }
 
+func (m *TxRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 func (*TxRequest) XXX_MessageName() string {
 	return "rpcevents.TxRequest"
 }
@@ -217,13 +230,26 @@ type BlocksRequest struct {
 	// StackDepth   | Integer    | uint64
 	// Exception    | String     | string
 	// -----------------------------------------
+	//   Decoded log and call events (when Decode is set)
+	// -----------------------------------------
+	// EventName    | String     | string
+	// FunctionName | String     | string
+	// Args.<name>  | String     | ABI argument formatted as string
+	// -----------------------------------------
 	//   Tx event (input/output)
 	// -----------------------------------------
 	// Exception  | String     | string
 	//
 	// For example:
 	// EventType = 'LogEvent' AND EventID CONTAINS 'bar' AND TxHash = '020304' AND Height >= 34 AND Index < 3 AND Address = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
-	Query                string   `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	//
+	// Conditions may also be negated with NOT and use IN, EXISTS, STARTS_WITH, and MATCHES (regular expression):
+	// NOT EventType = 'LogEvent' AND EXISTS Exception AND Origin IN ('DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF', 'FEEDFACEFEEDFACEFEEDFACEFEEDFACEFEEDFACE') AND EventID STARTS_WITH 'Log/' AND Exception MATCHES '^insufficient'
+	Query string `protobuf:"bytes,2,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Whether to decode log and call events according to the ABIs of the contracts concerned, which allows them to be
+	// queried by EventName or FunctionName and Args, for example:
+	// EventName = 'Transfer' AND Args.to = 'DEADBEEFDEADBEEFDEADBEEFDEADBEEFDEADBEEF'
+	Decode               bool     `protobuf:"varint,3,opt,name=Decode,proto3" json:"Decode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -276,6 +302,13 @@ func (m *BlocksRequest) GetQuery() string {
 	return ""
 }
 
+func (m *BlocksRequest) GetDecode() bool {
+	if m != nil {
+		return m.Decode
+	}
+	return false
+}
+
 func (*BlocksRequest) XXX_MessageName() string {
 	return "rpcevents.BlocksRequest"
 }
@@ -466,11 +499,13 @@ func (*GetTxsResponse) XXX_MessageName() string {
 }
 
 type Bound struct {
-	Type                 Bound_BoundType `protobuf:"varint,1,opt,name=Type,proto3,enum=rpcevents.Bound_BoundType" json:"Type,omitempty"`
-	Index                uint64          `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
-	XXX_unrecognized     []byte          `json:"-"`
-	XXX_sizecache        int32           `json:"-"`
+	Type  Bound_BoundType `protobuf:"varint,1,opt,name=Type,proto3,enum=rpcevents.Bound_BoundType" json:"Type,omitempty"`
+	Index uint64          `protobuf:"varint,2,opt,name=Index,proto3" json:"Index,omitempty"`
+	// Cursor of a StreamEvent previously received from Stream
+	Cursor               github_com_hyperledger_burrow_binary.HexBytes `protobuf:"bytes,3,opt,name=Cursor,proto3,customtype=github.com/hyperledger/burrow/binary.HexBytes" json:"Cursor"`
+	XXX_NoUnkeyedLiteral struct{}                                      `json:"-"`
+	XXX_unrecognized     []byte                                        `json:"-"`
+	XXX_sizecache        int32                                         `json:"-"`
 }
 
 func (m *Bound) Reset()         { *m = Bound{} }
@@ -531,6 +566,7 @@ type BlockRange struct {
 	// relative: block height counting back from latest
 	// latest: latest block when call is processed
 	// stream: for End keep sending new blocks, for start same as latest
+	// cursor: for Start resume from a StreamEvent previously received from Stream, skipping it and any events before it
 	Start                *Bound   `protobuf:"bytes,1,opt,name=Start,proto3" json:"Start,omitempty"`
 	End                  *Bound   `protobuf:"bytes,2,opt,name=End,proto3" json:"End,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -613,44 +649,46 @@ func init() { proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd6
 func init() { golang_proto.RegisterFile("rpcevents.proto", fileDescriptor_580b21d8d2fd68e4) }
 
 var fileDescriptor_580b21d8d2fd68e4 = []byte{
-	// 583 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0xed, 0xe6, 0xc3, 0xaa, 0x27, 0xfd, 0x30, 0xab, 0x82, 0x42, 0x84, 0xd2, 0xc8, 0x48, 0xa8,
-	0x12, 0xaa, 0x53, 0x05, 0x55, 0x9c, 0x10, 0x8a, 0x25, 0xd3, 0x16, 0xb5, 0x42, 0xac, 0x97, 0x0f,
-	0x21, 0x24, 0xe4, 0x38, 0x8b, 0x13, 0xd1, 0xda, 0xc6, 0x5e, 0x83, 0xf3, 0x53, 0xf8, 0x2b, 0x9c,
-	0x38, 0xf6, 0xc8, 0x99, 0x43, 0x85, 0xd2, 0x3f, 0x82, 0xbc, 0x6b, 0x3b, 0x4e, 0x29, 0xe5, 0x12,
-	0xed, 0xcc, 0x7b, 0x33, 0xf3, 0xf2, 0x76, 0xd6, 0xb0, 0x19, 0x85, 0x2e, 0xfb, 0xc2, 0x7c, 0x1e,
-	0x1b, 0x61, 0x14, 0xf0, 0x00, 0xab, 0x65, 0xa2, 0xb3, 0xeb, 0x4d, 0xf9, 0x24, 0x19, 0x19, 0x6e,
-	0x70, 0xd6, 0xf7, 0x02, 0x2f, 0xe8, 0x0b, 0xc6, 0x28, 0xf9, 0x28, 0x22, 0x11, 0x88, 0x93, 0xac,
-	0xec, 0x00, 0x4b, 0x99, 0x2b, 0xcf, 0xfa, 0x13, 0xd8, 0x3c, 0x60, 0xdc, 0x3c, 0x0d, 0xdc, 0x4f,
-	0x84, 0x7d, 0x4e, 0x58, 0xcc, 0xf1, 0x1d, 0x50, 0x0e, 0xd9, 0xd4, 0x9b, 0xf0, 0x36, 0xea, 0xa1,
-	0x9d, 0x06, 0xc9, 0x23, 0x8c, 0xa1, 0xf1, 0xc6, 0x99, 0xf2, 0x76, 0xad, 0x87, 0x76, 0x56, 0x89,
-	0x38, 0xeb, 0x3e, 0xa8, 0x34, 0x2d, 0x0a, 0x4f, 0x40, 0xa1, 0xe9, 0xa1, 0x13, 0x4f, 0x44, 0xe1,
-	0x9a, 0xb9, 0x7f, 0x7e, 0xb1, 0xbd, 0xf2, 0xeb, 0x62, 0xbb, 0x2a, 0x6f, 0x32, 0x0b, 0x59, 0x74,
-	0xca, 0xc6, 0x1e, 0x8b, 0xfa, 0xa3, 0x24, 0x8a, 0x82, 0xaf, 0xfd, 0xd1, 0xd4, 0x77, 0xa2, 0x99,
-	0x71, 0xc8, 0x52, 0x73, 0xc6, 0x59, 0x4c, 0xf2, 0x26, 0xd7, 0xce, 0x7b, 0x0f, 0xeb, 0x42, 0x6b,
-	0x5c, 0xcc, 0xdc, 0x07, 0x90, 0xe2, 0x1d, 0xdf, 0x63, 0x62, 0x6e, 0x6b, 0x70, 0xdb, 0x58, 0x78,
-	0xb5, 0x00, 0x49, 0x85, 0x88, 0xb7, 0xa0, 0xf9, 0x32, 0x61, 0xd1, 0x4c, 0x34, 0x57, 0x89, 0x0c,
-	0xf4, 0x13, 0xd8, 0xb0, 0x44, 0x19, 0x61, 0x71, 0x18, 0xf8, 0x31, 0xfb, 0xa7, 0x17, 0xf7, 0x41,
-	0x91, 0xcc, 0x76, 0xad, 0x57, 0xdf, 0x69, 0x0d, 0x5a, 0x86, 0xf0, 0x54, 0xe4, 0x48, 0x0e, 0xe9,
-	0x0c, 0xd6, 0x0f, 0x18, 0xa7, 0x69, 0x29, 0xb6, 0x07, 0x2d, 0x9b, 0x3b, 0x11, 0x5f, 0x6a, 0x59,
-	0x4d, 0xe1, 0x7b, 0xa0, 0x5a, 0xfe, 0x38, 0xc7, 0x6b, 0x02, 0x5f, 0x24, 0x16, 0xaa, 0xeb, 0x55,
-	0xd5, 0x1f, 0x60, 0xa3, 0x18, 0xf3, 0x1f, 0xd5, 0xfb, 0xb0, 0x46, 0x53, 0x2b, 0x65, 0x6e, 0xc2,
-	0xa7, 0x81, 0x5f, 0x68, 0xbf, 0x25, 0xb5, 0x57, 0x10, 0xb2, 0x44, 0xd3, 0xbf, 0x21, 0x68, 0x9a,
-	0x41, 0xe2, 0x8f, 0xb1, 0x01, 0x0d, 0x3a, 0x0b, 0xa5, 0xcf, 0x1b, 0x83, 0x4e, 0xd5, 0xe7, 0x0c,
-	0x97, 0xbf, 0x19, 0x83, 0x08, 0x5e, 0x26, 0xf8, 0xc8, 0x1f, 0xb3, 0x34, 0xff, 0x2b, 0x32, 0xd0,
-	0x9f, 0x83, 0x5a, 0x12, 0xf1, 0x1a, 0xac, 0x0e, 0x4d, 0xfb, 0xc5, 0xf1, 0x2b, 0x6a, 0x69, 0x2b,
-	0x59, 0x44, 0xac, 0xe3, 0x21, 0x3d, 0x7a, 0x6d, 0x69, 0x08, 0xab, 0xd0, 0x7c, 0x76, 0x44, 0x6c,
-	0xaa, 0xd5, 0x30, 0x80, 0x72, 0x3c, 0xa4, 0x96, 0x4d, 0xb5, 0x7a, 0x76, 0xb6, 0x29, 0xb1, 0x86,
-	0x27, 0x5a, 0x43, 0x7f, 0x5b, 0xbd, 0x7f, 0xfc, 0x00, 0x9a, 0xc2, 0xcd, 0x7c, 0x11, 0xb4, 0xab,
-	0x02, 0x89, 0x84, 0xb1, 0x0e, 0x75, 0xcb, 0x1f, 0x0b, 0x55, 0xd7, 0xb1, 0x32, 0x70, 0xf0, 0x1d,
-	0xc1, 0x66, 0x69, 0x82, 0xbc, 0x51, 0xfc, 0x18, 0x14, 0x9b, 0x47, 0xcc, 0x39, 0xc3, 0xed, 0xab,
-	0x3b, 0x56, 0x5c, 0x72, 0x27, 0xb7, 0x53, 0xf2, 0x44, 0xdd, 0x1e, 0xc2, 0xbb, 0x50, 0xa3, 0x29,
-	0xde, 0xaa, 0x14, 0x95, 0xcf, 0xa6, 0xf3, 0xb7, 0xff, 0xf8, 0x69, 0xb1, 0x5e, 0x37, 0xcc, 0xb9,
-	0x5b, 0x41, 0x96, 0xb7, 0x76, 0x0f, 0x99, 0xc3, 0xf3, 0x79, 0x17, 0xfd, 0x9c, 0x77, 0xd1, 0xef,
-	0x79, 0x17, 0xfd, 0xb8, 0xec, 0xa2, 0xf3, 0xcb, 0x2e, 0x7a, 0xf7, 0xf0, 0xe6, 0x87, 0x18, 0x85,
-	0x6e, 0xbf, 0xec, 0x39, 0x52, 0xc4, 0x07, 0xe2, 0xd1, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x65,
-	0xc9, 0x65, 0x0f, 0x79, 0x04, 0x00, 0x00,
+	// 622 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0xee, 0xe6, 0x4b, 0xcd, 0xa4, 0x1f, 0x7e, 0x57, 0x7d, 0x91, 0x89, 0x50, 0x1a, 0x19, 0x09,
+	0x55, 0x42, 0x4d, 0xaa, 0xa0, 0x8a, 0x13, 0x42, 0x49, 0x31, 0x6d, 0xa5, 0x56, 0x15, 0xeb, 0x6d,
+	0x41, 0x5c, 0x50, 0xe2, 0x0c, 0x49, 0x44, 0xeb, 0x0d, 0xeb, 0x35, 0x38, 0x7f, 0x80, 0x1f, 0xc5,
+	0x89, 0x63, 0x8f, 0x9c, 0x39, 0x54, 0xa8, 0xfd, 0x0b, 0xfc, 0x00, 0xe4, 0x5d, 0x27, 0x71, 0x2b,
+	0x5a, 0x0e, 0x5c, 0xa2, 0x9d, 0x79, 0xe6, 0xe3, 0x99, 0x99, 0x27, 0x86, 0x55, 0x39, 0xf6, 0xf1,
+	0x13, 0x06, 0x2a, 0x6c, 0x8c, 0xa5, 0x50, 0x82, 0x96, 0x67, 0x8e, 0xea, 0xe6, 0x60, 0xa4, 0x86,
+	0x51, 0xaf, 0xe1, 0x8b, 0xb3, 0xe6, 0x40, 0x0c, 0x44, 0x53, 0x47, 0xf4, 0xa2, 0xf7, 0xda, 0xd2,
+	0x86, 0x7e, 0x99, 0xcc, 0x2a, 0x60, 0x8c, 0xbe, 0x79, 0x3b, 0xcf, 0x60, 0x75, 0x17, 0x55, 0xe7,
+	0x54, 0xf8, 0x1f, 0x18, 0x7e, 0x8c, 0x30, 0x54, 0xf4, 0x1e, 0x94, 0xf6, 0x70, 0x34, 0x18, 0x2a,
+	0x9b, 0xd4, 0xc9, 0x46, 0x81, 0xa5, 0x16, 0xa5, 0x50, 0x78, 0xdd, 0x1d, 0x29, 0x3b, 0x57, 0x27,
+	0x1b, 0x8b, 0x4c, 0xbf, 0x9d, 0x2f, 0x04, 0xca, 0x3c, 0x9e, 0x66, 0x1e, 0x42, 0x89, 0xc7, 0x7b,
+	0xdd, 0x70, 0xa8, 0x33, 0x97, 0x3a, 0xdb, 0xe7, 0x17, 0xeb, 0x0b, 0x3f, 0x2e, 0xd6, 0xb3, 0xfc,
+	0x86, 0x93, 0x31, 0xca, 0x53, 0xec, 0x0f, 0x50, 0x36, 0x7b, 0x91, 0x94, 0xe2, 0x73, 0xb3, 0x37,
+	0x0a, 0xba, 0x72, 0xd2, 0xd8, 0xc3, 0xb8, 0x33, 0x51, 0x18, 0xb2, 0xb4, 0xc8, 0x9f, 0x1a, 0x26,
+	0xe4, 0x5e, 0xa0, 0x2f, 0xfa, 0x68, 0xe7, 0xb5, 0x37, 0xb5, 0x1c, 0x05, 0xcb, 0x7a, 0x88, 0x70,
+	0xca, 0x65, 0x1b, 0xc0, 0x4c, 0xd5, 0x0d, 0x06, 0xa8, 0xf9, 0x54, 0x5a, 0xff, 0x37, 0xe6, 0x4b,
+	0x9c, 0x83, 0x2c, 0x13, 0x48, 0xd7, 0xa0, 0xf8, 0x2a, 0x42, 0x39, 0xd1, 0x4d, 0xcb, 0xcc, 0x18,
+	0xb7, 0x76, 0x3d, 0x84, 0x15, 0x57, 0x97, 0x63, 0x18, 0x8e, 0x45, 0x10, 0xe2, 0xad, 0xcb, 0x7b,
+	0x08, 0x25, 0x13, 0x69, 0xe7, 0xea, 0xf9, 0x8d, 0x4a, 0xab, 0xd2, 0xd0, 0x47, 0xd0, 0x3e, 0x96,
+	0x42, 0x0e, 0xc2, 0xf2, 0x2e, 0x2a, 0x1e, 0xcf, 0x86, 0xa8, 0x43, 0xc5, 0x53, 0x5d, 0xa9, 0xae,
+	0x95, 0xcc, 0xba, 0xe8, 0x03, 0x28, 0xbb, 0x41, 0x3f, 0xc5, 0x73, 0x1a, 0x9f, 0x3b, 0xe6, 0xd3,
+	0xe4, 0x33, 0xd3, 0x38, 0xef, 0x60, 0x65, 0xda, 0xe6, 0x2f, 0xac, 0xb7, 0x61, 0x89, 0xc7, 0x6e,
+	0x8c, 0x7e, 0xa4, 0x46, 0x22, 0x98, 0x72, 0xff, 0xcf, 0x70, 0xcf, 0x20, 0xec, 0x5a, 0x98, 0xf3,
+	0x8b, 0x40, 0xb1, 0x23, 0xa2, 0xa0, 0x4f, 0x1b, 0x50, 0xe0, 0x93, 0xb1, 0xd9, 0xff, 0x4a, 0xab,
+	0x9a, 0xdd, 0x7f, 0x82, 0x9b, 0xdf, 0x24, 0x82, 0xe9, 0xb8, 0x84, 0xf0, 0x7e, 0xd0, 0xc7, 0x38,
+	0x1d, 0xc5, 0x18, 0x89, 0xae, 0x76, 0x22, 0x19, 0x0a, 0x69, 0xe7, 0xff, 0x49, 0x57, 0xa6, 0x88,
+	0x73, 0x02, 0xe5, 0x59, 0x5f, 0xba, 0x04, 0x8b, 0xed, 0x8e, 0x77, 0x74, 0x70, 0xcc, 0x5d, 0x6b,
+	0x21, 0xb1, 0x98, 0x7b, 0xd0, 0xe6, 0xfb, 0x27, 0xae, 0x45, 0x68, 0x19, 0x8a, 0x2f, 0xf7, 0x99,
+	0xc7, 0xad, 0x1c, 0x05, 0x28, 0x1d, 0xb4, 0xb9, 0xeb, 0x71, 0x2b, 0x9f, 0xbc, 0x3d, 0xce, 0xdc,
+	0xf6, 0xa1, 0x55, 0x48, 0xde, 0x3b, 0xc7, 0xcc, 0x3b, 0x62, 0x56, 0xd1, 0x79, 0x93, 0x95, 0x1c,
+	0x7d, 0x04, 0x45, 0x7d, 0xa8, 0x54, 0x7b, 0xd6, 0xcd, 0xd9, 0x99, 0x81, 0xa9, 0x03, 0x79, 0x37,
+	0xe8, 0xdb, 0xb9, 0x5b, 0xa2, 0x12, 0xb0, 0xf5, 0x95, 0xc0, 0xea, 0x6c, 0xbf, 0x46, 0x2c, 0xf4,
+	0x29, 0x94, 0x3c, 0x25, 0xb1, 0x7b, 0x46, 0xed, 0x9b, 0xb2, 0x9e, 0xea, 0xa7, 0x9a, 0x5e, 0xca,
+	0xc4, 0xe9, 0xbc, 0x2d, 0x42, 0x37, 0x21, 0xc7, 0x63, 0xba, 0x96, 0x49, 0xe2, 0xf1, 0x8d, 0x84,
+	0xcc, 0x35, 0xe9, 0xf3, 0xa9, 0x72, 0xef, 0xe8, 0x73, 0x3f, 0x83, 0x5c, 0xff, 0x43, 0x6c, 0x91,
+	0x4e, 0xfb, 0xfc, 0xb2, 0x46, 0xbe, 0x5f, 0xd6, 0xc8, 0xcf, 0xcb, 0x1a, 0xf9, 0x76, 0x55, 0x23,
+	0xe7, 0x57, 0x35, 0xf2, 0xf6, 0xf1, 0xdd, 0xb7, 0x93, 0x63, 0xbf, 0x39, 0xab, 0xd9, 0x2b, 0xe9,
+	0x8f, 0xd5, 0x93, 0xdf, 0x03, 0x00, 0x79, 0xec, 0x3c, 0xb7, 0x05, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -934,6 +972,16 @@ func (m *TxRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if m.Wait {
 		i--
 		if m.Wait {
@@ -981,6 +1029,16 @@ func (m *BlocksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	if m.Decode {
+		i--
+		if m.Decode {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Query) > 0 {
 		i -= len(m.Query)
 		copy(dAtA[i:], m.Query)
@@ -1163,6 +1221,16 @@ func (m *Bound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= len(m.XXX_unrecognized)
 		copy(dAtA[i:], m.XXX_unrecognized)
 	}
+	{
+		size := m.Cursor.Size()
+		i -= size
+		if _, err := m.Cursor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRpcevents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Index != 0 {
 		i = encodeVarintRpcevents(dAtA, i, uint64(m.Index))
 		i--
@@ -1267,6 +1335,9 @@ func (m *TxRequest) Size() (n int) {
 	if m.Wait {
 		n += 2
 	}
+	if m.Decode {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1287,6 +1358,9 @@ func (m *BlocksRequest) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcevents(uint64(l))
 	}
+	if m.Decode {
+		n += 2
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1369,6 +1443,8 @@ func (m *Bound) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovRpcevents(uint64(m.Index))
 	}
+	l = m.Cursor.Size()
+	n += 1 + l + sovRpcevents(uint64(l))
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1576,6 +1652,26 @@ func (m *TxRequest) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.Wait = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -1698,6 +1794,26 @@ func (m *BlocksRequest) Unmarshal(dAtA []byte) error {
 			}
 			m.Query = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decode", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Decode = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -2128,6 +2244,39 @@ func (m *Bound) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cursor", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRpcevents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRpcevents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Cursor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRpcevents(dAtA[iNdEx:])
@@ -0,0 +1,173 @@
+package rpcgraphql
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/graphql-go/graphql"
+	"github.com/graphql-go/graphql/language/ast"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Values that cannot be serialised or parsed are returned as nil, which graphql reports as an error
+
+var Uint64 = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Uint64",
+	Description: "An unsigned 64-bit integer, may be given as a decimal string",
+	Serialize: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case uint64:
+			return v
+		case int64:
+			if v >= 0 {
+				return uint64(v)
+			}
+		case int:
+			if v >= 0 {
+				return uint64(v)
+			}
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case int:
+			if v >= 0 {
+				return uint64(v)
+			}
+		case float64:
+			if v >= 0 && v == math.Trunc(v) && v < math.MaxUint64 {
+				return uint64(v)
+			}
+		case string:
+			return parseUint64(v)
+		}
+		return nil
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		switch v := valueAST.(type) {
+		case *ast.IntValue:
+			return parseUint64(v.Value)
+		case *ast.StringValue:
+			return parseUint64(v.Value)
+		}
+		return nil
+	},
+})
+
+var Address = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Address",
+	Description: "A 20-byte account address in hex",
+	Serialize: func(value interface{}) interface{} {
+		address, ok := value.(crypto.Address)
+		if !ok {
+			return nil
+		}
+		return address.String()
+	},
+	ParseValue: func(value interface{}) interface{} {
+		str, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		return parseAddress(str)
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		str, ok := valueAST.(*ast.StringValue)
+		if !ok {
+			return nil
+		}
+		return parseAddress(str.Value)
+	},
+})
+
+var Bytes = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Bytes",
+	Description: "A byte string in hex",
+	Serialize: func(value interface{}) interface{} {
+		switch v := value.(type) {
+		case []byte:
+			return strings.ToUpper(hex.EncodeToString(v))
+		case fmt.Stringer:
+			// HexBytes, Word256, and Bytecode all print as upper-case hex
+			return v.String()
+		}
+		return nil
+	},
+	ParseValue: func(value interface{}) interface{} {
+		str, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		return parseBytes(str)
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		str, ok := valueAST.(*ast.StringValue)
+		if !ok {
+			return nil
+		}
+		return parseBytes(str.Value)
+	},
+})
+
+var Time = graphql.NewScalar(graphql.ScalarConfig{
+	Name:        "Time",
+	Description: "A UTC timestamp in RFC 3339 format",
+	Serialize: func(value interface{}) interface{} {
+		t, ok := value.(time.Time)
+		if !ok {
+			return nil
+		}
+		return t.UTC().Format(time.RFC3339Nano)
+	},
+	ParseValue: func(value interface{}) interface{} {
+		str, ok := value.(string)
+		if !ok {
+			return nil
+		}
+		return parseTime(str)
+	},
+	ParseLiteral: func(valueAST ast.Value) interface{} {
+		str, ok := valueAST.(*ast.StringValue)
+		if !ok {
+			return nil
+		}
+		return parseTime(str.Value)
+	},
+})
+
+func parseUint64(str string) interface{} {
+	u, err := strconv.ParseUint(str, 10, 64)
+	if err != nil {
+		return nil
+	}
+	return u
+}
+
+func parseAddress(str string) interface{} {
+	address, err := crypto.AddressFromHexString(strings.TrimPrefix(str, "0x"))
+	if err != nil {
+		return nil
+	}
+	return address
+}
+
+func parseBytes(str string) interface{} {
+	bs, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+	if err != nil {
+		return nil
+	}
+	return bs
+}
+
+func parseTime(str string) interface{} {
+	t, err := time.Parse(time.RFC3339Nano, str)
+	if err != nil {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,702 @@
+package rpcgraphql
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/graphql-go/graphql"
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/errors"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/permission"
+	"github.com/hyperledger/burrow/rpc"
+	libgraphql "github.com/hyperledger/burrow/rpc/lib/graphql"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	tmtypes "github.com/tendermint/tendermint/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// The most blocks that may be read by a single query
+	MaxBlockRange = 1000
+	// The number of accounts or names returned by a list field when first is not given, and the most that may be
+	DefaultPageSize = 100
+	MaxPageSize     = 1000
+)
+
+type resolvers struct {
+	query      rpcquery.QueryServer
+	events     rpcevents.ExecutionEventsServer
+	blockchain bcm.BlockchainInfo
+	logger     *logging.Logger
+}
+
+// NewSchema builds the GraphQL schema of a chain, reading state from the query and events servers
+func NewSchema(query rpcquery.QueryServer, events rpcevents.ExecutionEventsServer, blockchain bcm.BlockchainInfo,
+	logger *logging.Logger) (*libgraphql.Schema, error) {
+
+	rs := &resolvers{
+		query:      query,
+		events:     events,
+		blockchain: blockchain,
+		logger:     logger.WithScope("rpcgraphql"),
+	}
+
+	decode := &graphql.ArgumentConfig{Type: graphql.Boolean, DefaultValue: false,
+		Description: "Decode log and call events with the ABIs of the contracts involved"}
+	queryArg := &graphql.ArgumentConfig{Type: graphql.String, Description: "A query in the syntax of the GRPC API"}
+	first := &graphql.ArgumentConfig{Type: graphql.Int, DefaultValue: DefaultPageSize,
+		Description: fmt.Sprintf("The number of items to list, at most %d", MaxPageSize)}
+
+	account := graphql.NewObject(graphql.ObjectConfig{Name: "Account", Fields: graphql.Fields{
+		"address": {Type: graphql.NewNonNull(Address)},
+		"publicKey": {Type: Bytes, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			acc := params.Source.(*acm.Account)
+			if !acc.PublicKey.IsSet() {
+				return nil, nil
+			}
+			return acc.PublicKey.PublicKey, nil
+		}},
+		"sequence": {Type: graphql.NewNonNull(Uint64)},
+		"balance":  {Type: graphql.NewNonNull(Uint64)},
+		"evmCode": {Type: Bytes, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return nonEmpty(params.Source.(*acm.Account).EVMCode.Bytes()), nil
+		}},
+		"wasmCode": {Type: Bytes, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return nonEmpty(params.Source.(*acm.Account).WASMCode.Bytes()), nil
+		}},
+		"nativeName": {Type: graphql.String},
+		"permissions": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			Description: "The base permissions set for the account",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return permission.BasePermissionsToStringList(params.Source.(*acm.Account).Permissions.Base), nil
+			}},
+		"roles": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.String))),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*acm.Account).Permissions.GetRoles(), nil
+			}},
+		"storage": {Type: Bytes, Description: "The value stored at a key of the account's storage",
+			Args: graphql.FieldConfigArgument{"key": {Type: graphql.NewNonNull(Bytes)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				value, err := rs.query.GetStorage(params.Context, &rpcquery.GetStorageParam{
+					Address: params.Source.(*acm.Account).Address,
+					Key:     binary.LeftPadWord256(params.Args["key"].([]byte)),
+				})
+				if err != nil {
+					return nil, err
+				}
+				return nonEmpty(value.Value), nil
+			}},
+	}})
+	// Resolves the account at the address returned by the resolver, if any
+	accountAt := func(address func(source interface{}) crypto.Address) graphql.FieldResolveFn {
+		return func(params graphql.ResolveParams) (interface{}, error) {
+			return rs.account(params.Context, address(params.Source))
+		}
+	}
+
+	exception := graphql.NewObject(graphql.ObjectConfig{Name: "Exception", Fields: graphql.Fields{
+		"code": {Type: graphql.NewNonNull(graphql.Int), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*errors.Exception).CodeNumber, nil
+		}},
+		"name": {Type: graphql.String, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			if code := params.Source.(*errors.Exception).ErrorCode(); code != nil {
+				return code.Name, nil
+			}
+			return nil, nil
+		}},
+		"message": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*errors.Exception).Exception, nil
+		}},
+		"revertReason": {Type: graphql.String, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			if reason := params.Source.(*errors.Exception).RevertReason; reason != "" {
+				return reason, nil
+			}
+			return nil, nil
+		}},
+	}})
+
+	abiDecoding := graphql.NewObject(graphql.ObjectConfig{Name: "ABIDecoding", Fields: graphql.Fields{
+		"name": {Type: graphql.NewNonNull(graphql.String)},
+		"args": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: "ABIArgument",
+			Fields: graphql.Fields{
+				"name":    {Type: graphql.NewNonNull(graphql.String)},
+				"type":    {Type: graphql.NewNonNull(graphql.String)},
+				"value":   {Type: graphql.NewNonNull(graphql.String)},
+				"indexed": {Type: graphql.NewNonNull(graphql.Boolean)},
+			},
+		}))))},
+	}})
+
+	logEvent := graphql.NewObject(graphql.ObjectConfig{Name: "LogEvent", Fields: graphql.Fields{
+		"address": {Type: graphql.NewNonNull(Address)},
+		"account": {Type: account, Resolve: accountAt(func(source interface{}) crypto.Address {
+			return source.(*exec.LogEvent).Address
+		})},
+		"data":    {Type: graphql.NewNonNull(Bytes)},
+		"topics":  {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(Bytes)))},
+		"decoded": {Type: abiDecoding, Description: "Present when the events were requested with decode"},
+	}})
+
+	callEvent := graphql.NewObject(graphql.ObjectConfig{Name: "CallEvent", Fields: graphql.Fields{
+		"callType": {Type: graphql.NewNonNull(graphql.String)},
+		"caller": {Type: graphql.NewNonNull(Address), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.CallEvent).CallData.Caller, nil
+		}},
+		"callerAccount": {Type: account, Resolve: accountAt(func(source interface{}) crypto.Address {
+			return source.(*exec.CallEvent).CallData.Caller
+		})},
+		"callee": {Type: graphql.NewNonNull(Address), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.CallEvent).CallData.Callee, nil
+		}},
+		"calleeAccount": {Type: account, Resolve: accountAt(func(source interface{}) crypto.Address {
+			return source.(*exec.CallEvent).CallData.Callee
+		})},
+		"data": {Type: graphql.NewNonNull(Bytes), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.CallEvent).CallData.Data, nil
+		}},
+		"value": {Type: graphql.NewNonNull(Uint64), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.CallEvent).CallData.Value, nil
+		}},
+		"gas": {Type: graphql.NewNonNull(Uint64), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.CallEvent).CallData.Gas, nil
+		}},
+		"origin":     {Type: graphql.NewNonNull(Address)},
+		"stackDepth": {Type: graphql.NewNonNull(Uint64)},
+		"return":     {Type: graphql.NewNonNull(Bytes)},
+		"decoded":    {Type: abiDecoding, Description: "Present when the events were requested with decode"},
+	}})
+
+	addressEvent := func(name string) *graphql.Object {
+		return graphql.NewObject(graphql.ObjectConfig{Name: name, Fields: graphql.Fields{
+			"address": {Type: graphql.NewNonNull(Address)},
+			"account": {Type: account, Resolve: accountAt(func(source interface{}) crypto.Address {
+				switch ev := source.(type) {
+				case *exec.InputEvent:
+					return ev.Address
+				default:
+					return ev.(*exec.OutputEvent).Address
+				}
+			})},
+		}})
+	}
+
+	event := graphql.NewObject(graphql.ObjectConfig{Name: "Event", Fields: graphql.Fields{
+		"type": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.Event).EventType().String(), nil
+		}},
+		"eventID": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.Event).Header.GetEventID(), nil
+		}},
+		"txHash": {Type: graphql.NewNonNull(Bytes), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			if header := params.Source.(*exec.Event).Header; header != nil {
+				return header.TxHash, nil
+			}
+			return nil, fmt.Errorf("event has no header")
+		}},
+		"height": {Type: graphql.NewNonNull(Uint64), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.Event).Header.GetHeight(), nil
+		}},
+		"index": {Type: graphql.NewNonNull(Uint64), Description: "The index of the event within its transaction",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*exec.Event).Header.GetIndex(), nil
+			}},
+		"exception": {Type: exception, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.Event).Header.GetException(), nil
+		}},
+		"input":  {Type: addressEvent("InputEvent")},
+		"output": {Type: addressEvent("OutputEvent")},
+		"call":   {Type: callEvent},
+		"log":    {Type: logEvent},
+	}})
+
+	var txExecution *graphql.Object
+	txExecution = graphql.NewObject(graphql.ObjectConfig{Name: "TxExecution", Fields: graphql.FieldsThunk(func() graphql.Fields {
+		return graphql.Fields{
+			"hash": {Type: graphql.NewNonNull(Bytes), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*exec.TxExecution).GetTxHash(), nil
+			}},
+			"txType": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*exec.TxExecution).GetTxType().String(), nil
+			}},
+			"height": {Type: graphql.NewNonNull(Uint64), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*exec.TxExecution).GetHeight(), nil
+			}},
+			"index": {Type: graphql.NewNonNull(Uint64), Description: "The index of the transaction within its block",
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					return params.Source.(*exec.TxExecution).GetIndex(), nil
+				}},
+			"signers": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(Address))),
+				Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+					var signers []crypto.Address
+					if env := params.Source.(*exec.TxExecution).Envelope; env != nil {
+						for _, sig := range env.Signatories {
+							if sig.Address != nil {
+								signers = append(signers, *sig.Address)
+							}
+						}
+					}
+					return signers, nil
+				}},
+			"result": {Type: graphql.NewObject(graphql.ObjectConfig{Name: "Result", Fields: graphql.Fields{
+				"return":  {Type: graphql.NewNonNull(Bytes)},
+				"gasUsed": {Type: graphql.NewNonNull(Uint64)},
+			}})},
+			"exception": {Type: exception},
+			"events":    {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(event)))},
+			"txExecutions": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(txExecution))),
+				Description: "The executions of the transactions of a proposal"},
+		}
+	})})
+
+	block := graphql.NewObject(graphql.ObjectConfig{Name: "Block", Fields: graphql.Fields{
+		"height": {Type: graphql.NewNonNull(Uint64)},
+		"hash": {Type: Bytes, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			hash, err := rs.blockchain.BlockHash(params.Source.(*exec.BlockExecution).Height)
+			if err != nil {
+				return nil, err
+			}
+			return nonEmpty(hash), nil
+		}},
+		"time": {Type: graphql.NewNonNull(Time), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.BlockExecution).Header.GetTime(), nil
+		}},
+		"chainID": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*exec.BlockExecution).Header.GetChainID(), nil
+		}},
+		"appHash": {Type: Bytes, Description: "The hash of the application state after the previous block",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return nonEmpty(params.Source.(*exec.BlockExecution).Header.GetAppHash()), nil
+			}},
+		"proposerAddress": {Type: Bytes, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return nonEmpty(params.Source.(*exec.BlockExecution).Header.GetProposerAddress()), nil
+		}},
+		"numTxs": {Type: graphql.NewNonNull(graphql.Int), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return len(params.Source.(*exec.BlockExecution).TxExecutions), nil
+		}},
+		"txs": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(txExecution))),
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return params.Source.(*exec.BlockExecution).TxExecutions, nil
+			}},
+	}})
+
+	name := graphql.NewObject(graphql.ObjectConfig{Name: "Name", Fields: graphql.Fields{
+		"name":    {Type: graphql.NewNonNull(graphql.String)},
+		"owner":   {Type: graphql.NewNonNull(Address)},
+		"data":    {Type: graphql.NewNonNull(graphql.String)},
+		"expires": {Type: graphql.NewNonNull(Uint64), Description: "The height at which the name expires"},
+	}})
+
+	proposal := graphql.NewObject(graphql.ObjectConfig{Name: "Proposal", Fields: graphql.Fields{
+		"hash": {Type: graphql.NewNonNull(Bytes)},
+		"state": {Type: graphql.NewNonNull(graphql.String), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*rpcquery.ProposalResult).Ballot.GetProposalState().String(), nil
+		}},
+		"name": {Type: graphql.String, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			if proposal := params.Source.(*rpcquery.ProposalResult).Ballot.GetProposal(); proposal != nil {
+				return proposal.Name, nil
+			}
+			return nil, nil
+		}},
+		"description": {Type: graphql.String, Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			if proposal := params.Source.(*rpcquery.ProposalResult).Ballot.GetProposal(); proposal != nil {
+				return proposal.Description, nil
+			}
+			return nil, nil
+		}},
+		"finalizingTx": {Type: Bytes, Description: "The hash of the transaction that executed the proposal",
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				if ballot := params.Source.(*rpcquery.ProposalResult).Ballot; ballot != nil && ballot.FinalizingTx != nil {
+					return *ballot.FinalizingTx, nil
+				}
+				return nil, nil
+			}},
+		"votes": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(graphql.NewObject(graphql.ObjectConfig{
+			Name: "Vote",
+			Fields: graphql.Fields{
+				"address":      {Type: graphql.NewNonNull(Address)},
+				"votingWeight": {Type: graphql.NewNonNull(Uint64)},
+			},
+		})))), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return params.Source.(*rpcquery.ProposalResult).Ballot.GetVotes(), nil
+		}},
+	}})
+
+	statusType := graphql.NewObject(graphql.ObjectConfig{Name: "Status", Fields: graphql.Fields{
+		"chainID":       {Type: graphql.NewNonNull(graphql.String)},
+		"runID":         {Type: graphql.NewNonNull(graphql.String)},
+		"burrowVersion": {Type: graphql.NewNonNull(graphql.String)},
+		"genesisHash":   {Type: graphql.NewNonNull(Bytes)},
+		"catchingUp":    {Type: graphql.NewNonNull(graphql.Boolean)},
+		"latestBlockHeight": {Type: graphql.NewNonNull(Uint64), Resolve: syncInfo(func(si *bcm.SyncInfo) interface{} {
+			return si.LatestBlockHeight
+		})},
+		"latestBlockHash": {Type: Bytes, Resolve: syncInfo(func(si *bcm.SyncInfo) interface{} {
+			return nonEmpty(si.LatestBlockHash)
+		})},
+		"latestBlockTime": {Type: Time, Resolve: syncInfo(func(si *bcm.SyncInfo) interface{} {
+			return si.LatestBlockTime
+		})},
+		"latestBlockSeenTime": {Type: Time, Resolve: syncInfo(func(si *bcm.SyncInfo) interface{} {
+			return si.LatestBlockSeenTime
+		})},
+	}})
+
+	queryType := graphql.NewObject(graphql.ObjectConfig{Name: "Query", Fields: graphql.Fields{
+		"status": {Type: graphql.NewNonNull(statusType), Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+			return rs.query.Status(params.Context, &rpcquery.StatusParam{})
+		}},
+		"block": {Type: block, Description: "The block at a height, or null if it has not been committed",
+			Args: graphql.FieldConfigArgument{"height": {Type: graphql.NewNonNull(Uint64)}, "decode": decode},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				height := params.Args["height"].(uint64)
+				return rs.block(params.Context, height, params.Args["decode"].(bool))
+			}},
+		"blocks": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(block))),
+			Description: fmt.Sprintf("The blocks containing transactions between two heights inclusive, "+
+				"at most %d blocks may be read at once", MaxBlockRange),
+			Args: graphql.FieldConfigArgument{
+				"from":   {Type: graphql.NewNonNull(Uint64)},
+				"to":     {Type: Uint64, Description: "Defaults to the latest block"},
+				"decode": decode,
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				from, to, err := rs.blockRange(params.Args)
+				if err != nil {
+					return nil, err
+				}
+				return rs.blocks(params.Context, from, to, params.Args["decode"].(bool))
+			}},
+		"tx": {Type: txExecution, Description: "A transaction by hash",
+			Args: graphql.FieldConfigArgument{
+				"hash": {Type: graphql.NewNonNull(Bytes)},
+				"wait": {Type: graphql.Boolean, DefaultValue: false,
+					Description: "Wait for the transaction to be committed if it has not been"},
+				"decode": decode,
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return rs.events.Tx(params.Context, &rpcevents.TxRequest{
+					TxHash: params.Args["hash"].([]byte),
+					Wait:   params.Args["wait"].(bool),
+					Decode: params.Args["decode"].(bool),
+				})
+			}},
+		"events": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(event))),
+			Description: fmt.Sprintf("The events of successful transactions between two heights inclusive, "+
+				"at most %d blocks may be read at once", MaxBlockRange),
+			Args: graphql.FieldConfigArgument{
+				"from":   {Type: graphql.NewNonNull(Uint64)},
+				"to":     {Type: Uint64, Description: "Defaults to the latest block"},
+				"query":  queryArg,
+				"decode": decode,
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				from, to, err := rs.blockRange(params.Args)
+				if err != nil {
+					return nil, err
+				}
+				qry, _ := params.Args["query"].(string)
+				return rs.eventsBetween(params.Context, from, to, qry, params.Args["decode"].(bool))
+			}},
+		"account": {Type: account, Description: "An account by address, or null if there is no such account",
+			Args: graphql.FieldConfigArgument{"address": {Type: graphql.NewNonNull(Address)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				return rs.account(params.Context, params.Args["address"].(crypto.Address))
+			}},
+		"accounts": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(account))),
+			Description: "A page of accounts in ascending order of address",
+			Args: graphql.FieldConfigArgument{
+				"query": queryArg,
+				"first": first,
+				"after": {Type: Address, Description: "Only list accounts after this address, " +
+					"pass the address of the last account of the previous page for the next"},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				n, err := pageSize(params.Args)
+				if err != nil {
+					return nil, err
+				}
+				qry, _ := params.Args["query"].(string)
+				param := &rpcquery.ListAccountsParam{Query: qry}
+				if after, ok := params.Args["after"].(crypto.Address); ok {
+					param.After = after.Bytes()
+				}
+				stream := &accountStream{serverStream: serverStream{ctx: params.Context}, first: n}
+				err = rs.query.ListAccounts(param, stream)
+				if err == errPageFull {
+					err = nil
+				}
+				return stream.accounts, err
+			}},
+		"name": {Type: name, Description: "A name registry entry, or null if there is no such entry",
+			Args: graphql.FieldConfigArgument{"name": {Type: graphql.NewNonNull(graphql.String)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				entry, err := rs.query.GetName(params.Context, &rpcquery.GetNameParam{Name: params.Args["name"].(string)})
+				if status.Code(err) == codes.NotFound {
+					return nil, nil
+				}
+				return entry, err
+			}},
+		"names": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(name))),
+			Description: "A page of name registry entries in ascending order of name",
+			Args: graphql.FieldConfigArgument{
+				"query": queryArg,
+				"first": first,
+				"after": {Type: graphql.String, Description: "Only list entries after this name, " +
+					"pass the name of the last entry of the previous page for the next"},
+			},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				n, err := pageSize(params.Args)
+				if err != nil {
+					return nil, err
+				}
+				qry, _ := params.Args["query"].(string)
+				param := &rpcquery.ListNamesParam{Query: qry}
+				param.After, _ = params.Args["after"].(string)
+				stream := &nameStream{serverStream: serverStream{ctx: params.Context}, first: n}
+				err = rs.query.ListNames(param, stream)
+				if err == errPageFull {
+					err = nil
+				}
+				return stream.entries, err
+			}},
+		"proposal": {Type: proposal, Description: "A governance proposal by hash",
+			Args: graphql.FieldConfigArgument{"hash": {Type: graphql.NewNonNull(Bytes)}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				hash := params.Args["hash"].([]byte)
+				ballot, err := rs.query.GetProposal(params.Context, &rpcquery.GetProposalParam{Hash: hash})
+				if err != nil {
+					return nil, err
+				}
+				return &rpcquery.ProposalResult{Hash: hash, Ballot: ballot}, nil
+			}},
+		"proposals": {Type: graphql.NewNonNull(graphql.NewList(graphql.NewNonNull(proposal))),
+			Args: graphql.FieldConfigArgument{"proposed": {Type: graphql.Boolean, DefaultValue: false,
+				Description: "Only list proposals that have not yet been executed or failed"}},
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				stream := &proposalStream{serverStream: serverStream{ctx: params.Context}}
+				err := rs.query.ListProposals(&rpcquery.ListProposalsParam{Proposed: params.Args["proposed"].(bool)},
+					stream)
+				return stream.proposals, err
+			}},
+	}})
+
+	subscription := graphql.NewObject(graphql.ObjectConfig{Name: "Subscription", Fields: graphql.Fields{
+		"blocks": {Type: graphql.NewNonNull(block), Description: "Each block as it is committed",
+			Args: graphql.FieldConfigArgument{"decode": decode},
+			Subscribe: func(params graphql.ResolveParams) (interface{}, error) {
+				return rs.subscribeBlocks(params.Context, params.Args["decode"].(bool)), nil
+			},
+			// Each block is passed as the source of the field
+			Resolve: func(params graphql.ResolveParams) (interface{}, error) {
+				if err, ok := params.Source.(error); ok {
+					return nil, err
+				}
+				return params.Source, nil
+			}},
+	}})
+
+	return libgraphql.NewSchema(graphql.SchemaConfig{Query: queryType, Subscription: subscription},
+		map[string]map[string]libgraphql.ComplexityFunc{
+			"Query": {
+				"blocks":   rs.blockRangeComplexity,
+				"events":   rs.blockRangeComplexity,
+				"accounts": pageComplexity,
+				"names":    pageComplexity,
+			},
+		})
+}
+
+// Returns the account at address or nil if there is no such account
+func (rs *resolvers) account(ctx context.Context, address crypto.Address) (*acm.Account, error) {
+	acc, err := rs.query.GetAccount(ctx, &rpcquery.GetAccountParam{Address: address})
+	if err != nil {
+		return nil, err
+	}
+	if acc.Address != address {
+		// We are given an empty account when there is none at the address
+		return nil, nil
+	}
+	return acc, nil
+}
+
+func (rs *resolvers) block(ctx context.Context, height uint64, decode bool) (*exec.BlockExecution, error) {
+	if height == 0 || height > rs.blockchain.LastBlockHeight() {
+		return nil, nil
+	}
+	blocks, err := rs.blocks(ctx, height, height, decode)
+	if err != nil {
+		return nil, err
+	}
+	if len(blocks) > 0 {
+		return blocks[0], nil
+	}
+	// We only store the executions of blocks with transactions
+	header, err := rs.blockchain.GetBlockHeader(height)
+	if err != nil {
+		return nil, err
+	}
+	abciHeader := tmtypes.TM2PB.Header(header)
+	return &exec.BlockExecution{
+		Height:       height,
+		Header:       &abciHeader,
+		TxExecutions: []*exec.TxExecution{},
+	}, nil
+}
+
+func (rs *resolvers) blocks(ctx context.Context, from, to uint64, decode bool) ([]*exec.BlockExecution, error) {
+	var blocks []*exec.BlockExecution
+	if to > rs.blockchain.LastBlockHeight() {
+		to = rs.blockchain.LastBlockHeight()
+	}
+	if from > to {
+		return blocks, nil
+	}
+	ba := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+	err := rs.events.Stream(&rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(from), rpcevents.AbsoluteBound(to)),
+		Decode:     decode,
+	}, eventStream{
+		serverStream: serverStream{ctx: ctx},
+		send: func(ev *exec.StreamEvent) error {
+			block, err := ba.Consume(ev)
+			if err != nil {
+				return err
+			}
+			if block != nil {
+				blocks = append(blocks, block)
+			}
+			return nil
+		},
+	})
+	return blocks, err
+}
+
+func (rs *resolvers) eventsBetween(ctx context.Context, from, to uint64, qry string, decode bool) ([]*exec.Event, error) {
+	var events []*exec.Event
+	if to > rs.blockchain.LastBlockHeight() {
+		to = rs.blockchain.LastBlockHeight()
+	}
+	if from > to {
+		return events, nil
+	}
+	stream := &eventsStream{serverStream: serverStream{ctx: ctx}}
+	err := rs.events.Events(&rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(from), rpcevents.AbsoluteBound(to)),
+		Query:      qry,
+		Decode:     decode,
+	}, stream)
+	if err != nil {
+		return nil, err
+	}
+	for _, res := range stream.responses {
+		events = append(events, res.Events...)
+	}
+	return events, nil
+}
+
+func (rs *resolvers) blockRange(args map[string]interface{}) (from, to uint64, err error) {
+	from, ok := args["from"].(uint64)
+	if !ok {
+		return 0, 0, fmt.Errorf("from must be given")
+	}
+	to = rs.blockchain.LastBlockHeight()
+	if arg, ok := args["to"].(uint64); ok {
+		to = arg
+	}
+	if to >= from && to-from >= MaxBlockRange {
+		return 0, 0, fmt.Errorf("cannot read more than %d blocks at once but requested %d to %d",
+			MaxBlockRange, from, to)
+	}
+	return from, to, nil
+}
+
+// Counts each block of the range as a copy of the selection, the range itself is checked when the field is resolved
+func (rs *resolvers) blockRangeComplexity(args map[string]interface{}, childComplexity int) int {
+	from, to, err := rs.blockRange(args)
+	if err != nil || from > to {
+		return 1 + childComplexity
+	}
+	return 1 + int(to-from+1)*childComplexity
+}
+
+func pageSize(args map[string]interface{}) (int, error) {
+	n, _ := args["first"].(int)
+	if n < 1 || n > MaxPageSize {
+		return 0, fmt.Errorf("first must be between 1 and %d but was %d", MaxPageSize, n)
+	}
+	return n, nil
+}
+
+func pageComplexity(args map[string]interface{}, childComplexity int) int {
+	n, err := pageSize(args)
+	if err != nil {
+		return 1 + childComplexity
+	}
+	return 1 + n*childComplexity
+}
+
+// Streams every block committed from now on until ctx is done
+func (rs *resolvers) subscribeBlocks(ctx context.Context, decode bool) chan interface{} {
+	ch := make(chan interface{})
+	go func() {
+		defer close(ch)
+		send := func(value interface{}) error {
+			select {
+			case ch <- value:
+				return nil
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+		ba := exec.NewBlockAccumulator(exec.NonConsecutiveBlocks)
+		err := rs.events.Stream(&rpcevents.BlocksRequest{
+			BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(rs.blockchain.LastBlockHeight()+1),
+				rpcevents.StreamBound()),
+			Decode: decode,
+		}, eventStream{
+			serverStream: serverStream{ctx: ctx},
+			send: func(ev *exec.StreamEvent) error {
+				block, err := ba.Consume(ev)
+				if err != nil {
+					return err
+				}
+				if block != nil {
+					return send(block)
+				}
+				return nil
+			},
+		})
+		if err != nil && ctx.Err() == nil {
+			rs.logger.InfoMsg("GraphQL block subscription failed", structure.ErrorKey, err)
+			send(err) // nolint: errcheck
+		}
+	}()
+	return ch
+}
+
+func syncInfo(field func(si *bcm.SyncInfo) interface{}) graphql.FieldResolveFn {
+	return func(params graphql.ResolveParams) (interface{}, error) {
+		si := params.Source.(*rpc.ResultStatus).SyncInfo
+		if si == nil {
+			return nil, nil
+		}
+		return field(si), nil
+	}
+}
+
+// Empty byte strings are returned as null
+func nonEmpty(bs []byte) interface{} {
+	if len(bs) == 0 {
+		return nil
+	}
+	return bs
+}
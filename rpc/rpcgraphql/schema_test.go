@@ -0,0 +1,201 @@
+package rpcgraphql
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/event"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/execution/state"
+	"github.com/hyperledger/burrow/logging"
+	libgraphql "github.com/hyperledger/burrow/rpc/lib/graphql"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/txs"
+	"github.com/hyperledger/burrow/txs/payload"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmtypes "github.com/tendermint/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+)
+
+var (
+	addr1 = crypto.Address{1}
+	addr2 = crypto.Address{2}
+	addr3 = crypto.Address{3}
+)
+
+func TestSchema(t *testing.T) {
+	schema, emitter := testSchema(t)
+
+	assert.JSONEq(t, fmt.Sprintf(`{"data":{"block":{"height":2,"hash":"%s","txs":[
+			{"hash":"%s","events":[{"type":"LogEvent","log":{"address":"%s","account":{"balance":100,"storage":"2A"}}}]}
+		]}}}`, blockHash(2), txHash(2, 0), addr1),
+		execute(t, schema, `{ block(height: 2) { height hash txs { hash events {
+			type log { address account { balance storage(key: "01") } } } } } }`))
+
+	// Blocks without transactions are not stored
+	assert.JSONEq(t, `{"data":{"blocks":[{"height":2,"numTxs":1},{"height":3,"numTxs":2}],
+			"empty":{"height":1,"numTxs":0},"future":null}}`,
+		execute(t, schema, `{ blocks(from: 0) { height numTxs } empty: block(height: 1) { height numTxs }
+			future: block(height: 4) { height } }`))
+
+	assert.JSONEq(t, `{"data":{"tx":{"height":3,"index":1,"txType":"CallTx"}}}`,
+		execute(t, schema, fmt.Sprintf(`{ tx(hash: "%s") { height index txType } }`, txHash(3, 1))))
+
+	assert.JSONEq(t, `{"data":{"events":[{"height":3,"log":{"account":null}}]}}`,
+		execute(t, schema, fmt.Sprintf(`{ events(from: 0, to: 3, query: "Address = '%s'") {
+			height log { account { address } } } }`, addr2)))
+
+	assert.JSONEq(t, `{"data":{"name":{"owner":"0100000000000000000000000000000000000000","data":"bar"},"missing":null}}`,
+		execute(t, schema, `{ name(name: "foo") { owner data } missing: name(name: "baz") { data } }`))
+
+	assert.Contains(t, execute(t, schema, `{ blocks(from: 1, to: 5000) { height } }`),
+		"cannot read more than 1000 blocks at once")
+
+	// Pages of accounts and names
+	assert.JSONEq(t, fmt.Sprintf(`{"data":{"accounts":[{"balance":100}],"next":[{"address":"%s"}],"last":[]}}`, addr3),
+		execute(t, schema, fmt.Sprintf(`{ accounts(first: 1) { balance } next: accounts(after: "%s") { address }
+			last: accounts(after: "%s") { address } }`, addr1, addr3)))
+	assert.JSONEq(t, `{"data":{"names":[{"name":"bar"}],"next":[{"name":"foo"}]}}`,
+		execute(t, schema, `{ names(first: 1) { name } next: names(first: 1, after: "bar") { name } }`))
+	assert.Contains(t, execute(t, schema, `{ names(first: 1001) { name } }`), "first must be between 1 and 1000")
+	assert.Contains(t, execute(t, schema, `{ accounts(first: 1000) { address balance sequence roles permissions
+			evmCode wasmCode nativeName publicKey storage(key: "01") } }`), "query complexity exceeds the maximum")
+
+	// Subscribe to new blocks
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	responses, err := schema.Subscribe(ctx, &libgraphql.Request{Query: `subscription { blocks { height numTxs } }`})
+	require.NoError(t, err)
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	timeout := time.After(5 * time.Second)
+	for {
+		select {
+		case res := <-responses:
+			bs, err := json.Marshal(res)
+			require.NoError(t, err)
+			assert.JSONEq(t, `{"data":{"blocks":{"height":4,"numTxs":1}}}`, string(bs))
+			return
+		case <-ticker.C:
+			// We cannot tell when the subscription has been made so keep publishing until it sees the block
+			be := mkBlock(4, addr1)
+			require.NoError(t, emitter.Publish(ctx, be, be))
+		case <-timeout:
+			t.Fatal("timed out waiting for block")
+		}
+	}
+}
+
+func testSchema(t *testing.T) (*libgraphql.Schema, *event.Emitter) {
+	st := state.NewState(dbm.NewMemDB())
+	blocks := map[uint64][]crypto.Address{
+		2: {addr1},
+		3: {addr1, addr2},
+	}
+	_, _, err := st.Update(func(ws state.Updatable) error {
+		err := ws.UpdateAccount(&acm.Account{Address: addr1, Balance: 100})
+		if err != nil {
+			return err
+		}
+		err = ws.SetStorage(addr1, binary.LeftPadWord256([]byte{1}), []byte{42})
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateAccount(&acm.Account{Address: addr3, Balance: 300})
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateName(&names.Entry{Name: "foo", Owner: addr1, Data: "bar", Expires: 100})
+		if err != nil {
+			return err
+		}
+		err = ws.UpdateName(&names.Entry{Name: "bar", Owner: addr3, Data: "baz", Expires: 100})
+		if err != nil {
+			return err
+		}
+		for height := uint64(1); height <= 3; height++ {
+			err = ws.AddBlock(mkBlock(height, blocks[height]...))
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	emitter := event.NewEmitter()
+	logger := logging.NewNoopLogger()
+	tip := &blockchain{height: 3}
+	schema, err := NewSchema(rpcquery.NewQueryServer(st, tip, nil, logger),
+		rpcevents.NewExecutionEventsServer(st, emitter, tip, logger), tip, logger)
+	require.NoError(t, err)
+	return schema, emitter
+}
+
+func execute(t *testing.T, schema *libgraphql.Schema, query string) string {
+	bs, err := json.Marshal(schema.Execute(context.Background(), &libgraphql.Request{Query: query}))
+	require.NoError(t, err)
+	return string(bs)
+}
+
+func mkBlock(height uint64, addresses ...crypto.Address) *exec.BlockExecution {
+	be := &exec.BlockExecution{Height: height}
+	for i, address := range addresses {
+		txe := &exec.TxExecution{
+			TxHeader: &exec.TxHeader{
+				TxType: payload.TypeCall,
+				TxHash: txHash(height, i),
+				Height: height,
+				Index:  uint64(i),
+			},
+			Envelope: txs.Enclose("test", &payload.CallTx{Input: &payload.TxInput{Address: address}}),
+		}
+		txe.Append(&exec.Event{
+			Header: &exec.Header{
+				TxType:    payload.TypeCall,
+				EventType: exec.TypeLog,
+				Height:    height,
+			},
+			Log: &exec.LogEvent{Address: address},
+		})
+		be.TxExecutions = append(be.TxExecutions, txe)
+	}
+	return be
+}
+
+func txHash(height uint64, index int) binary.HexBytes {
+	hash := sha256.Sum256([]byte{byte(height), byte(index)})
+	return hash[:]
+}
+
+func blockHash(height uint64) binary.HexBytes {
+	return txHash(height, -1)
+}
+
+// A chain without a block store
+type blockchain struct {
+	bcm.BlockchainInfo
+	height uint64
+}
+
+func (bc *blockchain) LastBlockHeight() uint64 {
+	return bc.height
+}
+
+func (bc *blockchain) BlockHash(height uint64) ([]byte, error) {
+	return blockHash(height), nil
+}
+
+func (bc *blockchain) GetBlockHeader(height uint64) (*tmtypes.Header, error) {
+	return &tmtypes.Header{Height: int64(height)}, nil
+}
@@ -0,0 +1,32 @@
+package rpcgraphql
+
+import (
+	"net"
+	"net/http"
+
+	"github.com/hyperledger/burrow/bcm"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"github.com/hyperledger/burrow/rpc"
+	"github.com/hyperledger/burrow/rpc/lib/graphql"
+	"github.com/hyperledger/burrow/rpc/lib/server"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+)
+
+// StartServer serves GraphQL queries over HTTP and subscriptions over websocket at pattern, with TLS when tlsConf is
+// not nil. Browsers may only make requests from the server's own origin or from allowedOrigins.
+func StartServer(query rpcquery.QueryServer, events rpcevents.ExecutionEventsServer, blockchain bcm.BlockchainInfo,
+	pattern string, allowedOrigins []string, listener net.Listener, limiter *rpc.RateLimiter, tlsConf *rpc.TLS,
+	logger *logging.Logger) (*http.Server, error) {
+
+	logger = logger.With(structure.ComponentKey, "RPC_GraphQL")
+	schema, err := NewSchema(query, events, blockchain, logger)
+	if err != nil {
+		return nil, err
+	}
+	mux := http.NewServeMux()
+	mux.Handle(pattern, graphql.NewHandler(schema, allowedOrigins, logger))
+	return server.StartHTTPServer(tlsConf.Listener(listener),
+		rpc.AuthorizeHandler(tlsConf, rpc.RateLimitHandler(limiter, tlsConf, rpc.InstrumentHandler("graphql", mux))), logger)
+}
@@ -0,0 +1,86 @@
+package rpcgraphql
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/execution/names"
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"google.golang.org/grpc"
+)
+
+// Adapters that let us call the streaming methods of the GRPC servers in-process
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (ss serverStream) Context() context.Context {
+	return ss.ctx
+}
+
+type eventStream struct {
+	serverStream
+	send func(*exec.StreamEvent) error
+}
+
+func (es eventStream) Send(ev *exec.StreamEvent) error {
+	return es.send(ev)
+}
+
+type eventsStream struct {
+	serverStream
+	responses []*rpcevents.EventsResponse
+}
+
+func (es *eventsStream) Send(res *rpcevents.EventsResponse) error {
+	es.responses = append(es.responses, res)
+	return es.ctx.Err()
+}
+
+// Returned by a paged stream to stop the server sending once the page is full
+var errPageFull = errors.New("page is full")
+
+// Collects the first accounts sent
+type accountStream struct {
+	serverStream
+	first    int
+	accounts []*acm.Account
+}
+
+func (as *accountStream) Send(acc *acm.Account) error {
+	as.accounts = append(as.accounts, acc)
+	if len(as.accounts) >= as.first {
+		return errPageFull
+	}
+	return as.ctx.Err()
+}
+
+// Collects the first name entries sent
+type nameStream struct {
+	serverStream
+	first   int
+	entries []*names.Entry
+}
+
+func (ns *nameStream) Send(entry *names.Entry) error {
+	ns.entries = append(ns.entries, entry)
+	if len(ns.entries) >= ns.first {
+		return errPageFull
+	}
+	return ns.ctx.Err()
+}
+
+type proposalStream struct {
+	serverStream
+	proposals []*rpcquery.ProposalResult
+}
+
+func (ps *proposalStream) Send(proposal *rpcquery.ProposalResult) error {
+	ps.proposals = append(ps.proposals, proposal)
+	return ps.ctx.Err()
+}
@@ -13,14 +13,19 @@ import (
 	"github.com/hyperledger/burrow/rpc/lib/server"
 )
 
-func StartServer(service *rpc.Service, pattern string, listener net.Listener, logger *logging.Logger) (*http.Server, error) {
+// StartServer serves the info RPC, with TLS when tlsConf is not nil
+func StartServer(service *rpc.Service, pattern string, listener net.Listener, limiter *rpc.RateLimiter,
+	tlsConf *rpc.TLS, logger *logging.Logger) (*http.Server, error) {
+
 	logger = logger.With(structure.ComponentKey, "RPC_Info")
 	routes := GetRoutes(service)
 	mux := http.NewServeMux()
 	wm := server.NewWebsocketManager(routes, logger)
+	wm.SetCallFilter(rpc.CallFilter(tlsConf, limiter))
 	mux.HandleFunc(pattern, wm.WebsocketHandler)
 	server.RegisterRPCFuncs(mux, routes, logger)
-	srv, err := server.StartHTTPServer(listener, mux, logger)
+	srv, err := server.StartHTTPServer(tlsConf.Listener(listener),
+		rpc.AuthorizeHandler(tlsConf, rpc.RateLimitHandler(limiter, tlsConf, rpc.InstrumentHandler("info", mux))), logger)
 	if err != nil {
 		return nil, err
 	}
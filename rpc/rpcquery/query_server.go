@@ -1,17 +1,16 @@
 package rpcquery
 
 import (
-	"bytes"
 	"context"
 	"fmt"
 
+	lru "github.com/hashicorp/golang-lru"
 	"github.com/hyperledger/burrow/acm"
 	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/acm/validator"
 	"github.com/hyperledger/burrow/bcm"
 	"github.com/hyperledger/burrow/consensus/tendermint"
 	"github.com/hyperledger/burrow/crypto"
-	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/event/query"
 	"github.com/hyperledger/burrow/execution/names"
 	"github.com/hyperledger/burrow/execution/proposal"
@@ -26,11 +25,16 @@ import (
 	"google.golang.org/grpc/status"
 )
 
+// Number of past heights whose state is kept loaded for reads at a height
+const maxStateHeights = 16
+
 type queryServer struct {
 	state      QueryState
 	blockchain bcm.BlockchainInfo
 	nodeView   *tendermint.NodeView
-	logger     *logging.Logger
+	// Height -> *state.ReadState
+	heights *lru.Cache
+	logger  *logging.Logger
 }
 
 var _ QueryServer = &queryServer{}
@@ -42,13 +46,21 @@ type QueryState interface {
 	registry.IterableReader
 	proposal.IterableReader
 	validator.History
+	// Iterate over the accounts and names with keys in [start, end)
+	IterateAccountsInRange(start, end []byte, consumer func(*acm.Account) error) error
+	IterateNamesInRange(start, end []byte, consumer func(*names.Entry) error) error
+	// LoadForestAtHeight returns the state as it was after the block at height without its validators
+	LoadForestAtHeight(height uint64) (*state.ReadState, error)
 }
 
 func NewQueryServer(state QueryState, blockchain bcm.BlockchainInfo, nodeView *tendermint.NodeView, logger *logging.Logger) *queryServer {
+	// Only errors on non-positive size
+	heights, _ := lru.New(maxStateHeights)
 	return &queryServer{
 		state:      state,
 		blockchain: blockchain,
 		nodeView:   nodeView,
+		heights:    heights,
 		logger:     logger,
 	}
 }
@@ -60,7 +72,11 @@ func (qs *queryServer) Status(ctx context.Context, param *StatusParam) (*rpc.Res
 // Account state
 
 func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (*acm.Account, error) {
-	acc, err := qs.state.GetAccount(param.Address)
+	st, err := qs.stateAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := st.GetAccount(param.Address)
 	if acc == nil {
 		acc = &acm.Account{}
 	}
@@ -71,70 +87,54 @@ func (qs *queryServer) GetAccount(ctx context.Context, param *GetAccountParam) (
 // by metadata hash
 func (qs *queryServer) GetMetadata(ctx context.Context, param *GetMetadataParam) (*MetadataResult, error) {
 	metadata := &MetadataResult{}
-	var contractMeta *acm.ContractMeta
 	var err error
 	if param.Address != nil {
-		acc, err := qs.state.GetAccount(*param.Address)
-		if err != nil {
-			return metadata, err
-		}
-		if acc != nil && acc.CodeHash != nil {
-			codehash := acc.CodeHash
-			if acc.Forebear != nil {
-				acc, err = qs.state.GetAccount(*acc.Forebear)
-				if err != nil {
-					return metadata, err
-				}
-			}
-
-			for _, m := range acc.ContractMeta {
-				if bytes.Equal(m.CodeHash, codehash) {
-					contractMeta = m
-					break
-				}
-			}
-
-			if contractMeta == nil {
-				deployCodehash := compile.GetDeployCodeHash(acc.EVMCode, *param.Address)
-				for _, m := range acc.ContractMeta {
-					if bytes.Equal(m.CodeHash, deployCodehash) {
-						contractMeta = m
-						break
-					}
-				}
-			}
-		}
+		metadata.Metadata, err = rpc.GetContractMetadata(qs.state, *param.Address)
 	} else if param.MetadataHash != nil {
-		contractMeta = &acm.ContractMeta{
+		metadata.Metadata, err = rpc.GetMetadata(qs.state, &acm.ContractMeta{
 			MetadataHash: *param.MetadataHash,
-		}
-	}
-	if contractMeta == nil {
-		return metadata, nil
-	}
-	if contractMeta.Metadata != "" {
-		// Looks like the metadata is already memoised - (e.g. by native.State)
-		metadata.Metadata = contractMeta.Metadata
-	} else {
-		var metadataHash acmstate.MetadataHash
-		copy(metadataHash[:], contractMeta.MetadataHash)
-		metadata.Metadata, err = qs.state.GetMetadata(metadataHash)
+		})
 	}
 	return metadata, err
 }
 
 func (qs *queryServer) GetStorage(ctx context.Context, param *GetStorageParam) (*StorageValue, error) {
-	val, err := qs.state.GetStorage(param.Address, param.Key)
+	st, err := qs.stateAt(param.Height)
+	if err != nil {
+		return nil, err
+	}
+	val, err := st.GetStorage(param.Address, param.Key)
 	return &StorageValue{Value: val}, err
 }
 
+// Returns the state as it was after the block at height, or the latest state if height is zero. The state at a past
+// height never changes so it is cached for clients reading many keys at the same height.
+func (qs *queryServer) stateAt(height uint64) (acmstate.Reader, error) {
+	if height == 0 {
+		return qs.state, nil
+	}
+	if lastHeight := qs.blockchain.LastBlockHeight(); height > lastHeight {
+		return nil, status.Errorf(codes.OutOfRange, "cannot read state at height %d after the last block %d",
+			height, lastHeight)
+	}
+	if st, ok := qs.heights.Get(height); ok {
+		return st.(*state.ReadState), nil
+	}
+	st, err := qs.state.LoadForestAtHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	qs.heights.Add(height, st)
+	return st, nil
+}
+
 func (qs *queryServer) ListAccounts(param *ListAccountsParam, stream Query_ListAccountsServer) error {
 	qry, err := query.NewOrEmpty(param.Query)
 	if err != nil {
 		return err
 	}
 	var streamErr error
-	err = qs.state.IterateAccounts(func(acc *acm.Account) error {
+	err = qs.state.IterateAccountsInRange(after(param.After), nil, func(acc *acm.Account) error {
 		if qry.Matches(acc) {
 			return stream.Send(acc)
 		} else {
@@ -163,7 +163,7 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 		return err
 	}
 	var streamErr error
-	err = qs.state.IterateNames(func(entry *names.Entry) error {
+	err = qs.state.IterateNamesInRange(after([]byte(param.After)), nil, func(entry *names.Entry) error {
 		if qry.Matches(entry) {
 			return stream.Send(entry)
 		} else {
@@ -176,6 +176,14 @@ func (qs *queryServer) ListNames(param *ListNamesParam, stream Query_ListNamesSe
 	return streamErr
 }
 
+// Returns the least key greater than key, or nil to start from the beginning if key is empty
+func after(key []byte) []byte {
+	if len(key) == 0 {
+		return nil
+	}
+	return append(append(make([]byte, 0, len(key)+1), key...), 0)
+}
+
 // Validators
 
 func (qs *queryServer) GetValidatorSet(ctx context.Context, param *GetValidatorSetParam) (*ValidatorSet, error) {
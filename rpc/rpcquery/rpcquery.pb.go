@@ -89,10 +89,12 @@ func (*StatusParam) XXX_MessageName() string {
 }
 
 type GetAccountParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	// Read the account as it was after the block at this height, 0 for the latest state
+	Height               uint64   `protobuf:"varint,2,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetAccountParam) Reset()         { *m = GetAccountParam{} }
@@ -119,6 +121,13 @@ func (m *GetAccountParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetAccountParam proto.InternalMessageInfo
 
+func (m *GetAccountParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetAccountParam) XXX_MessageName() string {
 	return "rpcquery.GetAccountParam"
 }
@@ -202,11 +211,13 @@ func (*MetadataResult) XXX_MessageName() string {
 }
 
 type GetStorageParam struct {
-	Address              github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
-	Key                  github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
-	XXX_NoUnkeyedLiteral struct{}                                     `json:"-"`
-	XXX_unrecognized     []byte                                       `json:"-"`
-	XXX_sizecache        int32                                        `json:"-"`
+	Address github_com_hyperledger_burrow_crypto.Address `protobuf:"bytes,1,opt,name=Address,proto3,customtype=github.com/hyperledger/burrow/crypto.Address" json:"Address"`
+	Key     github_com_hyperledger_burrow_binary.Word256 `protobuf:"bytes,2,opt,name=Key,proto3,customtype=github.com/hyperledger/burrow/binary.Word256" json:"Key"`
+	// Read the storage as it was after the block at this height, 0 for the latest state
+	Height               uint64   `protobuf:"varint,3,opt,name=Height,proto3" json:"Height,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *GetStorageParam) Reset()         { *m = GetStorageParam{} }
@@ -233,6 +244,13 @@ func (m *GetStorageParam) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetStorageParam proto.InternalMessageInfo
 
+func (m *GetStorageParam) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
 func (*GetStorageParam) XXX_MessageName() string {
 	return "rpcquery.GetStorageParam"
 }
@@ -273,7 +291,9 @@ func (*StorageValue) XXX_MessageName() string {
 }
 
 type ListAccountsParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Only list accounts with addresses after this one, pass the address of the last account received for the next page
+	After                []byte   `protobuf:"bytes,2,opt,name=After,proto3" json:"After,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -310,6 +330,13 @@ func (m *ListAccountsParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListAccountsParam) GetAfter() []byte {
+	if m != nil {
+		return m.After
+	}
+	return nil
+}
+
 func (*ListAccountsParam) XXX_MessageName() string {
 	return "rpcquery.ListAccountsParam"
 }
@@ -357,7 +384,9 @@ func (*GetNameParam) XXX_MessageName() string {
 }
 
 type ListNamesParam struct {
-	Query                string   `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	Query string `protobuf:"bytes,1,opt,name=Query,proto3" json:"Query,omitempty"`
+	// Only list names after this one, pass the last name received for the next page
+	After                string   `protobuf:"bytes,2,opt,name=After,proto3" json:"After,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -394,6 +423,13 @@ func (m *ListNamesParam) GetQuery() string {
 	return ""
 }
 
+func (m *ListNamesParam) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
 func (*ListNamesParam) XXX_MessageName() string {
 	return "rpcquery.ListNamesParam"
 }
@@ -996,73 +1032,74 @@ func init() { proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f
 func init() { golang_proto.RegisterFile("rpcquery.proto", fileDescriptor_88e25d9b99e39f02) }
 
 var fileDescriptor_88e25d9b99e39f02 = []byte{
-	// 1051 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xef, 0x6e, 0x1b, 0x45,
-	0x10, 0xe7, 0x9a, 0xc4, 0x71, 0xc6, 0x8e, 0xdd, 0x6e, 0x82, 0x9b, 0x5e, 0xa9, 0x53, 0x56, 0x22,
-	0x0d, 0x51, 0x7b, 0x36, 0xa6, 0x01, 0x04, 0x48, 0xa8, 0x8e, 0xc0, 0x0e, 0xa5, 0x51, 0x38, 0x43,
-	0x2b, 0x81, 0x84, 0xb4, 0xbe, 0x5b, 0xec, 0x53, 0xcf, 0xb7, 0x66, 0x6f, 0xaf, 0xc5, 0x8f, 0x81,
-	0x78, 0x0a, 0x1e, 0x80, 0xef, 0x7c, 0xec, 0x23, 0xa0, 0x7e, 0x88, 0x50, 0xfb, 0x22, 0xe8, 0xf6,
-	0x8f, 0x7d, 0x77, 0x71, 0x23, 0x40, 0xf4, 0x8b, 0xb5, 0x33, 0x3b, 0xf3, 0x1b, 0xef, 0xec, 0xfc,
-	0x7e, 0x7b, 0x50, 0xe3, 0x53, 0xef, 0xa7, 0x84, 0xf2, 0x99, 0x33, 0xe5, 0x4c, 0x30, 0x54, 0x36,
-	0xb6, 0x7d, 0x67, 0x14, 0x88, 0x71, 0x32, 0x74, 0x3c, 0x36, 0x69, 0x8d, 0xd8, 0x88, 0xb5, 0x64,
-	0xc0, 0x30, 0xf9, 0x51, 0x5a, 0xd2, 0x90, 0x2b, 0x95, 0x68, 0x7f, 0x98, 0x09, 0x17, 0x34, 0xf2,
-	0x29, 0x9f, 0x04, 0x91, 0xc8, 0x2e, 0xc9, 0xd0, 0x0b, 0x5a, 0x62, 0x36, 0xa5, 0xb1, 0xfa, 0xd5,
-	0x89, 0x95, 0x88, 0x4c, 0xe6, 0xc6, 0x06, 0xf1, 0x26, 0x7a, 0x59, 0x7f, 0x42, 0xc2, 0xc0, 0x27,
-	0x82, 0x71, 0xed, 0xa8, 0x71, 0x3a, 0x0a, 0x62, 0x61, 0xfe, 0xaa, 0xbd, 0xc1, 0xa7, 0x9e, 0x5e,
-	0x6e, 0x4e, 0xc9, 0x2c, 0x64, 0xc4, 0x57, 0x26, 0x0e, 0xa0, 0x32, 0x10, 0x44, 0x24, 0xf1, 0x29,
-	0xe1, 0x64, 0x82, 0xf6, 0xa1, 0xde, 0x0d, 0x99, 0xf7, 0xf8, 0x9b, 0x60, 0x42, 0x1f, 0x05, 0x62,
-	0x1c, 0x44, 0x3b, 0xd6, 0x4d, 0x6b, 0x7f, 0xc3, 0x2d, 0xba, 0x51, 0x1b, 0xb6, 0xa4, 0x6b, 0x40,
-	0x69, 0x94, 0x89, 0xbe, 0x24, 0xa3, 0x97, 0x6d, 0x61, 0x02, 0xf5, 0x1e, 0x15, 0xf7, 0x3c, 0x8f,
-	0x25, 0x91, 0x50, 0xe5, 0x4e, 0x60, 0xfd, 0x9e, 0xef, 0x73, 0x1a, 0xc7, 0xb2, 0x4c, 0xb5, 0x7b,
-	0xf7, 0xd9, 0xd9, 0xee, 0x1b, 0xcf, 0xcf, 0x76, 0x6f, 0x67, 0x5a, 0x34, 0x9e, 0x4d, 0x29, 0x0f,
-	0xa9, 0x3f, 0xa2, 0xbc, 0x35, 0x4c, 0x38, 0x67, 0x4f, 0x5b, 0x1e, 0x9f, 0x4d, 0x05, 0x73, 0x74,
-	0xae, 0x6b, 0x40, 0xf0, 0xef, 0x16, 0x5c, 0xee, 0x51, 0xf1, 0x80, 0x0a, 0xe2, 0x13, 0x41, 0x54,
-	0x91, 0x2f, 0x8b, 0x45, 0xda, 0xff, 0xb9, 0x00, 0xfa, 0x16, 0xaa, 0x06, 0xbc, 0x4f, 0xe2, 0xb1,
-	0x3c, 0x6e, 0xb5, 0xfb, 0xde, 0xf3, 0xb3, 0xdd, 0x3b, 0x17, 0x03, 0x0e, 0x83, 0x88, 0xf0, 0x99,
-	0xd3, 0xa7, 0x3f, 0x77, 0x67, 0x82, 0xc6, 0x6e, 0x0e, 0x06, 0xdf, 0x86, 0x9a, 0xb1, 0x5d, 0x1a,
-	0x27, 0xa1, 0x40, 0x36, 0x94, 0x8d, 0x47, 0xdf, 0xc0, 0xdc, 0xc6, 0xbf, 0x59, 0xb2, 0x93, 0x03,
-	0xc1, 0x38, 0x19, 0xd1, 0xd7, 0xd2, 0x49, 0xf4, 0x05, 0xac, 0xdc, 0xa7, 0x33, 0x7d, 0xbe, 0x7f,
-	0x88, 0xa5, 0xcf, 0xf8, 0x88, 0x71, 0xbf, 0x73, 0xf8, 0x81, 0x9b, 0x02, 0xe0, 0xef, 0xa1, 0xaa,
-	0xff, 0xe7, 0x43, 0x12, 0x26, 0x14, 0xdd, 0x87, 0x35, 0xb9, 0xd0, 0xff, 0xf2, 0x50, 0x23, 0xff,
-	0xcb, 0xee, 0x29, 0x0c, 0xfc, 0x2e, 0x5c, 0xf9, 0x2a, 0x88, 0xcd, 0x48, 0xe9, 0x11, 0xde, 0x86,
-	0xb5, 0xaf, 0x53, 0x56, 0xea, 0xb6, 0x29, 0x03, 0x63, 0xa8, 0xf6, 0xa8, 0x38, 0x21, 0x13, 0xdd,
-	0x2f, 0x04, 0xab, 0xa9, 0xa1, 0x83, 0xe4, 0x1a, 0xef, 0x41, 0x2d, 0x85, 0x4b, 0xd7, 0x17, 0x62,
-	0x5d, 0x83, 0xab, 0x29, 0x16, 0x15, 0x4f, 0x19, 0x7f, 0xec, 0x6a, 0xa6, 0xc9, 0x04, 0xdc, 0x80,
-	0xed, 0x1e, 0x15, 0x0f, 0x0d, 0x1d, 0x07, 0x54, 0x0d, 0x3a, 0xee, 0xc1, 0xf5, 0x82, 0xbf, 0x1f,
-	0xc4, 0x82, 0xe9, 0xb4, 0x94, 0x76, 0xc7, 0x91, 0x17, 0x26, 0x3e, 0x3d, 0xe5, 0xf4, 0x49, 0xc0,
-	0x12, 0x75, 0x8b, 0x2b, 0x6e, 0xd1, 0x8d, 0xbb, 0x50, 0x2f, 0x14, 0x46, 0x2d, 0x58, 0x19, 0x50,
-	0xb1, 0x63, 0xdd, 0x5c, 0xd9, 0xaf, 0x74, 0x6e, 0x38, 0x73, 0x95, 0x52, 0x01, 0x94, 0x53, 0x7f,
-	0x5e, 0xd7, 0x4d, 0x23, 0xf1, 0x2f, 0x16, 0x6c, 0x2d, 0xd9, 0xfc, 0xdf, 0x67, 0xe8, 0x00, 0x56,
-	0x4f, 0x98, 0x4f, 0xe5, 0x10, 0x55, 0x3a, 0x0d, 0x67, 0x2e, 0x4a, 0xa9, 0xf7, 0xd8, 0xa7, 0x91,
-	0x08, 0xc4, 0xcc, 0x95, 0x31, 0xb8, 0x07, 0x5b, 0x4b, 0xba, 0x83, 0xda, 0xb0, 0xae, 0x97, 0xfa,
-	0x7c, 0x8d, 0xc5, 0xf9, 0xb2, 0xf1, 0xae, 0x09, 0xc3, 0x27, 0x50, 0xcd, 0x6e, 0xa0, 0x06, 0x94,
-	0xc6, 0x34, 0x18, 0x8d, 0x85, 0x3c, 0xd3, 0xaa, 0xab, 0x2d, 0xb4, 0xa7, 0xba, 0x76, 0x49, 0xa2,
-	0x6e, 0x3b, 0x0b, 0x05, 0x2d, 0x34, 0x6b, 0x4f, 0x2a, 0xca, 0x29, 0x67, 0x53, 0x16, 0x93, 0x70,
-	0x3e, 0x3c, 0x92, 0xfd, 0xb2, 0x4b, 0xae, 0x5c, 0xe3, 0x36, 0xa0, 0x74, 0x78, 0x4c, 0xa0, 0x1e,
-	0x20, 0x1b, 0xca, 0xca, 0x43, 0x7d, 0x19, 0x5d, 0x76, 0xe7, 0x36, 0x7e, 0x00, 0x35, 0x13, 0xad,
-	0x49, 0xbf, 0x04, 0x17, 0xdd, 0x82, 0x52, 0x97, 0x84, 0x21, 0x13, 0xba, 0x8d, 0x75, 0xc7, 0x08,
-	0xb8, 0x72, 0xbb, 0x7a, 0x1b, 0xd7, 0x61, 0x53, 0x8a, 0x02, 0xd1, 0x44, 0xc0, 0x14, 0xd6, 0xa4,
-	0x85, 0x0e, 0xe0, 0xb2, 0xa1, 0x48, 0x2a, 0xc5, 0x47, 0xe9, 0x9d, 0xa8, 0x66, 0x9c, 0xf3, 0xa7,
-	0xb2, 0x9e, 0xf5, 0xb1, 0x44, 0x1c, 0x99, 0x2b, 0x5c, 0x75, 0x97, 0x6d, 0xe1, 0x5b, 0xb2, 0xae,
-	0x14, 0x7c, 0x75, 0xe6, 0x06, 0x94, 0xfa, 0xb9, 0x8e, 0x2b, 0xab, 0xf3, 0xeb, 0xba, 0x66, 0x13,
-	0xea, 0x40, 0x49, 0x3d, 0x3a, 0xe8, 0xcd, 0xc5, 0x75, 0x66, 0x9e, 0x21, 0xfb, 0x4a, 0xea, 0x76,
-	0x54, 0x57, 0x74, 0xe4, 0x21, 0xc0, 0xe2, 0xf5, 0x40, 0xd7, 0x16, 0x79, 0x85, 0x37, 0xc5, 0xae,
-	0x3a, 0xe9, 0xc3, 0x68, 0x02, 0x8f, 0xa0, 0x92, 0x79, 0x10, 0x90, 0x9d, 0xcb, 0xcb, 0xbd, 0x13,
-	0xf6, 0xce, 0x62, 0xaf, 0x20, 0xc6, 0x9f, 0xc9, 0xda, 0x5a, 0xc7, 0x0a, 0xb5, 0xb3, 0x2a, 0x6c,
-	0x37, 0xb2, 0xc7, 0xc9, 0xa8, 0xde, 0x27, 0x50, 0xcd, 0x0a, 0x15, 0xba, 0xbe, 0x88, 0x3b, 0x27,
-	0x60, 0xf9, 0x03, 0xb4, 0x2d, 0xd4, 0x82, 0x75, 0x2d, 0x5d, 0xa8, 0x91, 0x2b, 0x3d, 0x57, 0x33,
-	0xbb, 0xea, 0xa8, 0x2f, 0x83, 0xcf, 0xa3, 0x54, 0x10, 0x0e, 0x61, 0x63, 0xae, 0x63, 0x68, 0x27,
-	0x5f, 0x6a, 0x21, 0x6e, 0xf9, 0xa4, 0xb6, 0x85, 0x5c, 0x40, 0xe7, 0x65, 0x0d, 0xbd, 0x9d, 0x2f,
-	0xb9, 0x44, 0xf4, 0xec, 0x4c, 0x43, 0x8a, 0xd9, 0xc7, 0xf2, 0xa5, 0xca, 0x11, 0xb2, 0x99, 0x03,
-	0x3c, 0x27, 0x95, 0xf6, 0x2b, 0x18, 0x8e, 0x7e, 0x80, 0xc6, 0x72, 0x09, 0x45, 0xef, 0xbc, 0x12,
-	0x31, 0x2b, 0xb2, 0xf6, 0x8d, 0xe5, 0xc0, 0x06, 0xe5, 0x63, 0x39, 0x29, 0x86, 0x91, 0x85, 0x49,
-	0xc9, 0xf1, 0xdf, 0x2e, 0x72, 0x10, 0x1d, 0xc3, 0x66, 0x8e, 0xfc, 0xe8, 0xad, 0x7c, 0xd7, 0xf3,
-	0xaa, 0x90, 0x9d, 0xb4, 0xbc, 0x02, 0xb4, 0x2d, 0x74, 0x17, 0xca, 0x86, 0xc6, 0xe8, 0x6a, 0x61,
-	0xd2, 0x0c, 0xb5, 0xed, 0x7a, 0x9e, 0x36, 0x31, 0xfa, 0x08, 0x6a, 0x86, 0x84, 0x7d, 0x4a, 0x7c,
-	0xca, 0x0b, 0xb9, 0x0b, 0x7a, 0xda, 0x9b, 0x8e, 0xfa, 0xa4, 0x54, 0x71, 0xdd, 0x4f, 0xff, 0x7c,
-	0xd1, 0xb4, 0xfe, 0x7a, 0xd1, 0xb4, 0xfe, 0x78, 0xd9, 0xb4, 0x9e, 0xbd, 0x6c, 0x5a, 0xdf, 0x1d,
-	0x5c, 0xac, 0xf6, 0x7c, 0xea, 0xb5, 0x0c, 0xf4, 0xb0, 0x24, 0xbf, 0x22, 0xdf, 0xff, 0x3b, 0x00,
-	0x00, 0xff, 0xff, 0xa9, 0x73, 0xa1, 0xad, 0x1c, 0x0b, 0x00, 0x00,
+	// 1071 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x6d, 0x6f, 0x1b, 0x45,
+	0x10, 0xe6, 0x9a, 0xf7, 0x89, 0x63, 0xb7, 0x9b, 0xe0, 0xa6, 0x57, 0x9a, 0x94, 0x95, 0x68, 0xa3,
+	0xa8, 0x3d, 0x9b, 0xd0, 0x00, 0x82, 0x4a, 0x55, 0x1c, 0x81, 0x13, 0x4a, 0xa3, 0x70, 0x86, 0x56,
+	0x02, 0x09, 0x69, 0x7d, 0xb7, 0xb5, 0x4f, 0x3d, 0xdf, 0x9a, 0xbd, 0xbd, 0x96, 0xfb, 0x19, 0x88,
+	0xdf, 0xc2, 0x77, 0xf8, 0xd6, 0x9f, 0x80, 0xfa, 0x21, 0x42, 0xed, 0x1f, 0x41, 0xb7, 0x2f, 0xf7,
+	0x16, 0xb7, 0xa2, 0x08, 0xbe, 0x58, 0x3b, 0xb3, 0xb3, 0xf3, 0xdc, 0xce, 0xce, 0xf3, 0x8c, 0xa1,
+	0xc9, 0xa7, 0xde, 0x4f, 0x09, 0xe5, 0xa9, 0x33, 0xe5, 0x4c, 0x30, 0xb4, 0x6c, 0x6c, 0xfb, 0xf6,
+	0x28, 0x10, 0xe3, 0x64, 0xe8, 0x78, 0x6c, 0xd2, 0x19, 0xb1, 0x11, 0xeb, 0xc8, 0x80, 0x61, 0xf2,
+	0x58, 0x5a, 0xd2, 0x90, 0x2b, 0x75, 0xd0, 0xfe, 0xa4, 0x14, 0x2e, 0x68, 0xe4, 0x53, 0x3e, 0x09,
+	0x22, 0x51, 0x5e, 0x92, 0xa1, 0x17, 0x74, 0x44, 0x3a, 0xa5, 0xb1, 0xfa, 0xd5, 0x07, 0x57, 0x23,
+	0x32, 0xc9, 0x8d, 0x15, 0xe2, 0x4d, 0xf4, 0xb2, 0xf5, 0x94, 0x84, 0x81, 0x4f, 0x04, 0xe3, 0xda,
+	0xd1, 0xe4, 0x74, 0x14, 0xc4, 0xc2, 0x7c, 0xaa, 0xbd, 0xc2, 0xa7, 0x9e, 0x5e, 0xae, 0x4d, 0x49,
+	0x1a, 0x32, 0xe2, 0x2b, 0x13, 0x07, 0xb0, 0x3a, 0x10, 0x44, 0x24, 0xf1, 0x29, 0xe1, 0x64, 0x82,
+	0x76, 0xa0, 0xd5, 0x0b, 0x99, 0xf7, 0xe4, 0xdb, 0x60, 0x42, 0x1f, 0x05, 0x62, 0x1c, 0x44, 0x9b,
+	0xd6, 0x75, 0x6b, 0x67, 0xc5, 0xad, 0xbb, 0x51, 0x17, 0xd6, 0xa5, 0x6b, 0x40, 0x69, 0x54, 0x8a,
+	0xbe, 0x20, 0xa3, 0x67, 0x6d, 0xe1, 0x14, 0x5a, 0x7d, 0x2a, 0x0e, 0x3c, 0x8f, 0x25, 0x91, 0x50,
+	0x70, 0x27, 0xb0, 0x74, 0xe0, 0xfb, 0x9c, 0xc6, 0xb1, 0x84, 0x69, 0xf4, 0xee, 0x3c, 0x3f, 0xdb,
+	0x7e, 0xe7, 0xc5, 0xd9, 0xf6, 0xad, 0x52, 0x89, 0xc6, 0xe9, 0x94, 0xf2, 0x90, 0xfa, 0x23, 0xca,
+	0x3b, 0xc3, 0x84, 0x73, 0xf6, 0xac, 0xe3, 0xf1, 0x74, 0x2a, 0x98, 0xa3, 0xcf, 0xba, 0x26, 0x09,
+	0x6a, 0xc3, 0xe2, 0x11, 0x0d, 0x46, 0x63, 0x21, 0xbf, 0x63, 0xde, 0xd5, 0x16, 0xfe, 0xcd, 0x82,
+	0x8b, 0x7d, 0x2a, 0x1e, 0x50, 0x41, 0x7c, 0x22, 0x88, 0x02, 0xff, 0xaa, 0x0e, 0xde, 0xfd, 0xf7,
+	0xc0, 0xdf, 0x41, 0xc3, 0x24, 0x3f, 0x22, 0xf1, 0x58, 0xc2, 0x37, 0x7a, 0x1f, 0xbe, 0x38, 0xdb,
+	0xbe, 0xfd, 0xe6, 0x84, 0xc3, 0x20, 0x22, 0x3c, 0x75, 0x8e, 0xe8, 0xcf, 0xbd, 0x54, 0xd0, 0xd8,
+	0xad, 0xa4, 0xc1, 0xb7, 0xa0, 0x69, 0x6c, 0x97, 0xc6, 0x49, 0x28, 0x90, 0x0d, 0xcb, 0xc6, 0xa3,
+	0x5f, 0x26, 0xb7, 0xf1, 0x1f, 0x96, 0xac, 0xf0, 0x40, 0x30, 0x4e, 0x46, 0xf4, 0xff, 0xa9, 0xf0,
+	0x97, 0x30, 0x77, 0x9f, 0xa6, 0x9b, 0x17, 0xde, 0x26, 0x97, 0xbe, 0xe3, 0x23, 0xc6, 0xfd, 0xbd,
+	0xfd, 0x8f, 0xdd, 0x2c, 0x41, 0xe9, 0xa5, 0xe6, 0x2a, 0x2f, 0xf5, 0x03, 0x34, 0xf4, 0xf7, 0x3f,
+	0x24, 0x61, 0x42, 0xd1, 0x7d, 0x58, 0x90, 0x0b, 0xfd, 0xf5, 0xfb, 0x1a, 0xf1, 0x2d, 0xab, 0xaa,
+	0x72, 0xe0, 0x7b, 0x70, 0xe9, 0xeb, 0x20, 0x36, 0x2d, 0xa8, 0x5b, 0x7e, 0x03, 0x16, 0xbe, 0xc9,
+	0x58, 0xac, 0xcb, 0xa9, 0x8c, 0xcc, 0x7b, 0xf0, 0x58, 0x50, 0xae, 0x6e, 0xea, 0x2a, 0x03, 0x63,
+	0x68, 0xf4, 0xa9, 0x38, 0x21, 0x13, 0x5d, 0x5d, 0x04, 0xf3, 0x99, 0xa1, 0x8f, 0xca, 0x35, 0xbe,
+	0x0b, 0xcd, 0x0c, 0x24, 0x5b, 0xff, 0x73, 0x84, 0x15, 0x83, 0x70, 0x05, 0x2e, 0x67, 0x08, 0x54,
+	0x3c, 0x63, 0xfc, 0x89, 0xab, 0x59, 0x2c, 0xd3, 0xe0, 0x36, 0x6c, 0xf4, 0xa9, 0x78, 0x68, 0xa8,
+	0x3e, 0xa0, 0x8a, 0x44, 0xb8, 0x0f, 0x57, 0x6b, 0xfe, 0xa3, 0x20, 0x16, 0x8c, 0xa7, 0x39, 0xa5,
+	0x8f, 0x23, 0x2f, 0x4c, 0x7c, 0x7a, 0xca, 0xe9, 0xd3, 0x80, 0x25, 0xaa, 0x13, 0xe6, 0xdc, 0xba,
+	0x1b, 0xf7, 0xa0, 0x55, 0x03, 0x46, 0x1d, 0x98, 0x1b, 0x50, 0xb1, 0x69, 0x5d, 0x9f, 0xdb, 0x59,
+	0xdd, 0xbb, 0xe6, 0xe4, 0x0a, 0xa8, 0x02, 0x28, 0xa7, 0x7e, 0x8e, 0xeb, 0x66, 0x91, 0xf8, 0x17,
+	0x0b, 0xd6, 0x67, 0x6c, 0xfe, 0xe7, 0x7d, 0xb8, 0x0b, 0xf3, 0x27, 0xcc, 0xa7, 0xb2, 0x78, 0xab,
+	0x7b, 0x6d, 0x27, 0x17, 0xbc, 0xcc, 0x7b, 0xec, 0xd3, 0x48, 0x04, 0x22, 0x75, 0x65, 0x0c, 0xee,
+	0xc3, 0xfa, 0x8c, 0xea, 0xa0, 0x2e, 0x2c, 0xe9, 0xa5, 0xbe, 0x5f, 0xbb, 0xb8, 0x5f, 0x39, 0xde,
+	0x35, 0x61, 0xf8, 0x04, 0x1a, 0xe5, 0x8d, 0xac, 0x89, 0xc7, 0xaa, 0x89, 0x2d, 0xd5, 0xc4, 0xca,
+	0x42, 0x37, 0x54, 0xd5, 0x2e, 0xc8, 0xac, 0x1b, 0x4e, 0xa1, 0xce, 0xb5, 0x62, 0xdd, 0x90, 0xaa,
+	0x74, 0xca, 0xd9, 0x94, 0xc5, 0x24, 0xcc, 0x5b, 0x4a, 0x2a, 0x88, 0xac, 0x92, 0x2b, 0xd7, 0xb8,
+	0x0b, 0x28, 0x6b, 0x29, 0x13, 0xa8, 0xdb, 0xca, 0x86, 0x65, 0xe5, 0xa1, 0xbe, 0x8c, 0x5e, 0x76,
+	0x73, 0x1b, 0x3f, 0x80, 0xa6, 0x89, 0xd6, 0xc2, 0x31, 0x23, 0x2f, 0xba, 0x09, 0x8b, 0x3d, 0x12,
+	0x86, 0x4c, 0xe8, 0x32, 0xb6, 0x1c, 0x33, 0x1c, 0x94, 0xdb, 0xd5, 0xdb, 0xb8, 0x05, 0x6b, 0x52,
+	0x58, 0x88, 0x26, 0x0d, 0xa6, 0xb0, 0x20, 0x2d, 0xb4, 0x0b, 0x17, 0x0d, 0x9d, 0x32, 0x99, 0x3f,
+	0xcc, 0xde, 0x44, 0x15, 0xe3, 0x9c, 0x3f, 0x1b, 0x19, 0x65, 0x1f, 0x4b, 0xc4, 0xa1, 0x79, 0xc2,
+	0x79, 0x77, 0xd6, 0x16, 0xbe, 0x29, 0x71, 0xe5, 0x30, 0x51, 0x77, 0x2e, 0x64, 0xc3, 0x2a, 0xcb,
+	0xc6, 0xde, 0xaf, 0x4b, 0x9a, 0x63, 0x68, 0x0f, 0x16, 0xd5, 0x40, 0x43, 0xef, 0x16, 0xcf, 0x59,
+	0x1a, 0x71, 0xf6, 0xa5, 0xcc, 0xed, 0xa8, 0xaa, 0xe8, 0xc8, 0x7d, 0x80, 0x62, 0x32, 0xa1, 0x2b,
+	0xc5, 0xb9, 0xda, 0xbc, 0xb2, 0x1b, 0x4e, 0x36, 0x74, 0x4d, 0xe0, 0x21, 0xac, 0x96, 0x86, 0x0a,
+	0xb2, 0x2b, 0xe7, 0x2a, 0xb3, 0xc6, 0xde, 0x2c, 0xf6, 0x6a, 0x82, 0x7e, 0x4f, 0x62, 0x6b, 0xcd,
+	0xab, 0x61, 0x97, 0x95, 0xdc, 0x6e, 0x97, 0xaf, 0x53, 0x52, 0xc8, 0xcf, 0xa1, 0x51, 0x16, 0x35,
+	0x74, 0xb5, 0x88, 0x3b, 0x27, 0x76, 0xd5, 0x0b, 0x74, 0x2d, 0xd4, 0x81, 0x25, 0x2d, 0x68, 0xa8,
+	0x5d, 0x81, 0xce, 0x35, 0xce, 0x6e, 0x38, 0xea, 0x5f, 0xc7, 0x17, 0x51, 0x26, 0x08, 0xfb, 0xb0,
+	0x92, 0xab, 0x1b, 0xda, 0xac, 0x42, 0x15, 0x92, 0x57, 0x3d, 0xd4, 0xb5, 0x90, 0x0b, 0xe8, 0xbc,
+	0xac, 0xa1, 0xf7, 0xab, 0x90, 0x33, 0x44, 0xcf, 0x2e, 0x15, 0xa4, 0x7e, 0xfa, 0x58, 0x4e, 0xbb,
+	0x0a, 0x21, 0xb7, 0x2a, 0x09, 0xcf, 0x49, 0xa5, 0xfd, 0x1a, 0x86, 0xa3, 0x1f, 0xa1, 0x3d, 0x5b,
+	0x42, 0xd1, 0x07, 0xaf, 0xcd, 0x58, 0x16, 0x59, 0xfb, 0xda, 0xec, 0xc4, 0x26, 0xcb, 0x67, 0xb2,
+	0x53, 0x0c, 0x23, 0x6b, 0x9d, 0x52, 0xe1, 0xbf, 0x5d, 0xe7, 0x20, 0x3a, 0x86, 0xb5, 0x0a, 0xf9,
+	0xd1, 0x7b, 0xd5, 0xaa, 0x57, 0x55, 0xa1, 0xdc, 0x69, 0x55, 0x05, 0xe8, 0x5a, 0xe8, 0x0e, 0x2c,
+	0x1b, 0x1a, 0xa3, 0xcb, 0xb5, 0x4e, 0x33, 0xd4, 0xb6, 0x5b, 0x55, 0xda, 0xc4, 0xe8, 0x53, 0x68,
+	0x1a, 0x12, 0x1e, 0x51, 0xe2, 0x53, 0x5e, 0x3b, 0x5b, 0xd0, 0xd3, 0x5e, 0x73, 0xd4, 0xdf, 0x55,
+	0x15, 0xd7, 0xbb, 0xfb, 0xe7, 0xcb, 0x2d, 0xeb, 0xaf, 0x97, 0x5b, 0xd6, 0xef, 0xaf, 0xb6, 0xac,
+	0xe7, 0xaf, 0xb6, 0xac, 0xef, 0x77, 0xdf, 0xac, 0xf6, 0x7c, 0xea, 0x75, 0x4c, 0xea, 0xe1, 0xa2,
+	0xfc, 0x87, 0xfa, 0xd1, 0xdf, 0x03, 0x00, 0xc7, 0xce, 0x25, 0x5a, 0x78, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1725,6 +1762,9 @@ func (m *GetAccountParam) Size() (n int) {
 	_ = l
 	l = m.Address.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1777,6 +1817,9 @@ func (m *GetStorageParam) Size() (n int) {
 	n += 1 + l + sovRpcquery(uint64(l))
 	l = m.Key.Size()
 	n += 1 + l + sovRpcquery(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovRpcquery(uint64(m.Height))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1807,6 +1850,10 @@ func (m *ListAccountsParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
@@ -1839,6 +1886,10 @@ func (m *ListNamesParam) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovRpcquery(uint64(l))
 	}
+	l = len(m.After)
+	if l > 0 {
+		n += 1 + l + sovRpcquery(uint64(l))
+	}
 	if m.XXX_unrecognized != nil {
 		n += len(m.XXX_unrecognized)
 	}
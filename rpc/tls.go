@@ -0,0 +1,287 @@
+package rpc
+
+import (
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/logging/structure"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// Matches any client or method in TLSConfig.ClientMethods
+const AnyClient = "*"
+
+// Number of verified client certificates to remember
+const maxVerifiedClients = 10000
+
+type TLSConfig struct {
+	// PEM encoded certificate (chain) and private key served to clients
+	CertFile string
+	KeyFile  string
+	// PEM encoded CA certificates with which to verify client certificates, when set clients must present a
+	// certificate signed by one of them (mutual TLS)
+	ClientCAFile string
+	// Methods (by short name, e.g. 'GetAccount' or 'eth_call') that each client (by the common name of its certificate)
+	// may call. Methods may be patterns like 'Get*' and '*' matches any client. When empty clients may call any method.
+	ClientMethods map[string][]string `json:",omitempty" toml:",omitempty"`
+}
+
+// TLS serves the certificates in a TLSConfig reloading them when their files change and authorises the methods that
+// clients may call. A nil TLS serves plaintext and authorises every call.
+type TLS struct {
+	config    *TLSConfig
+	loadedAt  time.Time
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	// Fingerprints of the client certificates we have verified
+	verified *lru.Cache
+	logger   *logging.Logger
+	sync.Mutex
+}
+
+// NewTLS returns nil (no TLS) when conf is nil
+func NewTLS(conf *TLSConfig, logger *logging.Logger) (*TLS, error) {
+	if conf == nil {
+		return nil, nil
+	}
+	if conf.CertFile == "" || conf.KeyFile == "" {
+		return nil, fmt.Errorf("TLS requires both CertFile and KeyFile")
+	}
+	// Only errors on non-positive size
+	verified, _ := lru.New(maxVerifiedClients)
+	t := &TLS{
+		config:   conf,
+		verified: verified,
+		logger:   logger.With(structure.ComponentKey, "TLS"),
+	}
+	err := t.load()
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
+
+// Config returns a tls.Config that serves the current certificate and verifies clients against the current CAs
+func (t *TLS) Config() *tls.Config {
+	conf := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetCertificate: func(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+			cert, _ := t.current()
+			return cert, nil
+		},
+	}
+	if t.config.ClientCAFile != "" {
+		// We verify against the current CAs ourselves so that they can be reloaded
+		conf.ClientAuth = tls.RequireAnyClientCert
+		conf.VerifyPeerCertificate = t.verifyClient
+	}
+	return conf
+}
+
+// Listener wraps listener to serve TLS, it is returned as it is by a nil TLS
+func (t *TLS) Listener(listener net.Listener) net.Listener {
+	if t == nil {
+		return listener
+	}
+	return tls.NewListener(listener, t.Config())
+}
+
+// Authorize returns whether the client identified by a connection may call method
+func (t *TLS) Authorize(state *tls.ConnectionState, methods ...string) bool {
+	if t == nil || len(t.config.ClientMethods) == 0 {
+		return true
+	}
+	patterns, ok := t.config.ClientMethods[ClientName(state)]
+	if !ok {
+		patterns = t.config.ClientMethods[AnyClient]
+	}
+	for _, method := range methods {
+		if !matchAny(patterns, method) {
+			return false
+		}
+	}
+	return true
+}
+
+// Verified returns whether the certificate a client presented on a connection was verified against our client CAs.
+// Certificates are verified during the handshake but resumed sessions skip that, so one we do not remember verifying is
+// verified again.
+func (t *TLS) Verified(state *tls.ConnectionState) bool {
+	if t == nil || t.config.ClientCAFile == "" || state == nil || len(state.PeerCertificates) == 0 {
+		return false
+	}
+	if _, ok := t.verified.Get(sha256.Sum256(state.PeerCertificates[0].Raw)); ok {
+		return true
+	}
+	return t.verifyChain(state.PeerCertificates) == nil
+}
+
+// ClientName is the common name of a client's certificate or empty if it did not present one
+func ClientName(state *tls.ConnectionState) string {
+	if state == nil || len(state.PeerCertificates) == 0 {
+		return ""
+	}
+	return state.PeerCertificates[0].Subject.CommonName
+}
+
+// authorizeGRPC returns a PermissionDenied status error when the client of a call may not call fullMethod
+func (t *TLS) authorizeGRPC(ctx context.Context, fullMethod string) error {
+	if t == nil {
+		return nil
+	}
+	var state *tls.ConnectionState
+	if p, ok := peer.FromContext(ctx); ok {
+		if tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo); ok {
+			state = &tlsInfo.State
+		}
+	}
+	method := path.Base(fullMethod)
+	if !t.Authorize(state, method) {
+		return status.Errorf(codes.PermissionDenied, "client '%s' may not call %s", ClientName(state), method)
+	}
+	return nil
+}
+
+// AuthorizeHandler rejects requests for methods (named as for RateLimitHandler) that the client may not call with
+// 403 Forbidden
+func AuthorizeHandler(t *TLS, handler http.Handler) http.Handler {
+	if t == nil || len(t.config.ClientMethods) == 0 {
+		return handler
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		r, methods, err := httpMethods(r)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if !t.Authorize(r.TLS, methods...) {
+			http.Error(w, fmt.Sprintf("client '%s' may not call %s", ClientName(r.TLS),
+				strings.Join(methods, ", ")), http.StatusForbidden)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// CallFilter checks each call a client makes over a connection opened by a request, such as a websocket, against the
+// methods it may call with t and its limit in rl, either of which may be nil
+func CallFilter(t *TLS, rl *RateLimiter) func(r *http.Request, method string) error {
+	return func(r *http.Request, method string) error {
+		if !t.Authorize(r.TLS, method) {
+			return fmt.Errorf("client '%s' may not call %s", ClientName(r.TLS), method)
+		}
+		if rl != nil && !rl.Allow(rl.HTTPClient(r, t), method) {
+			return fmt.Errorf("rate limit exceeded for %s", method)
+		}
+		return nil
+	}
+}
+
+func (t *TLS) current() (*tls.Certificate, *x509.CertPool) {
+	t.Lock()
+	defer t.Unlock()
+	if t.modified() {
+		err := t.load()
+		if err != nil {
+			// Files may be part way through being replaced so keep serving what we have
+			t.logger.InfoMsg("could not reload TLS certificates", structure.ErrorKey, err)
+		}
+	}
+	return t.cert, t.clientCAs
+}
+
+// Whether any of our files have changed since we last loaded them
+func (t *TLS) modified() bool {
+	for _, file := range []string{t.config.CertFile, t.config.KeyFile, t.config.ClientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err == nil && info.ModTime().After(t.loadedAt) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t *TLS) load() error {
+	loadedAt := time.Now()
+	cert, err := tls.LoadX509KeyPair(t.config.CertFile, t.config.KeyFile)
+	if err != nil {
+		return fmt.Errorf("could not load TLS certificate: %v", err)
+	}
+	var clientCAs *x509.CertPool
+	if t.config.ClientCAFile != "" {
+		pem, err := ioutil.ReadFile(t.config.ClientCAFile)
+		if err != nil {
+			return fmt.Errorf("could not read client CA certificates: %v", err)
+		}
+		clientCAs = x509.NewCertPool()
+		if !clientCAs.AppendCertsFromPEM(pem) {
+			return fmt.Errorf("no client CA certificates found in %s", t.config.ClientCAFile)
+		}
+	}
+	t.cert = &cert
+	t.clientCAs = clientCAs
+	t.loadedAt = loadedAt
+	return nil
+}
+
+func (t *TLS) verifyClient(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+	if len(rawCerts) == 0 {
+		return fmt.Errorf("client did not present a certificate")
+	}
+	certs := make([]*x509.Certificate, len(rawCerts))
+	for i, raw := range rawCerts {
+		cert, err := x509.ParseCertificate(raw)
+		if err != nil {
+			return fmt.Errorf("could not parse client certificate: %v", err)
+		}
+		certs[i] = cert
+	}
+	return t.verifyChain(certs)
+}
+
+// Verifies a client's certificate chain, leaf first, remembering the leaf if it is valid
+func (t *TLS) verifyChain(certs []*x509.Certificate) error {
+	_, clientCAs := t.current()
+	opts := x509.VerifyOptions{
+		Roots:         clientCAs,
+		Intermediates: x509.NewCertPool(),
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	for _, cert := range certs[1:] {
+		opts.Intermediates.AddCert(cert)
+	}
+	_, err := certs[0].Verify(opts)
+	if err != nil {
+		return err
+	}
+	t.verified.Add(sha256.Sum256(certs[0].Raw), struct{}{})
+	return nil
+}
+
+func matchAny(patterns []string, method string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, method); ok {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,154 @@
+package rpc
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestTLS(t *testing.T) {
+	dir, err := ioutil.TempDir("", "burrow-tls")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	ca := newCertificate(t, "ca", nil)
+	conf := &TLSConfig{
+		CertFile:     filepath.Join(dir, "server.pem"),
+		KeyFile:      filepath.Join(dir, "server.key"),
+		ClientCAFile: filepath.Join(dir, "ca.pem"),
+		ClientMethods: map[string][]string{
+			"reader": {"status", "Get*"},
+			"admin":  {"*"},
+		},
+	}
+	writeCertificate(t, newCertificate(t, "server", ca), conf.CertFile, conf.KeyFile)
+	writeCertificate(t, ca, conf.ClientCAFile, filepath.Join(dir, "ca.key"))
+
+	tlsConf, err := NewTLS(conf, logging.NewNoopLogger())
+	require.NoError(t, err)
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	srv := &http.Server{Handler: AuthorizeHandler(tlsConf, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	}))}
+	go srv.Serve(tlsConf.Listener(listener))
+	defer srv.Close()
+
+	roots := x509.NewCertPool()
+	roots.AddCert(ca.Leaf)
+	get := func(client *tls.Certificate, path string) (string, int, error) {
+		clientConf := &tls.Config{RootCAs: roots, ServerName: "localhost"}
+		if client != nil {
+			clientConf.Certificates = []tls.Certificate{*client}
+		}
+		httpClient := &http.Client{Transport: &http.Transport{TLSClientConfig: clientConf}}
+		res, err := httpClient.Get("https://" + listener.Addr().String() + path)
+		if err != nil {
+			return "", 0, err
+		}
+		defer res.Body.Close()
+		return res.TLS.PeerCertificates[0].Subject.CommonName, res.StatusCode, nil
+	}
+
+	reader := newCertificate(t, "reader", ca)
+	_, code, err := get(reader, "/status")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	_, code, err = get(reader, "/broadcast_tx_sync")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusForbidden, code)
+	_, code, err = get(newCertificate(t, "admin", ca), "/broadcast_tx_sync")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	// Clients must present a certificate signed by our CA
+	_, _, err = get(nil, "/status")
+	assert.Error(t, err)
+	_, _, err = get(newCertificate(t, "admin", newCertificate(t, "ca", nil)), "/status")
+	assert.Error(t, err)
+
+	// Certificates are reloaded when they change
+	writeCertificate(t, newCertificate(t, "renewed", ca), conf.CertFile, conf.KeyFile)
+	future := time.Now().Add(time.Second)
+	require.NoError(t, os.Chtimes(conf.KeyFile, future, future))
+	name, code, err := get(reader, "/status")
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, code)
+	assert.Equal(t, "renewed", name)
+
+	// GRPC
+	ctx := peer.NewContext(context.Background(), &peer.Peer{
+		Addr:     listener.Addr(),
+		AuthInfo: credentials.TLSInfo{State: tls.ConnectionState{PeerCertificates: []*x509.Certificate{reader.Leaf}}},
+	})
+	assert.NoError(t, tlsConf.authorizeGRPC(ctx, "/rpcquery.Query/GetAccount"))
+	err = tlsConf.authorizeGRPC(ctx, "/rpctransact.Transact/BroadcastTxSync")
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	assert.True(t, strings.Contains(err.Error(), "reader"))
+
+	// Disabled
+	tlsConf, err = NewTLS(nil, logging.NewNoopLogger())
+	require.NoError(t, err)
+	assert.Nil(t, tlsConf)
+	assert.True(t, tlsConf.Authorize(nil, "BroadcastTxSync"))
+	assert.Equal(t, listener, tlsConf.Listener(listener))
+}
+
+// Issues a certificate for name signed by issuer, or self-signed CA certificate when issuer is nil
+func newCertificate(t *testing.T, name string, issuer *tls.Certificate) *tls.Certificate {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		DNSNames:     []string{"localhost"},
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	parent, signer := template, interface{}(key)
+	if issuer == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+	} else {
+		parent, signer = issuer.Leaf, issuer.PrivateKey
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, signer)
+	require.NoError(t, err)
+	leaf, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return &tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key, Leaf: leaf}
+}
+
+func writeCertificate(t *testing.T, cert *tls.Certificate, certFile, keyFile string) {
+	keyDER, err := x509.MarshalECPrivateKey(cert.PrivateKey.(*ecdsa.PrivateKey))
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(certFile,
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Certificate[0]}), 0600))
+	require.NoError(t, ioutil.WriteFile(keyFile,
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+}
@@ -0,0 +1,9 @@
+// +build cleveldb
+
+package storage
+
+import dbm "github.com/tendermint/tm-db"
+
+func init() {
+	tmDBBackends[dbm.CLevelDBBackend] = true
+}
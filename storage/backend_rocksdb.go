@@ -0,0 +1,9 @@
+// +build rocksdb
+
+package storage
+
+import dbm "github.com/tendermint/tm-db"
+
+func init() {
+	tmDBBackends[dbm.RocksDBBackend] = true
+}
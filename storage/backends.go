@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// Backends implemented by Burrow rather than tm-db
+var dbCreators = make(map[dbm.BackendType]func(name, dir string) (dbm.DB, error))
+
+// Backends compiled in to tm-db, cleveldb and rocksdb are added with their build tags
+var tmDBBackends = map[dbm.BackendType]bool{
+	dbm.GoLevelDBBackend: true,
+	dbm.MemDBBackend:     true,
+	dbm.FSDBBackend:      true,
+}
+
+// Backends returns the database backends compiled in to this build
+func Backends() []dbm.BackendType {
+	var backends []dbm.BackendType
+	for backend := range tmDBBackends {
+		backends = append(backends, backend)
+	}
+	for backend := range dbCreators {
+		if !tmDBBackends[backend] {
+			backends = append(backends, backend)
+		}
+	}
+	sort.Slice(backends, func(i, j int) bool {
+		return backends[i] < backends[j]
+	})
+	return backends
+}
+
+// CheckBackend returns an error if backend is not compiled in to this build
+func CheckBackend(backend dbm.BackendType) error {
+	if _, ok := dbCreators[backend]; ok || tmDBBackends[backend] {
+		return nil
+	}
+	backends := Backends()
+	names := make([]string, len(backends))
+	for i, b := range backends {
+		names[i] = string(b)
+	}
+	return fmt.Errorf("database backend '%s' is unknown or not compiled in to this build, expected one of: %s",
+		backend, strings.Join(names, ", "))
+}
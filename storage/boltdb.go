@@ -0,0 +1,297 @@
+package storage
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+
+	dbm "github.com/tendermint/tm-db"
+	"go.etcd.io/bbolt"
+)
+
+// The single bucket holding the keys of a BoltDB
+var boltBucket = []byte("tm")
+
+func init() {
+	// tm-db's own boltdb backend does not build against its current interface
+	dbCreators[dbm.BoltDBBackend] = func(name, dir string) (dbm.DB, error) {
+		return NewBoltDB(name, dir)
+	}
+}
+
+// BoltDB is a dbm.DB backed by etcd's fork of bolt (https://github.com/etcd-io/bbolt), a pure Go B+tree store
+// holding the database in a single file. Writes are synchronous. The file cannot grow while an iterator is open so a
+// write that needs it to blocks until every iterator is closed.
+type BoltDB struct {
+	db *bbolt.DB
+	// Iterators not yet closed, which would otherwise block Close
+	mtx       sync.Mutex
+	iterators map[*boltIterator]struct{}
+}
+
+var _ dbm.DB = &BoltDB{}
+
+// NewBoltDB opens (or creates) the database called name in dir
+func NewBoltDB(name, dir string) (*BoltDB, error) {
+	db, err := bbolt.Open(filepath.Join(dir, name+".db"), os.ModePerm, bbolt.DefaultOptions)
+	if err != nil {
+		return nil, err
+	}
+	err = db.Update(func(tx *bbolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(boltBucket)
+		return err
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &BoltDB{
+		db:        db,
+		iterators: make(map[*boltIterator]struct{}),
+	}, nil
+}
+
+func (bdb *BoltDB) Get(key []byte) (value []byte, err error) {
+	err = bdb.db.View(func(tx *bbolt.Tx) error {
+		if v := tx.Bucket(boltBucket).Get(boltKey(key)); v != nil {
+			value = append([]byte{}, v...)
+		}
+		return nil
+	})
+	return value, err
+}
+
+func (bdb *BoltDB) Has(key []byte) (bool, error) {
+	value, err := bdb.Get(key)
+	return value != nil, err
+}
+
+func (bdb *BoltDB) Set(key, value []byte) error {
+	if value == nil {
+		value = []byte{}
+	}
+	return bdb.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(boltBucket).Put(boltKey(key), value)
+	})
+}
+
+func (bdb *BoltDB) SetSync(key, value []byte) error {
+	return bdb.Set(key, value)
+}
+
+func (bdb *BoltDB) Delete(key []byte) error {
+	return bdb.db.Update(func(tx *bbolt.Tx) error {
+		return tx.Bucket(boltBucket).Delete(boltKey(key))
+	})
+}
+
+func (bdb *BoltDB) DeleteSync(key []byte) error {
+	return bdb.Delete(key)
+}
+
+func (bdb *BoltDB) Iterator(start, end []byte) (dbm.Iterator, error) {
+	return bdb.newIterator(start, end, false)
+}
+
+func (bdb *BoltDB) ReverseIterator(start, end []byte) (dbm.Iterator, error) {
+	return bdb.newIterator(start, end, true)
+}
+
+// Close closes the database, first closing any iterators still open
+func (bdb *BoltDB) Close() error {
+	bdb.mtx.Lock()
+	var iterators []*boltIterator
+	for it := range bdb.iterators {
+		iterators = append(iterators, it)
+	}
+	bdb.mtx.Unlock()
+	for _, it := range iterators {
+		it.Close()
+	}
+	return bdb.db.Close()
+}
+
+func (bdb *BoltDB) NewBatch() dbm.Batch {
+	return &boltBatch{db: bdb.db}
+}
+
+func (bdb *BoltDB) Print() error {
+	return bdb.db.View(func(tx *bbolt.Tx) error {
+		return tx.Bucket(boltBucket).ForEach(func(k, v []byte) error {
+			fmt.Printf("[%X]:\t[%X]\n", k, v)
+			return nil
+		})
+	})
+}
+
+func (bdb *BoltDB) Stats() map[string]string {
+	stats := bdb.db.Stats()
+	return map[string]string{
+		"FreePageN":     fmt.Sprintf("%v", stats.FreePageN),
+		"PendingPageN":  fmt.Sprintf("%v", stats.PendingPageN),
+		"FreeAlloc":     fmt.Sprintf("%v", stats.FreeAlloc),
+		"FreelistInuse": fmt.Sprintf("%v", stats.FreelistInuse),
+		"TxN":           fmt.Sprintf("%v", stats.TxN),
+		"OpenTxN":       fmt.Sprintf("%v", stats.OpenTxN),
+	}
+}
+
+// Bolt does not allow empty keys so an empty key is stored as the key consisting of a single zero byte
+func boltKey(key []byte) []byte {
+	if len(key) == 0 {
+		return []byte{0}
+	}
+	return key
+}
+
+type boltOp struct {
+	key    []byte
+	value  []byte
+	delete bool
+}
+
+type boltBatch struct {
+	db  *bbolt.DB
+	ops []boltOp
+}
+
+func (batch *boltBatch) Set(key, value []byte) {
+	if value == nil {
+		value = []byte{}
+	}
+	batch.ops = append(batch.ops, boltOp{key: boltKey(key), value: value})
+}
+
+func (batch *boltBatch) Delete(key []byte) {
+	batch.ops = append(batch.ops, boltOp{key: boltKey(key), delete: true})
+}
+
+func (batch *boltBatch) Write() error {
+	return batch.db.Update(func(tx *bbolt.Tx) error {
+		b := tx.Bucket(boltBucket)
+		for _, op := range batch.ops {
+			var err error
+			if op.delete {
+				err = b.Delete(op.key)
+			} else {
+				err = b.Put(op.key, op.value)
+			}
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (batch *boltBatch) WriteSync() error {
+	return batch.Write()
+}
+
+func (batch *boltBatch) Close() {
+	batch.ops = nil
+}
+
+// Iterates over the keys of a read-only transaction held open until the iterator is closed
+type boltIterator struct {
+	db      *BoltDB
+	tx      *bbolt.Tx
+	cursor  *bbolt.Cursor
+	start   []byte
+	end     []byte
+	reverse bool
+	key     []byte
+	value   []byte
+	invalid bool
+	err     error
+}
+
+func (bdb *BoltDB) newIterator(start, end []byte, reverse bool) (*boltIterator, error) {
+	tx, err := bdb.db.Begin(false)
+	if err != nil {
+		return nil, err
+	}
+	it := &boltIterator{
+		db:      bdb,
+		tx:      tx,
+		cursor:  tx.Bucket(boltBucket).Cursor(),
+		start:   start,
+		end:     end,
+		reverse: reverse,
+	}
+	if !reverse {
+		if start == nil {
+			it.key, it.value = it.cursor.First()
+		} else {
+			it.key, it.value = it.cursor.Seek(start)
+		}
+	} else if end == nil {
+		it.key, it.value = it.cursor.Last()
+	} else if k, _ := it.cursor.Seek(end); k == nil {
+		// Every key precedes end
+		it.key, it.value = it.cursor.Last()
+	} else {
+		it.key, it.value = it.cursor.Prev()
+	}
+	bdb.mtx.Lock()
+	bdb.iterators[it] = struct{}{}
+	bdb.mtx.Unlock()
+	return it, nil
+}
+
+func (it *boltIterator) Domain() ([]byte, []byte) {
+	return it.start, it.end
+}
+
+func (it *boltIterator) Valid() bool {
+	if it.invalid {
+		return false
+	}
+	if it.key == nil ||
+		(it.reverse && it.start != nil && bytes.Compare(it.key, it.start) < 0) ||
+		(!it.reverse && it.end != nil && bytes.Compare(it.key, it.end) >= 0) {
+		it.invalid = true
+	}
+	return !it.invalid
+}
+
+func (it *boltIterator) Next() {
+	it.assertValid()
+	if it.reverse {
+		it.key, it.value = it.cursor.Prev()
+	} else {
+		it.key, it.value = it.cursor.Next()
+	}
+}
+
+func (it *boltIterator) Key() []byte {
+	it.assertValid()
+	return append([]byte{}, it.key...)
+}
+
+func (it *boltIterator) Value() []byte {
+	it.assertValid()
+	return append([]byte{}, it.value...)
+}
+
+func (it *boltIterator) Error() error {
+	return it.err
+}
+
+func (it *boltIterator) Close() {
+	it.db.mtx.Lock()
+	defer it.db.mtx.Unlock()
+	if _, ok := it.db.iterators[it]; !ok {
+		return
+	}
+	delete(it.db.iterators, it)
+	it.err = it.tx.Rollback()
+}
+
+func (it *boltIterator) assertValid() {
+	if !it.Valid() {
+		panic("BoltDB iterator is invalid")
+	}
+}
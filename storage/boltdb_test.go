@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestBoltDB(t *testing.T) {
+	dir, err := ioutil.TempDir("", "TestBoltDB")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	db, err := OpenDB("test", dbm.BoltDBBackend, dir)
+	require.NoError(t, err)
+	batch := db.NewBatch()
+	for _, k := range []string{"a", "b", "c", "d"} {
+		batch.Set([]byte(k), []byte("value-"+k))
+	}
+	batch.Delete([]byte("d"))
+	require.NoError(t, batch.Write())
+	require.NoError(t, db.Set(nil, []byte("empty")))
+
+	value, err := db.Get([]byte("b"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value-b"), value)
+	has, err := db.Has([]byte("d"))
+	require.NoError(t, err)
+	assert.False(t, has)
+	value, err = db.Get(nil)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("empty"), value)
+
+	keys := func(it dbm.Iterator, err error) []string {
+		require.NoError(t, err)
+		defer it.Close()
+		var ks []string
+		for ; it.Valid(); it.Next() {
+			ks = append(ks, string(it.Key()))
+		}
+		require.NoError(t, it.Error())
+		return ks
+	}
+	assert.Equal(t, []string{"a", "b"}, keys(db.Iterator([]byte("a"), []byte("c"))))
+	assert.Equal(t, []string{"b", "c"}, keys(db.Iterator([]byte("b"), nil)))
+	assert.Equal(t, []string{"b", "a"}, keys(db.ReverseIterator([]byte("a"), []byte("c"))))
+	assert.Equal(t, []string{"c", "b", "a"}, keys(db.ReverseIterator([]byte("a"), []byte("z"))))
+	assert.Equal(t, []string{"c", "b"}, keys(db.ReverseIterator([]byte("b"), nil)))
+	require.NoError(t, db.Close())
+
+	// Reopen
+	db, err = OpenDB("test", dbm.BoltDBBackend, dir)
+	require.NoError(t, err)
+	defer db.Close()
+	value, err = db.Get([]byte("c"))
+	require.NoError(t, err)
+	assert.Equal(t, []byte("value-c"), value)
+}
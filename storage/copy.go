@@ -0,0 +1,68 @@
+package storage
+
+import (
+	"fmt"
+
+	dbm "github.com/tendermint/tm-db"
+)
+
+// The number of keys written to the destination DB in each batch by CopyDB
+const copyBatchSize = 10000
+
+// OpenDB opens (or creates) the DB called name in dir using backend returning an error rather than panicking if the
+// backend is unknown (i.e. not compiled in) or the DB cannot be opened
+func OpenDB(name string, backend dbm.BackendType, dir string) (db dbm.DB, err error) {
+	err = CheckBackend(backend)
+	if err != nil {
+		return nil, err
+	}
+	if create, ok := dbCreators[backend]; ok {
+		db, err = create(name, dir)
+		if err != nil {
+			return nil, fmt.Errorf("could not open %s DB '%s' in %s: %v", backend, name, dir, err)
+		}
+		return db, nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not open %s DB '%s' in %s: %v", backend, name, dir, r)
+		}
+	}()
+	return dbm.NewDB(name, backend, dir), nil
+}
+
+// CopyDB copies every key in src to dst returning the number of keys copied
+func CopyDB(dst dbm.DB, src KVIterable) (n uint64, err error) {
+	defer func() {
+		// Some experimental backends panic rather than return errors for unimplemented features
+		if r := recover(); r != nil {
+			err = fmt.Errorf("could not copy DB: %v", r)
+		}
+	}()
+	it, err := src.Iterator(nil, nil)
+	if err != nil {
+		return 0, err
+	}
+	defer it.Close()
+
+	batch := dst.NewBatch()
+	defer func() {
+		batch.Close()
+	}()
+	for ; it.Valid(); it.Next() {
+		batch.Set(it.Key(), it.Value())
+		n++
+		if n%copyBatchSize == 0 {
+			err = batch.Write()
+			if err != nil {
+				return n, err
+			}
+			batch.Close()
+			batch = dst.NewBatch()
+		}
+	}
+	if err = it.Error(); err != nil {
+		return n, err
+	}
+	return n, batch.WriteSync()
+}
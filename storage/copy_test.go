@@ -0,0 +1,38 @@
+package storage
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+)
+
+func TestCopyDB(t *testing.T) {
+	src := dbm.NewMemDB()
+	for i := 0; i < copyBatchSize*2+7; i++ {
+		require.NoError(t, src.Set([]byte(fmt.Sprintf("key-%06d", i)), []byte(fmt.Sprintf("value-%d", i))))
+	}
+	dst := dbm.NewMemDB()
+	n, err := CopyDB(dst, src)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(copyBatchSize*2+7), n)
+
+	it, err := src.Iterator(nil, nil)
+	require.NoError(t, err)
+	defer it.Close()
+	for ; it.Valid(); it.Next() {
+		value, err := dst.Get(it.Key())
+		require.NoError(t, err)
+		assert.Equal(t, it.Value(), value)
+	}
+}
+
+func TestOpenDB(t *testing.T) {
+	_, err := OpenDB("test", "notadb", "")
+	require.Error(t, err)
+	db, err := OpenDB("test", dbm.MemDBBackend, "")
+	require.NoError(t, err)
+	require.NotNil(t, db)
+}
@@ -18,6 +18,8 @@ const (
 // Access the read path of a forest
 type ForestReader interface {
 	Reader(prefix []byte) (KVCallbackIterableReader, error)
+	// Iterate over every tree in the forest by prefix
+	Iterate(start, end []byte, ascending bool, fn func(prefix []byte, tree KVCallbackIterableReader) error) error
 }
 
 // MutableForest is a collection of versioned lazily-loaded RWTrees organised by prefix. It maintains a global state hash
@@ -257,10 +259,18 @@ func (imf *ImmutableForest) Dump() string {
 func (imf *ImmutableForest) tree(prefix []byte) (*RWTree, error) {
 	// Try cache
 	if value, ok := imf.treeCache.Get(string(prefix)); ok {
+		forestCacheHits.Inc()
 		return value.(*RWTree), nil
 	}
+	forestCacheMisses.Inc()
 	// Not in caches but non-negative version - we should be able to load into memory
-	return imf.loadOrCreateTree(prefix)
+	tree, err := imf.loadOrCreateTree(prefix)
+	if err != nil {
+		return nil, err
+	}
+	// Only cache the tree once loaded so that concurrent readers never see a partially loaded tree
+	imf.treeCache.Add(string(prefix), tree)
+	return tree, nil
 }
 
 func (imf *ImmutableForest) commitID(prefix []byte) (*CommitID, error) {
@@ -287,7 +297,7 @@ func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 	}
 	if commitID.Version == 0 {
 		// This is the first time we have been asked to load this tree
-		return imf.newTree(prefix)
+		return tree, nil
 	}
 	err = tree.Load(commitID.Version, imf.overwriting)
 	if err != nil {
@@ -298,13 +308,7 @@ func (imf *ImmutableForest) loadOrCreateTree(prefix []byte) (*RWTree, error) {
 
 // Create a new in-memory IAVL tree
 func (imf *ImmutableForest) newTree(prefix []byte) (*RWTree, error) {
-	p := string(prefix)
-	tree, err := NewRWTree(NewPrefixDB(imf.treeDB, p), imf.cacheSize)
-	if err != nil {
-		return nil, err
-	}
-	imf.treeCache.Add(p, tree)
-	return tree, nil
+	return NewRWTree(NewPrefixDB(imf.treeDB, string(prefix)), imf.cacheSize)
 }
 
 // CommitID serialisation
@@ -0,0 +1,23 @@
+package storage
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	forestCacheHits = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "storage",
+		Name:      "forest_cache_hits_total",
+		Help:      "Trees read from the forest's cache",
+	})
+	forestCacheMisses = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "burrow",
+		Subsystem: "storage",
+		Name:      "forest_cache_misses_total",
+		Help:      "Trees loaded into the forest's cache",
+	})
+)
+
+// Collectors returns the metrics recorded by storage (see rpc/metrics)
+func Collectors() []prometheus.Collector {
+	return []prometheus.Collector{forestCacheHits, forestCacheMisses}
+}
@@ -46,7 +46,8 @@ $burrow_bin deploy -o '' -a Validator_0 --dir $burrow_dump deploy.yaml
 title="Dumping chain..."
 echo -e "${title//?/-}\n${title}\n${title//?/-}\n"
 
-$burrow_bin dump remote -b dump.bin
+validator=$(jq -r '.Validators[0].Address' genesis.json)
+$burrow_bin dump remote -b --sign $validator dump.bin
 $burrow_bin dump remote dump.json
 height=$(head -1  dump.json | jq .Height)
 
@@ -60,9 +61,9 @@ rm -rf .burrow burrow.toml
 title="Create new chain based of dump with new name..."
 echo -e "\n${title//?/-}\n${title}\n${title//?/-}\n"
 
-$burrow_bin configure -m BurrowTestRestoreNode -e "always" -n "Restored Chain" --genesis genesis-original.json --separate-genesis-doc genesis.json --restore-dump dump.json > burrow.toml
+$burrow_bin configure -m BurrowTestRestoreNode -e "always" -n "Restored Chain" --genesis genesis-original.json --separate-genesis-doc genesis.json --restore-dump dump.bin > burrow.toml
 
-$burrow_bin restore dump.json
+$burrow_bin restore dump.bin
 $burrow_bin start 2>> burrow.log &
 burrow_pid=$!
 sleep 13
@@ -11,29 +11,46 @@ const DefaultPostgresDBURL = "postgres://postgres@localhost:5432/postgres?sslmod
 
 // VentConfig is a set of configuration parameters
 type VentConfig struct {
-	DBAdapter      string
-	DBURL          string
-	DBSchema       string
-	GRPCAddr       string
+	DBAdapter string
+	DBURL     string
+	DBSchema  string
+	GRPCAddr  string
+	// Further chains to project into the same database alongside the one at GRPCAddr (requires the MultiChain SpecOpt)
+	ChainGRPCAddrs []string
 	HTTPAddr       string
+	// Address at which to serve the read API over gRPC (if set) when it is served over HTTP at HTTPAddr
+	QueryGRPCAddr  string
 	LogLevel       string
 	SpecFileOrDirs []string
-	AbiFileOrDirs  []string
-	SpecOpt        sqlsol.SpecOpt
+	// State spec files projecting account state and contract storage
+	StateSpecFileOrDirs []string
+	AbiFileOrDirs       []string
+	SpecOpt             sqlsol.SpecOpt
 	// Announce status every AnnouncePeriod
 	AnnounceEvery time.Duration
+	// How long to keep notifications in databases that store them for clients to poll (MySQL), zero keeps them forever
+	NotifyRetention time.Duration
+	// NATS server URL to which to publish a change data capture stream of rows instead of writing them to the database
+	BusURL string
+	// JetStream stream (created if it does not exist) capturing the subjects published to
+	BusStream string
+	// Prefix of the subject of each table, ending with '.'
+	BusTopicPrefix string
 }
 
 // DefaultFlags returns a configuration with default values
 func DefaultVentConfig() *VentConfig {
 	return &VentConfig{
-		DBAdapter:     types.PostgresDB,
-		DBURL:         DefaultPostgresDBURL,
-		DBSchema:      "vent",
-		GRPCAddr:      "localhost:10997",
-		HTTPAddr:      "0.0.0.0:8080",
-		LogLevel:      "debug",
-		SpecOpt:       sqlsol.None,
-		AnnounceEvery: time.Second * 5,
+		DBAdapter:       types.PostgresDB,
+		DBURL:           DefaultPostgresDBURL,
+		DBSchema:        "vent",
+		GRPCAddr:        "localhost:10997",
+		HTTPAddr:        "0.0.0.0:8080",
+		LogLevel:        "debug",
+		SpecOpt:         sqlsol.None,
+		AnnounceEvery:   time.Second * 5,
+		NotifyRetention: time.Hour,
+		BusStream:       "VENT",
+		BusTopicPrefix:  "vent.",
 	}
 }
@@ -3,6 +3,8 @@ package service
 import (
 	"context"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
@@ -10,13 +12,59 @@ import (
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 )
 
+// How long to wait for burrow to answer the lookups made to resolve the ABI of an event
+const abiLookupTimeout = 10 * time.Second
+
+// How long to remember that burrow holds no ABI for an event before asking again
+const missingAbiTTL = 10 * time.Minute
+
 type EventSpecGetter func(abi.EventID, crypto.Address) (*abi.EventSpec, error)
 
+// abiLookupError is returned when burrow could not be asked for the ABI of an event, so that unlike an ABI that is
+// absent it may yet be found by trying again
+type abiLookupError struct {
+	err error
+}
+
+func (e *abiLookupError) Error() string {
+	return fmt.Sprintf("could not look up ABI in burrow: %v", e.err)
+}
+
+// isAbiLookupError reports whether err means the ABI of an event could not be looked up rather than that it is absent
+func isAbiLookupError(err error) bool {
+	_, ok := err.(*abiLookupError)
+	return ok
+}
+
+// AbiCache persists the metadata from which the ABIs of contracts were resolved between runs
+type AbiCache interface {
+	GetAbis(chainID string) (map[crypto.Address]string, error)
+	SetAbi(chainID string, address crypto.Address, metadata string) error
+}
+
+type abiKey struct {
+	eventID abi.EventID
+	address crypto.Address
+}
+
+type missingAbi struct {
+	err     error
+	expires time.Time
+}
+
 // AbiProvider provides a method for loading ABIs from disk, and retrieving them from burrow on-demand
 type AbiProvider struct {
 	abiSpec *abi.Spec
 	cli     rpcquery.QueryClient
 	logger  *logging.Logger
+	// Guards the spec shared by the goroutines projecting the head of the chain and backfilling it
+	mtx sync.Mutex
+	// Metadata resolved from burrow by contract address that has not yet been taken to be cached
+	resolved map[crypto.Address]string
+	// Events for which burrow was found to hold no ABI so that they are not looked up again until they expire
+	missing    map[abiKey]missingAbi
+	missingTTL time.Duration
+	timeout    time.Duration
 }
 
 // NewAbiProvider loads ABIs from the filesystem. A set of zero or more files or directories can be passed in the path
@@ -31,40 +79,148 @@ func NewAbiProvider(paths []string, cli rpcquery.QueryClient, logger *logging.Lo
 	}
 
 	provider = &AbiProvider{
-		abiSpec: abiSpec,
-		cli:     cli,
-		logger:  logger.WithScope("NewAbiProvider"),
+		abiSpec:    abiSpec,
+		cli:        cli,
+		logger:     logger.WithScope("NewAbiProvider"),
+		resolved:   make(map[crypto.Address]string),
+		missing:    make(map[abiKey]missingAbi),
+		missingTTL: missingAbiTTL,
+		timeout:    abiLookupTimeout,
 	}
 	return
 }
 
-// GetEventAbi get the ABI for a particular eventID. If it is not known, it is retrieved from the burrow node via
-// the address for the contract
-func (p *AbiProvider) GetEventAbi(eventID abi.EventID, address crypto.Address) (*abi.EventSpec, error) {
-	evAbi, ok := p.abiSpec.EventsByID[eventID]
-	if !ok {
-		resp, err := p.cli.GetMetadata(context.Background(), &rpcquery.GetMetadataParam{Address: &address})
+// LoadAbis adds the ABIs read from metadata, such as that previously cached, keyed by contract address
+func (p *AbiProvider) LoadAbis(abis map[crypto.Address]string) error {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	specs := []*abi.Spec{p.abiSpec}
+	for address, metadata := range abis {
+		spec, err := abi.ReadSpec([]byte(metadata))
 		if err != nil {
-			p.logger.InfoMsg("Error retrieving abi for event", "address", address.String(), "eventid", eventID.String(), "error", err)
-			return nil, err
+			return fmt.Errorf("could not read ABI for contract at address %v: %v", address, err)
 		}
-		if resp == nil || resp.Metadata == "" {
-			p.logger.InfoMsg("ABI not found for contract", "address", address.String(), "eventid", eventID.String())
-			return nil, fmt.Errorf("No ABI present for contract at address %v", address)
+		specs = append(specs, spec)
+	}
+	p.abiSpec = abi.MergeSpec(specs)
+	return nil
+}
+
+// TakeResolved returns the metadata resolved from burrow by contract address since it was last called
+func (p *AbiProvider) TakeResolved() map[crypto.Address]string {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	resolved := p.resolved
+	p.resolved = make(map[crypto.Address]string)
+	return resolved
+}
+
+// GetEventAbi get the ABI for a particular eventID. If it is not known, it is retrieved from the burrow node via
+// the address for the contract, or failing that via the contracts deployed alongside it by its forebear. If burrow
+// cannot be asked the error returned is an abiLookupError
+func (p *AbiProvider) GetEventAbi(eventID abi.EventID, address crypto.Address) (*abi.EventSpec, error) {
+	key := abiKey{eventID: eventID, address: address}
+	evAbi, known, err := p.knownEventAbi(key)
+	if known {
+		return evAbi, err
+	}
+
+	// Burrow is asked without holding the lock so that events whose ABIs are known are not held up by the lookup
+	evAbi, metadata, err := p.resolveEventAbi(eventID, address)
+	if err != nil {
+		p.logger.InfoMsg("Error retrieving abi for event", "address", address.String(), "eventid", eventID.String(), "error", err)
+		return nil, &abiLookupError{err: err}
+	}
+	if evAbi == nil {
+		p.logger.InfoMsg("ABI not found for event", "address", address.String(), "eventid", eventID.String())
+		err = fmt.Errorf("no ABI present for event %v in metadata of contract at address %v or its forebears",
+			eventID, address)
+		p.mtx.Lock()
+		defer p.mtx.Unlock()
+		p.missing[key] = missingAbi{err: err, expires: time.Now().Add(p.missingTTL)}
+		return nil, err
+	}
+
+	spec, err := abi.ReadSpec([]byte(metadata))
+	if err != nil {
+		return nil, err
+	}
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	// Another lookup may have found the ABI in the meantime
+	if known, ok := p.abiSpec.EventsByID[eventID]; ok {
+		return known, nil
+	}
+	p.abiSpec = abi.MergeSpec([]*abi.Spec{p.abiSpec, spec})
+	p.resolved[address] = metadata
+	return evAbi, nil
+}
+
+// knownEventAbi returns the ABI of an event, or the error it was found to be missing with, if it need not be looked up
+func (p *AbiProvider) knownEventAbi(key abiKey) (*abi.EventSpec, bool, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if evAbi, ok := p.abiSpec.EventsByID[key.eventID]; ok {
+		return evAbi, true, nil
+	}
+	if missing, ok := p.missing[key]; ok {
+		if time.Now().Before(missing.expires) {
+			return nil, true, missing.err
 		}
-		a, err := abi.ReadSpec([]byte(resp.Metadata))
+		delete(p.missing, key)
+	}
+	return nil, false, nil
+}
+
+// resolveEventAbi looks for eventID in the metadata of the contract at address, then in the metadata of every
+// contract deployed by each of its forebears in turn. Contracts created by other contracts (e.g. by factories) have no
+// metadata of their own, but the metadata of their code is recorded against the account that deployed the factory.
+// Returns a nil event spec if none is found.
+func (p *AbiProvider) resolveEventAbi(eventID abi.EventID, address crypto.Address) (*abi.EventSpec, string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	resp, err := p.cli.GetMetadata(ctx, &rpcquery.GetMetadataParam{Address: &address})
+	if err != nil {
+		return nil, "", err
+	}
+	if evAbi := p.readEventAbi(eventID, address, resp.GetMetadata()); evAbi != nil {
+		return evAbi, resp.Metadata, nil
+	}
+
+	seen := make(map[crypto.Address]bool)
+	for ancestor := &address; ancestor != nil && !seen[*ancestor]; {
+		seen[*ancestor] = true
+		acc, err := p.cli.GetAccount(ctx, &rpcquery.GetAccountParam{Address: *ancestor})
 		if err != nil {
-			p.logger.InfoMsg("Failed to parse abi", "address", address.String(), "eventid", eventID.String(), "abi", resp.Metadata)
-			return nil, err
+			return nil, "", err
 		}
-		evAbi, ok = a.EventsByID[eventID]
-		if !ok {
-			p.logger.InfoMsg("Event missing from ABI spec for contract", "address", address.String(), "eventid", eventID.String(), "abi", resp.Metadata)
-			return nil, fmt.Errorf("Event missing from ABI spec for contract")
+		for _, contractMeta := range acc.GetContractMeta() {
+			metadata := contractMeta.Metadata
+			if metadata == "" {
+				resp, err := p.cli.GetMetadata(ctx, &rpcquery.GetMetadataParam{MetadataHash: &contractMeta.MetadataHash})
+				if err != nil {
+					return nil, "", err
+				}
+				metadata = resp.GetMetadata()
+			}
+			if evAbi := p.readEventAbi(eventID, *ancestor, metadata); evAbi != nil {
+				return evAbi, metadata, nil
+			}
 		}
-
-		p.abiSpec = abi.MergeSpec([]*abi.Spec{p.abiSpec, a})
+		ancestor = acc.Forebear
 	}
+	return nil, "", nil
+}
 
-	return evAbi, nil
+// readEventAbi returns the spec of eventID in metadata if present
+func (p *AbiProvider) readEventAbi(eventID abi.EventID, address crypto.Address, metadata string) *abi.EventSpec {
+	if metadata == "" {
+		return nil
+	}
+	spec, err := abi.ReadSpec([]byte(metadata))
+	if err != nil {
+		p.logger.InfoMsg("Failed to parse abi", "address", address.String(), "eventid", eventID.String(), "abi", metadata)
+		return nil
+	}
+	return spec.EventsByID[eventID]
 }
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/evm/abi"
+	"github.com/hyperledger/burrow/execution/solidity"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestAbiProvider(t *testing.T) {
+	spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+	require.NoError(t, err)
+	eventID := spec.EventsByName["ManyTypes"].ID
+	emitterMetadata := fmt.Sprintf(`{"Abi":%s}`, solidity.Abi_EventEmitter)
+
+	// The account that deployed a factory records the metadata of the contracts the factory creates
+	deployer := crypto.Address{1}
+	child := crypto.Address{2}
+	cli := &fakeQueryClient{
+		accounts: map[crypto.Address]*acm.Account{
+			deployer: {
+				Address: deployer,
+				ContractMeta: []*acm.ContractMeta{
+					{MetadataHash: []byte{1}},
+					{MetadataHash: []byte{2}},
+				},
+			},
+			child: {Address: child, Forebear: &deployer},
+		},
+		metadata: map[string]string{
+			string([]byte{1}): fmt.Sprintf(`{"Abi":%s}`, solidity.Abi_A),
+			string([]byte{2}): emitterMetadata,
+		},
+	}
+
+	t.Run("Resolves ABI through forebear", func(t *testing.T) {
+		provider, err := NewAbiProvider(nil, cli, logging.NewNoopLogger())
+		require.NoError(t, err)
+
+		evAbi, err := provider.GetEventAbi(eventID, child)
+		require.NoError(t, err)
+		assert.Equal(t, "ManyTypes", evAbi.Name)
+		assert.Equal(t, map[crypto.Address]string{child: emitterMetadata}, provider.TakeResolved())
+		assert.Empty(t, provider.TakeResolved())
+	})
+
+	t.Run("Remembers missing ABI", func(t *testing.T) {
+		provider, err := NewAbiProvider(nil, cli, logging.NewNoopLogger())
+		require.NoError(t, err)
+
+		// A forebear cycle must not be followed forever
+		loop := crypto.Address{3}
+		cli.accounts[loop] = &acm.Account{Address: loop, Forebear: &loop}
+		cli.calls = 0
+		_, err = provider.GetEventAbi(eventID, loop)
+		require.Error(t, err)
+		calls := cli.calls
+		_, err = provider.GetEventAbi(eventID, loop)
+		require.Error(t, err)
+		assert.Equal(t, calls, cli.calls, "should not look up missing ABI again")
+		assert.False(t, isAbiLookupError(err))
+
+		// Until it expires
+		key := abiKey{eventID: eventID, address: loop}
+		provider.missing[key] = missingAbi{err: provider.missing[key].err, expires: time.Now()}
+		_, err = provider.GetEventAbi(eventID, loop)
+		require.Error(t, err)
+		assert.True(t, cli.calls > calls, "should look up expired missing ABI again")
+	})
+
+	t.Run("Does not take failed lookup for missing ABI", func(t *testing.T) {
+		provider, err := NewAbiProvider(nil, cli, logging.NewNoopLogger())
+		require.NoError(t, err)
+
+		cli.err = fmt.Errorf("connection refused")
+		_, err = provider.GetEventAbi(eventID, child)
+		cli.err = nil
+		require.Error(t, err)
+		assert.True(t, isAbiLookupError(err))
+
+		evAbi, err := provider.GetEventAbi(eventID, child)
+		require.NoError(t, err)
+		assert.Equal(t, "ManyTypes", evAbi.Name)
+	})
+
+	t.Run("Does not hold up known ABIs while looking one up", func(t *testing.T) {
+		blocked := &fakeQueryClient{blocked: make(chan struct{}, 1), release: make(chan struct{})}
+		provider, err := NewAbiProvider(nil, blocked, logging.NewNoopLogger())
+		require.NoError(t, err)
+		err = provider.LoadAbis(map[crypto.Address]string{child: emitterMetadata})
+		require.NoError(t, err)
+
+		lookedUp := make(chan error)
+		go func() {
+			_, err := provider.GetEventAbi(abi.EventID{1}, child)
+			lookedUp <- err
+		}()
+		// Wait until the lookup is waiting on burrow
+		<-blocked.blocked
+		evAbi, err := provider.GetEventAbi(eventID, child)
+		require.NoError(t, err)
+		assert.Equal(t, "ManyTypes", evAbi.Name)
+
+		close(blocked.release)
+		require.Error(t, <-lookedUp)
+	})
+
+	t.Run("Loads cached ABIs", func(t *testing.T) {
+		provider, err := NewAbiProvider(nil, &fakeQueryClient{}, logging.NewNoopLogger())
+		require.NoError(t, err)
+
+		err = provider.LoadAbis(map[crypto.Address]string{child: emitterMetadata})
+		require.NoError(t, err)
+		evAbi, err := provider.GetEventAbi(eventID, child)
+		require.NoError(t, err)
+		assert.Equal(t, "ManyTypes", evAbi.Name)
+		assert.Empty(t, provider.TakeResolved())
+	})
+}
+
+type fakeQueryClient struct {
+	rpcquery.QueryClient
+	accounts map[crypto.Address]*acm.Account
+	// Metadata by hash
+	metadata map[string]string
+	calls    int
+	// Returned by every call if set
+	err error
+	// If set calls are announced on blocked and then wait for release to be closed
+	blocked chan struct{}
+	release chan struct{}
+}
+
+func (cli *fakeQueryClient) wait() {
+	if cli.blocked != nil {
+		select {
+		case cli.blocked <- struct{}{}:
+		default:
+		}
+		<-cli.release
+	}
+}
+
+func (cli *fakeQueryClient) GetAccount(ctx context.Context, param *rpcquery.GetAccountParam,
+	opts ...grpc.CallOption) (*acm.Account, error) {
+	cli.wait()
+	cli.calls++
+	if cli.err != nil {
+		return nil, cli.err
+	}
+	if acc, ok := cli.accounts[param.Address]; ok {
+		return acc, nil
+	}
+	return &acm.Account{}, nil
+}
+
+// GetMetadata only looks up metadata by hash, so has none for contracts created by other contracts
+func (cli *fakeQueryClient) GetMetadata(ctx context.Context, param *rpcquery.GetMetadataParam,
+	opts ...grpc.CallOption) (*rpcquery.MetadataResult, error) {
+	cli.wait()
+	cli.calls++
+	if cli.err != nil {
+		return nil, cli.err
+	}
+	if param.MetadataHash == nil {
+		return &rpcquery.MetadataResult{}, nil
+	}
+	return &rpcquery.MetadataResult{Metadata: cli.metadata[string(*param.MetadataHash)]}, nil
+}
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"io"
+	"math"
+	"sort"
+
+	"github.com/hyperledger/burrow/rpc/rpcevents"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/pkg/errors"
+)
+
+// backfill projects the tables whose event classes have changed since they were last projected into replacement
+// tables from the start of each chain while the other tables carry on being projected from the head of the chain.
+// Once the replacement tables have caught up with the head they cut over to replace the tables.
+type backfill struct {
+	db *sqldb.SQLDB
+	// The name of the replacement for each table being backfilled
+	replacements map[string]string
+	// The spec of the event classes of each replacement
+	replacementSpecs map[string]string
+	// The replacements left by an earlier backfill that cannot be resumed
+	stale []string
+	// The replacement tables
+	tables types.EventTables
+	// The tables with which to commit blocks from the head of the chain - those not being backfilled and the
+	// replacement tables
+	headTables types.EventTables
+	// The projection of the event classes of the tables being backfilled
+	projection *sqlsol.Projection
+	// All of the projected tables and the specs of their event classes to record on cut over
+	projectionTables types.EventTables
+	specs            map[string]string
+	chains           map[string]*backfillChain
+}
+
+type backfillChain struct {
+	// The height of the last block committed from the head of the chain
+	height uint64
+	// Whether any block has been backfilled and the height of the last one
+	started        bool
+	backfillHeight uint64
+	// Closed once the backfill has caught up with the head after which blocks from the head are committed to the
+	// replacement tables
+	done chan struct{}
+}
+
+// newBackfill returns a backfill of the tables of projection whose event classes are not those recorded in db or nil
+// if there are none or nothing has been projected yet, in which case the specs of projection are recorded. A backfill
+// left by an earlier run with the same specs resumes from the last block it committed for every table.
+func newBackfill(db *sqldb.SQLDB, projection *sqlsol.Projection, chainIDs []string) (*backfill, error) {
+	specs, err := projection.TableSpecs()
+	if err != nil {
+		return nil, err
+	}
+	tableNames := make([]string, 0, len(projection.Tables))
+	for tableName := range projection.Tables {
+		tableNames = append(tableNames, tableName)
+	}
+	err = db.RecoverCutOver(tableNames)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not recover interrupted cut over")
+	}
+	recorded, err := db.TableSpecs()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not read recorded specs")
+	}
+
+	bf := &backfill{
+		db:               db,
+		replacements:     make(map[string]string),
+		replacementSpecs: make(map[string]string),
+		tables:           make(types.EventTables),
+		headTables:       make(types.EventTables),
+		projection:       &sqlsol.Projection{Tables: projection.Tables},
+		projectionTables: projection.Tables,
+		specs:            specs,
+		chains:           make(map[string]*backfillChain),
+	}
+
+	behind := false
+	for _, chainID := range chainIDs {
+		height, err := db.LastBlockHeight(chainID)
+		if err != nil {
+			return nil, err
+		}
+		behind = behind || height > 0
+		bf.chains[chainID] = &backfillChain{
+			height: height,
+			done:   make(chan struct{}),
+		}
+	}
+
+	// Tables projected before specs were recorded are assumed to be up to date
+	if behind && len(recorded) > 0 {
+		for tableName, spec := range specs {
+			if recorded[tableName] != spec {
+				bf.replacements[tableName] = db.Tables.BackfillPrefix + tableName
+			}
+		}
+	}
+
+	if len(bf.replacements) == 0 {
+		return nil, db.SetTableSpecs(specs)
+	}
+
+	// Every replacement must have been backfilled from a chain for it to resume, from the lowest height of any, since
+	// committing blocks again is idempotent
+	resume := make(map[string]uint64)
+	for chainID := range bf.chains {
+		resume[chainID] = math.MaxUint64
+	}
+	for tableName, replacement := range bf.replacements {
+		bf.replacementSpecs[replacement] = specs[tableName]
+		spec, heights, err := db.BackfillProgress(replacement)
+		if err != nil {
+			return nil, errors.Wrapf(err, "could not read progress of %s", replacement)
+		}
+		if spec != specs[tableName] {
+			bf.stale = append(bf.stale, replacement)
+			heights = nil
+		}
+		for chainID, height := range resume {
+			if backfilled, ok := heights[chainID]; !ok {
+				delete(resume, chainID)
+			} else if backfilled < height {
+				resume[chainID] = backfilled
+			}
+		}
+	}
+	for chainID, height := range resume {
+		chain := bf.chains[chainID]
+		chain.started = true
+		chain.backfillHeight = height
+		chain.checkCaughtUp()
+	}
+
+	for _, eventClass := range projection.Spec {
+		if _, ok := bf.replacements[eventClass.TableName]; ok {
+			bf.projection.Spec = append(bf.projection.Spec, eventClass)
+		}
+	}
+	for tableName, table := range projection.Tables {
+		replacement, ok := bf.replacements[tableName]
+		if !ok {
+			bf.headTables[tableName] = table
+			continue
+		}
+		// Notification triggers are created on cut over
+		replacementTable := &types.SQLTable{
+			Name:    replacement,
+			Columns: table.Columns,
+		}
+		bf.tables[replacement] = replacementTable
+		bf.headTables[replacement] = replacementTable
+	}
+	return bf, nil
+}
+
+// tableNames returns the names of the tables being backfilled
+func (bf *backfill) tableNames() []string {
+	tableNames := make([]string, 0, len(bf.replacements))
+	for tableName := range bf.replacements {
+		tableNames = append(tableNames, tableName)
+	}
+	sort.Strings(tableNames)
+	return tableNames
+}
+
+// prepare drops the replacement tables left by an earlier backfill that cannot be resumed
+func (bf *backfill) prepare() error {
+	for _, replacement := range bf.stale {
+		err := bf.db.DropTable(replacement)
+		if err != nil {
+			return errors.Wrapf(err, "could not drop table %s", replacement)
+		}
+	}
+	return nil
+}
+
+// head returns the tables and rows with which to commit a block from the head of the chain. The rows of the tables
+// being backfilled go to their replacements once the backfill has caught up and are otherwise left for the backfill.
+func (bf *backfill) head(blockEvents types.EventData) (types.EventTables, types.EventData) {
+	chain := bf.chains[blockEvents.ChainID]
+	data := types.EventData{
+		ChainID:     blockEvents.ChainID,
+		BlockHeight: blockEvents.BlockHeight,
+		Tables:      make(map[string]types.EventDataTable, len(blockEvents.Tables)),
+	}
+	for tableName, rows := range blockEvents.Tables {
+		replacement, ok := bf.replacements[tableName]
+		if !ok {
+			data.Tables[tableName] = rows
+		} else if finished(chain.done) && blockEvents.BlockHeight > chain.backfillHeight {
+			data.Tables[replacement] = rows
+		}
+	}
+	return bf.headTables, data
+}
+
+// committedHead records that a block from the head of the chain has been committed
+func (bf *backfill) committedHead(chainID string, height uint64) {
+	chain := bf.chains[chainID]
+	chain.height = height
+	chain.checkCaughtUp()
+}
+
+// commit commits a backfilled block to the replacement tables unless the backfill has already caught up
+func (bf *backfill) commit(blockEvents types.EventData) error {
+	chain := bf.chains[blockEvents.ChainID]
+	if finished(chain.done) {
+		return nil
+	}
+	data := types.EventData{
+		ChainID:     blockEvents.ChainID,
+		BlockHeight: blockEvents.BlockHeight,
+		Tables:      make(map[string]types.EventDataTable),
+	}
+	for tableName, replacement := range bf.replacements {
+		if rows, ok := blockEvents.Tables[tableName]; ok {
+			data.Tables[replacement] = rows
+		}
+	}
+	if len(data.Tables) > 0 {
+		err := bf.db.SetBackfillRows(blockEvents.ChainID, bf.tables, data, bf.replacementSpecs)
+		if err != nil {
+			return errors.Wrapf(err, "could not commit backfilled block %d", blockEvents.BlockHeight)
+		}
+	}
+	chain.started = true
+	chain.backfillHeight = blockEvents.BlockHeight
+	chain.checkCaughtUp()
+	return nil
+}
+
+// complete is true when the replacement tables of every chain hold all of the blocks committed from its head and no
+// more so that they can replace the tables being backfilled
+func (bf *backfill) complete() bool {
+	for _, chain := range bf.chains {
+		if !finished(chain.done) || chain.height < chain.backfillHeight {
+			return false
+		}
+	}
+	return true
+}
+
+// cutOver replaces the tables being backfilled with their replacements and records the specs of the projection
+func (bf *backfill) cutOver() error {
+	return bf.db.CutOver(bf.replacements, bf.projectionTables, bf.specs)
+}
+
+func (chain *backfillChain) checkCaughtUp() {
+	if !finished(chain.done) && chain.started && chain.backfillHeight >= chain.height {
+		close(chain.done)
+	}
+}
+
+// backfillChain streams the blocks of chain from its start to end to eventCh until the backfill catches up
+func (c *Consumer) backfillChain(chain *chainConnection, bf *backfill, end *rpcevents.Bound,
+	eventCh chan<- types.EventData) error {
+
+	done := bf.chains[chain.Burrow.ChainID].done
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		// Stop streaming once caught up
+		select {
+		case <-done:
+		case <-ctx.Done():
+		}
+		cancel()
+	}()
+
+	// Resume after the last block backfilled by an earlier run
+	var start uint64
+	if progress := bf.chains[chain.Burrow.ChainID]; progress.started {
+		start = progress.backfillHeight + 1
+	}
+	c.Logger.InfoMsg("Backfilling tables", "chain_id", chain.Burrow.ChainID, "tables", bf.tableNames(),
+		"from_height", start)
+
+	cli := rpcevents.NewExecutionEventsClient(chain.conn)
+	blocks, err := cli.Stream(ctx, &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(start), end),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "Error connecting to block stream")
+	}
+
+	// Block and transaction tables are never backfilled
+	err = rpcevents.ConsumeBlockExecutions(blocks,
+		NewBlockConsumer(bf.projection, c.Config.SpecOpt&^(sqlsol.BlockTx|sqlsol.DeadLetter), chain.abiProvider.GetEventAbi, nil,
+			eventCh, done, c.Logger))
+
+	if err != nil && err != io.EOF && !finished(done) && !finished(c.Done) {
+		return errors.Wrapf(err, "Error receiving blocks to backfill")
+	}
+	return nil
+}
@@ -12,13 +12,18 @@ import (
 	"github.com/pkg/errors"
 )
 
+// NewBlockConsumer returns a function that projects each block it is passed onto eventCh, stateAt is used to read the
+// state after each block projected by any state classes in projection (and may be nil if there are none)
 func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEventSpec EventSpecGetter,
-	eventCh chan<- types.EventData, doneCh chan struct{},
+	stateAt StateAt, eventCh chan<- types.EventData, doneCh chan struct{},
 	logger *logging.Logger) func(blockExecution *exec.BlockExecution) error {
 
 	logger = logger.WithScope("makeBlockConsumer")
 
 	var blockHeight uint64
+	// The first block consumed reads all of the state at the addresses of each state class rather than only that
+	// touched by the block so that the state projection is complete however far behind it started
+	initialState := true
 
 	return func(blockExecution *exec.BlockExecution) error {
 		if finished(doneCh) {
@@ -27,6 +32,7 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 
 		// set new block number
 		blockHeight = blockExecution.Height
+		chainID := blockExecution.GetHeader().GetChainID()
 
 		logger.TraceMsg("Block received",
 			"height", blockExecution.Height,
@@ -34,6 +40,14 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 
 		// create a fresh new structure to store block data at this height
 		blockData := sqlsol.NewBlockData(blockHeight)
+		blockData.Data.ChainID = chainID
+		// When projecting several chains rows are keyed by the chain they were projected from
+		keyByChain := func(row types.EventDataRow) types.EventDataRow {
+			if opt.Enabled(sqlsol.MultiChain) {
+				row.RowData[columns.ChainID] = chainID
+			}
+			return row
+		}
 
 		if opt.Enabled(sqlsol.Block) {
 			blkRawData, err := buildBlkData(projection.Tables, blockExecution)
@@ -41,7 +55,7 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 				return errors.Wrapf(err, "Error building block raw data")
 			}
 			// set row in structure
-			blockData.AddRow(tables.Block, blkRawData)
+			blockData.AddRow(tables.Block, keyByChain(blkRawData))
 		}
 
 		// get transactions for a given block
@@ -54,7 +68,7 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 					return errors.Wrapf(err, "Error building tx raw data")
 				}
 				// set row in structure
-				blockData.AddRow(tables.Tx, txRawData)
+				blockData.AddRow(tables.Tx, keyByChain(txRawData))
 			}
 
 			// reverted transactions don't have to update event data tables
@@ -71,6 +85,8 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 					}
 				}
 
+				originAddress := getOriginAddress(txe)
+
 				// get events for a given transaction
 				for _, event := range txe.Events {
 					if event.Log == nil {
@@ -81,6 +97,11 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 					var tagged query.Tagged = event
 					eventID := event.Log.SolidityEventID()
 					eventSpec, eventSpecErr := getEventSpec(eventID, event.Log.Address)
+					if isAbiLookupError(eventSpecErr) {
+						// The ABI may yet be found so fail the block to be consumed again rather than dead letter it
+						return errors.Wrapf(eventSpecErr, "could not get ABI for solidity event with id %v at address %v",
+							eventID, event.Log.Address)
+					}
 					if eventSpecErr != nil {
 						logger.InfoMsg("could not get ABI for solidity event",
 							structure.ErrorKey, eventSpecErr,
@@ -93,6 +114,9 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 
 					// see which spec filter matches with the one in event data
 					for _, eventClass := range projection.Spec {
+						if !eventClass.AppliesToChain(chainID) {
+							continue
+						}
 						qry, err := eventClass.Query()
 
 						if err != nil {
@@ -111,19 +135,40 @@ func NewBlockConsumer(projection *sqlsol.Projection, opt sqlsol.SpecOpt, getEven
 								"filter", eventClass.Filter)
 
 							// unpack, decode & build event data
-							eventData, err := buildEventData(projection, eventClass, event, txOrigin, eventSpec, logger)
+							eventData, err := buildEventData(projection, eventClass, event, txOrigin,
+								originAddress, eventSpec, logger)
 							if err != nil {
 								return errors.Wrapf(err, "Error building event data")
 							}
 
 							// set row in structure
-							blockData.AddRow(eventClass.TableName, eventData)
+							blockData.AddRow(eventClass.TableName, keyByChain(eventData))
 						}
 					}
+
+					// Any event class matching would have returned above so the event goes undecoded for want of an ABI
+					if eventSpecErr != nil && opt.Enabled(sqlsol.DeadLetter) {
+						deadLetter, err := buildDeadLetterData(txe, event, eventID, eventSpecErr)
+						if err != nil {
+							return errors.Wrapf(err, "Error building dead letter data")
+						}
+						blockData.AddRow(tables.DeadLetter, keyByChain(deadLetter))
+					}
 				}
 			}
 		}
 
+		if len(projection.StateSpec) > 0 {
+			err := buildStateData(projection, blockExecution, stateAt(blockExecution.Height), initialState,
+				func(tableName string, row types.EventDataRow) {
+					blockData.AddRow(tableName, keyByChain(row))
+				})
+			if err != nil {
+				return errors.Wrapf(err, "Error building state data")
+			}
+			initialState = false
+		}
+
 		// upsert rows in specific SQL event tables and update block number
 		// store block data in SQL tables (if any)
 		for name, rows := range blockData.Data.Tables {
@@ -78,7 +78,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
 		tables, err := consumeBlock(blockConsumer, eventCh, log)
 		require.NoError(t, err)
 		rows := tables[tableName]
@@ -86,6 +86,129 @@ func TestBlockConsumer(t *testing.T) {
 		assert.Equal(t, direction, rows[0].RowData["direction"])
 	})
 
+	t.Run("Consume matching event with transformed columns", func(t *testing.T) {
+		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+		require.NoError(t, err)
+
+		tableName := "Events"
+		projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+			{
+				TableName: tableName,
+				Filter:    "EventName = 'ManyTypes'",
+				FieldMappings: append([]*types.EventFieldMapping{
+					{
+						Transform:  "concat(upper(string(direction)), '-', german)",
+						Type:       types.EventFieldTypeString,
+						ColumnName: "label",
+					},
+					{
+						Transform:  "scale(newDepth, 2)",
+						Type:       types.EventFieldTypeString,
+						ColumnName: "depth",
+					},
+					{
+						Transform:  "timestamp(blockTime)",
+						Type:       types.EventFieldTypeTimestamp,
+						ColumnName: "time",
+					},
+				}, fieldMappings...),
+			},
+		})
+		require.NoError(t, err)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
+		tables, err := consumeBlock(blockConsumer, eventCh, log)
+		require.NoError(t, err)
+		rows := tables[tableName]
+		require.Len(t, rows, 1)
+		assert.Equal(t, direction, rows[0].RowData["direction"])
+		assert.Equal(t, "FROGS-foo", rows[0].RowData["label"])
+		assert.Equal(t, "10.00", rows[0].RowData["depth"])
+		assert.Equal(t, time.Time{}, rows[0].RowData["time"])
+	})
+
+	t.Run("Consume matching event with failing transform", func(t *testing.T) {
+		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+		require.NoError(t, err)
+
+		tableName := "Events"
+		projectionWith := func(nullOnError bool) *sqlsol.Projection {
+			projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+				{
+					TableName: tableName,
+					Filter:    "EventName = 'ManyTypes'",
+					FieldMappings: append([]*types.EventFieldMapping{
+						{
+							// german is not JSON
+							Transform:   "json(german, 'name')",
+							Type:        types.EventFieldTypeString,
+							ColumnName:  "name",
+							NullOnError: nullOnError,
+						},
+					}, fieldMappings...),
+				},
+			})
+			require.NoError(t, err)
+			return projection
+		}
+		blockConsumer := NewBlockConsumer(projectionWith(false), sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh,
+			logger)
+		_, err = consumeBlock(blockConsumer, eventCh, log)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "Error transforming event data for column name")
+
+		blockConsumer = NewBlockConsumer(projectionWith(true), sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh,
+			logger)
+		tables, err := consumeBlock(blockConsumer, eventCh, log)
+		require.NoError(t, err)
+		rows := tables[tableName]
+		require.Len(t, rows, 1)
+		assert.Equal(t, direction, rows[0].RowData["direction"])
+		assert.NotContains(t, rows[0].RowData, "name")
+	})
+
+	t.Run("Consume matching event with aggregate columns", func(t *testing.T) {
+		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+		require.NoError(t, err)
+
+		tableName := "Depths"
+		projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+			{
+				TableName: tableName,
+				Filter:    "EventName = 'ManyTypes'",
+				FieldMappings: []*types.EventFieldMapping{
+					{
+						Field:         "direction",
+						Type:          types.EventFieldTypeString,
+						ColumnName:    "direction",
+						BytesToString: true,
+						Primary:       true,
+					},
+					{
+						Field:      "newDepth",
+						Type:       types.EventFieldTypeInt,
+						ColumnName: "depth",
+						Aggregate:  types.AggregateSum,
+					},
+					{
+						Type:       types.EventFieldTypeInt,
+						ColumnName: "events",
+						Aggregate:  types.AggregateCount,
+					},
+				},
+			},
+		})
+		require.NoError(t, err)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
+		tables, err := consumeBlock(blockConsumer, eventCh, log)
+		require.NoError(t, err)
+		rows := tables[tableName]
+		require.Len(t, rows, 1)
+		assert.Equal(t, direction, rows[0].RowData["direction"])
+		assert.Equal(t, int64(1000), *rows[0].RowData["depth"].(*int64))
+		// Each event contributes one to a count
+		assert.Equal(t, 1, rows[0].RowData["events"])
+	})
+
 	t.Run("Consume matching event without ABI", func(t *testing.T) {
 		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
 		require.NoError(t, err)
@@ -103,7 +226,7 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
 		_, err = consumeBlock(blockConsumer, eventCh, log)
 		require.Error(t, err)
 		require.Contains(t, err.Error(), "could not find ABI")
@@ -126,11 +249,56 @@ func TestBlockConsumer(t *testing.T) {
 			},
 		})
 		require.NoError(t, err)
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
 		table, err := consumeBlock(blockConsumer, eventCh, log)
 		require.Len(t, table, 0, "should match no event")
 	})
 
+	t.Run("Record non-matching event without ABI as a dead letter", func(t *testing.T) {
+		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+		require.NoError(t, err)
+
+		// Remove the ABI
+		delete(spec.EventsByID, manyTypesEventSpec.ID)
+
+		projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+			{
+				TableName:     "Events",
+				Filter:        "EventName = 'ManyTypes'",
+				FieldMappings: fieldMappings,
+			},
+		})
+		require.NoError(t, err)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.DeadLetter, spec.GetEventAbi, nil, eventCh, doneCh, logger)
+		table, err := consumeBlock(blockConsumer, eventCh, log)
+		require.NoError(t, err)
+		require.Len(t, table, 1)
+		rows := table[tables.DeadLetter]
+		require.Len(t, rows, 1)
+		assert.Equal(t, manyTypesEventSpec.ID.String(), rows[0].RowData[columns.EventID])
+		assert.Equal(t, log.Address.String(), rows[0].RowData[columns.Address])
+		assert.Equal(t, log.Data.String(), rows[0].RowData[columns.LogData])
+		assert.Contains(t, rows[0].RowData[columns.Error], "could not find ABI")
+	})
+
+	t.Run("Fail block when ABI cannot be looked up", func(t *testing.T) {
+		projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+			{
+				TableName:     "Events",
+				Filter:        "EventName = 'ManyTypes'",
+				FieldMappings: fieldMappings,
+			},
+		})
+		require.NoError(t, err)
+		getEventSpec := func(abi.EventID, crypto.Address) (*abi.EventSpec, error) {
+			return nil, &abiLookupError{err: fmt.Errorf("connection refused")}
+		}
+		blockConsumer := NewBlockConsumer(projection, sqlsol.DeadLetter, getEventSpec, nil, eventCh, doneCh, logger)
+		_, err = consumeBlock(blockConsumer, eventCh, log)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "connection refused")
+	})
+
 	// This is possibly 'bad' behaviour - since you may be missing an ABI - but for now it is expected. On-chain ABIs
 	// ought to solve this
 	t.Run("Consume event that doesn't match without ABI tags", func(t *testing.T) {
@@ -150,7 +318,7 @@ func TestBlockConsumer(t *testing.T) {
 		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
 		require.NoError(t, err)
 
-		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer := NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
 		table, err := consumeBlock(blockConsumer, eventCh, log)
 		// Check matches
 		require.NoError(t, err)
@@ -158,11 +326,44 @@ func TestBlockConsumer(t *testing.T) {
 		require.Len(t, table[tableName], 1)
 		// Now Remove the ABI - should not match the event
 		delete(spec.EventsByID, manyTypesEventSpec.ID)
-		blockConsumer = NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, eventCh, doneCh, logger)
+		blockConsumer = NewBlockConsumer(projection, sqlsol.None, spec.GetEventAbi, nil, eventCh, doneCh, logger)
 		table, err = consumeBlock(blockConsumer, eventCh, log)
 		require.NoError(t, err)
 		require.Len(t, table, 0, "should match no events")
 	})
+
+	t.Run("Consume events from several chains", func(t *testing.T) {
+		spec, err := abi.ReadSpec(solidity.Abi_EventEmitter)
+		require.NoError(t, err)
+
+		projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+			{
+				TableName:     "Events",
+				Filter:        "EventName = 'ManyTypes'",
+				FieldMappings: fieldMappings,
+			},
+			{
+				TableName:     "OnlyChainB",
+				Filter:        "EventName = 'ManyTypes'",
+				FieldMappings: fieldMappings,
+				ChainIDs:      []string{"ChainB"},
+			},
+		})
+		require.NoError(t, err)
+
+		blockConsumer := NewBlockConsumer(projection, sqlsol.MultiChain, spec.GetEventAbi, nil, eventCh, doneCh, logger)
+		table, err := consumeChainBlock(blockConsumer, eventCh, "ChainA", log)
+		require.NoError(t, err)
+		require.Len(t, table, 1)
+		require.Len(t, table["Events"], 1)
+		assert.Equal(t, "ChainA", table["Events"][0].RowData[columns.ChainID])
+
+		table, err = consumeChainBlock(blockConsumer, eventCh, "ChainB", log)
+		require.NoError(t, err)
+		require.Len(t, table, 2)
+		assert.Equal(t, "ChainB", table["Events"][0].RowData[columns.ChainID])
+		assert.Equal(t, "ChainB", table["OnlyChainB"][0].RowData[columns.ChainID])
+	})
 }
 
 const timeout = time.Second
@@ -171,9 +372,14 @@ var errTimeout = fmt.Errorf("timed out after %s waiting for consumer to emit blo
 
 func consumeBlock(blockConsumer func(*exec.BlockExecution) error, eventCh <-chan types.EventData,
 	logEvents ...*exec.LogEvent) (map[string]types.EventDataTable, error) {
+	return consumeChainBlock(blockConsumer, eventCh, "", logEvents...)
+}
+
+func consumeChainBlock(blockConsumer func(*exec.BlockExecution) error, eventCh <-chan types.EventData,
+	chainID string, logEvents ...*exec.LogEvent) (map[string]types.EventDataTable, error) {
 
 	block := &exec.BlockExecution{
-		Header: &tmTypes.Header{},
+		Header: &tmTypes.Header{ChainID: chainID},
 	}
 	for _, logEvent := range logEvents {
 		txe := &exec.TxExecution{
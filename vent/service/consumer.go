@@ -7,12 +7,14 @@ import (
 	"sync"
 	"time"
 
+	"github.com/hyperledger/burrow/acm/acmstate"
 	"github.com/hyperledger/burrow/rpc"
 
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc/rpcevents"
 	"github.com/hyperledger/burrow/rpc/rpcquery"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
@@ -23,15 +25,21 @@ import (
 
 // Consumer contains basic configuration for consumer to run
 type Consumer struct {
-	Config         *config.VentConfig
-	Logger         *logging.Logger
-	DB             *sqldb.SQLDB
+	Config *config.VentConfig
+	Logger *logging.Logger
+	// Sink to which projected rows are committed, when nil Run connects to the SQL database in Config and sets DB
+	Sink sink.Sink
+	DB   *sqldb.SQLDB
+	// Connection to the chain at Config.GRPCAddr
 	GRPCConnection *grpc.ClientConn
 	// external events channel used for when vent is leveraged as a library
 	EventsChannel chan types.EventData
 	Done          chan struct{}
 	shutdownOnce  sync.Once
+	// Status of the chain at Config.GRPCAddr
 	Status
+	// All of the chains projected starting with the one at Config.GRPCAddr
+	chains []*chainConnection
 }
 
 // Status announcement
@@ -40,6 +48,13 @@ type Status struct {
 	Burrow              *rpc.ResultStatus
 }
 
+type chainConnection struct {
+	grpcAddr    string
+	conn        *grpc.ClientConn
+	abiProvider *AbiProvider
+	*Status
+}
+
 // NewConsumer constructs a new consumer configuration.
 // The event channel will be passed a collection of rows generated from all of the events in a single block
 // It will be closed by the consumer when it is finished
@@ -58,146 +73,224 @@ func NewConsumer(cfg *config.VentConfig, log *logging.Logger, eventChannel chan
 func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 	var err error
 
-	c.Logger.InfoMsg("Connecting to Burrow gRPC server")
-
-	c.GRPCConnection, err = grpc.Dial(c.Config.GRPCAddr, grpc.WithInsecure())
-	if err != nil {
-		return errors.Wrapf(err, "Error connecting to Burrow gRPC server at %s", c.Config.GRPCAddr)
+	grpcAddrs := append([]string{c.Config.GRPCAddr}, c.Config.ChainGRPCAddrs...)
+	if len(grpcAddrs) > 1 && !c.Config.SpecOpt.Enabled(sqlsol.MultiChain) {
+		return fmt.Errorf("projecting %d chains requires the MultiChain spec option", len(grpcAddrs))
 	}
-	defer c.GRPCConnection.Close()
-	defer close(c.EventsChannel)
 
-	// get the chain ID to compare with the one stored in the db
-	qCli := rpcquery.NewQueryClient(c.GRPCConnection)
-	c.Status.Burrow, err = qCli.Status(context.Background(), &rpcquery.StatusParam{})
-	if err != nil {
-		return errors.Wrapf(err, "Error getting chain status")
-	}
+	c.chains = nil
+	chainsByID := make(map[string]*chainConnection, len(grpcAddrs))
+	for i, grpcAddr := range grpcAddrs {
+		c.Logger.InfoMsg("Connecting to Burrow gRPC server", "grpc_addr", grpcAddr)
 
-	abiProvider, err := NewAbiProvider(c.Config.AbiFileOrDirs, rpcquery.NewQueryClient(c.GRPCConnection), c.Logger)
-	if err != nil {
-		return errors.Wrapf(err, "Error loading ABIs")
+		chain := &chainConnection{
+			grpcAddr: grpcAddr,
+			Status:   new(Status),
+		}
+		if i == 0 {
+			chain.Status = &c.Status
+		}
+		chain.conn, err = grpc.Dial(grpcAddr, grpc.WithInsecure())
+		if err != nil {
+			return errors.Wrapf(err, "Error connecting to Burrow gRPC server at %s", grpcAddr)
+		}
+		defer chain.conn.Close()
+		c.chains = append(c.chains, chain)
+
+		// get the chain ID to compare with the one stored in the db
+		qCli := rpcquery.NewQueryClient(chain.conn)
+		chain.Burrow, err = qCli.Status(context.Background(), &rpcquery.StatusParam{})
+		if err != nil {
+			return errors.Wrapf(err, "Error getting chain status")
+		}
+		if _, ok := chainsByID[chain.Burrow.ChainID]; ok {
+			return fmt.Errorf("chain %s at %s is already being projected", chain.Burrow.ChainID, grpcAddr)
+		}
+		chainsByID[chain.Burrow.ChainID] = chain
+
+		chain.abiProvider, err = NewAbiProvider(c.Config.AbiFileOrDirs, qCli, c.Logger)
+		if err != nil {
+			return errors.Wrapf(err, "Error loading ABIs")
+		}
 	}
+	c.GRPCConnection = c.chains[0].conn
+	defer close(c.EventsChannel)
 
-	if len(projection.Spec) == 0 {
-		c.Logger.InfoMsg("No events specifications found")
+	if len(projection.Spec) == 0 && len(projection.StateSpec) == 0 {
+		c.Logger.InfoMsg("No events or state specifications found")
 		return nil
 	}
 
-	c.Logger.InfoMsg("Connecting to SQL database")
+	if c.Sink == nil {
+		c.Logger.InfoMsg("Connecting to SQL database")
 
-	connection := types.SQLConnection{
-		DBAdapter: c.Config.DBAdapter,
-		DBURL:     c.Config.DBURL,
-		DBSchema:  c.Config.DBSchema,
-		Log:       c.Logger,
+		connection := types.SQLConnection{
+			DBAdapter:       c.Config.DBAdapter,
+			DBURL:           c.Config.DBURL,
+			DBSchema:        c.Config.DBSchema,
+			MultiChain:      c.Config.SpecOpt.Enabled(sqlsol.MultiChain),
+			NotifyRetention: c.Config.NotifyRetention,
+			Log:             c.Logger,
+		}
+
+		c.DB, err = sqldb.NewSQLDB(connection)
+		if err != nil {
+			return fmt.Errorf("error connecting to SQL database: %v", err)
+		}
+		c.Sink = c.DB
 	}
+	defer c.Sink.Close()
 
-	c.DB, err = sqldb.NewSQLDB(connection)
-	if err != nil {
-		return fmt.Errorf("error connecting to SQL database: %v", err)
+	for _, chain := range c.chains {
+		err = c.Sink.Init(chain.Burrow.ChainID, chain.Burrow.BurrowVersion)
+		if err != nil {
+			return fmt.Errorf("could not clean tables after ChainID change: %v", err)
+		}
 	}
-	defer c.DB.Close()
 
-	err = c.DB.Init(c.Burrow.ChainID, c.Burrow.BurrowVersion)
-	if err != nil {
-		return fmt.Errorf("could not clean tables after ChainID change: %v", err)
+	// ABIs resolved from chain metadata on previous runs need not be resolved again
+	abiCache, _ := c.Sink.(AbiCache)
+	if abiCache != nil {
+		for _, chain := range c.chains {
+			abis, err := abiCache.GetAbis(chain.Burrow.ChainID)
+			if err != nil {
+				return errors.Wrap(err, "Error getting cached ABIs")
+			}
+			err = chain.abiProvider.LoadAbis(abis)
+			if err != nil {
+				return errors.Wrap(err, "Error loading cached ABIs")
+			}
+		}
+	}
+
+	// Tables whose event classes have changed can be backfilled in an SQL database
+	var bf *backfill
+	if db, ok := c.Sink.(*sqldb.SQLDB); ok {
+		chainIDs := make([]string, len(c.chains))
+		for i, chain := range c.chains {
+			chainIDs[i] = chain.Burrow.ChainID
+		}
+		bf, err = newBackfill(db, projection, chainIDs)
+		if err != nil {
+			return errors.Wrap(err, "Error planning backfill")
+		}
 	}
 
 	c.Logger.InfoMsg("Synchronizing config and database projection structures")
 
-	err = c.DB.SynchronizeDB(c.Burrow.ChainID, projection.Tables)
+	tables := projection.Tables
+	if bf != nil {
+		err = bf.prepare()
+		if err != nil {
+			return err
+		}
+		// The tables being backfilled are left as they are until their replacements cut over
+		tables = bf.headTables
+	}
+	err = c.Sink.Synchronize(c.Burrow.ChainID, tables)
 	if err != nil {
 		return errors.Wrap(err, "Error trying to synchronize database")
 	}
+	if bf != nil {
+		// A resumed backfill may already have caught up
+		bf, err = c.cutOver(bf)
+		if err != nil {
+			return err
+		}
+	}
 
 	// doneCh is used for sending a "done" signal from each goroutine to the main thread
 	// eventCh is used for sending received events to the main thread to be stored in the db
-	errCh := make(chan error, 1)
+	// backfillCh is used for sending backfilled events to the main thread to be stored in the db
+	errCh := make(chan error, 2*len(c.chains))
 	eventCh := make(chan types.EventData)
+	backfillCh := make(chan types.EventData)
 
-	go func() {
-		defer func() {
-			c.Shutdown()
-		}()
-		go c.announceEvery(c.Done)
-
-		c.Logger.InfoMsg("Getting last processed block number from SQL log table")
-
-		// NOTE [Silas]: I am preserving the comment below that dates from the early days of Vent. I have looked at the
-		// bosmarmot git history and I cannot see why the original author thought that it was the case that there was
-		// no way of knowing if the last block of events was committed since the block and its associated log is
-		// committed atomically in a transaction and this is a core part of he design of Vent - in order that it does not
-		// repeat
-
-		// [ORIGINAL COMMENT]
-		// right now there is no way to know if the last block of events was completely read
-		// so we have to begin processing from the last block number stored in database
-		// and update event data if already present
-		fromBlock, err := c.DB.LastBlockHeight(c.Burrow.ChainID)
-		if err != nil {
-			errCh <- errors.Wrapf(err, "Error trying to get last processed block number")
-			return
-		}
+	go c.announceEvery(c.Done)
 
-		startingBlock := fromBlock
-		// Start the block after the last one successfully committed - apart from if this is the first block
-		// We include block 0 because it is where we currently place dump/restored transactions
-		if startingBlock > 0 {
-			startingBlock++
-		}
-
-		// setup block range to get needed blocks server side
-		cli := rpcevents.NewExecutionEventsClient(c.GRPCConnection)
-		var end *rpcevents.Bound
+	var wg sync.WaitGroup
+	for _, chain := range c.chains {
+		end := rpcevents.LatestBound()
 		if stream {
 			end = rpcevents.StreamBound()
-		} else {
-			end = rpcevents.LatestBound()
-		}
-
-		request := &rpcevents.BlocksRequest{
-			BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(startingBlock), end),
+		} else if bf != nil {
+			// So that the backfill and the head finish at the same block and can cut over
+			end = rpcevents.AbsoluteBound(chain.Burrow.SyncInfo.LatestBlockHeight)
 		}
-
-		// gets blocks in given range based on last processed block taken from database
-		stream, err := cli.Stream(context.Background(), request)
-		if err != nil {
-			errCh <- errors.Wrapf(err, "Error connecting to block stream")
-			return
-		}
-
-		// get blocks
-
-		c.Logger.TraceMsg("Waiting for blocks...")
-
-		err = rpcevents.ConsumeBlockExecutions(stream,
-			NewBlockConsumer(projection, c.Config.SpecOpt, abiProvider.GetEventAbi, eventCh, c.Done, c.Logger))
-
-		if err != nil {
-			if err == io.EOF {
-				c.Logger.InfoMsg("EOF stream received...")
-			} else {
-				if finished(c.Done) {
-					c.Logger.TraceMsg("GRPC connection closed")
-				} else {
-					errCh <- errors.Wrapf(err, "Error receiving blocks")
-					return
-				}
+		wg.Add(1)
+		go func(chain *chainConnection) {
+			defer wg.Done()
+			err := c.consumeChain(chain, projection, end, eventCh)
+			if err != nil {
+				errCh <- err
+				// An error on any chain stops them all
+				c.Shutdown()
 			}
+		}(chain)
+		if bf != nil {
+			wg.Add(1)
+			go func(chain *chainConnection) {
+				defer wg.Done()
+				err := c.backfillChain(chain, bf, end, backfillCh)
+				if err != nil {
+					errCh <- err
+					c.Shutdown()
+				}
+			}(chain)
 		}
+	}
+	go func() {
+		wg.Wait()
+		c.Shutdown()
 	}()
 
 	for {
 		select {
 		// Process block events
 		case blk := <-eventCh:
-			c.Status.LastProcessedHeight = blk.BlockHeight
-			err := c.commitBlock(projection, blk)
+			chain, ok := chainsByID[blk.ChainID]
+			if !ok {
+				return fmt.Errorf("received block %d from unexpected chain %s", blk.BlockHeight, blk.ChainID)
+			}
+			chain.LastProcessedHeight = blk.BlockHeight
+			err := c.commitBlock(projection, blk, bf)
 			if err != nil {
 				c.Logger.InfoMsg("error committing block", "err", err)
 				return err
 			}
+			err = c.cacheAbis(abiCache, chain)
+			if err != nil {
+				return err
+			}
+			if bf != nil {
+				bf.committedHead(blk.ChainID, blk.BlockHeight)
+				bf, err = c.cutOver(bf)
+				if err != nil {
+					return err
+				}
+			}
+
+		// Process backfilled block events
+		case blk := <-backfillCh:
+			if bf == nil {
+				// Already cut over
+				continue
+			}
+			err := bf.commit(blk)
+			if err != nil {
+				c.Logger.InfoMsg("error committing backfilled block", "err", err)
+				return err
+			}
+			if chain, ok := chainsByID[blk.ChainID]; ok {
+				err = c.cacheAbis(abiCache, chain)
+				if err != nil {
+					return err
+				}
+			}
+			bf, err = c.cutOver(bf)
+			if err != nil {
+				return err
+			}
 
 		// Await completion
 		case <-c.Done:
@@ -217,10 +310,80 @@ func (c *Consumer) Run(projection *sqlsol.Projection, stream bool) error {
 	}
 }
 
-func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData) error {
+// consumeChain streams the blocks of chain from after the last one committed to end to eventCh
+func (c *Consumer) consumeChain(chain *chainConnection, projection *sqlsol.Projection, end *rpcevents.Bound,
+	eventCh chan<- types.EventData) error {
+
+	c.Logger.InfoMsg("Getting last processed block number from SQL log table", "chain_id", chain.Burrow.ChainID)
+
+	// NOTE [Silas]: I am preserving the comment below that dates from the early days of Vent. I have looked at the
+	// bosmarmot git history and I cannot see why the original author thought that it was the case that there was
+	// no way of knowing if the last block of events was committed since the block and its associated log is
+	// committed atomically in a transaction and this is a core part of he design of Vent - in order that it does not
+	// repeat
+
+	// [ORIGINAL COMMENT]
+	// right now there is no way to know if the last block of events was completely read
+	// so we have to begin processing from the last block number stored in database
+	// and update event data if already present
+	fromBlock, err := c.Sink.LastBlockHeight(chain.Burrow.ChainID)
+	if err != nil {
+		return errors.Wrapf(err, "Error trying to get last processed block number")
+	}
+
+	startingBlock := fromBlock
+	// Start the block after the last one successfully committed - apart from if this is the first block
+	// We include block 0 because it is where we currently place dump/restored transactions
+	if startingBlock > 0 {
+		startingBlock++
+	}
+
+	// setup block range to get needed blocks server side
+	cli := rpcevents.NewExecutionEventsClient(chain.conn)
+	request := &rpcevents.BlocksRequest{
+		BlockRange: rpcevents.NewBlockRange(rpcevents.AbsoluteBound(startingBlock), end),
+	}
+
+	// gets blocks in given range based on last processed block taken from database
+	blocks, err := cli.Stream(context.Background(), request)
+	if err != nil {
+		return errors.Wrapf(err, "Error connecting to block stream")
+	}
+
+	// get blocks
+
+	c.Logger.TraceMsg("Waiting for blocks...")
+
+	query := rpcquery.NewQueryClient(chain.conn)
+	stateAt := func(height uint64) acmstate.Reader {
+		return &queryState{cli: query, height: height}
+	}
+	err = rpcevents.ConsumeBlockExecutions(blocks,
+		NewBlockConsumer(projection, c.Config.SpecOpt, chain.abiProvider.GetEventAbi, stateAt, eventCh, c.Done,
+			c.Logger))
+
+	if err != nil {
+		if err == io.EOF {
+			c.Logger.InfoMsg("EOF stream received...")
+		} else {
+			if finished(c.Done) {
+				c.Logger.TraceMsg("GRPC connection closed")
+			} else {
+				return errors.Wrapf(err, "Error receiving blocks")
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.EventData, bf *backfill) error {
+	tables, data := projection.Tables, blockEvents
+	if bf != nil {
+		tables, data = bf.head(blockEvents)
+	}
 	// upsert rows in specific SQL event tables and update block number
-	if err := c.DB.SetBlock(c.Burrow.ChainID, projection.Tables, blockEvents); err != nil {
-		return fmt.Errorf("error upserting rows in database: %v", err)
+	if err := c.Sink.SetBlock(data.ChainID, tables, data); err != nil {
+		return fmt.Errorf("error committing rows to sink: %v", err)
 	}
 
 	// send to the external events channel in a non-blocking manner
@@ -231,28 +394,57 @@ func (c *Consumer) commitBlock(projection *sqlsol.Projection, blockEvents types.
 	return nil
 }
 
+// cacheAbis records the metadata of the ABIs resolved for chain since last cached (if there is a cache)
+func (c *Consumer) cacheAbis(abiCache AbiCache, chain *chainConnection) error {
+	if abiCache == nil {
+		return nil
+	}
+	for address, metadata := range chain.abiProvider.TakeResolved() {
+		err := abiCache.SetAbi(chain.Burrow.ChainID, address, metadata)
+		if err != nil {
+			return fmt.Errorf("could not cache ABI for contract at address %v: %v", address, err)
+		}
+	}
+	return nil
+}
+
+// cutOver cuts over to the tables of bf once complete, returning the backfill that remains (nil if it has cut over)
+func (c *Consumer) cutOver(bf *backfill) (*backfill, error) {
+	if !bf.complete() {
+		return bf, nil
+	}
+	c.Logger.InfoMsg("Backfill caught up, cutting over", "tables", bf.tableNames())
+	err := bf.cutOver()
+	if err != nil {
+		return nil, fmt.Errorf("could not cut over to backfilled tables: %v", err)
+	}
+	return nil, nil
+}
+
 // Health returns the health status for the consumer
 func (c *Consumer) Health() error {
 	if finished(c.Done) {
 		return errors.New("closing service")
 	}
 
-	// check db status
-	if c.DB == nil {
-		return errors.New("database disconnected")
+	// check sink status
+	if c.Sink == nil {
+		return errors.New("sink disconnected")
 	}
 
-	if err := c.DB.Ping(); err != nil {
-		return errors.New("database unavailable")
+	if err := c.Sink.Ping(); err != nil {
+		return errors.New("sink unavailable")
 	}
 
 	// check grpc connection status
-	if c.GRPCConnection == nil {
+	if len(c.chains) == 0 {
 		return errors.New("grpc disconnected")
 	}
 
-	if grpcState := c.GRPCConnection.GetState(); grpcState != connectivity.Ready {
-		return errors.New("grpc connection not ready")
+	for _, chain := range c.chains {
+		if grpcState := chain.conn.GetState(); grpcState != connectivity.Ready {
+			return fmt.Errorf("grpc connection to %s not ready", chain.grpcAddr)
+		}
 	}
 
 	return nil
@@ -263,48 +455,53 @@ func (c *Consumer) Shutdown() {
 	c.shutdownOnce.Do(func() {
 		c.Logger.InfoMsg("Shutting down vent consumer...")
 		close(c.Done)
-		c.GRPCConnection.Close()
+		for _, chain := range c.chains {
+			chain.conn.Close()
+		}
 	})
 }
 
-func (c *Consumer) updateStatus(qcli rpcquery.QueryClient) {
+func (chain *chainConnection) updateStatus(logger *logging.Logger) {
+	qcli := rpcquery.NewQueryClient(chain.conn)
 	stat, err := qcli.Status(context.Background(), &rpcquery.StatusParam{})
 	if err != nil {
-		c.Logger.InfoMsg("could not get blockchain status", "err", err)
+		logger.InfoMsg("could not get blockchain status", "err", err, "grpc_addr", chain.grpcAddr)
 		return
 	}
-	c.Status.Burrow = stat
+	chain.Burrow = stat
 }
 
-func (c *Consumer) statusMessage() []interface{} {
+func (chain *chainConnection) statusMessage() []interface{} {
 	var catchUpRatio float64
-	if c.Burrow.SyncInfo.LatestBlockHeight > 0 {
-		catchUpRatio = float64(c.LastProcessedHeight) / float64(c.Burrow.SyncInfo.LatestBlockHeight)
+	if chain.Burrow.SyncInfo.LatestBlockHeight > 0 {
+		catchUpRatio = float64(chain.LastProcessedHeight) / float64(chain.Burrow.SyncInfo.LatestBlockHeight)
 	}
 	return []interface{}{
 		"msg", "status",
-		"last_processed_height", c.LastProcessedHeight,
+		"chain_id", chain.Burrow.ChainID,
+		"last_processed_height", chain.LastProcessedHeight,
 		"fraction_caught_up", catchUpRatio,
-		"burrow_latest_block_height", c.Burrow.SyncInfo.LatestBlockHeight,
-		"burrow_latest_block_duration", c.Burrow.SyncInfo.LatestBlockDuration,
-		"burrow_latest_block_hash", c.Burrow.SyncInfo.LatestBlockHash,
-		"burrow_latest_app_hash", c.Burrow.SyncInfo.LatestAppHash,
-		"burrow_latest_block_time", c.Burrow.SyncInfo.LatestBlockTime,
-		"burrow_latest_block_seen_time", c.Burrow.SyncInfo.LatestBlockSeenTime,
-		"burrow_node_info", c.Burrow.NodeInfo,
-		"burrow_catching_up", c.Burrow.CatchingUp,
+		"burrow_latest_block_height", chain.Burrow.SyncInfo.LatestBlockHeight,
+		"burrow_latest_block_duration", chain.Burrow.SyncInfo.LatestBlockDuration,
+		"burrow_latest_block_hash", chain.Burrow.SyncInfo.LatestBlockHash,
+		"burrow_latest_app_hash", chain.Burrow.SyncInfo.LatestAppHash,
+		"burrow_latest_block_time", chain.Burrow.SyncInfo.LatestBlockTime,
+		"burrow_latest_block_seen_time", chain.Burrow.SyncInfo.LatestBlockSeenTime,
+		"burrow_node_info", chain.Burrow.NodeInfo,
+		"burrow_catching_up", chain.Burrow.CatchingUp,
 	}
 }
 
 func (c *Consumer) announceEvery(doneCh <-chan struct{}) {
 	if c.Config.AnnounceEvery != 0 {
-		qcli := rpcquery.NewQueryClient(c.GRPCConnection)
 		ticker := time.NewTicker(c.Config.AnnounceEvery)
 		for {
 			select {
 			case <-ticker.C:
-				c.updateStatus(qcli)
-				c.Logger.InfoMsg("Announcement", c.statusMessage()...)
+				for _, chain := range c.chains {
+					chain.updateStatus(c.Logger)
+					c.Logger.InfoMsg("Announcement", chain.statusMessage()...)
+				}
 			case <-doneCh:
 				ticker.Stop()
 				return
@@ -0,0 +1,67 @@
+// +build integration
+
+package service_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/hyperledger/burrow/integration"
+	"github.com/hyperledger/burrow/integration/rpctest"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/service"
+	"github.com/hyperledger/burrow/vent/sink"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/test"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBusConsumer(t *testing.T) {
+	kern, shutdown := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdown()
+	inputAddress := rpctest.PrivateAccounts[0].GetAddress()
+	grpcAddress := kern.GRPCListenAddress().String()
+	tcli := test.NewTransactClient(t, grpcAddress)
+	time.Sleep(2 * time.Second)
+
+	create := test.CreateContract(t, tcli, inputAddress)
+	test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEvent1", "Description of TestEvent1")
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEvent2",
+		"Description of TestEvent2")
+
+	cfg := config.DefaultVentConfig()
+	cfg.GRPCAddr = grpcAddress
+	resolveSpec(cfg, testViewSpec)
+
+	broker := sink.NewMemoryBroker()
+	runBusConsumer(t, cfg, broker)
+
+	messages := broker.Messages("vent.EventTest")
+	require.Len(t, messages, 2)
+	row := new(sink.RowMessage)
+	require.NoError(t, json.Unmarshal(messages[1].Value, row))
+	require.Equal(t, types.ActionUpsert, row.Action)
+	require.Equal(t, txe.Height, row.Height)
+	require.Equal(t, "TestEvent2", row.Row["testname"])
+
+	bs := sink.NewBusSink(broker, "vent.", logging.NewNoopLogger())
+	height, err := bs.LastBlockHeight(kern.Blockchain.ChainID())
+	require.NoError(t, err)
+	require.True(t, height >= txe.Height, "checkpoint height %d should be at least %d", height, txe.Height)
+
+	// Resuming from the checkpoint does not publish the rows again
+	runBusConsumer(t, cfg, broker)
+	require.Len(t, broker.Messages("vent.EventTest"), 2)
+}
+
+func runBusConsumer(t *testing.T, cfg *config.VentConfig, broker sink.Broker) {
+	consumer := service.NewConsumer(cfg, logging.NewNoopLogger(), make(chan types.EventData, 100))
+	consumer.Sink = sink.NewBusSink(broker, "vent.", logging.NewNoopLogger())
+
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+	require.NoError(t, err)
+	require.NoError(t, consumer.Run(projection, false))
+}
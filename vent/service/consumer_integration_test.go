@@ -3,13 +3,17 @@
 package service_test
 
 import (
+	"database/sql"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"math/rand"
 	"path"
 	"runtime"
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/core"
 	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/rpc/rpctransact"
@@ -64,6 +68,12 @@ func testConsumer(t *testing.T, chainID string, cfg *config.VentConfig, tcli rpc
 		ensureEvents(t, db, chainID, eventColumnName, txeA.Height, 1)
 		eventData := ensureEvents(t, db, chainID, eventColumnName, txeB.Height, 1)
 
+		// derived columns
+		row := eventData.Tables[eventColumnName][0].RowData
+		require.Equal(t, inputAddress.String(), row["origin"])
+		require.Equal(t, "TestEvent4: Description of TestEvent4", row["summary"])
+		require.NotEmpty(t, row["time"])
+
 		// block & tx raw data also persisted
 		if cfg.SpecOpt&sqlsol.Block > 0 {
 			tblData := eventData.Tables[tables.Block]
@@ -126,6 +136,161 @@ func testDeleteEvent(t *testing.T, chainID string, cfg *config.VentConfig, tcli
 	// delete not allowed on log mode
 }
 
+// Projects the chains run by kerns into the same database
+func testMultiChain(t *testing.T, cfg *config.VentConfig, inputAddress crypto.Address, kerns ...*core.Kernel) {
+	resolveSpec(cfg, testViewSpec)
+	cfg.SpecOpt |= sqlsol.MultiChain
+	grpcAddrs := make([]string, len(kerns))
+	for i, kern := range kerns {
+		grpcAddrs[i] = kern.GRPCListenAddress().String()
+	}
+	cfg.GRPCAddr = grpcAddrs[0]
+	cfg.ChainGRPCAddrs = grpcAddrs[1:]
+
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+
+	eventColumnName := "EventTest"
+	heights := make([]uint64, len(grpcAddrs))
+	for i, grpcAddr := range grpcAddrs {
+		tcli := test.NewTransactClient(t, grpcAddr)
+		create := test.CreateContract(t, tcli, inputAddress)
+		// The same event on every chain must not collide
+		txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestEvent1", "Description")
+		heights[i] = txe.Height
+	}
+
+	consumer := service.NewConsumer(cfg, logging.NewNoopLogger(), make(chan types.EventData, 100))
+	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+	require.NoError(t, err)
+	err = consumer.Run(projection, false)
+	require.NoError(t, err)
+
+	for i, kern := range kerns {
+		chainID := kern.Blockchain.ChainID()
+		eventData := ensureEvents(t, db, chainID, eventColumnName, heights[i], 1)
+		require.Equal(t, chainID, eventData.Tables[eventColumnName][0].RowData["_chainid"])
+	}
+}
+
+// Projects the number of things stored by EventsTest and the accounts that add them
+func testState(t *testing.T, chainID string, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+	test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestState1", "Description")
+	txe := test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestState2", "Description")
+
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+	resolveSpec(cfg, testViewSpec)
+
+	stateSpec, err := json.Marshal(types.StateSpec{
+		{
+			TableName: "ThingCounts",
+			Addresses: []string{create.Receipt.ContractAddress.String()},
+			StorageLayout: &types.StorageLayout{
+				Storage: []*types.StorageVariable{{Label: "length", Slot: "0", Type: "t_int256"}},
+				Types: map[string]*types.StorageType{
+					"t_int256": {Encoding: types.StorageEncodingInplace, Label: "int256", NumberOfBytes: "32"},
+				},
+			},
+			FieldMappings: []*types.StateFieldMapping{{Field: "length", ColumnName: "count"}},
+		},
+		{
+			TableName:     "Accounts",
+			FieldMappings: []*types.StateFieldMapping{{Field: types.AccountSequenceField, ColumnName: "sequence"}},
+		},
+	})
+	require.NoError(t, err)
+	stateSpecFile := path.Join(t.TempDir(), "state.json")
+	require.NoError(t, ioutil.WriteFile(stateSpecFile, stateSpec, 0600))
+	cfg.StateSpecFileOrDirs = []string{stateSpecFile}
+
+	runConsumer(t, cfg)
+
+	eventData, err := db.GetBlock(chainID, txe.Height)
+	require.NoError(t, err)
+	require.Len(t, eventData.Tables["ThingCounts"], 1)
+	require.Equal(t, "2", eventData.Tables["ThingCounts"][0].RowData["count"])
+
+	var sequence string
+	for _, row := range eventData.Tables["Accounts"] {
+		if row.RowData["_address"] == inputAddress.String() {
+			sequence = row.RowData["sequence"].(string)
+		}
+	}
+	require.NotEmpty(t, sequence, "input account should be projected")
+}
+
+// Changes the spec of a projected table and adds a new one then checks both are backfilled from the start of the chain
+func testBackfill(t *testing.T, cfg *config.VentConfig, tcli rpctransact.TransactClient, inputAddress crypto.Address) {
+	create := test.CreateContract(t, tcli, inputAddress)
+	test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestBackfill1", "Description")
+
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+	resolveSpec(cfg, testViewSpec)
+	runConsumer(t, cfg)
+
+	bs, err := ioutil.ReadFile(cfg.SpecFileOrDirs[0])
+	require.NoError(t, err)
+	spec := types.ProjectionSpec{}
+	require.NoError(t, json.Unmarshal(bs, &spec))
+	for _, eventClass := range spec {
+		if eventClass.TableName == "EventTest" {
+			eventClass.FieldMappings = append(eventClass.FieldMappings,
+				&types.EventFieldMapping{Transform: "upper(string(name))", ColumnName: "shout", Type: "string"})
+			spec = append(spec, &types.EventClass{
+				TableName: "EventNames",
+				Filter:    eventClass.Filter,
+				FieldMappings: []*types.EventFieldMapping{
+					{Field: "name", ColumnName: "name", Type: "bytes32", BytesToString: true, Primary: true},
+					{ColumnName: "events", Type: "uint64", Aggregate: types.AggregateCount},
+				},
+			})
+		}
+	}
+	bs, err = json.Marshal(spec)
+	require.NoError(t, err)
+	specFile := path.Join(t.TempDir(), "spec.json")
+	require.NoError(t, ioutil.WriteFile(specFile, bs, 0600))
+	cfg.SpecFileOrDirs = []string{specFile}
+
+	test.CallAddEvent(t, tcli, inputAddress, create.Receipt.ContractAddress, "TestBackfill2", "Description")
+	runConsumer(t, cfg)
+
+	shouts := selectColumn(t, db, "EventTest", "testname", "shout")
+	require.Equal(t, "TESTBACKFILL1", shouts["TestBackfill1"])
+	require.Equal(t, "TESTBACKFILL2", shouts["TestBackfill2"])
+
+	events := selectColumn(t, db, "EventNames", "name", "events")
+	require.Equal(t, "1", events["TestBackfill1"])
+	require.Equal(t, "1", events["TestBackfill2"])
+
+	specs, err := db.TableSpecs()
+	require.NoError(t, err)
+	require.Contains(t, specs, "EventNames")
+
+	// The log must still rebuild the replaced tables
+	require.NoError(t, db.RestoreDB(time.Time{}, "RESTORED"))
+}
+
+// Returns the string value of column in each row of table keyed by the value of keyColumn
+func selectColumn(t *testing.T, db *sqldb.SQLDB, table, keyColumn, column string) map[string]string {
+	rows, err := db.DB.Query(fmt.Sprintf("SELECT %s, %s FROM %s", db.DBAdapter.SecureName(keyColumn),
+		db.DBAdapter.SecureName(column), db.DBAdapter.SchemaName(table)))
+	require.NoError(t, err)
+	defer rows.Close()
+
+	values := make(map[string]string)
+	for rows.Next() {
+		var key, value sql.NullString
+		require.NoError(t, rows.Scan(&key, &value))
+		values[key.String] = value.String
+	}
+	require.NoError(t, rows.Err())
+	return values
+}
+
 func ensureEvents(t *testing.T, db *sqldb.SQLDB, chainID, column string, height, numEvents uint64) types.EventData {
 	eventData, err := db.GetBlock(chainID, height)
 	require.NoError(t, err)
@@ -208,7 +373,7 @@ func runConsumer(t *testing.T, cfg *config.VentConfig) chan types.EventData {
 	ch := make(chan types.EventData, 100)
 	consumer := service.NewConsumer(cfg, logging.NewNoopLogger(), ch)
 
-	projection, err := sqlsol.SpecLoader(cfg.SpecFileOrDirs, cfg.SpecOpt)
+	projection, err := sqlsol.ProjectionLoader(cfg.SpecFileOrDirs, cfg.StateSpecFileOrDirs, cfg.SpecOpt)
 	require.NoError(t, err)
 
 	err = consumer.Run(projection, false)
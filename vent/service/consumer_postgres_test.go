@@ -47,6 +47,14 @@ func TestPostgresConsumer(t *testing.T) {
 			testResume(t, test.PostgresVentConfig(grpcAddress))
 		})
 
+		t.Run("PostgresState", func(t *testing.T) {
+			testState(t, kern.Blockchain.ChainID(), test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("PostgresBackfill", func(t *testing.T) {
+			testBackfill(t, test.PostgresVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
 		t.Run("PostgresTriggers", func(t *testing.T) {
 			tCli := test.NewTransactClient(t, kern.GRPCListenAddress().String())
 			create := test.CreateContract(t, tCli, inputAddress)
@@ -39,5 +39,27 @@ func TestSqliteConsumer(t *testing.T) {
 		t.Run("SqliteResume", func(t *testing.T) {
 			testResume(t, test.SqliteVentConfig(grpcAddress))
 		})
+
+		t.Run("SqliteState", func(t *testing.T) {
+			testState(t, kern.Blockchain.ChainID(), test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
+
+		t.Run("SqliteBackfill", func(t *testing.T) {
+			testBackfill(t, test.SqliteVentConfig(grpcAddress), tcli, inputAddress)
+		})
 	})
 }
+
+func TestSqliteMultiChainConsumer(t *testing.T) {
+	kernA, shutdownA := integration.RunNode(t, rpctest.GenesisDoc, rpctest.PrivateAccounts)
+	defer shutdownA()
+	genesisDocB := integration.TestGenesisDoc(rpctest.PrivateAccounts, 0)
+	genesisDocB.ChainName = "OtherChain"
+	kernB, shutdownB := integration.RunNode(t, genesisDocB, rpctest.PrivateAccounts)
+	defer shutdownB()
+
+	t.Parallel()
+	time.Sleep(2 * time.Second)
+
+	testMultiChain(t, test.SqliteVentConfig(""), rpctest.PrivateAccounts[0].GetAddress(), kernA, kernB)
+}
@@ -12,7 +12,8 @@ import (
 )
 
 // decodeEvent unpacks & decodes event data
-func decodeEvent(eventHeader *exec.Header, log *exec.LogEvent, txOrigin *exec.Origin, evAbi *abi.EventSpec) (map[string]interface{}, error) {
+func decodeEvent(eventHeader *exec.Header, log *exec.LogEvent, txOrigin *exec.Origin, originAddress *crypto.Address,
+	evAbi *abi.EventSpec) (map[string]interface{}, error) {
 	// to prepare decoded data and map to event item name
 	data := make(map[string]interface{})
 
@@ -24,6 +25,10 @@ func decodeEvent(eventHeader *exec.Header, log *exec.LogEvent, txOrigin *exec.Or
 	data[types.EventIndexLabel] = strconv.FormatUint(eventHeader.GetIndex(), 10)
 	data[types.EventTypeLabel] = eventHeader.GetEventType().String()
 	data[types.TxTxHashLabel] = eventHeader.TxHash.String()
+	data[types.BlockTimeLabel] = txOrigin.GetTime()
+	if originAddress != nil {
+		data[types.OriginLabel] = originAddress.String()
+	}
 
 	// build expected interface type array to get log event values
 	unpackedData := abi.GetPackingTypes(evAbi.Inputs)
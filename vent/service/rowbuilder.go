@@ -7,6 +7,7 @@ import (
 	"strings"
 	"unicode/utf8"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/execution/exec"
 	"github.com/hyperledger/burrow/logging"
@@ -17,7 +18,8 @@ import (
 
 // buildEventData builds event data from transactions
 func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass, event *exec.Event,
-	txOrigin *exec.Origin, evAbi *abi.EventSpec, logger *logging.Logger) (types.EventDataRow, error) {
+	txOrigin *exec.Origin, originAddress *crypto.Address, evAbi *abi.EventSpec,
+	logger *logging.Logger) (types.EventDataRow, error) {
 
 	// a fresh new row to store column/value data
 	row := make(map[string]interface{})
@@ -27,7 +29,7 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 	eventLog := event.GetLog()
 
 	// decode event data using the provided abi specification
-	decodedData, err := decodeEvent(eventHeader, eventLog, txOrigin, evAbi)
+	decodedData, err := decodeEvent(eventHeader, eventLog, txOrigin, originAddress, evAbi)
 	if err != nil {
 		return types.EventDataRow{}, errors.Wrapf(err, "Error decoding event (filter: %s)", eventClass.Filter)
 	}
@@ -63,9 +65,58 @@ func buildEventData(projection *sqlsol.Projection, eventClass *types.EventClass,
 		}
 	}
 
+	// derive the columns of transformed and counting mappings from the decoded data
+	for _, fieldMapping := range eventClass.FieldMappings {
+		if fieldMapping.Transform == "" && fieldMapping.Aggregate != types.AggregateCount {
+			continue
+		}
+		column, err := projection.GetColumn(eventClass.TableName, fieldMapping.ColumnName)
+		if err != nil {
+			logger.TraceMsg("could not get column", "err", err)
+			continue
+		}
+		if fieldMapping.Aggregate == types.AggregateCount {
+			// each event counts once
+			row[column.Name] = 1
+			continue
+		}
+		if rowAction == types.ActionDelete && !fieldMapping.Primary {
+			// a delete only matches on the primary key so need not carry fields the event may not have
+			continue
+		}
+		tr, err := fieldMapping.GetTransform()
+		if err != nil {
+			return types.EventDataRow{}, errors.Wrapf(err, "Error parsing transform of column %s", column.Name)
+		}
+		value, err := tr.Evaluate(func(name string) (interface{}, bool) {
+			value, ok := decodedData[name]
+			return value, ok
+		})
+		if err != nil {
+			if !fieldMapping.NullOnError {
+				return types.EventDataRow{}, errors.Wrapf(err, "Error transforming event data for column %s",
+					column.Name)
+			}
+			logger.InfoMsg("could not transform event data, leaving column null", "err", err,
+				"column", column.Name)
+			continue
+		}
+		row[column.Name] = value
+	}
+
 	return types.EventDataRow{Action: rowAction, RowData: row, EventClass: eventClass}, nil
 }
 
+// getOriginAddress returns the address of the account that signed the transaction, which is its first input, if any
+func getOriginAddress(txe *exec.TxExecution) *crypto.Address {
+	for _, ev := range txe.Events {
+		if ev.Input != nil {
+			return &ev.Input.Address
+		}
+	}
+	return nil
+}
+
 // buildBlkData builds block data from block stream
 func buildBlkData(tbls types.EventTables, block *exec.BlockExecution) (types.EventDataRow, error) {
 	// a fresh new row to store column/value data
@@ -137,6 +188,30 @@ func buildTxData(txe *exec.TxExecution) (types.EventDataRow, error) {
 	}, nil
 }
 
+// buildDeadLetterData builds a row recording an event of txe that could not be decoded for want of an ABI
+func buildDeadLetterData(txe *exec.TxExecution, event *exec.Event, eventID abi.EventID,
+	eventSpecErr error) (types.EventDataRow, error) {
+
+	topics, err := json.Marshal(event.Log.Topics)
+	if err != nil {
+		return types.EventDataRow{}, fmt.Errorf("couldn't marshal topics of event %v: %v", event, err)
+	}
+
+	return types.EventDataRow{
+		Action: types.ActionUpsert,
+		RowData: map[string]interface{}{
+			columns.Height:     txe.Height,
+			columns.TxHash:     txe.TxHash.String(),
+			columns.EventIndex: event.Header.Index,
+			columns.Address:    event.Log.Address.String(),
+			columns.EventID:    eventID.String(),
+			columns.Topics:     string(topics),
+			columns.LogData:    event.Log.Data.String(),
+			columns.Error:      eventSpecErr.Error(),
+		},
+	}, nil
+}
+
 func sanitiseBytesForString(bs []byte, l *logging.Logger) string {
 	str, err := UTF8StringFromBytes(bs)
 	if err != nil {
@@ -2,10 +2,15 @@ package service
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/process"
+	"github.com/hyperledger/burrow/rpc"
 	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/ventquery"
+	"google.golang.org/grpc"
 )
 
 // Server exposes HTTP endpoints for the service
@@ -13,28 +18,35 @@ type Server struct {
 	Config   *config.VentConfig
 	Log      *logging.Logger
 	Consumer *Consumer
-	mux      *http.ServeMux
-	stopCh   chan bool
+	// Serves the read API when set
+	Query  *ventquery.Server
+	mux    *http.ServeMux
+	stopCh chan bool
 }
 
-// NewServer returns a new HTTP server
-func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer) *Server {
+// NewServer returns a new HTTP server, serving the read API of query under /query if it is not nil
+func NewServer(cfg *config.VentConfig, log *logging.Logger, consumer *Consumer, query *ventquery.Server) *Server {
 	// setup handlers
 	mux := http.NewServeMux()
 
 	mux.HandleFunc("/health", healthHandler(consumer))
+	if query != nil {
+		mux.Handle("/query/", http.StripPrefix("/query", query.HTTPHandler()))
+	}
 
 	return &Server{
 		Config:   cfg,
 		Log:      log,
 		Consumer: consumer,
+		Query:    query,
 		mux:      mux,
 		stopCh:   make(chan bool, 1),
 	}
 }
 
-// Run starts the HTTP server
-func (s *Server) Run() {
+// Run starts the HTTP server and the gRPC server of the read API if it has an address, returning an error if the
+// gRPC server cannot listen or stops serving
+func (s *Server) Run() error {
 	s.Log.InfoMsg("Starting HTTP Server")
 
 	// start http server
@@ -45,12 +57,37 @@ func (s *Server) Run() {
 		httpServer.ListenAndServe()
 	}()
 
-	// wait for stop signal
-	<-s.stopCh
+	var grpcServer *grpc.Server
+	serveErrCh := make(chan error, 1)
+	if s.Query != nil && s.Config.QueryGRPCAddr != "" {
+		listener, err := process.ListenerFromAddress(s.Config.QueryGRPCAddr)
+		if err != nil {
+			httpServer.Shutdown(context.Background())
+			return fmt.Errorf("could not listen for gRPC queries on %s: %v", s.Config.QueryGRPCAddr, err)
+		}
+		grpcServer = rpc.NewGRPCServer(nil, nil, s.Log)
+		ventquery.RegisterQueryServer(grpcServer, s.Query)
+		go func() {
+			s.Log.InfoMsg("gRPC Server listening", "address", s.Config.QueryGRPCAddr)
+			serveErrCh <- grpcServer.Serve(listener)
+		}()
+	}
+
+	// wait for stop signal or for the gRPC server to fail
+	var err error
+	select {
+	case <-s.stopCh:
+	case err = <-serveErrCh:
+		err = fmt.Errorf("gRPC query server stopped serving: %v", err)
+	}
 
 	s.Log.InfoMsg("Shutting down HTTP Server...")
 
 	httpServer.Shutdown(context.Background())
+	if grpcServer != nil {
+		grpcServer.Stop()
+	}
+	return err
 }
 
 // ServeHTTP dispatches the HTTP requests using the Server Mux
@@ -57,7 +57,7 @@ func TestServer(t *testing.T) {
 			time.Sleep(2 * time.Second)
 
 			// setup test server
-			server := service.NewServer(cfg, log, consumer)
+			server := service.NewServer(cfg, log, consumer, nil)
 
 			httpServer := httptest.NewServer(server)
 			defer httpServer.Close()
@@ -0,0 +1,273 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/rpc/rpcquery"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// StateAt returns a reader of the state as it was after the block at height
+type StateAt func(height uint64) acmstate.Reader
+
+// queryState reads the state of accounts and their storage as it was after the block at height from the query service
+// of a Burrow node, so that the state projected for a block is that of the block however far behind the chain Vent is
+type queryState struct {
+	cli    rpcquery.QueryClient
+	height uint64
+}
+
+var _ acmstate.Reader = &queryState{}
+
+func (qs *queryState) GetAccount(address crypto.Address) (*acm.Account, error) {
+	acc, err := qs.cli.GetAccount(context.Background(), &rpcquery.GetAccountParam{Address: address, Height: qs.height})
+	if err != nil {
+		return nil, err
+	}
+	// The query service returns an empty account for one that does not exist
+	if acc.Address == crypto.ZeroAddress {
+		return nil, nil
+	}
+	return acc, nil
+}
+
+func (qs *queryState) GetStorage(address crypto.Address, key binary.Word256) ([]byte, error) {
+	value, err := qs.cli.GetStorage(context.Background(), &rpcquery.GetStorageParam{Address: address, Key: key,
+		Height: qs.height})
+	if err != nil {
+		return nil, err
+	}
+	return value.Value, nil
+}
+
+// touchedState holds the accounts touched by a block
+type touchedState struct {
+	accounts map[crypto.Address]struct{}
+}
+
+func getTouchedState(blockExecution *exec.BlockExecution) *touchedState {
+	touched := &touchedState{
+		accounts: make(map[crypto.Address]struct{}),
+	}
+	for _, txe := range blockExecution.TxExecutions {
+		for _, ev := range txe.Events {
+			switch {
+			case ev.Input != nil:
+				touched.touch(ev.Input.Address)
+			case ev.Output != nil:
+				touched.touch(ev.Output.Address)
+			case ev.Call != nil && ev.Call.CallData != nil:
+				callData := ev.Call.CallData
+				touched.touch(callData.Caller)
+				touched.touch(callData.Callee)
+			case ev.GovernAccount != nil && ev.GovernAccount.AccountUpdate != nil:
+				update := ev.GovernAccount.AccountUpdate
+				if update.Address != nil {
+					touched.touch(*update.Address)
+				} else if update.PublicKey != nil {
+					touched.touch(update.PublicKey.GetAddress())
+				}
+			}
+		}
+	}
+	return touched
+}
+
+func (touched *touchedState) touch(address crypto.Address) {
+	touched.accounts[address] = struct{}{}
+}
+
+// Accounts in order
+func (touched *touchedState) Accounts() []crypto.Address {
+	addresses := make([]crypto.Address, 0, len(touched.accounts))
+	for address := range touched.accounts {
+		addresses = append(addresses, address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return addresses[i].String() < addresses[j].String()
+	})
+	return addresses
+}
+
+// buildStateData reads the state projected by each of the state classes of projection from the accounts touched by
+// blockExecution (or from all of their addresses when initial is set) passing a row for each account or configured
+// mapping key to addRow. Mapping keys whose value is zero (and accounts that no longer exist) are passed as deletions.
+// state must be the state as it was after blockExecution.
+func buildStateData(projection *sqlsol.Projection, blockExecution *exec.BlockExecution, state acmstate.Reader,
+	initial bool, addRow func(tableName string, row types.EventDataRow)) error {
+
+	chainID := blockExecution.GetHeader().GetChainID()
+	touched := getTouchedState(blockExecution)
+
+	for _, stateClass := range projection.StateSpec {
+		if !stateClass.AppliesToChain(chainID) {
+			continue
+		}
+		fields, err := stateClass.Fields()
+		if err != nil {
+			return err
+		}
+		addresses, err := stateClass.GetAddresses()
+		if err != nil {
+			return err
+		}
+		if len(addresses) == 0 {
+			addresses = touched.Accounts()
+		} else if !initial {
+			addresses = touchedAddresses(addresses, touched)
+		}
+
+		for _, address := range addresses {
+			acc, err := state.GetAccount(address)
+			if err != nil {
+				return fmt.Errorf("could not get account %v for state class %s: %v", address, stateClass.TableName, err)
+			}
+			keyRow := map[string]interface{}{
+				columns.Address: address.String(),
+			}
+			if acc == nil {
+				if stateClass.Mapping == "" {
+					addRow(stateClass.TableName, types.EventDataRow{Action: types.ActionDelete, RowData: keyRow})
+				}
+				continue
+			}
+
+			row := map[string]interface{}{
+				columns.Address: address.String(),
+				columns.ChainID: chainID,
+				columns.Height:  strconv.FormatUint(blockExecution.Height, 10),
+			}
+			var mappingValue *types.StateField
+			for _, field := range fields {
+				if field.MappingValue {
+					mappingValue = field
+					continue
+				}
+				row[field.ColumnName], err = readStateField(state, acc, field)
+				if err != nil {
+					return fmt.Errorf("could not read %s of account %v for state class %s: %v", field.Field, address,
+						stateClass.TableName, err)
+				}
+			}
+
+			if stateClass.Mapping == "" {
+				addRow(stateClass.TableName, types.EventDataRow{Action: types.ActionUpsert, RowData: row})
+				continue
+			}
+
+			keyType, err := stateClass.MappingKeyType()
+			if err != nil {
+				return err
+			}
+			keys, err := mappingKeys(stateClass, keyType)
+			if err != nil {
+				return err
+			}
+			variable, _, err := stateClass.StorageLayout.Variable(stateClass.Mapping)
+			if err != nil {
+				return err
+			}
+			slot, err := variable.SlotKey()
+			if err != nil {
+				return err
+			}
+			for _, key := range keys {
+				keyValue, _ := keyType.DecodeKey(key)
+				value, err := state.GetStorage(address, types.MappingKey(slot, key))
+				if err != nil {
+					return fmt.Errorf("could not read %s of account %v for state class %s: %v", stateClass.Mapping,
+						address, stateClass.TableName, err)
+				}
+				if binary.LeftPadWord256(value).IsZero() {
+					addRow(stateClass.TableName, types.EventDataRow{
+						Action: types.ActionDelete,
+						RowData: map[string]interface{}{
+							columns.Address:    address.String(),
+							columns.StorageKey: keyValue,
+						},
+					})
+					continue
+				}
+				keyedRow := make(map[string]interface{}, len(row)+2)
+				for column, value := range row {
+					keyedRow[column] = value
+				}
+				keyedRow[columns.StorageKey] = keyValue
+				if mappingValue != nil {
+					keyedRow[mappingValue.ColumnName], err = mappingValue.StorageType.DecodeValue(value, 0)
+					if err != nil {
+						return err
+					}
+				}
+				addRow(stateClass.TableName, types.EventDataRow{Action: types.ActionUpsert, RowData: keyedRow})
+			}
+		}
+	}
+	return nil
+}
+
+func readStateField(state acmstate.Reader, acc *acm.Account, field *types.StateField) (interface{}, error) {
+	if field.Variable != nil {
+		slot, err := field.Variable.SlotKey()
+		if err != nil {
+			return nil, err
+		}
+		value, err := state.GetStorage(acc.Address, slot)
+		if err != nil {
+			return nil, err
+		}
+		return field.StorageType.DecodeValue(value, field.Variable.Offset)
+	}
+	switch field.Field {
+	case types.AccountBalanceField:
+		return strconv.FormatUint(acc.Balance, 10), nil
+	case types.AccountSequenceField:
+		return strconv.FormatUint(acc.Sequence, 10), nil
+	case types.AccountPermissionsField:
+		return strconv.FormatUint(uint64(acc.Permissions.Base.Perms), 10), nil
+	case types.AccountRolesField:
+		return strings.Join(acc.Permissions.Roles, ","), nil
+	case types.AccountCodeHashField:
+		return []byte(acc.CodeHash), nil
+	}
+	return nil, fmt.Errorf("unknown account field %s", field.Field)
+}
+
+// The keys of the mapping projected by stateClass to read, as configured by its Keys
+func mappingKeys(stateClass *types.StateClass, keyType *types.StorageType) ([]binary.Word256, error) {
+
+	seen := make(map[binary.Word256]struct{})
+	var keys []binary.Word256
+	for _, key := range stateClass.Keys {
+		word, err := keyType.EncodeKey(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid key of mapping %s in state class %s: %v", stateClass.Mapping,
+				stateClass.TableName, err)
+		}
+		if _, ok := seen[word]; !ok {
+			seen[word] = struct{}{}
+			keys = append(keys, word)
+		}
+	}
+	return keys, nil
+}
+
+func touchedAddresses(addresses []crypto.Address, touched *touchedState) []crypto.Address {
+	var touchedAddresses []crypto.Address
+	for _, address := range addresses {
+		if _, ok := touched.accounts[address]; ok {
+			touchedAddresses = append(touchedAddresses, address)
+		}
+	}
+	return touchedAddresses
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/acm"
+	"github.com/hyperledger/burrow/acm/acmstate"
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/hyperledger/burrow/execution/exec"
+	"github.com/hyperledger/burrow/vent/sqlsol"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	tmTypes "github.com/tendermint/tendermint/abci/types"
+)
+
+// contract Token { address owner; uint8 decimals; mapping(address => uint) balances; }
+var tokenLayout = &types.StorageLayout{
+	Storage: []*types.StorageVariable{
+		{Label: "owner", Slot: "0", Offset: 0, Type: "t_address"},
+		{Label: "decimals", Slot: "0", Offset: 20, Type: "t_uint8"},
+		{Label: "balances", Slot: "1", Offset: 0, Type: "t_mapping(t_address,t_uint256)"},
+	},
+	Types: map[string]*types.StorageType{
+		"t_address": {Encoding: "inplace", Label: "address", NumberOfBytes: "20"},
+		"t_uint8":   {Encoding: "inplace", Label: "uint8", NumberOfBytes: "1"},
+		"t_uint256": {Encoding: "inplace", Label: "uint256", NumberOfBytes: "32"},
+		"t_mapping(t_address,t_uint256)": {Encoding: "mapping", Label: "mapping(address => uint256)",
+			NumberOfBytes: "32", Key: "t_address", Value: "t_uint256"},
+	},
+}
+
+func TestBuildStateData(t *testing.T) {
+	token := crypto.Address{1}
+	owner := crypto.Address{2}
+	alice := crypto.Address{3}
+	bob := crypto.Address{4}
+
+	st := acmstate.NewMemoryState()
+	for _, address := range []crypto.Address{token, owner, alice} {
+		require.NoError(t, st.UpdateAccount(&acm.Account{Address: address, Balance: 10}))
+	}
+	slot0 := append(make([]byte, 11), 18)
+	slot0 = append(slot0, owner.Bytes()...)
+	require.NoError(t, st.SetStorage(token, binary.Zero256, slot0))
+	balancesSlot := binary.Int64ToWord256(1)
+	require.NoError(t, st.SetStorage(token, types.MappingKey(balancesSlot, alice.Word256()),
+		binary.Int64ToWord256(100).Bytes()))
+
+	projection, err := sqlsol.NewProjection(nil)
+	require.NoError(t, err)
+	err = projection.AddStateSpec(types.StateSpec{
+		{
+			TableName:     "Tokens",
+			Addresses:     []string{token.String()},
+			StorageLayout: tokenLayout,
+			FieldMappings: []*types.StateFieldMapping{
+				{Field: "owner", ColumnName: "owner"},
+				{Field: "decimals", ColumnName: "decimals"},
+				{Field: types.AccountBalanceField, ColumnName: "balance"},
+			},
+		},
+		{
+			TableName:     "Balances",
+			Addresses:     []string{token.String()},
+			StorageLayout: tokenLayout,
+			Mapping:       "balances",
+			Keys:          []string{alice.String(), bob.String()},
+			FieldMappings: []*types.StateFieldMapping{
+				{Field: "balances", ColumnName: "balance"},
+			},
+		},
+		{
+			TableName: "Accounts",
+			FieldMappings: []*types.StateFieldMapping{
+				{Field: types.AccountBalanceField, ColumnName: "balance"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.NotNil(t, projection.Tables["Balances"].GetColumn(columns.StorageKey))
+
+	// alice transfers to bob
+	txe := &exec.TxExecution{TxHeader: &exec.TxHeader{}}
+	txe.Input(alice, nil)
+	require.NoError(t, txe.Call(&exec.CallEvent{
+		CallData: &exec.CallData{
+			Caller: alice,
+			Callee: token,
+			Data:   append([]byte{0xa9, 0x05, 0x9c, 0xbb}, bob.Word256().Bytes()...),
+		},
+		Origin: alice,
+	}, nil))
+	block := &exec.BlockExecution{Height: 7, Header: &tmTypes.Header{ChainID: "Chain"}}
+	block.AppendTxs(txe)
+
+	rows := make(map[string][]types.EventDataRow)
+	addRow := func(tableName string, row types.EventDataRow) {
+		rows[tableName] = append(rows[tableName], row)
+	}
+	require.NoError(t, buildStateData(projection, block, st, false, addRow))
+
+	require.Len(t, rows["Tokens"], 1)
+	assert.Equal(t, types.ActionUpsert, rows["Tokens"][0].Action)
+	assert.Equal(t, owner.String(), rows["Tokens"][0].RowData["owner"])
+	assert.Equal(t, "18", rows["Tokens"][0].RowData["decimals"])
+	assert.Equal(t, "10", rows["Tokens"][0].RowData["balance"])
+	assert.Equal(t, "7", rows["Tokens"][0].RowData[columns.Height])
+
+	// alice's balance and bob's (zero so deleted) are read at their configured keys
+	require.Len(t, rows["Balances"], 2)
+	assert.Equal(t, types.ActionUpsert, rows["Balances"][0].Action)
+	assert.Equal(t, alice.String(), rows["Balances"][0].RowData[columns.StorageKey])
+	assert.Equal(t, "100", rows["Balances"][0].RowData["balance"])
+	assert.Equal(t, types.ActionDelete, rows["Balances"][1].Action)
+	assert.Equal(t, bob.String(), rows["Balances"][1].RowData[columns.StorageKey])
+
+	// alice (caller) and the token (callee) are touched
+	require.Len(t, rows["Accounts"], 2)
+
+	// Nothing touches the token in an empty block unless reading the initial state
+	rows = make(map[string][]types.EventDataRow)
+	block = &exec.BlockExecution{Height: 8, Header: &tmTypes.Header{ChainID: "Chain"}}
+	require.NoError(t, buildStateData(projection, block, st, false, addRow))
+	assert.Len(t, rows, 0)
+	require.NoError(t, buildStateData(projection, block, st, true, addRow))
+	assert.Len(t, rows["Tokens"], 1)
+	assert.Len(t, rows["Balances"], 2)
+}
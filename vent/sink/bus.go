@@ -0,0 +1,179 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Topic (after the prefix) to which BusSink publishes a checkpoint for each block keyed by chain ID
+const CheckpointTopic = "_vent_chaininfo"
+
+// Message is a keyed message published to a topic
+type Message struct {
+	Topic string
+	Key   []byte
+	Value []byte
+}
+
+// Broker is a message bus such as Kafka or NATS
+type Broker interface {
+	// Publish publishes messages in order, messages with the same topic and key must be delivered in the order given.
+	// On error some of the messages may have been published, they are published again when the block is retried so a
+	// broker should deduplicate them (or consumers tolerate repeats).
+	Publish(messages []Message) error
+	// Last returns the last message published to topic with key, or nil if there is none
+	Last(topic string, key []byte) (*Message, error)
+	Ping() error
+}
+
+// RowMessage is the value of the message published for each row upsert or delete
+type RowMessage struct {
+	ChainID string                 `json:"chainID"`
+	Height  uint64                 `json:"height"`
+	Table   string                 `json:"table"`
+	Action  types.DBAction         `json:"action"`
+	Row     map[string]interface{} `json:"row"`
+}
+
+// Checkpoint is the value of the message published to CheckpointTopic after each block
+type Checkpoint struct {
+	ChainID       string `json:"chainID"`
+	BurrowVersion string `json:"burrowVersion"`
+	Height        uint64 `json:"height"`
+}
+
+// BusSink publishes a change data capture stream of projected rows to a Broker. Each row upsert or delete is
+// published to the topic of its table keyed by a JSON object of its primary key columns. The messages of a block are
+// published in a single batch ending with a checkpoint that records the block's height, so a block is published again
+// unless all of its messages were. The broker is not closed with the sink so that it can be shared.
+type BusSink struct {
+	broker        Broker
+	topicPrefix   string
+	burrowVersion string
+	logger        *logging.Logger
+}
+
+var _ Sink = &BusSink{}
+
+// NewBusSink publishes to topics named by topicPrefix followed by the table name
+func NewBusSink(broker Broker, topicPrefix string, logger *logging.Logger) *BusSink {
+	return &BusSink{
+		broker:      broker,
+		topicPrefix: topicPrefix,
+		logger:      logger,
+	}
+}
+
+// Init records the Burrow version for checkpoints, since checkpoints are keyed by chain ID there is nothing to reset
+// when the chain changes
+func (bs *BusSink) Init(chainID, burrowVersion string) error {
+	bs.burrowVersion = burrowVersion
+	return bs.broker.Ping()
+}
+
+// Synchronize does nothing since messages carry their own columns
+func (bs *BusSink) Synchronize(chainID string, tables types.EventTables) error {
+	return nil
+}
+
+func (bs *BusSink) SetBlock(chainID string, tables types.EventTables, eventData types.EventData) error {
+	// Publish tables in a consistent order
+	tableNames := make([]string, 0, len(tables))
+	for name := range tables {
+		tableNames = append(tableNames, name)
+	}
+	sort.Strings(tableNames)
+
+	var messages []Message
+	for _, name := range tableNames {
+		table := tables[name]
+		for _, row := range eventData.Tables[name] {
+			if row.Action != types.ActionUpsert && row.Action != types.ActionDelete {
+				return fmt.Errorf("invalid row action %s", row.Action)
+			}
+			key, err := rowKey(table, row)
+			if err != nil {
+				return err
+			}
+			value, err := json.Marshal(RowMessage{
+				ChainID: chainID,
+				Height:  eventData.BlockHeight,
+				Table:   name,
+				Action:  row.Action,
+				Row:     row.RowData,
+			})
+			if err != nil {
+				return fmt.Errorf("could not marshal row of table %s: %v", name, err)
+			}
+			messages = append(messages, Message{Topic: bs.Topic(name), Key: key, Value: value})
+		}
+	}
+
+	checkpoint, err := json.Marshal(Checkpoint{
+		ChainID:       chainID,
+		BurrowVersion: bs.burrowVersion,
+		Height:        eventData.BlockHeight,
+	})
+	if err != nil {
+		return fmt.Errorf("could not marshal checkpoint: %v", err)
+	}
+	messages = append(messages, Message{Topic: bs.Topic(CheckpointTopic), Key: []byte(chainID), Value: checkpoint})
+
+	bs.logger.InfoMsg("Publishing block", "height", eventData.BlockHeight, "messages", len(messages))
+	err = bs.broker.Publish(messages)
+	if err != nil {
+		return fmt.Errorf("could not publish block %d: %v", eventData.BlockHeight, err)
+	}
+	return nil
+}
+
+func (bs *BusSink) LastBlockHeight(chainID string) (uint64, error) {
+	msg, err := bs.broker.Last(bs.Topic(CheckpointTopic), []byte(chainID))
+	if err != nil {
+		return 0, fmt.Errorf("could not get last checkpoint: %v", err)
+	}
+	if msg == nil {
+		return 0, nil
+	}
+	checkpoint := new(Checkpoint)
+	err = json.Unmarshal(msg.Value, checkpoint)
+	if err != nil {
+		return 0, fmt.Errorf("could not unmarshal checkpoint: %v", err)
+	}
+	return checkpoint.Height, nil
+}
+
+func (bs *BusSink) Ping() error {
+	return bs.broker.Ping()
+}
+
+func (bs *BusSink) Close() {
+}
+
+// Topic returns the topic to which rows of table are published
+func (bs *BusSink) Topic(table string) string {
+	return bs.topicPrefix + table
+}
+
+// rowKey marshals the primary key columns of row to a JSON object
+func rowKey(table *types.SQLTable, row types.EventDataRow) ([]byte, error) {
+	key := make(map[string]interface{})
+	for _, column := range table.Columns {
+		if !column.Primary {
+			continue
+		}
+		value, ok := row.RowData[column.Name]
+		if !ok {
+			return nil, fmt.Errorf("error null primary key for column %s of table %s", column.Name, table.Name)
+		}
+		key[column.Name] = value
+	}
+	if len(key) == 0 {
+		return nil, fmt.Errorf("table %s has no primary key", table.Name)
+	}
+	return json.Marshal(key)
+}
@@ -0,0 +1,111 @@
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const chainID = "CHAIN_123"
+
+var tables = types.EventTables{
+	"tokens": {
+		Name: "tokens",
+		Columns: []*types.SQLTableColumn{
+			{Name: "owner", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "name", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "supply", Type: types.SQLColumnTypeNumeric},
+		},
+	},
+}
+
+func TestBusSink_SetBlock(t *testing.T) {
+	broker := NewMemoryBroker()
+	bs := NewBusSink(broker, "vent.", logging.NewNoopLogger())
+	require.NoError(t, bs.Init(chainID, "1.0.0"))
+
+	height, err := bs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	err = bs.SetBlock(chainID, tables, types.EventData{
+		BlockHeight: 7,
+		Tables: map[string]types.EventDataTable{
+			"tokens": {
+				{Action: types.ActionUpsert, RowData: map[string]interface{}{"owner": "bob", "name": "frog", "supply": 3}},
+				{Action: types.ActionDelete, RowData: map[string]interface{}{"owner": "bob", "name": "toad"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"vent._vent_chaininfo", "vent.tokens"}, broker.Topics())
+	messages := broker.Messages("vent.tokens")
+	require.Len(t, messages, 2)
+	assert.Equal(t, `{"name":"frog","owner":"bob"}`, string(messages[0].Key))
+	assert.Equal(t, `{"name":"toad","owner":"bob"}`, string(messages[1].Key))
+
+	row := new(RowMessage)
+	require.NoError(t, json.Unmarshal(messages[1].Value, row))
+	assert.Equal(t, RowMessage{
+		ChainID: chainID,
+		Height:  7,
+		Table:   "tokens",
+		Action:  types.ActionDelete,
+		Row:     map[string]interface{}{"owner": "bob", "name": "toad"},
+	}, *row)
+
+	height, err = bs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), height)
+
+	// Checkpoints are per chain
+	height, err = bs.LastBlockHeight("OTHER_CHAIN")
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+}
+
+func TestBusSink_SetBlockAtomic(t *testing.T) {
+	broker := &failingBroker{MemoryBroker: NewMemoryBroker()}
+	bs := NewBusSink(broker, "", logging.NewNoopLogger())
+	require.NoError(t, bs.Init(chainID, "1.0.0"))
+
+	block := func(height uint64, rows ...types.EventDataRow) types.EventData {
+		return types.EventData{BlockHeight: height, Tables: map[string]types.EventDataTable{"tokens": rows}}
+	}
+	upsert := types.EventDataRow{Action: types.ActionUpsert,
+		RowData: map[string]interface{}{"owner": "bob", "name": "frog"}}
+
+	require.NoError(t, bs.SetBlock(chainID, tables, block(1, upsert)))
+
+	broker.fail = true
+	require.Error(t, bs.SetBlock(chainID, tables, block(2, upsert)))
+	broker.fail = false
+
+	// A row without its primary key fails the whole block before anything is published
+	err := bs.SetBlock(chainID, tables, block(3, upsert, types.EventDataRow{Action: types.ActionUpsert,
+		RowData: map[string]interface{}{"owner": "bob"}}))
+	require.Error(t, err)
+
+	assert.Len(t, broker.Messages("tokens"), 1)
+	height, err := bs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), height)
+}
+
+type failingBroker struct {
+	*MemoryBroker
+	fail bool
+}
+
+func (fb *failingBroker) Publish(messages []Message) error {
+	if fb.fail {
+		return fmt.Errorf("could not publish")
+	}
+	return fb.MemoryBroker.Publish(messages)
+}
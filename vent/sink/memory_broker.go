@@ -0,0 +1,67 @@
+package sink
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+)
+
+// MemoryBroker is an in-process Broker that keeps every message published to it, for tests and for using vent as a
+// library
+type MemoryBroker struct {
+	topics map[string][]Message
+	sync.Mutex
+}
+
+var _ Broker = &MemoryBroker{}
+
+func NewMemoryBroker() *MemoryBroker {
+	return &MemoryBroker{
+		topics: make(map[string][]Message),
+	}
+}
+
+func (mb *MemoryBroker) Publish(messages []Message) error {
+	mb.Lock()
+	defer mb.Unlock()
+	for _, msg := range messages {
+		mb.topics[msg.Topic] = append(mb.topics[msg.Topic], msg)
+	}
+	return nil
+}
+
+func (mb *MemoryBroker) Last(topic string, key []byte) (*Message, error) {
+	mb.Lock()
+	defer mb.Unlock()
+	messages := mb.topics[topic]
+	for i := len(messages) - 1; i >= 0; i-- {
+		if bytes.Equal(messages[i].Key, key) {
+			msg := messages[i]
+			return &msg, nil
+		}
+	}
+	return nil, nil
+}
+
+// Messages returns the messages published to topic in order
+func (mb *MemoryBroker) Messages(topic string) []Message {
+	mb.Lock()
+	defer mb.Unlock()
+	return append([]Message(nil), mb.topics[topic]...)
+}
+
+// Topics returns the topics that have been published to sorted by name
+func (mb *MemoryBroker) Topics() []string {
+	mb.Lock()
+	defer mb.Unlock()
+	topics := make([]string, 0, len(mb.topics))
+	for topic := range mb.topics {
+		topics = append(topics, topic)
+	}
+	sort.Strings(topics)
+	return topics
+}
+
+func (mb *MemoryBroker) Ping() error {
+	return nil
+}
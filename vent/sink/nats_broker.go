@@ -0,0 +1,114 @@
+package sink
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// Header carrying the key of each message published by NATSBroker
+const NATSKeyHeader = "Vent-Key"
+
+// How long to wait for JetStream to acknowledge what has been published
+const NATSPublishTimeout = 30 * time.Second
+
+// NATSBroker publishes to a NATS JetStream stream. The subject of each message is its topic followed by the hex of its
+// key so that Last can ask JetStream for the last message of a subject, so subscribe to '<topic>.>' for every row of a
+// table. The key itself is sent in the Vent-Key header. Each message carries a JetStream message ID derived from its
+// subject and value so that republishing a block after a failure is deduplicated within the stream's duplicate window.
+type NATSBroker struct {
+	conn   *nats.Conn
+	js     nats.JetStreamContext
+	stream string
+}
+
+var _ Broker = &NATSBroker{}
+
+// NewNATSBroker connects to the NATS servers at url and creates the stream capturing subjects if it does not exist
+func NewNATSBroker(url, stream string, subjects ...string) (*NATSBroker, error) {
+	conn, err := nats.Connect(url, nats.Name("vent"))
+	if err != nil {
+		return nil, fmt.Errorf("could not connect to NATS at %s: %v", url, err)
+	}
+	js, err := conn.JetStream()
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not get JetStream context: %v", err)
+	}
+	_, err = js.StreamInfo(stream)
+	if err == nats.ErrStreamNotFound {
+		_, err = js.AddStream(&nats.StreamConfig{
+			Name:     stream,
+			Subjects: subjects,
+			Storage:  nats.FileStorage,
+		})
+	}
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("could not get or create JetStream stream %s: %v", stream, err)
+	}
+	return &NATSBroker{
+		conn:   conn,
+		js:     js,
+		stream: stream,
+	}, nil
+}
+
+// Publish publishes messages in order and waits for JetStream to store them. Messages published before a failure are
+// not withdrawn but will be deduplicated when they are published again.
+func (nb *NATSBroker) Publish(messages []Message) error {
+	futures := make([]nats.PubAckFuture, len(messages))
+	for i, msg := range messages {
+		natsMsg := nats.NewMsg(NATSSubject(msg.Topic, msg.Key))
+		natsMsg.Header.Set(NATSKeyHeader, string(msg.Key))
+		natsMsg.Data = msg.Value
+		id := sha256.Sum256(append([]byte(natsMsg.Subject+"\n"), msg.Value...))
+		var err error
+		futures[i], err = nb.js.PublishMsgAsync(natsMsg, nats.MsgId(hex.EncodeToString(id[:])))
+		if err != nil {
+			return fmt.Errorf("could not publish to %s: %v", natsMsg.Subject, err)
+		}
+	}
+	timeout := time.After(NATSPublishTimeout)
+	for _, future := range futures {
+		select {
+		case <-future.Ok():
+		case err := <-future.Err():
+			return fmt.Errorf("could not publish to %s: %v", future.Msg().Subject, err)
+		case <-timeout:
+			return fmt.Errorf("timed out after %v waiting for JetStream to acknowledge messages", NATSPublishTimeout)
+		}
+	}
+	return nil
+}
+
+func (nb *NATSBroker) Last(topic string, key []byte) (*Message, error) {
+	msg, err := nb.js.GetLastMsg(nb.stream, NATSSubject(topic, key))
+	if err == nats.ErrMsgNotFound {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &Message{Topic: topic, Key: key, Value: msg.Data}, nil
+}
+
+func (nb *NATSBroker) Ping() error {
+	if !nb.conn.IsConnected() {
+		return fmt.Errorf("not connected to NATS (status %v)", nb.conn.Status())
+	}
+	return nil
+}
+
+// Close closes the connection to NATS once everything published has been sent
+func (nb *NATSBroker) Close() error {
+	return nb.conn.Drain()
+}
+
+// NATSSubject returns the subject to which a message of topic with key is published
+func NATSSubject(topic string, key []byte) string {
+	return topic + "." + hex.EncodeToString(key)
+}
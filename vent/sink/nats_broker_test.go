@@ -0,0 +1,82 @@
+// +build integration,nats
+
+package sink
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"syscall"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/nats-io/nats.go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// Override with NATS_URL
+const DefaultNATSURL = nats.DefaultURL
+
+func TestNATSBroker(t *testing.T) {
+	url := DefaultNATSURL
+	if natsURL, ok := syscall.Getenv("NATS_URL"); ok {
+		t.Logf("Using NATS_URL '%s'", natsURL)
+		url = natsURL
+	}
+	stream := fmt.Sprintf("TEST_%d", rand.Int63())
+	prefix := stream + "."
+	broker, err := NewNATSBroker(url, stream, prefix+">")
+	require.NoError(t, err)
+	defer func() {
+		js, err := broker.conn.JetStream()
+		require.NoError(t, err)
+		require.NoError(t, js.DeleteStream(stream))
+		require.NoError(t, broker.Close())
+	}()
+	require.NoError(t, broker.Ping())
+
+	bs := NewBusSink(broker, prefix, logging.NewNoopLogger())
+	require.NoError(t, bs.Init(chainID, "1.0.0"))
+
+	height, err := bs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(0), height)
+
+	block := types.EventData{
+		BlockHeight: 7,
+		Tables: map[string]types.EventDataTable{
+			"tokens": {
+				{Action: types.ActionUpsert, RowData: map[string]interface{}{"owner": "bob", "name": "frog", "supply": 3}},
+				{Action: types.ActionDelete, RowData: map[string]interface{}{"owner": "bob", "name": "toad"}},
+			},
+		},
+	}
+	require.NoError(t, bs.SetBlock(chainID, tables, block))
+
+	height, err = bs.LastBlockHeight(chainID)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), height)
+
+	msg, err := broker.Last(prefix+"tokens", []byte(`{"name":"toad","owner":"bob"}`))
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+	row := new(RowMessage)
+	require.NoError(t, json.Unmarshal(msg.Value, row))
+	assert.Equal(t, types.ActionDelete, row.Action)
+	assert.Equal(t, uint64(7), row.Height)
+
+	msg, err = broker.Last(prefix+"tokens", []byte(`{"name":"newt","owner":"bob"}`))
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	// Publishing the same block again after a failure is deduplicated
+	info, err := broker.js.StreamInfo(stream)
+	require.NoError(t, err)
+	published := info.State.Msgs
+	require.NoError(t, bs.SetBlock(chainID, tables, block))
+	info, err = broker.js.StreamInfo(stream)
+	require.NoError(t, err)
+	assert.Equal(t, published, info.State.Msgs)
+}
@@ -0,0 +1,21 @@
+package sink
+
+import (
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Sink receives the rows that vent projects from each block. The consumer commits each block with SetBlock, which must
+// apply all of the block's rows and record its height atomically so that a block is never partially applied or applied
+// out of order when vent restarts from LastBlockHeight.
+type Sink interface {
+	// Init prepares the sink to receive blocks from chainID
+	Init(chainID, burrowVersion string) error
+	// Synchronize prepares the sink to receive rows for tables
+	Synchronize(chainID string, tables types.EventTables) error
+	// SetBlock applies the rows of a block and records its height
+	SetBlock(chainID string, tables types.EventTables, eventData types.EventData) error
+	// LastBlockHeight returns the height of the last block set for chainID
+	LastBlockHeight(chainID string) (uint64, error)
+	Ping() error
+	Close()
+}
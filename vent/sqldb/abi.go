@@ -0,0 +1,70 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// GetAbis returns the metadata recorded by SetAbi for each contract address of chainID
+func (db *SQLDB) GetAbis(chainID string) (map[crypto.Address]string, error) {
+	query := db.DB.Rebind(fmt.Sprintf("SELECT %s, %s FROM %s WHERE %s = ?",
+		db.DBAdapter.SecureName(db.Columns.Address), // select
+		db.DBAdapter.SecureName(db.Columns.Abi),
+		db.DBAdapter.SchemaName(db.Tables.Abi),      // from
+		db.DBAdapter.SecureName(db.Columns.ChainID), // where
+	))
+	rows, err := db.DB.Query(query, chainID)
+	if err != nil {
+		db.Log.InfoMsg("Error querying ABIs", "err", err, "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	abis := make(map[crypto.Address]string)
+	for rows.Next() {
+		var address, metadata string
+		if err = rows.Scan(&address, &metadata); err != nil {
+			db.Log.InfoMsg("Error scanning ABIs", "err", err)
+			return nil, err
+		}
+		addr, err := crypto.AddressFromHexString(address)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse address of recorded ABI: %v", err)
+		}
+		abis[addr] = metadata
+	}
+	return abis, rows.Err()
+}
+
+// SetAbi records the metadata holding the ABI of the contract at address on chainID, replacing any recorded before
+func (db *SQLDB) SetAbi(chainID string, address crypto.Address, metadata string) error {
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	query := tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?",
+		db.DBAdapter.SchemaName(db.Tables.Abi),      // from
+		db.DBAdapter.SecureName(db.Columns.ChainID), // where
+		db.DBAdapter.SecureName(db.Columns.Address),
+	))
+	if _, err = tx.Exec(query, chainID, address.String()); err != nil {
+		db.Log.InfoMsg("Error deleting ABI", "err", err, "query", query)
+		return err
+	}
+
+	query = tx.Rebind(fmt.Sprintf("INSERT INTO %s (%s, %s, %s) VALUES (?, ?, ?)",
+		db.DBAdapter.SchemaName(db.Tables.Abi), // insert
+		db.DBAdapter.SecureName(db.Columns.ChainID),
+		db.DBAdapter.SecureName(db.Columns.Address),
+		db.DBAdapter.SecureName(db.Columns.Abi),
+	))
+	if _, err = tx.Exec(query, chainID, address.String(), metadata); err != nil {
+		db.Log.InfoMsg("Error inserting ABI", "err", err, "query", query)
+		return err
+	}
+	return tx.Commit()
+}
@@ -2,6 +2,7 @@ package adapters
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/hyperledger/burrow/vent/types"
@@ -25,12 +26,19 @@ type DBAdapter interface {
 	FindTableQuery() string
 	// TableDefinitionQuery builds a SELECT query to get a table structure from the Dictionary table
 	TableDefinitionQuery() string
+	// CreateIndexQuery builds a CREATE INDEX query to index a column of a table, which fails with
+	// SQLErrorTypeDuplicatedIndex or does nothing if the index already exists
+	CreateIndexQuery(tableName string, column *types.SQLTableColumn) string
 	// AlterColumnQuery builds an ALTER COLUMN query to alter a table structure (only adding columns is supported)
 	AlterColumnQuery(tableName, columnName string, sqlColumnType types.SQLColumnType, length, order int) (string, string)
 	// SelectRowQuery builds a SELECT query to get row values
 	SelectRowQuery(tableName, fields, indexValue string) string
 	// SelectLogQuery builds a SELECT query to get all tables involved in a given block transaction
 	SelectLogQuery() string
+	// SelectChangesQuery builds a SELECT query to get the entries of the Log table with an id greater than the first
+	// parameter at a height greater than the second, then the chain ID and table name when selected by them, in the
+	// order they were logged up to a limit given by the last parameter
+	SelectChangesQuery(byChainID, byTableName bool) string
 	// InsertLogQuery builds an INSERT query to store data in Log table
 	InsertLogQuery() string
 	// UpsertQuery builds an INSERT... ON CONFLICT (or similar) query to upsert data in event tables based on PK
@@ -43,6 +51,11 @@ type DBAdapter interface {
 	CleanDBQueries() types.SQLCleanDBQuery
 	// DropTableQuery builds a DROP TABLE query to delete a table
 	DropTableQuery(tableName string) string
+	// RenameTableQuery builds a query to rename a table (and anything named after it that queries depend on)
+	RenameTableQuery(tableName, newName string) string
+	// ReplaceTablesQuery builds a query renaming each table named by the keys of replacements to replacedPrefix
+	// followed by its name and the table named by its value to its name, all at once where DDL cannot be rolled back
+	ReplaceTablesQuery(replacements map[string]string, replacedPrefix string) string
 	// Get the schema qualified name of the given table
 	SchemaName(tableName string) string
 }
@@ -52,8 +65,14 @@ type DBNotifyTriggerAdapter interface {
 	// of each column will be sent once whenever any of the columns changes. Expected to replace existing function.
 	CreateNotifyFunctionQuery(function, channel string, columns ...string) string
 	// Create a trigger that fires the named function after any operation on a row in table. Expected to replace existing
-	// trigger.
-	CreateTriggerQuery(triggerName, tableName, functionName string) string
+	// trigger. The queries are run in order, each may contain only one statement.
+	CreateTriggerQuery(triggerName, tableName, functionName string) ([]string, error)
+}
+
+type DBNotifyPruneAdapter interface {
+	// Delete the notifications written more than a number of seconds ago, given as the only parameter, from a database
+	// that stores notifications rather than sending them
+	PruneNotificationsQuery() string
 }
 
 // clean queries from tabs, spaces  and returns
@@ -65,3 +84,104 @@ func clean(parameter string) string {
 func Cleanf(format string, args ...interface{}) string {
 	return clean(fmt.Sprintf(format, args...))
 }
+
+// aggregateUpdate returns the expression for the new value of a column maintaining aggregate given the expressions
+// for its existing value and the value being upserted
+func aggregateUpdate(aggregate, existing, value string) string {
+	switch aggregate {
+	case types.AggregateSum, types.AggregateCount:
+		return Cleanf("COALESCE(%s, 0) + %s", existing, value)
+	case types.AggregateMin:
+		return Cleanf("CASE WHEN %[1]s IS NULL OR %[2]s < %[1]s THEN %[2]s ELSE %[1]s END", existing, value)
+	case types.AggregateMax:
+		return Cleanf("CASE WHEN %[1]s IS NULL OR %[2]s > %[1]s THEN %[2]s ELSE %[1]s END", existing, value)
+	}
+	return value
+}
+
+// positionColumns returns the columns (block height and the index of the row among those of the block in its table)
+// recording the position of the row that last upserted a row of table if table maintains aggregates and row has a
+// position, otherwise nil. Aggregates accumulate the value of each row so a row is only updated by a later one, making
+// replaying a block (on restart, or after a crash before its height was committed) idempotent.
+func positionColumns(table *types.SQLTable, row types.EventDataRow, columns types.SQLColumnNames) []string {
+	aggregate := false
+	for _, column := range table.Columns {
+		if column.Aggregate != "" {
+			aggregate = true
+			break
+		}
+	}
+	if !aggregate {
+		return nil
+	}
+	position := []string{columns.Height, columns.RowIndex}
+	for _, name := range position {
+		if table.GetColumn(name) == nil || row.RowData[name] == nil {
+			return nil
+		}
+	}
+	return position
+}
+
+// laterRow returns the condition that the row being upserted, whose position is given by the expressions value returns
+// for each of position, comes after the one recorded in the existing row, given by existing
+func laterRow(position []string, existing, value func(column string) string) string {
+	existingPosition := make([]string, len(position))
+	valuePosition := make([]string, len(position))
+	for i, column := range position {
+		existingPosition[i] = existing(column)
+		valuePosition[i] = value(column)
+	}
+	return Cleanf("(%s IS NULL OR (%s) < (%s))", existingPosition[0], strings.Join(existingPosition, ", "),
+		strings.Join(valuePosition, ", "))
+}
+
+// replaceTablesQuery returns the query for ReplaceTablesQuery for a database that can roll back DDL from the query
+// renaming a table
+func replaceTablesQuery(replacements map[string]string, replacedPrefix string,
+	rename func(tableName, newName string) string) string {
+
+	var query string
+	for _, tableName := range sortedKeys(replacements) {
+		query += rename(tableName, replacedPrefix+tableName) + " " + rename(replacements[tableName], tableName) + " "
+	}
+	return strings.TrimSpace(query)
+}
+
+// indexName names the index of a column after its table
+func indexName(tableName, columnName string) string {
+	return tableName + "_" + columnName + "_idx"
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// selectChangesQuery returns the query for SelectChangesQuery from the schema qualified name of the log table, the
+// type to cast its height (which it holds as a string) to for comparison, and the i-th (from 1) parameter placeholder
+func selectChangesQuery(names types.SQLNames, logTable, heightType string, param func(i int) string,
+	byChainID, byTableName bool) string {
+
+	columns := names.Columns
+	query := Cleanf("SELECT %s, %s, %s, %s, %s, %s, %s FROM %s WHERE %s > %s AND CAST(%s AS %s) > %s",
+		columns.Id, columns.ChainID, columns.TableName, columns.Height, // select
+		columns.TxHash, columns.Action, columns.DataRow,
+		logTable,             // from
+		columns.Id, param(1), // where
+		columns.Height, heightType, param(2))
+	n := 2
+	if byChainID {
+		n++
+		query += Cleanf(" AND %s = %s", columns.ChainID, param(n))
+	}
+	if byTableName {
+		n++
+		query += Cleanf(" AND %s = %s", columns.TableName, param(n))
+	}
+	return query + Cleanf(" ORDER BY %s LIMIT %s;", columns.Id, param(n+1))
+}
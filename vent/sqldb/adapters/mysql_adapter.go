@@ -0,0 +1,670 @@
+package adapters
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/jmoiron/sqlx"
+)
+
+// MySQL cannot declare a VARCHAR without a length or index LONGTEXT and LONGBLOB columns without a prefix length so
+// we give these a length when they need one
+const MySQLDefaultVarcharLength = 255
+
+// Table to which the triggers created by MySQLAdapter write notifications for consumers to poll
+const MySQLNotifyTable = "_vent_notify"
+
+// Columns of MySQLNotifyTable
+const (
+	MySQLNotifyIDColumn      = "_id"
+	MySQLNotifyChannelColumn = "_channel"
+	MySQLNotifyPayloadColumn = "_payload"
+	MySQLNotifyTimeColumn    = "_time"
+)
+
+// Postgres TEXT and BYTEA are unbounded so we use the LONG types here. JSON is stored as LONGTEXT (as MariaDB's JSON
+// is) since MySQL will not accept a JSON value from a binary parameter.
+var mysqlDataTypes = map[types.SQLColumnType]string{
+	types.SQLColumnTypeBool:      "BOOLEAN",
+	types.SQLColumnTypeByteA:     "LONGBLOB",
+	types.SQLColumnTypeInt:       "INTEGER",
+	types.SQLColumnTypeSerial:    "BIGINT UNSIGNED AUTO_INCREMENT",
+	types.SQLColumnTypeText:      "LONGTEXT",
+	types.SQLColumnTypeVarchar:   "VARCHAR",
+	types.SQLColumnTypeTimeStamp: "DATETIME",
+	types.SQLColumnTypeNumeric:   "DECIMAL",
+	types.SQLColumnTypeJSON:      "LONGTEXT",
+	types.SQLColumnTypeBigInt:    "BIGINT",
+}
+
+// MySQLAdapter implements DBAdapter for MySQL and MariaDB, Schema is the MySQL database in which vent's tables live
+type MySQLAdapter struct {
+	Schema string
+	types.SQLNames
+	Log *logging.Logger
+	// Channel and columns of each notify 'function' keyed by name, MySQL triggers cannot call a function with the row
+	// so we build the notification in the trigger itself
+	notifyFunctions map[string]mysqlNotifyFunction
+	sync.Mutex
+}
+
+type mysqlNotifyFunction struct {
+	channel string
+	columns []string
+}
+
+var _ DBAdapter = &MySQLAdapter{}
+var _ DBNotifyTriggerAdapter = &MySQLAdapter{}
+var _ DBNotifyPruneAdapter = &MySQLAdapter{}
+
+// NewMySQLAdapter constructs a new db adapter
+func NewMySQLAdapter(schema string, sqlNames types.SQLNames, log *logging.Logger) *MySQLAdapter {
+	return &MySQLAdapter{
+		Schema:          schema,
+		SQLNames:        sqlNames,
+		Log:             log,
+		notifyFunctions: make(map[string]mysqlNotifyFunction),
+	}
+}
+
+// Open connects to a database given a DSN like 'user:password@tcp(localhost:3306)/'
+func (ma *MySQLAdapter) Open(dbURL string) (*sqlx.DB, error) {
+	if ma.Schema == "" {
+		return nil, fmt.Errorf("no schema supplied")
+	}
+
+	cfg, err := mysql.ParseDSN(dbURL)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse MySQL DSN: %v", err)
+	}
+	// Report matched rather than changed rows so that setting an unchanged block height affects its row
+	cfg.ClientFoundRows = true
+
+	db, err := sqlx.Open("mysql", cfg.FormatDSN())
+	if err != nil {
+		ma.Log.InfoMsg("Error creating database connection", "err", err)
+		return nil, err
+	}
+
+	if err := db.Ping(); err != nil {
+		ma.Log.InfoMsg("Error opening database connection", "err", err)
+		return nil, err
+	}
+
+	query := Cleanf("CREATE DATABASE IF NOT EXISTS %s;", ma.SecureName(ma.Schema))
+	ma.Log.InfoMsg("CREATE SCHEMA", "query", query)
+	if _, err = db.Exec(query); err != nil {
+		ma.Log.InfoMsg("Error creating schema", "err", err)
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// TypeMapping convert generic dataTypes to database dependent dataTypes
+func (ma *MySQLAdapter) TypeMapping(sqlColumnType types.SQLColumnType) (string, error) {
+	if sqlDataType, ok := mysqlDataTypes[sqlColumnType]; ok {
+		return sqlDataType, nil
+	}
+
+	return "", fmt.Errorf("datatype %v not recognized", sqlColumnType)
+}
+
+// SecureColumnName return columns between appropriate security containers
+func (ma *MySQLAdapter) SecureName(name string) string {
+	return "`" + name + "`"
+}
+
+// CreateTableQuery builds query for creating a new table
+func (ma *MySQLAdapter) CreateTableQuery(tableName string, columns []*types.SQLTableColumn) (string, string) {
+	// build query
+	columnsDef := ""
+	primaryKey := ""
+	dictionaryValues := ""
+
+	for i, column := range columns {
+		secureColumn := ma.SecureName(column.Name)
+		pKey := 0
+
+		if columnsDef != "" {
+			columnsDef += ", "
+			dictionaryValues += ", "
+		}
+
+		columnsDef += Cleanf("%s %s", secureColumn, ma.columnType(column.Type, column.Length, column.Primary))
+
+		if column.Primary {
+			pKey = 1
+			columnsDef += " NOT NULL"
+			if primaryKey != "" {
+				primaryKey += ", "
+			}
+			primaryKey += secureColumn
+		}
+
+		dictionaryValues += Cleanf("('%s','%s',%d,%d,%d,%d)",
+			tableName,
+			column.Name,
+			column.Type,
+			column.Length,
+			pKey,
+			i)
+	}
+
+	query := Cleanf("CREATE TABLE %s (%s", ma.SchemaName(tableName), columnsDef)
+	if primaryKey != "" {
+		query += "," + Cleanf("PRIMARY KEY (%s)", primaryKey)
+	}
+	query += ");"
+
+	dictionaryQuery := Cleanf("INSERT INTO %s (%s,%s,%s,%s,%s,%s) VALUES %s;",
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName, ma.Columns.ColumnName,
+		ma.Columns.ColumnType, ma.Columns.ColumnLength,
+		ma.Columns.PrimaryKey, ma.Columns.ColumnOrder,
+		dictionaryValues)
+
+	return query, dictionaryQuery
+}
+
+// columnType gives the MySQL type of a column, with a length where MySQL requires one
+func (ma *MySQLAdapter) columnType(sqlColumnType types.SQLColumnType, length int, primary bool) string {
+	sqlType, _ := ma.TypeMapping(sqlColumnType)
+	if length == 0 {
+		switch sqlColumnType {
+		case types.SQLColumnTypeVarchar:
+			length = MySQLDefaultVarcharLength
+		case types.SQLColumnTypeText, types.SQLColumnTypeByteA:
+			if primary {
+				length = MySQLDefaultVarcharLength
+			}
+		}
+	}
+	switch {
+	case sqlColumnType == types.SQLColumnTypeText && length > 0:
+		return Cleanf("VARCHAR(%d)", length)
+	case sqlColumnType == types.SQLColumnTypeByteA && length > 0:
+		return Cleanf("VARBINARY(%d)", length)
+	case sqlColumnType == types.SQLColumnTypeNumeric && length == 0:
+		// Without a precision MySQL would give us DECIMAL(10), 65 digits is the most it allows
+		return Cleanf("%s(65)", sqlType)
+	case length > 0 && sqlColumnType != types.SQLColumnTypeJSON:
+		return Cleanf("%s(%d)", sqlType, length)
+	}
+	return sqlType
+}
+
+// CreateIndexQuery returns a query that indexes a column, MySQL has no CREATE INDEX IF NOT EXISTS
+func (ma *MySQLAdapter) CreateIndexQuery(tableName string, column *types.SQLTableColumn) string {
+	indexColumn := ma.SecureName(column.Name)
+	// MySQL can only index a prefix of the columns without a length
+	if strings.HasPrefix(ma.columnType(column.Type, column.Length, column.Primary), "LONG") {
+		indexColumn = Cleanf("%s(%d)", indexColumn, MySQLDefaultVarcharLength)
+	}
+	return Cleanf("CREATE INDEX %s ON %s (%s);",
+		ma.SecureName(indexName(tableName, column.Name)), ma.SchemaName(tableName), indexColumn)
+}
+
+// FindTableQuery returns a query that checks if a table exists
+func (ma *MySQLAdapter) FindTableQuery() string {
+	query := "SELECT COUNT(*) found FROM %s WHERE %s = ?;"
+
+	return Cleanf(query,
+		ma.SchemaName(ma.Tables.Dictionary), // from
+		ma.Columns.TableName)                // where
+}
+
+// TableDefinitionQuery returns a query with table structure
+func (ma *MySQLAdapter) TableDefinitionQuery() string {
+	query := `
+		SELECT
+			%s,%s,%s,%s
+		FROM
+			%s
+		WHERE
+			%s = ?
+		ORDER BY
+			%s;`
+
+	return Cleanf(query,
+		ma.Columns.ColumnName, ma.Columns.ColumnType, // select
+		ma.Columns.ColumnLength, ma.Columns.PrimaryKey, // select
+		ma.SchemaName(ma.Tables.Dictionary), // from
+		ma.Columns.TableName,                // where
+		ma.Columns.ColumnOrder)              // order by
+}
+
+// AlterColumnQuery returns a query for adding a new column to a table
+func (ma *MySQLAdapter) AlterColumnQuery(tableName, columnName string, sqlColumnType types.SQLColumnType, length, order int) (string, string) {
+	query := Cleanf("ALTER TABLE %s ADD COLUMN %s %s;",
+		ma.SchemaName(tableName),
+		ma.SecureName(columnName),
+		ma.columnType(sqlColumnType, length, false))
+
+	dictionaryQuery := Cleanf(`
+		INSERT INTO %s (%s,%s,%s,%s,%s,%s)
+		VALUES ('%s','%s',%d,%d,%d,%d);`,
+
+		ma.SchemaName(ma.Tables.Dictionary),
+
+		ma.Columns.TableName, ma.Columns.ColumnName,
+		ma.Columns.ColumnType, ma.Columns.ColumnLength,
+		ma.Columns.PrimaryKey, ma.Columns.ColumnOrder,
+
+		tableName, columnName, sqlColumnType, length, 0, order)
+
+	return query, dictionaryQuery
+}
+
+// SelectRowQuery returns a query for selecting row values
+func (ma *MySQLAdapter) SelectRowQuery(tableName, fields, indexValue string) string {
+	return Cleanf("SELECT %s FROM %s WHERE %s = '%s';",
+		fields,                        // select
+		ma.SchemaName(tableName),      // from
+		ma.Columns.Height, indexValue, // where
+	)
+}
+
+// SelectLogQuery returns a query for selecting all tables involved in a block trn
+func (ma *MySQLAdapter) SelectLogQuery() string {
+	query := `
+		SELECT DISTINCT %s,%s FROM %s l WHERE %s = ? AND %s = ?;`
+
+	return Cleanf(query,
+		ma.Columns.TableName, ma.Columns.EventName, // select
+		ma.SchemaName(ma.Tables.Log), // from
+		ma.Columns.Height,
+		ma.Columns.ChainID) // where
+}
+
+// SelectChangesQuery returns a query for selecting the log entries above a log id and height
+func (ma *MySQLAdapter) SelectChangesQuery(byChainID, byTableName bool) string {
+	return selectChangesQuery(ma.SQLNames, ma.SchemaName(ma.Tables.Log), "UNSIGNED",
+		func(i int) string { return "?" }, byChainID, byTableName)
+}
+
+// InsertLogQuery returns a query to insert a row in log table
+func (ma *MySQLAdapter) InsertLogQuery() string {
+	query := `
+		INSERT INTO %s (%s,%s,%s,%s,%s,%s,%s,%s,%s,%s,%s)
+		VALUES (CURRENT_TIMESTAMP, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);`
+
+	return Cleanf(query,
+		ma.SchemaName(ma.Tables.Log), // insert
+		//fields
+		ma.Columns.TimeStamp,
+		ma.Columns.ChainID, ma.Columns.TableName, ma.Columns.EventName, ma.Columns.EventFilter,
+		ma.Columns.Height, ma.Columns.TxHash, ma.Columns.Action, ma.Columns.DataRow,
+		ma.Columns.SqlStmt, ma.Columns.SqlValues)
+}
+
+// ErrorEquals verify if an error is of a given SQL type
+func (ma *MySQLAdapter) ErrorEquals(err error, sqlErrorType types.SQLErrorType) bool {
+	if err, ok := err.(*mysql.MySQLError); ok {
+		switch sqlErrorType {
+		case types.SQLErrorTypeGeneric:
+			return true
+		case types.SQLErrorTypeDuplicatedColumn:
+			// ER_DUP_FIELDNAME
+			return err.Number == 1060
+		case types.SQLErrorTypeDuplicatedTable:
+			// ER_TABLE_EXISTS_ERROR
+			return err.Number == 1050
+		case types.SQLErrorTypeDuplicatedSchema:
+			// ER_DB_CREATE_EXISTS
+			return err.Number == 1007
+		case types.SQLErrorTypeUndefinedTable:
+			// ER_NO_SUCH_TABLE, ER_BAD_TABLE_ERROR
+			return err.Number == 1146 || err.Number == 1051
+		case types.SQLErrorTypeUndefinedColumn:
+			// ER_BAD_FIELD_ERROR
+			return err.Number == 1054
+		case types.SQLErrorTypeDuplicatedIndex:
+			// ER_DUP_KEYNAME
+			return err.Number == 1061
+		case types.SQLErrorTypeInvalidType:
+			// ER_PARSE_ERROR - MySQL reports an unknown type as a syntax error
+			return err.Number == 1064
+		}
+	}
+
+	return false
+}
+
+func (ma *MySQLAdapter) UpsertQuery(table *types.SQLTable, row types.EventDataRow) (types.UpsertDeleteQuery, interface{}, error) {
+
+	pointers := make([]interface{}, 0)
+
+	columns := ""
+	insValues := ""
+	updValues := ""
+	pkColumns := ""
+	values := ""
+	var txHash interface{} = nil
+
+	// MySQL has no condition on the update, so each column is only updated by a later row, and since each assignment
+	// sees the columns assigned before it the position columns are assigned last starting from the least significant
+	later := ""
+	positionUpdates := make(map[string]string)
+	position := positionColumns(table, row, ma.Columns)
+	if position != nil {
+		later = laterRow(position, ma.SecureName, func(column string) string {
+			return Cleanf("VALUES(%s)", ma.SecureName(column))
+		})
+	}
+
+	// for each column in table
+	for _, column := range table.Columns {
+		secureColumn := ma.SecureName(column.Name)
+
+		// INSERT INTO TABLE (*columns).........
+		if columns != "" {
+			columns += ", "
+			insValues += ", "
+			values += ", "
+		}
+		columns += secureColumn
+		insValues += "?"
+
+		if column.Primary {
+			if pkColumns != "" {
+				pkColumns += ", "
+			}
+			pkColumns += secureColumn
+		}
+
+		// find data for column
+		if value, ok := row.RowData[column.Name]; ok {
+			// load hash value
+			if column.Name == ma.Columns.TxHash {
+				txHash = value
+			}
+
+			// column found (not null)
+			// load values
+			pointers = append(pointers, &value)
+			values += fmt.Sprint(value)
+
+			if !column.Primary {
+				// column is not PK
+				// add to update list
+				// INSERT........... ON DUPLICATE KEY UPDATE (*updValues)
+				update := aggregateUpdate(column.Aggregate, secureColumn, Cleanf("VALUES(%s)", secureColumn))
+				if later != "" {
+					update = Cleanf("IF(%s, %s, %s)", later, update, secureColumn)
+				}
+				if isPositionColumn(position, column.Name) {
+					positionUpdates[column.Name] = Cleanf("%s = %s", secureColumn, update)
+					continue
+				}
+				if updValues != "" {
+					updValues += ", "
+				}
+				updValues += Cleanf("%s = %s", secureColumn, update)
+			}
+		} else if column.Primary {
+			// column NOT found (is null) and is PK
+			return types.UpsertDeleteQuery{}, nil, fmt.Errorf("error null primary key for column %s", secureColumn)
+		} else {
+			// column NOT found (is null) and is NOT PK
+			pointers = append(pointers, nil)
+			values += "null"
+		}
+	}
+
+	for i := len(position) - 1; i >= 0; i-- {
+		if updValues != "" {
+			updValues += ", "
+		}
+		updValues += positionUpdates[position[i]]
+	}
+
+	query := Cleanf("INSERT INTO %s (%s) VALUES (%s) ", ma.SchemaName(table.Name), columns, insValues)
+
+	if updValues == "" {
+		// Nothing to update so we leave the existing row as it is, which MySQL has no DO NOTHING for
+		pk := strings.Split(pkColumns, ", ")[0]
+		updValues = Cleanf("%s = %s", pk, pk)
+	}
+	query += Cleanf("ON DUPLICATE KEY UPDATE %s", updValues)
+	query += ";"
+
+	return types.UpsertDeleteQuery{Query: query, Values: values, Pointers: pointers}, txHash, nil
+}
+
+func isPositionColumn(position []string, name string) bool {
+	for _, column := range position {
+		if column == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (ma *MySQLAdapter) DeleteQuery(table *types.SQLTable, row types.EventDataRow) (types.UpsertDeleteQuery, error) {
+
+	pointers := make([]interface{}, 0)
+	columns := ""
+	values := ""
+
+	// for each column in table
+	for _, column := range table.Columns {
+
+		//only PK for delete
+		if column.Primary {
+			secureColumn := ma.SecureName(column.Name)
+
+			// WHERE ..........
+			if columns != "" {
+				columns += " AND "
+				values += ", "
+			}
+
+			columns += Cleanf("%s = ?", secureColumn)
+
+			//find data for column
+			if value, ok := row.RowData[column.Name]; ok {
+				// column found (not null)
+				// load values
+				pointers = append(pointers, &value)
+				values += fmt.Sprint(value)
+
+			} else {
+				// column NOT found (is null) and is PK
+				return types.UpsertDeleteQuery{}, fmt.Errorf("error null primary key for column %s", secureColumn)
+			}
+		}
+	}
+
+	if columns == "" {
+		return types.UpsertDeleteQuery{}, fmt.Errorf("error primary key not found for deletion")
+	}
+
+	query := Cleanf("DELETE FROM %s WHERE %s;", ma.SchemaName(table.Name), columns)
+
+	return types.UpsertDeleteQuery{Query: query, Values: values, Pointers: pointers}, nil
+}
+
+func (ma *MySQLAdapter) RestoreDBQuery() string {
+	return Cleanf(`SELECT %s, %s, %s, %s, %s FROM %s
+								WHERE %s != '%s' AND  %s != '%s' AND DATE_FORMAT(%s,'%%Y-%%m-%%d %%H:%%i:%%s')<=?
+								ORDER BY %s;`,
+		ma.Columns.Id, ma.Columns.TableName, ma.Columns.Action, // select id, table, action
+		ma.Columns.SqlStmt, ma.Columns.SqlValues, // select stmt, values
+		ma.SchemaName(ma.Tables.Log),          // from
+		ma.Columns.TableName, ma.Tables.Block, // where not _vent_block
+		ma.Columns.TableName, ma.Tables.Tx, // where not _vent_tx
+		ma.Columns.TimeStamp, // where time
+		ma.Columns.Id)
+}
+
+func (ma *MySQLAdapter) CleanDBQueries() types.SQLCleanDBQuery {
+
+	// Chain info
+	selectChainIDQry := Cleanf(`
+		SELECT
+		COUNT(*) REGISTERS,
+		COALESCE(MAX(%s),'') CHAINID,
+		COALESCE(MAX(%s),'') BVERSION
+		FROM %s;`,
+		ma.Columns.ChainID, ma.Columns.BurrowVersion,
+		ma.SchemaName(ma.Tables.ChainInfo))
+
+	deleteChainIDQry := Cleanf(`
+		DELETE FROM %s;`,
+		ma.SchemaName(ma.Tables.ChainInfo))
+
+	insertChainIDQry := Cleanf(`
+		INSERT INTO %s (%s,%s,%s) VALUES(?,?,?)`,
+		ma.SchemaName(ma.Tables.ChainInfo),
+		ma.Columns.ChainID, ma.Columns.BurrowVersion, ma.Columns.Height)
+
+	// Dictionary
+	selectDictionaryQry := Cleanf(`
+		SELECT DISTINCT %s
+		FROM %s
+ 		WHERE %s
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
+		ma.Columns.TableName,
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName,
+		ma.Tables.Log, ma.Tables.Dictionary, ma.Tables.ChainInfo, ma.Tables.Spec, ma.Tables.Abi, ma.Tables.Backfill)
+
+	deleteDictionaryQry := Cleanf(`
+		DELETE FROM %s
+		WHERE %s
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
+		ma.SchemaName(ma.Tables.Dictionary),
+		ma.Columns.TableName,
+		ma.Tables.Log, ma.Tables.Dictionary, ma.Tables.ChainInfo, ma.Tables.Spec, ma.Tables.Abi, ma.Tables.Backfill)
+
+	// log
+	deleteLogQry := Cleanf(`
+		DELETE FROM %s;`,
+		ma.SchemaName(ma.Tables.Log))
+
+	return types.SQLCleanDBQuery{
+		SelectChainIDQry:    selectChainIDQry,
+		DeleteChainIDQry:    deleteChainIDQry,
+		InsertChainIDQry:    insertChainIDQry,
+		SelectDictionaryQry: selectDictionaryQry,
+		DeleteDictionaryQry: deleteDictionaryQry,
+		DeleteLogQry:        deleteLogQry,
+	}
+}
+
+func (ma *MySQLAdapter) DropTableQuery(tableName string) string {
+	// Dropping a table drops its triggers, MySQL does not have dependent views to cascade to
+	return Cleanf(`DROP TABLE IF EXISTS %s;`, ma.SchemaName(tableName))
+}
+
+func (ma *MySQLAdapter) RenameTableQuery(tableName, newName string) string {
+	return Cleanf(`RENAME TABLE %s TO %s;`, ma.SchemaName(tableName), ma.SchemaName(newName))
+}
+
+// ReplaceTablesQuery renames all of the tables in a single RENAME TABLE, which is atomic, since MySQL commits DDL
+// implicitly rather than as part of a transaction
+func (ma *MySQLAdapter) ReplaceTablesQuery(replacements map[string]string, replacedPrefix string) string {
+	renames := make([]string, 0, 2*len(replacements))
+	for _, tableName := range sortedKeys(replacements) {
+		renames = append(renames,
+			Cleanf("%s TO %s", ma.SchemaName(tableName), ma.SchemaName(replacedPrefix+tableName)),
+			Cleanf("%s TO %s", ma.SchemaName(replacements[tableName]), ma.SchemaName(tableName)))
+	}
+	return Cleanf(`RENAME TABLE %s;`, strings.Join(renames, ", "))
+}
+
+// CreateNotifyFunctionQuery returns a query creating MySQLNotifyTable, since MySQL cannot LISTEN/NOTIFY the triggers
+// created by CreateTriggerQuery for function insert a row into that table with channel and a JSON payload of columns.
+// Consumers poll it with NotificationsQuery.
+func (ma *MySQLAdapter) CreateNotifyFunctionQuery(function, channel string, columns ...string) string {
+	ma.Lock()
+	if ma.notifyFunctions == nil {
+		ma.notifyFunctions = make(map[string]mysqlNotifyFunction)
+	}
+	ma.notifyFunctions[function] = mysqlNotifyFunction{channel: channel, columns: columns}
+	ma.Unlock()
+
+	return Cleanf(`CREATE TABLE IF NOT EXISTS %s (
+			%s BIGINT UNSIGNED AUTO_INCREMENT NOT NULL,
+			%s VARCHAR(%d) NOT NULL,
+			%s JSON,
+			%s TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP,
+			PRIMARY KEY (%s),
+			KEY (%s));`,
+		ma.SchemaName(MySQLNotifyTable),
+		ma.SecureName(MySQLNotifyIDColumn),
+		ma.SecureName(MySQLNotifyChannelColumn), MySQLDefaultVarcharLength,
+		ma.SecureName(MySQLNotifyPayloadColumn),
+		ma.SecureName(MySQLNotifyTimeColumn),
+		ma.SecureName(MySQLNotifyIDColumn),
+		ma.SecureName(MySQLNotifyTimeColumn))
+}
+
+// CreateTriggerQuery returns the queries (re)creating insert, update, and delete triggers on table that write the
+// notifications of a function previously passed to CreateNotifyFunctionQuery
+func (ma *MySQLAdapter) CreateTriggerQuery(triggerName, tableName, functionName string) ([]string, error) {
+	ma.Lock()
+	notify, ok := ma.notifyFunctions[functionName]
+	ma.Unlock()
+	if !ok {
+		return nil, fmt.Errorf("cannot create trigger %s since notify function %s has not been created",
+			triggerName, functionName)
+	}
+
+	var queries []string
+	for _, op := range []string{"INSERT", "UPDATE", "DELETE"} {
+		record := "NEW"
+		if op == "DELETE" {
+			record = "OLD"
+		}
+		trigger := ma.SchemaName(triggerName + "_" + strings.ToLower(op))
+		payload := Cleanf("'%s', '%s'", ma.Columns.Action, op)
+		if len(notify.columns) > 0 {
+			payload += ", " + ma.jsonObjectArgs(record, notify.columns)
+		}
+		queries = append(queries,
+			Cleanf(`DROP TRIGGER IF EXISTS %s;`, trigger),
+			Cleanf(`CREATE TRIGGER %s AFTER %s ON %s
+			FOR EACH ROW
+			INSERT INTO %s (%s, %s) VALUES ('%s', JSON_OBJECT(%s));`,
+				trigger, op, ma.SchemaName(tableName), // create
+				ma.SchemaName(MySQLNotifyTable),                                                  // insert
+				ma.SecureName(MySQLNotifyChannelColumn), ma.SecureName(MySQLNotifyPayloadColumn), // columns
+				notify.channel, payload)) // values
+	}
+	return queries, nil
+}
+
+// PruneNotificationsQuery returns a query deleting the notifications written more than a number of seconds ago
+func (ma *MySQLAdapter) PruneNotificationsQuery() string {
+	return Cleanf("DELETE FROM %s WHERE %s < NOW() - INTERVAL ? SECOND;",
+		ma.SchemaName(MySQLNotifyTable), ma.SecureName(MySQLNotifyTimeColumn))
+}
+
+// NotificationsQuery returns a query for the id, channel and payload of the notifications after a given id
+func (ma *MySQLAdapter) NotificationsQuery() string {
+	return Cleanf("SELECT %s, %s, %s FROM %s WHERE %s > ? ORDER BY %s;",
+		ma.SecureName(MySQLNotifyIDColumn), ma.SecureName(MySQLNotifyChannelColumn),
+		ma.SecureName(MySQLNotifyPayloadColumn), // select
+		ma.SchemaName(MySQLNotifyTable),         // from
+		ma.SecureName(MySQLNotifyIDColumn),      // where
+		ma.SecureName(MySQLNotifyIDColumn))      // order by
+}
+
+func (ma *MySQLAdapter) SchemaName(tableName string) string {
+	return fmt.Sprintf("%s.%s", ma.SecureName(ma.Schema), ma.SecureName(tableName))
+}
+
+func (ma *MySQLAdapter) jsonObjectArgs(record string, columns []string) string {
+	elements := make([]string, len(columns))
+	for i, column := range columns {
+		elements[i] = "'" + column + "', " + record + "." + ma.SecureName(column)
+	}
+
+	return strings.Join(elements, ", ")
+}
@@ -0,0 +1,139 @@
+package adapters
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLAdapter_CreateTableQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	query, _ := ma.CreateTableQuery("tokens", []*types.SQLTableColumn{
+		{Name: "id", Type: types.SQLColumnTypeSerial, Primary: true},
+		{Name: "name", Type: types.SQLColumnTypeText, Primary: true},
+		{Name: "owner", Type: types.SQLColumnTypeVarchar, Length: 40},
+		{Name: "symbol", Type: types.SQLColumnTypeVarchar},
+		{Name: "supply", Type: types.SQLColumnTypeNumeric},
+		{Name: "data", Type: types.SQLColumnTypeByteA},
+	})
+	assert.Equal(t, "CREATE TABLE `vent`.`tokens` (`id` BIGINT UNSIGNED AUTO_INCREMENT NOT NULL, "+
+		"`name` VARCHAR(255) NOT NULL, `owner` VARCHAR(40), `symbol` VARCHAR(255), `supply` DECIMAL(65), "+
+		"`data` LONGBLOB,PRIMARY KEY (`id`, `name`));", query)
+}
+
+func TestMySQLAdapter_UpsertQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	table := &types.SQLTable{
+		Name: "tokens",
+		Columns: []*types.SQLTableColumn{
+			{Name: "name", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "owner", Type: types.SQLColumnTypeVarchar},
+			{Name: "supply", Type: types.SQLColumnTypeNumeric},
+		},
+	}
+
+	upsert, _, err := ma.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{
+		"name":  "frog",
+		"owner": "bob",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `vent`.`tokens` (`name`, `owner`, `supply`) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE `owner` = VALUES(`owner`);", upsert.Query)
+	assert.Len(t, upsert.Pointers, 3)
+
+	upsert, _, err = ma.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{"name": "frog"}})
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `vent`.`tokens` (`name`, `owner`, `supply`) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE `name` = `name`;", upsert.Query)
+
+	_, _, err = ma.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{"owner": "bob"}})
+	require.Error(t, err)
+}
+
+func TestMySQLAdapter_UpsertQueryAggregate(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	table := &types.SQLTable{
+		Name: "balances",
+		Columns: []*types.SQLTableColumn{
+			{Name: "holder", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "balance", Type: types.SQLColumnTypeNumeric, Aggregate: types.AggregateSum},
+			{Name: "largest", Type: types.SQLColumnTypeNumeric, Aggregate: types.AggregateMax},
+		},
+	}
+
+	upsert, _, err := ma.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{
+		"holder":  "bob",
+		"balance": "10",
+		"largest": "10",
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, "INSERT INTO `vent`.`balances` (`holder`, `balance`, `largest`) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE `balance` = COALESCE(`balance`, 0) + VALUES(`balance`), "+
+		"`largest` = CASE WHEN `largest` IS NULL OR VALUES(`largest`) > `largest` THEN VALUES(`largest`) "+
+		"ELSE `largest` END;", upsert.Query)
+
+	// Rows are only updated by rows after the one that last updated them
+	table = &types.SQLTable{
+		Name: "balances",
+		Columns: append(table.Columns,
+			&types.SQLTableColumn{Name: "_height", Type: types.SQLColumnTypeBigInt},
+			&types.SQLTableColumn{Name: "_rowindex", Type: types.SQLColumnTypeBigInt}),
+	}
+	upsert, _, err = ma.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{
+		"holder":    "bob",
+		"balance":   "10",
+		"largest":   "10",
+		"_height":   "7",
+		"_rowindex": 2,
+	}})
+	require.NoError(t, err)
+	later := "(`_height` IS NULL OR (`_height`, `_rowindex`) < (VALUES(`_height`), VALUES(`_rowindex`)))"
+	assert.Equal(t, "INSERT INTO `vent`.`balances` (`holder`, `balance`, `largest`, `_height`, `_rowindex`) "+
+		"VALUES (?, ?, ?, ?, ?) ON DUPLICATE KEY UPDATE "+
+		"`balance` = IF("+later+", COALESCE(`balance`, 0) + VALUES(`balance`), `balance`), "+
+		"`largest` = IF("+later+", CASE WHEN `largest` IS NULL OR VALUES(`largest`) > `largest` "+
+		"THEN VALUES(`largest`) ELSE `largest` END, `largest`), "+
+		"`_rowindex` = IF("+later+", VALUES(`_rowindex`), `_rowindex`), "+
+		"`_height` = IF("+later+", VALUES(`_height`), `_height`);", upsert.Query)
+}
+
+func TestMySQLAdapter_ReplaceTablesQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	// A single statement since MySQL cannot roll back renaming tables
+	assert.Equal(t, "RENAME TABLE `vent`.`animals` TO `vent`.`_old_animals`, `vent`.`_new_animals` TO `vent`.`animals`, "+
+		"`vent`.`plants` TO `vent`.`_old_plants`, `vent`.`_new_plants` TO `vent`.`plants`;",
+		ma.ReplaceTablesQuery(map[string]string{"plants": "_new_plants", "animals": "_new_animals"}, "_old_"))
+}
+
+func TestMySQLAdapter_CreateIndexQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	assert.Equal(t, "CREATE INDEX `transfers_holder_idx` ON `vent`.`transfers` (`holder`);",
+		ma.CreateIndexQuery("transfers", &types.SQLTableColumn{Name: "holder", Type: types.SQLColumnTypeVarchar}))
+	// Only a prefix of a column without a length can be indexed
+	assert.Equal(t, "CREATE INDEX `transfers_memo_idx` ON `vent`.`transfers` (`memo`(255));",
+		ma.CreateIndexQuery("transfers", &types.SQLTableColumn{Name: "memo", Type: types.SQLColumnTypeText}))
+}
+
+func TestMySQLAdapter_CreateTriggerQuery(t *testing.T) {
+	ma := NewMySQLAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	// Triggers need the notify function for their payload
+	_, err := ma.CreateTriggerQuery("trigger", "tokens", "notify")
+	require.Error(t, err)
+
+	ma.CreateNotifyFunctionQuery("notify", "supply", "name", "supply")
+	queries, err := ma.CreateTriggerQuery("trigger", "tokens", "notify")
+	require.NoError(t, err)
+	// A drop and a create for each of insert, update, and delete, one statement to a query
+	require.Len(t, queries, 6)
+	assert.Equal(t, "DROP TRIGGER IF EXISTS `vent`.`trigger_insert`;", queries[0])
+	assert.Contains(t, queries[1], "CREATE TRIGGER `vent`.`trigger_insert` AFTER INSERT ON `vent`.`tokens`")
+	assert.Contains(t, queries[3], "JSON_OBJECT('_action', 'UPDATE', 'name', NEW.`name`, 'supply', NEW.`supply`)")
+	assert.Contains(t, queries[5], "JSON_OBJECT('_action', 'DELETE', 'name', OLD.`name`, 'supply', OLD.`supply`)")
+	for _, query := range queries {
+		assert.Equal(t, 1, strings.Count(query, ";"))
+	}
+}
@@ -165,6 +165,12 @@ func (pa *PostgresAdapter) CreateTableQuery(tableName string, columns []*types.S
 	return query, dictionaryQuery
 }
 
+// CreateIndexQuery returns a query that indexes a column unless it is already indexed
+func (pa *PostgresAdapter) CreateIndexQuery(tableName string, column *types.SQLTableColumn) string {
+	return Cleanf("CREATE INDEX IF NOT EXISTS %s ON %s (%s);",
+		pa.SecureName(indexName(tableName, column.Name)), pa.SchemaName(tableName), pa.SecureName(column.Name))
+}
+
 // FindTableQuery returns a query that checks if a table exists
 func (pa *PostgresAdapter) FindTableQuery() string {
 	query := "SELECT COUNT(*) found FROM %s.%s WHERE %s = $1;"
@@ -244,6 +250,12 @@ func (pa *PostgresAdapter) SelectLogQuery() string {
 		pa.Columns.ChainID) // where
 }
 
+// SelectChangesQuery returns a query for selecting the log entries above a log id and height
+func (pa *PostgresAdapter) SelectChangesQuery(byChainID, byTableName bool) string {
+	return selectChangesQuery(pa.SQLNames, pa.SchemaName(pa.Tables.Log), "NUMERIC",
+		func(i int) string { return fmt.Sprintf("$%d", i) }, byChainID, byTableName)
+}
+
 // InsertLogQuery returns a query to insert a row in log table
 func (pa *PostgresAdapter) InsertLogQuery() string {
 	query := `
@@ -271,7 +283,7 @@ func errorEquals(err error, sqlErrorType types.SQLErrorType) bool {
 			return true
 		case types.SQLErrorTypeDuplicatedColumn:
 			return err.Code == "42701"
-		case types.SQLErrorTypeDuplicatedTable:
+		case types.SQLErrorTypeDuplicatedTable, types.SQLErrorTypeDuplicatedIndex:
 			return err.Code == "42P07"
 		case types.SQLErrorTypeDuplicatedSchema:
 			return err.Code == "42P06"
@@ -333,7 +345,12 @@ func (pa *PostgresAdapter) UpsertQuery(table *types.SQLTable, row types.EventDat
 				if updValues != "" {
 					updValues += ", "
 				}
-				updValues += secureColumn + " = $" + Cleanf("%d", i)
+				if column.Aggregate != "" {
+					updValues += secureColumn + " = " + aggregateUpdate(column.Aggregate,
+						pa.SecureName(table.Name)+"."+secureColumn, "EXCLUDED."+secureColumn)
+				} else {
+					updValues += secureColumn + " = $" + Cleanf("%d", i)
+				}
 			}
 		} else if column.Primary {
 			// column NOT found (is null) and is PK
@@ -351,6 +368,13 @@ func (pa *PostgresAdapter) UpsertQuery(table *types.SQLTable, row types.EventDat
 
 	if updValues != "" {
 		query += Cleanf("ON CONFLICT ON CONSTRAINT %s_pkey DO UPDATE SET %s", table.Name, updValues)
+		if position := positionColumns(table, row, pa.Columns); position != nil {
+			query += " WHERE " + laterRow(position, func(column string) string {
+				return pa.SecureName(table.Name) + "." + pa.SecureName(column)
+			}, func(column string) string {
+				return "EXCLUDED." + pa.SecureName(column)
+			})
+		}
 	} else {
 		query += Cleanf("ON CONFLICT ON CONSTRAINT %s_pkey DO NOTHING", table.Name)
 	}
@@ -445,19 +469,19 @@ func (pa *PostgresAdapter) CleanDBQueries() types.SQLCleanDBQuery {
 		SELECT DISTINCT %s 
 		FROM %s.%s 
  		WHERE %s
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
 		pa.Columns.TableName,
 		pa.Schema, pa.Tables.Dictionary,
 		pa.Columns.TableName,
-		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo)
+		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo, pa.Tables.Spec, pa.Tables.Abi, pa.Tables.Backfill)
 
 	deleteDictionaryQry := Cleanf(`
 		DELETE FROM %s.%s 
 		WHERE %s 
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
 		pa.Schema, pa.Tables.Dictionary,
 		pa.Columns.TableName,
-		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo)
+		pa.Tables.Log, pa.Tables.Dictionary, pa.Tables.ChainInfo, pa.Tables.Spec, pa.Tables.Abi, pa.Tables.Backfill)
 
 	// log
 	deleteLogQry := Cleanf(`
@@ -481,6 +505,19 @@ func (pa *PostgresAdapter) DropTableQuery(tableName string) string {
 	return Cleanf(`DROP TABLE IF EXISTS %s CASCADE;`, pa.SchemaName(tableName))
 }
 
+func (pa *PostgresAdapter) RenameTableQuery(tableName, newName string) string {
+	// Upserts name the primary key constraint after the table so rename it (through its index) too
+	return Cleanf(`ALTER TABLE %s RENAME TO %s;
+		ALTER INDEX IF EXISTS %s.%s_pkey RENAME TO %s_pkey;`,
+		pa.SchemaName(tableName), pa.SecureName(newName), // table
+		pa.Schema, tableName, newName, // primary key
+	)
+}
+
+func (pa *PostgresAdapter) ReplaceTablesQuery(replacements map[string]string, replacedPrefix string) string {
+	return replaceTablesQuery(replacements, replacedPrefix, pa.RenameTableQuery)
+}
+
 func (pa *PostgresAdapter) CreateNotifyFunctionQuery(function, channel string, columns ...string) string {
 	return Cleanf(`CREATE OR REPLACE FUNCTION %s() RETURNS trigger AS
 		$trigger$
@@ -503,20 +540,20 @@ func (pa *PostgresAdapter) CreateNotifyFunctionQuery(function, channel string, c
 	)
 }
 
-func (pa *PostgresAdapter) CreateTriggerQuery(triggerName, tableName, functionName string) string {
+func (pa *PostgresAdapter) CreateTriggerQuery(triggerName, tableName, functionName string) ([]string, error) {
 	trigger := pa.SecureName(triggerName)
 	table := pa.SchemaName(tableName)
-	return Cleanf(`DROP TRIGGER IF EXISTS %s ON %s CASCADE; 
-		CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s
+	return []string{
+		Cleanf(`DROP TRIGGER IF EXISTS %s ON %s CASCADE;`, trigger, table),
+		Cleanf(`CREATE TRIGGER %s AFTER INSERT OR UPDATE OR DELETE ON %s
 		FOR EACH ROW 
 		EXECUTE PROCEDURE %s();
 		`,
-		trigger,                     // drop
-		table,                       // on
-		trigger,                     // create
-		table,                       // on
-		pa.SchemaName(functionName), // function
-	)
+			trigger,                     // create
+			table,                       // on
+			pa.SchemaName(functionName), // function
+		),
+	}, nil
 }
 
 func (pa *PostgresAdapter) SchemaName(tableName string) string {
@@ -3,10 +3,72 @@ package adapters
 import (
 	"testing"
 
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestPostgresAdapter_CreateTriggerQuery(t *testing.T) {
 	assert.Equal(t, `'Address', NEW."Address", 'Name', NEW."Name", 'Index', NEW."Index"`,
 		jsonBuildObjectArgs("NEW", []string{"Address", "Name", "Index"}))
 }
+
+func TestPostgresAdapter_CreateIndexQuery(t *testing.T) {
+	pa := NewPostgresAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	assert.Equal(t, `CREATE INDEX IF NOT EXISTS "transfers_holder_idx" ON vent."transfers" ("holder");`,
+		pa.CreateIndexQuery("transfers", &types.SQLTableColumn{Name: "holder", Type: types.SQLColumnTypeVarchar}))
+}
+
+func TestPostgresAdapter_UpsertQueryAggregate(t *testing.T) {
+	pa := NewPostgresAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	table := &types.SQLTable{
+		Name: "balances",
+		Columns: []*types.SQLTableColumn{
+			{Name: "holder", Type: types.SQLColumnTypeVarchar, Primary: true},
+			{Name: "balance", Type: types.SQLColumnTypeNumeric, Aggregate: types.AggregateSum},
+			{Name: "transfers", Type: types.SQLColumnTypeInt, Aggregate: types.AggregateCount},
+		},
+	}
+
+	upsert, _, err := pa.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{
+		"holder":    "bob",
+		"balance":   "10",
+		"transfers": 1,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO vent."balances" ("holder", "balance", "transfers") VALUES ($1, $2, $3) `+
+		`ON CONFLICT ON CONSTRAINT balances_pkey DO UPDATE SET `+
+		`"balance" = COALESCE("balances"."balance", 0) + EXCLUDED."balance", `+
+		`"transfers" = COALESCE("balances"."transfers", 0) + EXCLUDED."transfers";`, upsert.Query)
+
+	// Rows are only updated by rows after the one that last updated them
+	table = &types.SQLTable{
+		Name: "balances",
+		Columns: append(table.Columns,
+			&types.SQLTableColumn{Name: "_height", Type: types.SQLColumnTypeBigInt},
+			&types.SQLTableColumn{Name: "_rowindex", Type: types.SQLColumnTypeBigInt}),
+	}
+	upsert, _, err = pa.UpsertQuery(table, types.EventDataRow{RowData: map[string]interface{}{
+		"holder":    "bob",
+		"balance":   "10",
+		"transfers": 1,
+		"_height":   "7",
+		"_rowindex": 2,
+	}})
+	require.NoError(t, err)
+	assert.Equal(t, `INSERT INTO vent."balances" ("holder", "balance", "transfers", "_height", "_rowindex") `+
+		`VALUES ($1, $2, $3, $4, $5) ON CONFLICT ON CONSTRAINT balances_pkey DO UPDATE SET `+
+		`"balance" = COALESCE("balances"."balance", 0) + EXCLUDED."balance", `+
+		`"transfers" = COALESCE("balances"."transfers", 0) + EXCLUDED."transfers", `+
+		`"_height" = $4, "_rowindex" = $5 WHERE ("balances"."_height" IS NULL OR `+
+		`("balances"."_height", "balances"."_rowindex") < (EXCLUDED."_height", EXCLUDED."_rowindex"));`,
+		upsert.Query)
+}
+
+func TestPostgresAdapter_SelectChangesQuery(t *testing.T) {
+	pa := NewPostgresAdapter("vent", types.DefaultSQLNames, logging.NewNoopLogger())
+	assert.Equal(t, `SELECT _id, _chainid, _tablename, _height, _txhash, _action, _datarow FROM vent."_vent_log" `+
+		`WHERE _id > $1 AND CAST(_height AS NUMERIC) > $2 AND _tablename = $3 ORDER BY _id LIMIT $4;`,
+		pa.SelectChangesQuery(false, true))
+}
@@ -163,6 +163,12 @@ func (sla *SQLiteAdapter) TableDefinitionQuery() string {
 		sla.Columns.ColumnOrder) // order by
 }
 
+// CreateIndexQuery returns a query that indexes a column unless it is already indexed
+func (sla *SQLiteAdapter) CreateIndexQuery(tableName string, column *types.SQLTableColumn) string {
+	return Cleanf("CREATE INDEX IF NOT EXISTS %s ON %s (%s);",
+		sla.SecureName(indexName(tableName, column.Name)), sla.SecureName(tableName), sla.SecureName(column.Name))
+}
+
 // AlterColumnQuery returns a query for adding a new column to a table
 func (sla *SQLiteAdapter) AlterColumnQuery(tableName, columnName string, sqlColumnType types.SQLColumnType, length, order int) (string, string) {
 	sqlType, _ := sla.TypeMapping(sqlColumnType)
@@ -207,6 +213,12 @@ func (sla *SQLiteAdapter) SelectLogQuery() string {
 		sla.Columns.ChainID)
 }
 
+// SelectChangesQuery returns a query for selecting the log entries above a log id and height
+func (sla *SQLiteAdapter) SelectChangesQuery(byChainID, byTableName bool) string {
+	return selectChangesQuery(sla.SQLNames, sla.Tables.Log, "INTEGER",
+		func(i int) string { return fmt.Sprintf("$%d", i) }, byChainID, byTableName)
+}
+
 // InsertLogQuery returns a query to insert a row in log table
 func (sla *SQLiteAdapter) InsertLogQuery() string {
 	query := `
@@ -235,6 +247,8 @@ func (sla *SQLiteAdapter) ErrorEquals(err error, sqlErrorType types.SQLErrorType
 			return err.Code == 1 && strings.Contains(errDescription, "duplicate column")
 		case types.SQLErrorTypeDuplicatedTable:
 			return err.Code == 1 && strings.Contains(errDescription, "table") && strings.Contains(errDescription, "already exists")
+		case types.SQLErrorTypeDuplicatedIndex:
+			return err.Code == 1 && strings.Contains(errDescription, "index") && strings.Contains(errDescription, "already exists")
 		case types.SQLErrorTypeUndefinedTable:
 			return err.Code == 1 && strings.Contains(errDescription, "no such table")
 		case types.SQLErrorTypeUndefinedColumn:
@@ -293,7 +307,12 @@ func (sla *SQLiteAdapter) UpsertQuery(table *types.SQLTable, row types.EventData
 				if updValues != "" {
 					updValues += ", "
 				}
-				updValues += secureColumn + " = $" + Cleanf("%d", i)
+				if column.Aggregate != "" {
+					updValues += secureColumn + " = " + aggregateUpdate(column.Aggregate, secureColumn,
+						"excluded."+secureColumn)
+				} else {
+					updValues += secureColumn + " = $" + Cleanf("%d", i)
+				}
 			}
 		} else if column.Primary {
 			// column NOT found (is null) and is PK
@@ -319,6 +338,11 @@ func (sla *SQLiteAdapter) UpsertQuery(table *types.SQLTable, row types.EventData
 	if pkColumns != "" {
 		if updValues != "" {
 			query += Cleanf("ON CONFLICT (%s) DO UPDATE SET %s", pkColumns, updValues)
+			if position := positionColumns(table, row, sla.Columns); position != nil {
+				query += " WHERE " + laterRow(position, sla.SecureName, func(column string) string {
+					return "excluded." + sla.SecureName(column)
+				})
+			}
 		} else {
 			query += Cleanf("ON CONFLICT (%s) DO NOTHING", pkColumns)
 		}
@@ -419,19 +443,19 @@ func (sla *SQLiteAdapter) CleanDBQueries() types.SQLCleanDBQuery {
 		SELECT DISTINCT %s 
 		FROM %s 
  		WHERE %s
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
 		sla.Columns.TableName,
 		sla.Tables.Dictionary,
 		sla.Columns.TableName,
-		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo)
+		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo, sla.Tables.Spec, sla.Tables.Abi, sla.Tables.Backfill)
 
 	deleteDictionaryQry := Cleanf(`
 		DELETE FROM %s 
 		WHERE %s 
-		NOT IN ('%s','%s','%s');`,
+		NOT IN ('%s','%s','%s','%s','%s','%s');`,
 		sla.Tables.Dictionary,
 		sla.Columns.TableName,
-		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo)
+		sla.Tables.Log, sla.Tables.Dictionary, sla.Tables.ChainInfo, sla.Tables.Spec, sla.Tables.Abi, sla.Tables.Backfill)
 
 	// log
 	deleteLogQry := Cleanf(`
@@ -453,6 +477,14 @@ func (sla *SQLiteAdapter) DropTableQuery(tableName string) string {
 	return Cleanf(`DROP TABLE IF EXISTS %s;`, sla.SecureName(tableName))
 }
 
+func (sla *SQLiteAdapter) RenameTableQuery(tableName, newName string) string {
+	return Cleanf(`ALTER TABLE %s RENAME TO %s;`, sla.SecureName(tableName), sla.SecureName(newName))
+}
+
+func (sla *SQLiteAdapter) ReplaceTablesQuery(replacements map[string]string, replacedPrefix string) string {
+	return replaceTablesQuery(replacements, replacedPrefix, sla.RenameTableQuery)
+}
+
 func (sla *SQLiteAdapter) SchemaName(tableName string) string {
 	return secureName(tableName)
 }
@@ -50,6 +50,10 @@ func (*SQLiteAdapter) TableDefinitionQuery() string {
 	panic("implement me")
 }
 
+func (*SQLiteAdapter) CreateIndexQuery(tableName string, column *types.SQLTableColumn) string {
+	panic("implement me")
+}
+
 func (*SQLiteAdapter) AlterColumnQuery(tableName, columnName string, sqlColumnType types.SQLColumnType, length, order int) (string, string) {
 	panic("implement me")
 }
@@ -62,6 +66,10 @@ func (*SQLiteAdapter) SelectLogQuery() string {
 	panic("implement me")
 }
 
+func (*SQLiteAdapter) SelectChangesQuery(byChainID, byTableName bool) string {
+	panic("implement me")
+}
+
 func (*SQLiteAdapter) InsertLogQuery() string {
 	panic("implement me")
 }
@@ -86,6 +94,14 @@ func (*SQLiteAdapter) DropTableQuery(tableName string) string {
 	panic("implement me")
 }
 
+func (*SQLiteAdapter) RenameTableQuery(tableName, newName string) string {
+	panic("implement me")
+}
+
+func (*SQLiteAdapter) ReplaceTablesQuery(replacements map[string]string, replacedPrefix string) string {
+	panic("implement me")
+}
+
 func (*SQLiteAdapter) SchemaName(tableName string) string {
 	panic("implement me")
 }
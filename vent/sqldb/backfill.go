@@ -0,0 +1,403 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/jmoiron/sqlx"
+)
+
+// TableSpecs returns the spec recorded by SetTableSpecs for each table
+func (db *SQLDB) TableSpecs() (map[string]string, error) {
+	query := fmt.Sprintf("SELECT %s, %s FROM %s",
+		db.DBAdapter.SecureName(db.Columns.TableName), // select
+		db.DBAdapter.SecureName(db.Columns.Spec),
+		db.DBAdapter.SchemaName(db.Tables.Spec), // from
+	)
+	rows, err := db.DB.Query(query)
+	if err != nil {
+		db.Log.InfoMsg("Error querying specs", "err", err, "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	specs := make(map[string]string)
+	for rows.Next() {
+		var tableName, spec string
+		if err = rows.Scan(&tableName, &spec); err != nil {
+			db.Log.InfoMsg("Error scanning specs", "err", err)
+			return nil, err
+		}
+		specs[tableName] = spec
+	}
+	return specs, rows.Err()
+}
+
+// SetTableSpecs records the spec of the event classes projected into each table, replacing any recorded before
+func (db *SQLDB) SetTableSpecs(specs map[string]string) error {
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = db.setTableSpecs(tx, specs)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// DropTable drops a table along with its dictionary and log entries
+func (db *SQLDB) DropTable(tableName string) error {
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = db.dropTable(tx, tableName)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// BackfillProgress returns the spec of the event classes being backfilled into tableName and the height of the last
+// block backfilled into it from each chain, or no spec if tableName does not exist or has no progress recorded
+func (db *SQLDB) BackfillProgress(tableName string) (string, map[string]uint64, error) {
+	found, err := db.findTable(tableName)
+	if err != nil || !found {
+		return "", nil, err
+	}
+	return db.backfillProgress(db.DB, tableName)
+}
+
+// CutOver replaces each table named by the keys of replacements with the table named by its value, carrying over the
+// replacement's dictionary and log entries, and records specs. The replaced tables are renamed with ReplacedPrefix
+// together with the replacements and dropped once everything else has been committed, so where renaming cannot be
+// rolled back (MySQL) an interrupted cut over can be finished by RecoverCutOver. It then creates the notification
+// triggers of the replaced tables from tables.
+func (db *SQLDB) CutOver(replacements map[string]string, tables types.EventTables, specs map[string]string) error {
+	db.Log.InfoMsg("Cutting over tables", "replacements", replacements)
+
+	// Tables new to the projection have nothing to replace
+	existing := make(map[string]string)
+	for tableName, replacement := range replacements {
+		found, err := db.tableExists(tableName)
+		if err != nil {
+			return err
+		}
+		if found {
+			existing[tableName] = replacement
+		}
+	}
+
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	// MySQL commits before and after DDL so the tables must be renamed first
+	for tableName, replacement := range replacements {
+		if _, ok := existing[tableName]; !ok {
+			err = db.renameTable(tx, db.DBAdapter.RenameTableQuery(replacement, tableName))
+			if err != nil {
+				return err
+			}
+		}
+	}
+	if len(existing) > 0 {
+		err = db.renameTable(tx, db.DBAdapter.ReplaceTablesQuery(existing, db.Tables.ReplacedPrefix))
+		if err != nil {
+			return err
+		}
+	}
+
+	for tableName, replacement := range replacements {
+		err = db.replaceEntries(tx, tableName, replacement)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = db.setTableSpecs(tx, specs)
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		db.Log.InfoMsg("Error on commit", "err", err)
+		return err
+	}
+
+	for tableName := range replacements {
+		if _, ok := existing[tableName]; ok {
+			err = db.dropReplaced(tableName)
+			if err != nil {
+				return err
+			}
+		}
+		if table, ok := tables[tableName]; ok {
+			err = db.createTableTriggers(table)
+			if err != nil {
+				return fmt.Errorf("could not create table notification triggers: %v", err)
+			}
+			err = db.createTableIndexes(table)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// RecoverCutOver finishes any cut over of the named tables that was interrupted after the tables were renamed, which
+// can only happen where renaming cannot be rolled back. A replacement that has been renamed but still has its progress
+// recorded has its dictionary and log entries carried over to the table, which is recorded as projected from the spec
+// it was backfilled from, and any replaced table left behind is dropped.
+func (db *SQLDB) RecoverCutOver(tableNames []string) error {
+	for _, tableName := range tableNames {
+		replacement := db.Tables.BackfillPrefix + tableName
+		spec, _, err := db.backfillProgress(db.DB, replacement)
+		if err != nil {
+			return err
+		}
+		if spec != "" {
+			renamed, err := db.renamed(replacement, tableName)
+			if err != nil {
+				return err
+			}
+			if renamed {
+				db.Log.InfoMsg("Recovering interrupted cut over", "table_name", tableName)
+				err = db.recoverEntries(tableName, replacement, spec)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		// Replaced tables have no dictionary entries to find them by
+		found, err := db.tableExists(db.Tables.ReplacedPrefix + tableName)
+		if err != nil {
+			return err
+		}
+		if found {
+			err = db.dropReplaced(tableName)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// renamed checks whether tableName has been renamed to newName
+func (db *SQLDB) renamed(tableName, newName string) (bool, error) {
+	found, err := db.tableExists(tableName)
+	if err != nil || found {
+		return false, err
+	}
+	return db.tableExists(newName)
+}
+
+// tableExists checks whether tableName exists in the database regardless of the dictionary
+func (db *SQLDB) tableExists(tableName string) (bool, error) {
+	query := fmt.Sprintf("SELECT 1 FROM %s WHERE 1 = 0", db.DBAdapter.SchemaName(tableName))
+	rows, err := db.DB.Query(query)
+	if err != nil {
+		if db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+			return false, nil
+		}
+		db.Log.InfoMsg("Error finding table", "err", err, "query", query)
+		return false, err
+	}
+	return true, rows.Close()
+}
+
+func (db *SQLDB) recoverEntries(tableName, replacement, spec string) error {
+	specs, err := db.TableSpecs()
+	if err != nil {
+		return err
+	}
+	specs[tableName] = spec
+
+	tx, err := db.DB.Beginx()
+	if err != nil {
+		db.Log.InfoMsg("Error beginning transaction", "err", err)
+		return err
+	}
+	defer tx.Rollback()
+
+	err = db.replaceEntries(tx, tableName, replacement)
+	if err != nil {
+		return err
+	}
+	err = db.setTableSpecs(tx, specs)
+	if err != nil {
+		return err
+	}
+	return tx.Commit()
+}
+
+// replaceEntries replaces the dictionary and log entries of tableName with those of replacement and deletes the
+// progress recorded for replacement
+func (db *SQLDB) replaceEntries(tx *sqlx.Tx, tableName, replacement string) error {
+	err := db.deleteEntries(tx, tableName, db.Tables.Dictionary, db.Tables.Log)
+	if err != nil {
+		return err
+	}
+	err = db.deleteEntries(tx, replacement, db.Tables.Backfill)
+	if err != nil {
+		return err
+	}
+
+	query := tx.Rebind(fmt.Sprintf("UPDATE %s SET %s = ? WHERE %s = ?",
+		db.DBAdapter.SchemaName(db.Tables.Dictionary), // update
+		db.DBAdapter.SecureName(db.Columns.TableName), // set
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+	))
+	if _, err = tx.Exec(query, tableName, replacement); err != nil {
+		db.Log.InfoMsg("Error renaming dictionary entries", "err", err, "query", query)
+		return err
+	}
+
+	// So that restoring from the log rebuilds the table as it now is
+	query = tx.Rebind(fmt.Sprintf("UPDATE %s SET %s = ?, %s = REPLACE(%s, ?, ?) WHERE %s = ?",
+		db.DBAdapter.SchemaName(db.Tables.Log),        // update
+		db.DBAdapter.SecureName(db.Columns.TableName), // set
+		db.DBAdapter.SecureName(db.Columns.SqlStmt),
+		db.DBAdapter.SecureName(db.Columns.SqlStmt),
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+	))
+	if _, err = tx.Exec(query, tableName, replacement, tableName, replacement); err != nil {
+		db.Log.InfoMsg("Error renaming log entries", "err", err, "query", query)
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) renameTable(tx *sqlx.Tx, query string) error {
+	db.Log.InfoMsg("RENAME TABLE", "query", query)
+	if _, err := tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error renaming table", "err", err, "query", query)
+		return err
+	}
+	return nil
+}
+
+// dropReplaced drops the table renamed from tableName when it was replaced
+func (db *SQLDB) dropReplaced(tableName string) error {
+	query := db.DBAdapter.DropTableQuery(db.Tables.ReplacedPrefix + tableName)
+	db.Log.InfoMsg("DROP TABLE", "query", query)
+	if _, err := db.DB.Exec(query); err != nil {
+		db.Log.InfoMsg("Error dropping replaced table", "err", err, "query", query)
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) backfillProgress(q sqlx.Queryer, tableName string) (string, map[string]uint64, error) {
+	query := db.DB.Rebind(fmt.Sprintf("SELECT %s, %s, %s FROM %s WHERE %s = ?",
+		db.DBAdapter.SecureName(db.Columns.ChainID), // select
+		db.DBAdapter.SecureName(db.Columns.Spec),
+		db.DBAdapter.SecureName(db.Columns.Height),
+		db.DBAdapter.SchemaName(db.Tables.Backfill),   // from
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+	))
+	rows, err := q.Query(query, tableName)
+	if err != nil {
+		db.Log.InfoMsg("Error querying backfill progress", "err", err, "query", query)
+		return "", nil, err
+	}
+	defer rows.Close()
+
+	var spec string
+	heights := make(map[string]uint64)
+	for rows.Next() {
+		var chainID string
+		var height uint64
+		if err = rows.Scan(&chainID, &spec, &height); err != nil {
+			db.Log.InfoMsg("Error scanning backfill progress", "err", err)
+			return "", nil, err
+		}
+		heights[chainID] = height
+	}
+	return spec, heights, rows.Err()
+}
+
+func (db *SQLDB) setBackfillHeight(tx *sqlx.Tx, tableName, chainID, spec string, height uint64) error {
+	query := tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ? AND %s = ?",
+		db.DBAdapter.SchemaName(db.Tables.Backfill),   // from
+		db.DBAdapter.SecureName(db.Columns.TableName), // where
+		db.DBAdapter.SecureName(db.Columns.ChainID),
+	))
+	if _, err := tx.Exec(query, tableName, chainID); err != nil {
+		db.Log.InfoMsg("Error deleting backfill progress", "err", err, "query", query)
+		return err
+	}
+
+	query = tx.Rebind(fmt.Sprintf("INSERT INTO %s (%s, %s, %s, %s) VALUES (?, ?, ?, ?)",
+		db.DBAdapter.SchemaName(db.Tables.Backfill), // insert
+		db.DBAdapter.SecureName(db.Columns.TableName),
+		db.DBAdapter.SecureName(db.Columns.ChainID),
+		db.DBAdapter.SecureName(db.Columns.Spec),
+		db.DBAdapter.SecureName(db.Columns.Height),
+	))
+	if _, err := tx.Exec(query, tableName, chainID, spec, height); err != nil {
+		db.Log.InfoMsg("Error inserting backfill progress", "err", err, "query", query)
+		return err
+	}
+	return nil
+}
+
+func (db *SQLDB) setTableSpecs(tx *sqlx.Tx, specs map[string]string) error {
+	query := fmt.Sprintf("DELETE FROM %s", db.DBAdapter.SchemaName(db.Tables.Spec))
+	if _, err := tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error deleting specs", "err", err, "query", query)
+		return err
+	}
+
+	query = tx.Rebind(fmt.Sprintf("INSERT INTO %s (%s, %s) VALUES (?, ?)",
+		db.DBAdapter.SchemaName(db.Tables.Spec), // insert
+		db.DBAdapter.SecureName(db.Columns.TableName),
+		db.DBAdapter.SecureName(db.Columns.Spec),
+	))
+	for tableName, spec := range specs {
+		if _, err := tx.Exec(query, tableName, spec); err != nil {
+			db.Log.InfoMsg("Error inserting spec", "err", err, "query", query)
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *SQLDB) dropTable(tx *sqlx.Tx, tableName string) error {
+	query := db.DBAdapter.DropTableQuery(tableName)
+	db.Log.InfoMsg("DROP TABLE", "query", query)
+	if _, err := tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error dropping table", "err", err, "query", query)
+		return err
+	}
+	return db.deleteEntries(tx, tableName, db.Tables.Dictionary, db.Tables.Log, db.Tables.Backfill)
+}
+
+// deleteEntries deletes the entries for tableName from each of systemTables
+func (db *SQLDB) deleteEntries(tx *sqlx.Tx, tableName string, systemTables ...string) error {
+	for _, systemTable := range systemTables {
+		query := tx.Rebind(fmt.Sprintf("DELETE FROM %s WHERE %s = ?",
+			db.DBAdapter.SchemaName(systemTable),          // from
+			db.DBAdapter.SecureName(db.Columns.TableName), // where
+		))
+		if _, err := tx.Exec(query, tableName); err != nil {
+			db.Log.InfoMsg("Error deleting table entries", "err", err, "query", query)
+			return err
+		}
+	}
+	return nil
+}
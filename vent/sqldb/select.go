@@ -0,0 +1,198 @@
+package sqldb
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/vent/types"
+)
+
+// Comparison operators by which a Filter can select rows
+var filterOperators = map[string]bool{"=": true, "!=": true, "<": true, "<=": true, ">": true, ">=": true}
+
+// Filter selects the rows whose Column compares to Value by Operator
+type Filter struct {
+	Column   string
+	Operator string
+	Value    string
+}
+
+// Order orders rows by Column
+type Order struct {
+	Column     string
+	Descending bool
+}
+
+// Change is an entry of the log recording a row being upserted or deleted
+type Change struct {
+	ID        uint64
+	ChainID   string
+	TableName string
+	Height    uint64
+	TxHash    string
+	Action    types.DBAction
+	// The values of the row's columns, omitting nulls
+	RowData map[string]string
+}
+
+// SelectRows returns the values of the columns of table for the rows matching every filter in order, skipping offset
+// rows and returning at most limit. Null values are omitted.
+func (db *SQLDB) SelectRows(table *types.SQLTable, filters []Filter, orderBy []Order, limit, offset uint64) (
+	[]map[string]string, error) {
+
+	columns := make(map[string]bool, len(table.Columns))
+	fields := make([]string, len(table.Columns))
+	for i, column := range table.Columns {
+		columns[column.Name] = true
+		fields[i] = db.DBAdapter.SecureName(column.Name)
+	}
+
+	query := fmt.Sprintf("SELECT %s FROM %s", strings.Join(fields, ", "), db.DBAdapter.SchemaName(table.Name))
+	values := make([]interface{}, len(filters))
+	for i, filter := range filters {
+		if !columns[filter.Column] {
+			return nil, fmt.Errorf("cannot filter on column %s that is not in table %s", filter.Column, table.Name)
+		}
+		if !filterOperators[filter.Operator] {
+			return nil, fmt.Errorf("unknown filter operator '%s'", filter.Operator)
+		}
+		if i == 0 {
+			query += " WHERE "
+		} else {
+			query += " AND "
+		}
+		query += fmt.Sprintf("%s %s ?", db.DBAdapter.SecureName(filter.Column), filter.Operator)
+		values[i] = filter.Value
+	}
+
+	if len(orderBy) == 0 {
+		// Without an order pages would not be stable
+		for _, column := range table.Columns {
+			if column.Primary {
+				orderBy = append(orderBy, Order{Column: column.Name})
+			}
+		}
+	}
+	for i, order := range orderBy {
+		if !columns[order.Column] {
+			return nil, fmt.Errorf("cannot order by column %s that is not in table %s", order.Column, table.Name)
+		}
+		if i == 0 {
+			query += " ORDER BY "
+		} else {
+			query += ", "
+		}
+		query += db.DBAdapter.SecureName(order.Column)
+		if order.Descending {
+			query += " DESC"
+		}
+	}
+	query = db.DB.Rebind(fmt.Sprintf("%s LIMIT %d OFFSET %d", query, limit, offset))
+
+	db.Log.InfoMsg("Query rows", "query", query, "values", fmt.Sprintf("%v", values))
+	rows, err := db.DB.Query(query, values...)
+	if err != nil {
+		db.Log.InfoMsg("Error querying rows", "err", err)
+		return nil, err
+	}
+	defer rows.Close()
+
+	containers := make([]sql.NullString, len(table.Columns))
+	pointers := make([]interface{}, len(containers))
+	for i := range pointers {
+		pointers[i] = &containers[i]
+	}
+
+	var results []map[string]string
+	for rows.Next() {
+		if err = rows.Scan(pointers...); err != nil {
+			db.Log.InfoMsg("Error scanning row", "err", err)
+			return nil, err
+		}
+		row := make(map[string]string)
+		for i, column := range table.Columns {
+			if containers[i].Valid {
+				row[column.Name] = containers[i].String
+			}
+		}
+		results = append(results, row)
+	}
+	return results, rows.Err()
+}
+
+// SelectChanges returns at most limit of the changes logged after the change with id after at heights above since in
+// the order they were logged, restricted to those of chainID and tableName unless empty
+func (db *SQLDB) SelectChanges(after, since uint64, chainID, tableName string, limit uint64) ([]*Change, error) {
+	values := []interface{}{after, since}
+	if chainID != "" {
+		values = append(values, chainID)
+	}
+	if tableName != "" {
+		values = append(values, tableName)
+	}
+	values = append(values, limit)
+
+	query := db.DBAdapter.SelectChangesQuery(chainID != "", tableName != "")
+	rows, err := db.DB.Query(query, values...)
+	if err != nil {
+		db.Log.InfoMsg("Error querying log", "err", err, "query", query)
+		return nil, err
+	}
+	defer rows.Close()
+
+	var changes []*Change
+	for rows.Next() {
+		change := new(Change)
+		var height, action string
+		var txHash sql.NullString
+		var rowData []byte
+		err = rows.Scan(&change.ID, &change.ChainID, &change.TableName, &height, &txHash, &action, &rowData)
+		if err != nil {
+			db.Log.InfoMsg("Error scanning log", "err", err)
+			return nil, err
+		}
+		change.Height, err = strconv.ParseUint(height, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse height of logged change %d: %v", change.ID, err)
+		}
+		change.TxHash = txHash.String
+		change.Action = types.DBAction(action)
+		change.RowData, err = getRowDataFromJSON(rowData)
+		if err != nil {
+			return nil, fmt.Errorf("could not unmarshal row of logged change %d: %v", change.ID, err)
+		}
+		changes = append(changes, change)
+	}
+	return changes, rows.Err()
+}
+
+// getRowDataFromJSON returns the string value of each non-null column of the logged row JSON
+func getRowDataFromJSON(JSON []byte) (map[string]string, error) {
+	decoder := json.NewDecoder(bytes.NewReader(JSON))
+	decoder.UseNumber()
+	values := make(map[string]interface{})
+	if err := decoder.Decode(&values); err != nil {
+		return nil, err
+	}
+	rowData := make(map[string]string, len(values))
+	for column, value := range values {
+		switch v := value.(type) {
+		case nil:
+		case string:
+			rowData[column] = v
+		case json.Number:
+			rowData[column] = v.String()
+		default:
+			bs, err := json.Marshal(v)
+			if err != nil {
+				return nil, err
+			}
+			rowData[column] = string(bs)
+		}
+	}
+	return rowData, nil
+}
@@ -8,9 +8,11 @@ import (
 	"math"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sink"
 	"github.com/hyperledger/burrow/vent/sqldb/adapters"
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/jmoiron/sqlx"
@@ -27,17 +29,30 @@ type SQLDB struct {
 	adapters.DBAdapter
 	Schema  string
 	Queries Queries
+	// Keep the data of every chain rather than dropping it when the chain ID changes
+	MultiChain bool
 	types.SQLNames
 	Log *logging.Logger
+	// How long to keep notifications in databases that store them for clients to poll, zero keeps them forever
+	NotifyRetention time.Duration
+	prunedAt        time.Time
+	pruneMtx        sync.Mutex
 }
 
+// How often stored notifications are pruned
+const NotifyPruneEvery = time.Minute
+
+var _ sink.Sink = &SQLDB{}
+
 // NewSQLDB delegates work to a specific database adapter implementation,
 // opens database connection and create log tables
 func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 	db := &SQLDB{
-		Schema:   connection.DBSchema,
-		SQLNames: types.DefaultSQLNames,
-		Log:      connection.Log,
+		Schema:          connection.DBSchema,
+		MultiChain:      connection.MultiChain,
+		SQLNames:        types.DefaultSQLNames,
+		Log:             connection.Log,
+		NotifyRetention: connection.NotifyRetention,
 	}
 
 	switch connection.DBAdapter {
@@ -46,6 +61,9 @@ func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 
 	case types.SQLiteDB:
 		db.DBAdapter = adapters.NewSQLiteAdapter(db.SQLNames, connection.Log)
+
+	case types.MySQLDB:
+		db.DBAdapter = adapters.NewMySQLAdapter(safe(connection.DBSchema), db.SQLNames, connection.Log)
 	default:
 		return nil, errors.New("invalid database adapter")
 	}
@@ -66,7 +84,7 @@ func NewSQLDB(connection types.SQLConnection) (*SQLDB, error) {
 }
 
 // Initialise the system and chain tables in case this is the first run - is idempotent though will drop tables
-// if ChainID has changed
+// if ChainID has changed (unless MultiChain in which case chainID is added to the chains in the database)
 func (db *SQLDB) Init(chainID, burrowVersion string) error {
 	db.Log.InfoMsg("Initializing DB")
 
@@ -97,6 +115,41 @@ func (db *SQLDB) Init(chainID, burrowVersion string) error {
 		}
 	}
 
+	// IMPORTANT: DO NOT CHANGE TABLE CREATION ORDER (4)
+	if err := db.createTable(chainID, sysTables[db.Tables.Spec], true); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedTable) {
+			db.Log.InfoMsg("Error creating Spec table", "err", err)
+			return err
+		}
+	}
+
+	// IMPORTANT: DO NOT CHANGE TABLE CREATION ORDER (5)
+	if err := db.createTable(chainID, sysTables[db.Tables.Abi], true); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedTable) {
+			db.Log.InfoMsg("Error creating Abi table", "err", err)
+			return err
+		}
+	}
+
+	// IMPORTANT: DO NOT CHANGE TABLE CREATION ORDER (6)
+	if err := db.createTable(chainID, sysTables[db.Tables.Backfill], true); err != nil {
+		if !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedTable) {
+			db.Log.InfoMsg("Error creating Backfill table", "err", err)
+			return err
+		}
+	}
+
+	if db.MultiChain {
+		err := db.addChain(chainID, burrowVersion)
+		if err != nil {
+			return fmt.Errorf("could not add chain to database: %v", err)
+		}
+		if db.Queries.LastBlockHeight == nil {
+			db.Queries, err = db.prepareQueries()
+		}
+		return err
+	}
+
 	chainIDChanged, err := db.InitChain(chainID, burrowVersion)
 	if err != nil {
 		return fmt.Errorf("could not initialise chain in database: %v", err)
@@ -169,6 +222,29 @@ func (db *SQLDB) InitChain(chainID, burrowVersion string) (chainIDChanged bool,
 
 }
 
+// addChain stores chainID with a zero height unless it is already stored
+func (db *SQLDB) addChain(chainID, burrowVersion string) error {
+	query := db.DB.Rebind(fmt.Sprintf("SELECT COUNT(*) FROM %s WHERE %s = ?",
+		db.DBAdapter.SchemaName(db.Tables.ChainInfo), // from
+		db.DBAdapter.SecureName(db.Columns.ChainID),  // where
+	))
+	found := 0
+	if err := db.DB.QueryRow(query, chainID).Scan(&found); err != nil {
+		db.Log.InfoMsg("Error selecting CHAIN ID", "err", err, "query", query)
+		return err
+	}
+	if found > 0 {
+		return nil
+	}
+
+	query = db.DBAdapter.CleanDBQueries().InsertChainIDQry
+	_, err := db.DB.Exec(query, chainID, burrowVersion, 0)
+	if err != nil {
+		db.Log.InfoMsg("Error inserting CHAIN ID", "err", err, "query", query)
+	}
+	return err
+}
+
 // CleanTables drop tables if stored chainID is different from the given one & store new chainID
 // if the chainID is the same, do nothing
 func (db *SQLDB) CleanTables(chainID, burrowVersion string) error {
@@ -234,6 +310,27 @@ func (db *SQLDB) CleanTables(chainID, burrowVersion string) error {
 		db.Log.InfoMsg("Error deleting log", "err", err, "query", query)
 		return err
 	}
+
+	// Delete Specs
+	query = fmt.Sprintf("DELETE FROM %s", db.DBAdapter.SchemaName(db.Tables.Spec))
+	if _, err = tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error deleting specs", "err", err, "query", query)
+		return err
+	}
+
+	// Delete ABIs
+	query = fmt.Sprintf("DELETE FROM %s", db.DBAdapter.SchemaName(db.Tables.Abi))
+	if _, err = tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error deleting ABIs", "err", err, "query", query)
+		return err
+	}
+
+	// Delete backfill progress
+	query = fmt.Sprintf("DELETE FROM %s", db.DBAdapter.SchemaName(db.Tables.Backfill))
+	if _, err = tx.Exec(query); err != nil {
+		db.Log.InfoMsg("Error deleting backfill progress", "err", err, "query", query)
+		return err
+	}
 	// Drop database tables
 	for _, tableName = range tables {
 		query = db.DBAdapter.DropTableQuery(tableName)
@@ -272,6 +369,11 @@ func (db *SQLDB) Ping() error {
 	return nil
 }
 
+// Synchronize implements sink.Sink with SynchronizeDB
+func (db *SQLDB) Synchronize(chainID string, eventTables types.EventTables) error {
+	return db.SynchronizeDB(chainID, eventTables)
+}
+
 // SynchronizeDB synchronize db tables structures from given tables specifications
 func (db *SQLDB) SynchronizeDB(chainID string, eventTables types.EventTables) error {
 	db.Log.InfoMsg("Synchronizing DB")
@@ -297,6 +399,28 @@ func (db *SQLDB) SynchronizeDB(chainID string, eventTables types.EventTables) er
 
 // SetBlock inserts or updates multiple rows and stores log info in SQL tables
 func (db *SQLDB) SetBlock(chainID string, eventTables types.EventTables, eventData types.EventData) error {
+	return db.setBlock(chainID, eventTables, eventData, func(tx *sqlx.Tx) error {
+		return db.SetBlockHeight(tx, chainID, eventData.BlockHeight)
+	})
+}
+
+// SetBackfillRows is SetBlock for tables being backfilled behind the chain's height, recording the height of the block
+// as the progress of each of eventTables along with the spec given for it by specs in place of the chain's height
+func (db *SQLDB) SetBackfillRows(chainID string, eventTables types.EventTables, eventData types.EventData,
+	specs map[string]string) error {
+	return db.setBlock(chainID, eventTables, eventData, func(tx *sqlx.Tx) error {
+		for tableName := range eventTables {
+			err := db.setBackfillHeight(tx, tableName, chainID, specs[tableName], eventData.BlockHeight)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (db *SQLDB) setBlock(chainID string, eventTables types.EventTables, eventData types.EventData,
+	setHeight func(tx *sqlx.Tx) error) error {
 	db.Log.InfoMsg("Synchronize Block", "action", "SYNC")
 
 	// Begin tx
@@ -322,8 +446,13 @@ loop:
 	for _, table := range eventTables {
 		tableName = safe(table.Name)
 		dataRows := eventData.Tables[table.Name]
+		// rows maintaining aggregates are applied once, by their position in the block
+		rowIndex := table.GetColumn(db.Columns.RowIndex) != nil
 		// for Each Row
-		for _, row := range dataRows {
+		for i, row := range dataRows {
+			if rowIndex && row.Action == types.ActionUpsert {
+				row.RowData[db.Columns.RowIndex] = i
+			}
 			var queryVal types.UpsertDeleteQuery
 			var txHash interface{}
 			var errQuery error
@@ -422,7 +551,7 @@ loop:
 					return err
 				}
 				//Retry
-				return db.SetBlock(chainID, eventTables, eventData)
+				return db.setBlock(chainID, eventTables, eventData, setHeight)
 			}
 
 			// Columns do not match
@@ -433,7 +562,7 @@ loop:
 					return err
 				}
 				//Retry
-				return db.SetBlock(chainID, eventTables, eventData)
+				return db.setBlock(chainID, eventTables, eventData, setHeight)
 			}
 			return err
 		}
@@ -442,7 +571,7 @@ loop:
 
 	db.Log.InfoMsg("COMMIT", "action", "COMMIT")
 
-	err = db.SetBlockHeight(tx, chainID, eventData.BlockHeight)
+	err = setHeight(tx)
 	if err != nil {
 		db.Log.InfoMsg("Could not commit block height", "err", err)
 		return err
@@ -454,9 +583,31 @@ loop:
 		return err
 	}
 
+	db.pruneNotifications()
 	return nil
 }
 
+// Deletes notifications older than NotifyRetention from databases that store them, at most every NotifyPruneEvery.
+// Failing to prune is not fatal since the notifications have been committed with their block.
+func (db *SQLDB) pruneNotifications() {
+	pruner, ok := db.DBAdapter.(adapters.DBNotifyPruneAdapter)
+	if !ok || db.NotifyRetention <= 0 {
+		return
+	}
+	db.pruneMtx.Lock()
+	defer db.pruneMtx.Unlock()
+	if time.Since(db.prunedAt) < NotifyPruneEvery {
+		return
+	}
+	db.prunedAt = time.Now()
+	query := pruner.PruneNotificationsQuery()
+	_, err := db.DB.Exec(query, int64(db.NotifyRetention.Seconds()))
+	// The notifications table only exists once a notification trigger has been created
+	if err != nil && !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeUndefinedTable) {
+		db.Log.InfoMsg("Could not prune notifications", "err", err, "query", query)
+	}
+}
+
 // GetBlock returns all tables structures and row data for given block
 func (db *SQLDB) GetBlock(chainID string, height uint64) (types.EventData, error) {
 	var data types.EventData
@@ -522,6 +673,10 @@ func (db *SQLDB) GetBlock(chainID string, height uint64) (types.EventData, error
 					row[col] = containers[i].String
 				}
 			}
+			// Other chains may have rows at the same height
+			if rowChainID, ok := row[db.Columns.ChainID]; ok && db.MultiChain && rowChainID != chainID {
+				continue
+			}
 			dataRows = append(dataRows, types.EventDataRow{Action: types.ActionRead, RowData: row})
 		}
 
@@ -836,13 +991,13 @@ func (db *SQLDB) alterTable(chainID string, table *types.SQLTable) error {
 		}
 	}
 
-	// Ensure triggers are defined
+	// Ensure triggers and indexes are defined
 	err = db.createTableTriggers(table)
 	if err != nil {
 		db.Log.InfoMsg("error creating notification triggers", "err", err, "value", fmt.Sprintf("%v", table))
 		return fmt.Errorf("could not create table notification triggers: %v", err)
 	}
-	return nil
+	return db.createTableIndexes(table)
 }
 
 // createTable creates a new table
@@ -881,6 +1036,11 @@ func (db *SQLDB) createTable(chainID string, table *types.SQLTable, isInitialise
 		return fmt.Errorf("could not create table notification triggers: %v", err)
 	}
 
+	err = db.createTableIndexes(table)
+	if err != nil {
+		return err
+	}
+
 	//insert log (if action is not database initialization)
 	if !isInitialise {
 		// Marshal the table into a JSON string.
@@ -902,6 +1062,26 @@ func (db *SQLDB) createTable(chainID string, table *types.SQLTable, isInitialise
 	return nil
 }
 
+// Creates the indexes of the indexed columns of table that do not already exist. Tables being backfilled are indexed
+// once they cut over rather than while they are filled (and so that their indexes are named after the table).
+func (db *SQLDB) createTableIndexes(table *types.SQLTable) error {
+	if strings.HasPrefix(table.Name, db.Tables.BackfillPrefix) {
+		return nil
+	}
+	for _, column := range table.Columns {
+		if !column.Index {
+			continue
+		}
+		query := db.DBAdapter.CreateIndexQuery(table.Name, column)
+		db.Log.InfoMsg("CREATE INDEX", "query", query)
+		_, err := db.DB.Exec(query)
+		if err != nil && !db.DBAdapter.ErrorEquals(err, types.SQLErrorTypeDuplicatedIndex) {
+			return fmt.Errorf("could not create index on column %s of table %s: %v", column.Name, table.Name, err)
+		}
+	}
+	return nil
+}
+
 // Creates (or updates) table notification triggers and functions
 func (db *SQLDB) createTableTriggers(table *types.SQLTable) error {
 	// If the adapter supports notification triggers
@@ -918,11 +1098,16 @@ func (db *SQLDB) createTableTriggers(table *types.SQLTable) error {
 			}
 
 			trigger := fmt.Sprintf("%s_%s_notify_trigger", table.Name, channel)
-			query = dbNotify.CreateTriggerQuery(trigger, table.Name, function)
-			db.Log.InfoMsg("CREATE NOTIFICATION TRIGGER", "query", query)
-			_, err = db.DB.Exec(query)
+			queries, err := dbNotify.CreateTriggerQuery(trigger, table.Name, function)
 			if err != nil {
-				return fmt.Errorf("could not create notification trigger: %v", err)
+				return fmt.Errorf("could not build notification trigger: %v", err)
+			}
+			for _, query := range queries {
+				db.Log.InfoMsg("CREATE NOTIFICATION TRIGGER", "query", query)
+				_, err = db.DB.Exec(query)
+				if err != nil {
+					return fmt.Errorf("could not create notification trigger: %v", err)
+				}
 			}
 		}
 	}
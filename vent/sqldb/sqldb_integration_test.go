@@ -8,6 +8,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/hyperledger/burrow/crypto"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/sqldb"
 	"github.com/hyperledger/burrow/vent/sqldb/adapters"
@@ -158,6 +159,264 @@ func testSetBlock(t *testing.T, cfg *config.VentConfig) {
 	})
 }
 
+func testMultiChain(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: keeps the checkpoints of several chains", cfg.DBAdapter), func(t *testing.T) {
+		cfg.SpecOpt |= sqlsol.MultiChain
+		db, closeDB := test.NewTestDB(t, cfg)
+		defer closeDB()
+
+		const otherChainID = "CHAIN_456"
+		err := db.Init(otherChainID, test.BurrowVersion)
+		require.NoError(t, err)
+
+		err = db.SetBlock(test.ChainID, types.EventTables{}, types.EventData{BlockHeight: 10})
+		require.NoError(t, err)
+		err = db.SetBlock(otherChainID, types.EventTables{}, types.EventData{BlockHeight: 3})
+		require.NoError(t, err)
+
+		// Initialising either chain again must not wipe the other's data
+		err = db.Init(test.ChainID, test.BurrowVersion)
+		require.NoError(t, err)
+
+		height, err := db.LastBlockHeight(test.ChainID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(10), height)
+
+		height, err = db.LastBlockHeight(otherChainID)
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), height)
+	})
+}
+
+func testAbiCache(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: caches ABIs by chain and address", cfg.DBAdapter), func(t *testing.T) {
+		db, closeDB := test.NewTestDB(t, cfg)
+		defer closeDB()
+
+		address := crypto.Address{1, 2, 3}
+		err := db.SetAbi(test.ChainID, address, `{"Abi":[]}`)
+		require.NoError(t, err)
+		// Replaces the ABI recorded before
+		err = db.SetAbi(test.ChainID, address, `{"Abi":[{"type":"event","name":"Foo","inputs":[]}]}`)
+		require.NoError(t, err)
+
+		abis, err := db.GetAbis(test.ChainID)
+		require.NoError(t, err)
+		assert.Equal(t, map[crypto.Address]string{
+			address: `{"Abi":[{"type":"event","name":"Foo","inputs":[]}]}`,
+		}, abis)
+
+		abis, err = db.GetAbis("CHAIN_456")
+		require.NoError(t, err)
+		assert.Empty(t, abis)
+
+		err = db.CleanTables(test.ChainID, test.BurrowVersion)
+		require.NoError(t, err)
+		abis, err = db.GetAbis(test.ChainID)
+		require.NoError(t, err)
+		assert.Empty(t, abis)
+	})
+}
+
+func testAggregate(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: maintains aggregate columns across blocks and restores them", cfg.DBAdapter),
+		func(t *testing.T) {
+			db, closeDB := test.NewTestDB(t, cfg)
+			defer closeDB()
+
+			err := db.Ping()
+			require.NoError(t, err)
+
+			tables := types.EventTables{
+				"Balances": &types.SQLTable{
+					Name: "test_balances",
+					Columns: []*types.SQLTableColumn{
+						{Name: "holder", Type: types.SQLColumnTypeVarchar, Length: 100, Primary: true},
+						{Name: columns.Height, Type: types.SQLColumnTypeBigInt},
+						{Name: columns.RowIndex, Type: types.SQLColumnTypeBigInt},
+						{Name: "balance", Type: types.SQLColumnTypeInt, Aggregate: types.AggregateSum},
+						{Name: "transfers", Type: types.SQLColumnTypeInt, Aggregate: types.AggregateCount},
+						{Name: "smallest", Type: types.SQLColumnTypeInt, Aggregate: types.AggregateMin},
+						{Name: "largest", Type: types.SQLColumnTypeInt, Aggregate: types.AggregateMax},
+						{Name: "memo", Type: types.SQLColumnTypeVarchar, Length: 100},
+					},
+				},
+			}
+			// SetBlock records the index of each row in its block alongside its height
+			at := func(height uint64, rowData map[string]interface{}) types.EventDataRow {
+				rowData[columns.Height] = height
+				return types.EventDataRow{Action: types.ActionUpsert, RowData: rowData}
+			}
+			transfer := func(height uint64, holder, amount, memo string) types.EventDataRow {
+				return at(height, map[string]interface{}{
+					"holder": holder, "balance": amount, "transfers": 1, "smallest": amount, "largest": amount,
+					"memo": memo,
+				})
+			}
+
+			block1 := types.EventData{
+				BlockHeight: 1,
+				Tables: map[string]types.EventDataTable{
+					"test_balances": {
+						transfer(1, "alice", "10", "first"),
+						transfer(1, "bob", "5", "first"),
+						// Every row for a holder in a block is applied
+						transfer(1, "alice", "-3", "second"),
+						transfer(1, "alice", "3", "second"),
+						transfer(1, "alice", "-3", "second"),
+					},
+				},
+			}
+			block2 := types.EventData{
+				BlockHeight: 2,
+				Tables: map[string]types.EventDataTable{
+					"test_balances": {
+						transfer(2, "alice", "20", "third"),
+						// Rows without aggregate values leave the aggregates as they are
+						at(2, map[string]interface{}{"holder": "bob", "memo": "note"}),
+					},
+				},
+			}
+			require.NoError(t, db.SetBlock(test.ChainID, tables, block1))
+			require.NoError(t, db.SetBlock(test.ChainID, tables, block2))
+
+			expected := map[string][]string{
+				"alice": {"27", "5", "-3", "20", "third"},
+				"bob":   {"5", "1", "5", "5", "note"},
+			}
+			assertBalances := func(table string) {
+				_, rows := selectAll(t, db, table)
+				require.Len(t, rows, len(expected))
+				for _, row := range rows {
+					assert.Equal(t, expected[fmt.Sprint(row["holder"])], []string{fmt.Sprint(row["balance"]),
+						fmt.Sprint(row["transfers"]), fmt.Sprint(row["smallest"]), fmt.Sprint(row["largest"]),
+						fmt.Sprint(row["memo"])})
+				}
+			}
+			assertBalances("test_balances")
+
+			// Consuming blocks again (when restarting from block 0 or after a crash) does not accumulate their events
+			// twice
+			require.NoError(t, db.SetBlock(test.ChainID, tables, block1))
+			assertBalances("test_balances")
+			require.NoError(t, db.SetBlock(test.ChainID, tables, block2))
+			assertBalances("test_balances")
+
+			// Replaying the log must accumulate the aggregates again exactly once
+			prefix := "RESTORED"
+			err = db.RestoreDB(time.Time{}, prefix)
+			require.NoError(t, err)
+			assertBalances(fmt.Sprintf("%s_%s", prefix, "test_balances"))
+
+			_, err = db.DB.Exec(db.DBAdapter.DropTableQuery("test_balances"))
+			require.NoError(t, err)
+			err = db.RestoreDB(time.Time{}, "")
+			require.NoError(t, err)
+			assertBalances("test_balances")
+		})
+}
+
+func testCutOver(t *testing.T, cfg *config.VentConfig) {
+	t.Run(fmt.Sprintf("%s: resumes backfills and cuts over to their tables", cfg.DBAdapter), func(t *testing.T) {
+		db, closeDB := test.NewTestDB(t, cfg)
+		defer closeDB()
+
+		table := func(name string) *types.SQLTable {
+			return &types.SQLTable{
+				Name: name,
+				Columns: []*types.SQLTableColumn{
+					{Name: "name", Type: types.SQLColumnTypeVarchar, Length: 100, Primary: true},
+					{Name: columns.Height, Type: types.SQLColumnTypeBigInt},
+					{Name: "colour", Type: types.SQLColumnTypeVarchar, Length: 100, Index: true},
+				},
+			}
+		}
+		row := func(name, colour string) types.EventDataRow {
+			return types.EventDataRow{Action: types.ActionUpsert, RowData: map[string]interface{}{
+				"name": name, "colour": colour,
+			}}
+		}
+		replacedTable := func(tableName string) string {
+			return db.Tables.ReplacedPrefix + tableName
+		}
+		replacements := func(tableNames ...string) map[string]string {
+			replacements := make(map[string]string)
+			for _, tableName := range tableNames {
+				replacements[tableName] = db.Tables.BackfillPrefix + tableName
+			}
+			return replacements
+		}
+		backfill := func(tableName string, height uint64, colour string) {
+			replacement := db.Tables.BackfillPrefix + tableName
+			err := db.SetBackfillRows(test.ChainID, types.EventTables{replacement: table(replacement)}, types.EventData{
+				BlockHeight: height,
+				Tables:      map[string]types.EventDataTable{replacement: {row("frog", colour)}},
+			}, map[string]string{replacement: "spec2"})
+			require.NoError(t, err)
+		}
+		assertColour := func(tableName, colour string) {
+			_, rows := selectAll(t, db, tableName)
+			require.Len(t, rows, 1)
+			assert.Equal(t, colour, rows[0]["colour"])
+		}
+
+		tables := types.EventTables{"animals": table("animals"), "plants": table("plants")}
+		err := db.SetBlock(test.ChainID, tables, types.EventData{
+			BlockHeight: 10,
+			Tables: map[string]types.EventDataTable{
+				"animals": {row("frog", "green")},
+				"plants":  {row("frog", "green")},
+			},
+		})
+		require.NoError(t, err)
+		require.NoError(t, db.SetTableSpecs(map[string]string{"animals": "spec1", "plants": "spec1"}))
+
+		backfill("animals", 3, "brown")
+		backfill("animals", 7, "blue")
+		spec, heights, err := db.BackfillProgress(db.Tables.BackfillPrefix + "animals")
+		require.NoError(t, err)
+		assert.Equal(t, "spec2", spec)
+		assert.Equal(t, map[string]uint64{test.ChainID: 7}, heights)
+
+		// Nothing to resume for a table that has not been backfilled
+		spec, _, err = db.BackfillProgress(db.Tables.BackfillPrefix + "plants")
+		require.NoError(t, err)
+		assert.Equal(t, "", spec)
+
+		err = db.CutOver(replacements("animals"), tables, map[string]string{"animals": "spec2", "plants": "spec1"})
+		require.NoError(t, err)
+		assertColour("animals", "blue")
+		spec, _, err = db.BackfillProgress(db.Tables.BackfillPrefix + "animals")
+		require.NoError(t, err)
+		assert.Equal(t, "", spec)
+		_, rows := selectAll(t, db, db.Tables.Log)
+		for _, row := range rows {
+			assert.NotEqual(t, db.Tables.BackfillPrefix+"animals", row[columns.TableName])
+		}
+
+		// Cut over interrupted once the tables had been renamed (which MySQL cannot roll back)
+		backfill("plants", 7, "blue")
+		backfill("trees", 7, "blue")
+		_, err = db.DB.Exec(db.DBAdapter.ReplaceTablesQuery(replacements("plants"), db.Tables.ReplacedPrefix))
+		require.NoError(t, err)
+		_, err = db.DB.Exec(db.DBAdapter.RenameTableQuery(db.Tables.BackfillPrefix+"trees", "trees"))
+		require.NoError(t, err)
+		require.NoError(t, db.RecoverCutOver([]string{"animals", "plants", "trees"}))
+		assertColour("plants", "blue")
+		assertColour("trees", "blue")
+		specs, err := db.TableSpecs()
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"animals": "spec2", "plants": "spec2", "trees": "spec2"}, specs)
+		for _, tableName := range []string{"animals", "plants"} {
+			_, err = db.DB.Exec(fmt.Sprintf("SELECT * FROM %s", db.DBAdapter.SchemaName(replacedTable(tableName))))
+			require.Error(t, err, "replaced table should be dropped")
+		}
+		// Recovering again is a no-op
+		require.NoError(t, db.RecoverCutOver([]string{"animals", "plants", "trees"}))
+		assertColour("plants", "blue")
+	})
+}
+
 func getBlock() (types.EventTables, types.EventData) {
 	longtext := "qwertyuiopasdfghjklzxcvbnm1234567890QWERTYUIOPASDFGHJKLZXCVBNM"
 	longtext = fmt.Sprintf("%s %s %s %s %s", longtext, longtext, longtext, longtext, longtext)
@@ -0,0 +1,108 @@
+// +build integration,mysql
+
+package sqldb_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/sqldb/adapters"
+	"github.com/hyperledger/burrow/vent/test"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMySQLSynchronizeDB(t *testing.T) {
+	testSynchronizeDB(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLCleanDB(t *testing.T) {
+	testCleanDB(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLSetBlock(t *testing.T) {
+	testSetBlock(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLMultiChain(t *testing.T) {
+	testMultiChain(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLRestore(t *testing.T) {
+	testRestore(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLAggregate(t *testing.T) {
+	testAggregate(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLBlockNotification(t *testing.T) {
+	cfg := test.MySQLVentConfig("")
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+
+	functionName := "notify_height"
+	channelName := "height_notification"
+	mad := db.DBAdapter.(*adapters.MySQLAdapter)
+
+	// Run twice to show idempotency
+	for i := 0; i < 2; i++ {
+		query := mad.CreateNotifyFunctionQuery(functionName, channelName, columns.Height)
+		_, err := db.DB.Exec(query)
+		require.NoError(t, err)
+
+		queries, err := mad.CreateTriggerQuery("notify_height_trigger", tables.Log, functionName)
+		require.NoError(t, err)
+		for _, query := range queries {
+			_, err = db.DB.Exec(query)
+			require.NoError(t, err)
+		}
+	}
+
+	// new block
+	str, dat := getBlock()
+	err := db.SetBlock(test.ChainID, str, dat)
+	require.NoError(t, err)
+
+	rows, err := db.DB.Query(mad.NotificationsQuery(), 0)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	type payload struct {
+		Action string `json:"_action"`
+		Height string `json:"_height"`
+	}
+	found := false
+	for rows.Next() {
+		var id uint64
+		var channel string
+		var data []byte
+		require.NoError(t, rows.Scan(&id, &channel, &data))
+		if channel != channelName {
+			continue
+		}
+		pl := new(payload)
+		require.NoError(t, json.Unmarshal(data, pl))
+		require.Equal(t, "INSERT", pl.Action)
+		require.Equal(t, fmt.Sprint(dat.BlockHeight), pl.Height)
+		found = true
+	}
+	require.NoError(t, rows.Err())
+	require.True(t, found, "expected a notification on %s", channelName)
+
+	// Pruning everything written up to a second from now leaves no notifications
+	_, err = db.DB.Exec(mad.PruneNotificationsQuery(), -1)
+	require.NoError(t, err)
+	var count int
+	require.NoError(t, db.DB.QueryRow(fmt.Sprintf("SELECT COUNT(*) FROM %s;",
+		mad.SchemaName(adapters.MySQLNotifyTable))).Scan(&count))
+	require.Equal(t, 0, count)
+}
+
+func TestMySQLAbiCache(t *testing.T) {
+	testAbiCache(t, test.MySQLVentConfig(""))
+}
+
+func TestMySQLCutOver(t *testing.T) {
+	testCutOver(t, test.MySQLVentConfig(""))
+}
@@ -31,6 +31,14 @@ func TestPostgresSetBlock(t *testing.T) {
 	testSetBlock(t, test.PostgresVentConfig(""))
 }
 
+func TestPostgresMultiChain(t *testing.T) {
+	testMultiChain(t, test.PostgresVentConfig(""))
+}
+
+func TestPostgresAggregate(t *testing.T) {
+	testAggregate(t, test.PostgresVentConfig(""))
+}
+
 func TestRestore(t *testing.T) {
 	testRestore(t, test.PostgresVentConfig(""))
 }
@@ -53,9 +61,12 @@ func TestPostgresBlockNotification(t *testing.T) {
 		_, err := db.DB.Exec(query)
 		require.NoError(t, err)
 
-		query = pad.CreateTriggerQuery("notify_height_trigger", tables.Log, functionName)
-		_, err = db.DB.Exec(query)
+		queries, err := pad.CreateTriggerQuery("notify_height_trigger", tables.Log, functionName)
 		require.NoError(t, err)
+		for _, query := range queries {
+			_, err = db.DB.Exec(query)
+			require.NoError(t, err)
+		}
 	}
 
 	listener := pq.NewListener(cfg.DBURL, time.Second, time.Second*20, func(event pq.ListenerEventType, err error) {
@@ -109,3 +120,11 @@ func TestPostgresBlockNotification(t *testing.T) {
 		require.NoError(t, err)
 	}
 }
+
+func TestPostgresAbiCache(t *testing.T) {
+	testAbiCache(t, test.PostgresVentConfig(""))
+}
+
+func TestPostgresCutOver(t *testing.T) {
+	testCutOver(t, test.PostgresVentConfig(""))
+}
@@ -20,6 +20,22 @@ func TestSqliteSetBlock(t *testing.T) {
 	testSetBlock(t, test.SqliteVentConfig(""))
 }
 
+func TestSqliteMultiChain(t *testing.T) {
+	testMultiChain(t, test.SqliteVentConfig(""))
+}
+
 func TestSqliteRestore(t *testing.T) {
 	testRestore(t, test.SqliteVentConfig(""))
 }
+
+func TestSqliteAggregate(t *testing.T) {
+	testAggregate(t, test.SqliteVentConfig(""))
+}
+
+func TestSqliteAbiCache(t *testing.T) {
+	testAbiCache(t, test.SqliteVentConfig(""))
+}
+
+func TestSqliteCutOver(t *testing.T) {
+	testCutOver(t, test.SqliteVentConfig(""))
+}
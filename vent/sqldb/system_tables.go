@@ -118,5 +118,67 @@ func (db *SQLDB) systemTablesDefinition() types.EventTables {
 			},
 			NotifyChannels: map[string][]string{types.BlockHeightLabel: {columns.Height}},
 		},
+		tables.Spec: {
+			Name: tables.Spec,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.TableName,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				// The JSON of the event classes last projected into the table
+				{
+					Name: columns.Spec,
+					Type: types.SQLColumnTypeText,
+				},
+			},
+		},
+		tables.Backfill: {
+			Name: tables.Backfill,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.TableName,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				{
+					Name:    columns.ChainID,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				// The JSON of the event classes being projected into the table
+				{
+					Name: columns.Spec,
+					Type: types.SQLColumnTypeText,
+				},
+				// The height of the last block of the chain projected into the table
+				{
+					Name:   columns.Height,
+					Type:   types.SQLColumnTypeNumeric,
+					Length: digits(maxUint64),
+				},
+			},
+		},
+		tables.Abi: {
+			Name: tables.Abi,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.ChainID,
+					Type:    types.SQLColumnTypeVarchar,
+					Primary: true,
+				},
+				{
+					Name:    columns.Address,
+					Type:    types.SQLColumnTypeVarchar,
+					Length:  40,
+					Primary: true,
+				},
+				// The metadata from which the ABI of the contract at the address was read
+				{
+					Name: columns.Abi,
+					Type: types.SQLColumnTypeText,
+				},
+			},
+		},
 	}
 }
@@ -1,8 +1,17 @@
 package sqlsol
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
 
+	"github.com/hyperledger/burrow/deploy/compile"
 	"github.com/hyperledger/burrow/execution/evm/abi"
 	"github.com/hyperledger/burrow/vent/types"
 )
@@ -57,3 +66,365 @@ func GenerateSpecFromAbis(spec *abi.Spec) ([]*types.EventClass, error) {
 
 	return []*types.EventClass{&ev}, nil
 }
+
+// DeleteMarkerField is the conventional name of an event parameter that marks the event as deleting a row
+const DeleteMarkerField = "__DELETE__"
+
+// Natspec directives read by GenerateSpecFromSolidity from the documentation of an event (@dev, @notice, or
+// @custom:vent) or of one of its parameters (@param), written as vent:<directive> or vent:<directive>=<value>
+const (
+	// Event: the table into which to project the event, the event's name by default
+	TableDirective = "table"
+	// Event: leave the event out of the spec, Parameter: leave the parameter's column out of the event's class
+	SkipDirective = "skip"
+	// Event: notify every column on the comma-separated channels, Parameter: notify the parameter's column on them,
+	// in either case on a channel named after the table if none are given
+	NotifyDirective = "notify"
+	// Parameter: make the parameter's column part of the table's primary key
+	PrimaryDirective = "primary"
+	// Parameter: index the parameter's column, as every indexed parameter's column not in the primary key already is
+	IndexDirective = "index"
+	// Parameter: the parameter marks the event as deleting the row with its key
+	DeleteDirective = "delete"
+	// Parameter: the name of the parameter's column, the parameter's name by default
+	ColumnDirective = "column"
+	// Parameter: project a bytesN parameter as a string
+	StringDirective = "string"
+)
+
+var directiveRegex = regexp.MustCompile(`vent:([a-z]+)(?:=(\S+))?`)
+
+// LoadSolidityOutput reads the contracts from each file of solc output given, or each .json or .bin file in each
+// directory given
+func LoadSolidityOutput(solcFileOrDirs ...string) ([]*compile.SolidityContract, error) {
+	if len(solcFileOrDirs) == 0 {
+		return nil, fmt.Errorf("no solc output file or directory provided")
+	}
+
+	var contracts []*compile.SolidityContract
+	for _, dir := range solcFileOrDirs {
+		err := filepath.Walk(dir, func(path string, fi os.FileInfo, err error) error {
+			if err != nil {
+				return fmt.Errorf("error walking solc output location '%s': %v", dir, err)
+			}
+			ext := filepath.Ext(path)
+			if fi.IsDir() || (path != dir && ext != ".json" && ext != ".bin") {
+				return nil
+			}
+			bs, err := ioutil.ReadFile(path)
+			if err != nil {
+				return err
+			}
+			cs, err := ReadSolidityOutput(bs)
+			if err != nil {
+				return fmt.Errorf("error reading solc output at %s: %v", path, err)
+			}
+			contracts = append(contracts, cs...)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	return contracts, nil
+}
+
+// ReadSolidityOutput reads the contracts from the standard JSON output of solc, or from a single contract as written
+// by burrow deploy
+func ReadSolidityOutput(bs []byte) ([]*compile.SolidityContract, error) {
+	output := new(compile.SolidityOutput)
+	err := json.Unmarshal(bs, output)
+	if err != nil {
+		return nil, fmt.Errorf("could not read solc output: %v", err)
+	}
+	if len(output.Contracts) == 0 {
+		contract := new(compile.SolidityContract)
+		err = json.Unmarshal(bs, contract)
+		if err != nil || len(contract.Abi) == 0 {
+			return nil, fmt.Errorf("no contracts found in solc output")
+		}
+		return []*compile.SolidityContract{contract}, nil
+	}
+	var contracts []*compile.SolidityContract
+	for _, file := range sortedKeys(output.Contracts) {
+		for _, name := range sortedKeys(output.Contracts[file]) {
+			contract := output.Contracts[file][name]
+			contracts = append(contracts, &contract)
+		}
+	}
+	return contracts, nil
+}
+
+type eventDoc struct {
+	spec *abi.EventSpec
+	// Directives of the event and of each of its parameters by name
+	directives      map[string]string
+	paramDirectives map[string]map[string]string
+	deleteMarker    string
+}
+
+// GenerateSpecFromSolidity creates a spec projecting each event of contracts into a table of its own (or that named by
+// its natspec) with a column for each of its parameters. The primary key is made up of the parameters marked primary
+// in the natspec of the events of a table, or failing that of the indexed parameters of any event of the table that
+// deletes rows, otherwise the table is a log of events. Also returns warnings about anything that could not be
+// projected.
+func GenerateSpecFromSolidity(contracts ...*compile.SolidityContract) (types.ProjectionSpec, []string, error) {
+	var warnings []string
+	var events []*eventDoc
+	byID := make(map[abi.EventID]bool)
+	byName := make(map[string]*eventDoc)
+
+	for _, contract := range contracts {
+		if len(contract.Abi) == 0 {
+			continue
+		}
+		spec, err := abi.ReadSpec(contract.Abi)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not read ABI: %v", err)
+		}
+		docs, err := readEventDocs(contract.Devdoc, contract.Userdoc)
+		if err != nil {
+			return nil, nil, err
+		}
+		for _, name := range sortedKeys(spec.EventsByName) {
+			ev := spec.EventsByName[name]
+			// Contracts repeat the events they inherit
+			if ev.Anonymous || byID[ev.ID] {
+				continue
+			}
+			byID[ev.ID] = true
+			doc := docs[ev.ID]
+			if doc == nil {
+				doc = &eventDoc{directives: make(map[string]string), paramDirectives: make(map[string]map[string]string)}
+			}
+			doc.spec = ev
+			if _, ok := doc.directives[SkipDirective]; ok {
+				continue
+			}
+			if other, ok := byName[ev.Name]; ok {
+				return nil, nil, fmt.Errorf("events %v and %v share a name so cannot be told apart by their filters, "+
+					"mark one with vent:%s", other.spec, ev, SkipDirective)
+			}
+			byName[ev.Name] = doc
+			for _, in := range ev.Inputs {
+				if _, ok := doc.paramDirectives[in.Name][DeleteDirective]; ok || in.Name == DeleteMarkerField {
+					doc.deleteMarker = in.Name
+				}
+			}
+			events = append(events, doc)
+		}
+	}
+
+	// Parameters map to the same columns in every event of a table so their primary, index, string, and column
+	// directives apply to the parameter of that name across the table
+	tableDirectives := make(map[string]map[string]map[string]string)
+	for _, doc := range events {
+		table := doc.table()
+		if tableDirectives[table] == nil {
+			tableDirectives[table] = make(map[string]map[string]string)
+		}
+		for _, in := range doc.spec.Inputs {
+			for _, directive := range []string{PrimaryDirective, IndexDirective, StringDirective, ColumnDirective} {
+				if value, ok := doc.paramDirectives[in.Name][directive]; ok {
+					setDirective(tableDirectives[table], in.Name, directive, value)
+				}
+			}
+		}
+	}
+	for _, doc := range events {
+		table := doc.table()
+		if doc.deleteMarker == "" || hasDirective(tableDirectives[table], PrimaryDirective) {
+			continue
+		}
+		// The row an event deletes must be identified by its key, which is what events are indexed by
+		for _, in := range doc.spec.Inputs {
+			if in.Indexed && in.Name != doc.deleteMarker {
+				setDirective(tableDirectives[table], in.Name, PrimaryDirective, "")
+			}
+		}
+		if !hasDirective(tableDirectives[table], PrimaryDirective) {
+			return nil, nil, fmt.Errorf("event %v deletes rows of table %s but there is no primary key, mark its "+
+				"key parameters with vent:%s", doc.spec, table, PrimaryDirective)
+		}
+	}
+
+	var projectionSpec types.ProjectionSpec
+	for _, doc := range events {
+		eventClass := &types.EventClass{
+			TableName:         doc.table(),
+			Filter:            fmt.Sprintf("EventName = '%s'", doc.spec.Name),
+			DeleteMarkerField: doc.deleteMarker,
+		}
+		for i, in := range doc.spec.Inputs {
+			if _, ok := doc.paramDirectives[in.Name][SkipDirective]; ok || in.Name == doc.deleteMarker {
+				continue
+			}
+			if in.Name == "" || in.IsArray {
+				warnings = append(warnings, fmt.Sprintf("cannot project parameter %d of event %v", i, doc.spec))
+				continue
+			}
+			directives := tableDirectives[eventClass.TableName][in.Name]
+			_, primary := directives[PrimaryDirective]
+			// Events are looked up by their indexed parameters so their rows are likely to be too
+			_, index := directives[IndexDirective]
+			mapping := &types.EventFieldMapping{
+				Field:      in.Name,
+				ColumnName: in.Name,
+				Type:       in.EVM.GetSignature(),
+				Primary:    primary,
+				Index:      !primary && (index || in.Indexed),
+				Notify:     notifyChannels(eventClass.TableName, doc.directives, doc.paramDirectives[in.Name]),
+			}
+			if _, _, err := getSQLType(mapping.Type, false); err != nil {
+				warnings = append(warnings, fmt.Sprintf("cannot project parameter %s of event %v: %v", in.Name,
+					doc.spec, err))
+				continue
+			}
+			if column := directives[ColumnDirective]; column != "" {
+				mapping.ColumnName = column
+			}
+			if _, ok := directives[StringDirective]; ok {
+				if _, ok := in.EVM.(abi.EVMBytes); !ok || in.Hashed {
+					warnings = append(warnings, fmt.Sprintf("cannot project parameter %s of event %v as a string",
+						in.Name, doc.spec))
+				} else {
+					mapping.BytesToString = true
+				}
+			}
+			eventClass.FieldMappings = append(eventClass.FieldMappings, mapping)
+		}
+		if len(eventClass.FieldMappings) == 0 {
+			warnings = append(warnings, fmt.Sprintf("event %v has no parameters to project", doc.spec))
+			continue
+		}
+		projectionSpec = append(projectionSpec, eventClass)
+	}
+
+	sort.SliceStable(projectionSpec, func(i, j int) bool {
+		return projectionSpec[i].TableName < projectionSpec[j].TableName
+	})
+
+	// Check the spec would be loaded
+	bs, err := json.Marshal(projectionSpec)
+	if err != nil {
+		return nil, nil, err
+	}
+	_, err = NewProjectionFromBytes(bs)
+	if err != nil {
+		return nil, nil, fmt.Errorf("generated spec is not valid: %v", err)
+	}
+	return projectionSpec, warnings, nil
+}
+
+func (doc *eventDoc) table() string {
+	if table := doc.directives[TableDirective]; table != "" {
+		return table
+	}
+	return doc.spec.Name
+}
+
+// readEventDocs reads the directives from the natspec of each event in devdoc and userdoc by event ID
+func readEventDocs(devdoc, userdoc json.RawMessage) (map[abi.EventID]*eventDoc, error) {
+	type natspec struct {
+		Events map[string]map[string]json.RawMessage
+	}
+	docs := make(map[abi.EventID]*eventDoc)
+	for _, bs := range []json.RawMessage{devdoc, userdoc} {
+		if len(bs) == 0 {
+			continue
+		}
+		ns := new(natspec)
+		err := json.Unmarshal(bs, ns)
+		if err != nil {
+			return nil, fmt.Errorf("could not read natspec: %v", err)
+		}
+		for signature, tags := range ns.Events {
+			// Natspec is keyed by the signature from which the event ID is derived
+			id := abi.GetEventID(signature)
+			doc := docs[id]
+			if doc == nil {
+				doc = &eventDoc{directives: make(map[string]string), paramDirectives: make(map[string]map[string]string)}
+				docs[id] = doc
+			}
+			for tag, value := range tags {
+				if tag == "params" {
+					params := make(map[string]string)
+					err = json.Unmarshal(value, &params)
+					if err != nil {
+						return nil, fmt.Errorf("could not read natspec params of event %s: %v", signature, err)
+					}
+					for param, text := range params {
+						for directive, value := range readDirectives(text) {
+							setDirective(doc.paramDirectives, param, directive, value)
+						}
+					}
+					continue
+				}
+				var text string
+				if json.Unmarshal(value, &text) == nil {
+					for directive, value := range readDirectives(text) {
+						doc.directives[directive] = value
+					}
+				}
+			}
+		}
+	}
+	return docs, nil
+}
+
+func setDirective(paramDirectives map[string]map[string]string, param, directive, value string) {
+	if paramDirectives[param] == nil {
+		paramDirectives[param] = make(map[string]string)
+	}
+	paramDirectives[param][directive] = value
+}
+
+func hasDirective(paramDirectives map[string]map[string]string, directive string) bool {
+	for _, directives := range paramDirectives {
+		if _, ok := directives[directive]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+func readDirectives(text string) map[string]string {
+	directives := make(map[string]string)
+	for _, match := range directiveRegex.FindAllStringSubmatch(text, -1) {
+		directives[match[1]] = match[2]
+	}
+	return directives
+}
+
+// notifyChannels returns the channels named by the notify directives, where a notify directive without channels
+// names the channel of the table
+func notifyChannels(table string, directives ...map[string]string) []string {
+	var channels []string
+	seen := make(map[string]bool)
+	for _, ds := range directives {
+		value, ok := ds[NotifyDirective]
+		if !ok {
+			continue
+		}
+		if value == "" {
+			value = table
+		}
+		for _, channel := range strings.Split(value, ",") {
+			if channel != "" && !seen[channel] {
+				seen[channel] = true
+				channels = append(channels, channel)
+			}
+		}
+	}
+	return channels
+}
+
+func sortedKeys(m interface{}) []string {
+	keys := reflect.ValueOf(m).MapKeys()
+	strs := make([]string, len(keys))
+	for i, key := range keys {
+		strs[i] = key.String()
+	}
+	sort.Strings(strs)
+	return strs
+}
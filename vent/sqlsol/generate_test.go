@@ -51,3 +51,116 @@ func TestGenerateSpecFromAbis(t *testing.T) {
 			},
 		})
 }
+
+func TestGenerateSpecFromSolidity(t *testing.T) {
+	// As output by solc --standard-json with natspec for the events
+	contracts, err := sqlsol.ReadSolidityOutput([]byte(`{
+  "contracts": {
+    "Things.sol": {
+      "Things": {
+        "abi": [
+          {"type": "event", "name": "UpdateThing", "inputs": [
+            {"name": "name", "type": "bytes32", "indexed": true},
+            {"name": "key", "type": "bytes32", "indexed": true},
+            {"name": "description", "type": "bytes32", "indexed": false},
+            {"name": "tags", "type": "uint256[]", "indexed": false}]},
+          {"type": "event", "name": "DeleteThing", "inputs": [
+            {"name": "name", "type": "bytes32", "indexed": true},
+            {"name": "key", "type": "bytes32", "indexed": true},
+            {"name": "__DELETE__", "type": "int256", "indexed": false}]},
+          {"type": "event", "name": "Transfer", "inputs": [
+            {"name": "from", "type": "address", "indexed": true},
+            {"name": "to", "type": "address", "indexed": true},
+            {"name": "amount", "type": "uint256", "indexed": false}]},
+          {"type": "event", "name": "Debug", "inputs": [
+            {"name": "message", "type": "string", "indexed": false}]}
+        ],
+        "devdoc": {
+          "events": {
+            "UpdateThing(bytes32,bytes32,bytes32,uint256[])": {
+              "details": "Thing added or changed vent:table=Things vent:notify",
+              "params": {
+                "name": "Name of the thing vent:string",
+                "description": "What the thing is vent:column=desc vent:notify=descriptions vent:index"
+              }
+            },
+            "DeleteThing(bytes32,bytes32,int256)": {
+              "custom:vent": "vent:table=Things"
+            },
+            "Debug(string)": {
+              "details": "vent:skip"
+            },
+            "Transfer(address,address,uint256)": {
+              "params": {
+                "to": "vent:skip"
+              }
+            }
+          }
+        }
+      }
+    },
+    "Token.sol": {
+      "Token": {
+        "abi": [
+          {"type": "event", "name": "Transfer", "inputs": [
+            {"name": "from", "type": "address", "indexed": true},
+            {"name": "to", "type": "address", "indexed": true},
+            {"name": "amount", "type": "uint256", "indexed": false}]}
+        ]
+      }
+    }
+  }
+}`))
+	require.NoError(t, err)
+	require.Len(t, contracts, 2)
+
+	spec, warnings, err := sqlsol.GenerateSpecFromSolidity(contracts...)
+	require.NoError(t, err)
+	require.Len(t, warnings, 1)
+	require.Contains(t, warnings[0], "UpdateThing")
+
+	require.Equal(t, types.ProjectionSpec{
+		{
+			TableName:         "Things",
+			Filter:            "EventName = 'DeleteThing'",
+			DeleteMarkerField: "__DELETE__",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "name", ColumnName: "name", Type: "bytes32", Primary: true, BytesToString: true},
+				{Field: "key", ColumnName: "key", Type: "bytes32", Primary: true},
+			},
+		},
+		{
+			TableName: "Things",
+			Filter:    "EventName = 'UpdateThing'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "name", ColumnName: "name", Type: "bytes32", Primary: true, BytesToString: true,
+					Notify: []string{"Things"}},
+				{Field: "key", ColumnName: "key", Type: "bytes32", Primary: true, Notify: []string{"Things"}},
+				{Field: "description", ColumnName: "desc", Type: "bytes32", Index: true,
+					Notify: []string{"Things", "descriptions"}},
+			},
+		},
+		{
+			TableName: "Transfer",
+			Filter:    "EventName = 'Transfer'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "from", ColumnName: "from", Type: "address", Index: true},
+				{Field: "amount", ColumnName: "amount", Type: "uint256"},
+			},
+		},
+	}, spec)
+
+	t.Run("Deleting without a key", func(t *testing.T) {
+		contracts, err := sqlsol.ReadSolidityOutput([]byte(`{
+  "Abi": [
+    {"type": "event", "name": "Remove", "inputs": [
+      {"name": "id", "type": "uint256", "indexed": false},
+      {"name": "__DELETE__", "type": "bool", "indexed": false}]}
+  ]
+}`))
+		require.NoError(t, err)
+		_, _, err = sqlsol.GenerateSpecFromSolidity(contracts...)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "no primary key")
+	})
+}
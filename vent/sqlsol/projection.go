@@ -18,8 +18,9 @@ import (
 
 // Projection contains EventTable, Event & Abi specifications
 type Projection struct {
-	Tables types.EventTables
-	Spec   types.ProjectionSpec
+	Tables    types.EventTables
+	Spec      types.ProjectionSpec
+	StateSpec types.StateSpec
 }
 
 // NewProjectionFromBytes creates a Projection from a stream of bytes
@@ -111,6 +112,13 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 			return nil, fmt.Errorf("no DeleteMarkerField allowed if no primary key on %v", eventClass)
 		}
 
+		// aggregates are maintained per primary key
+		for _, mapping := range eventClass.FieldMappings {
+			if !primary && mapping.Aggregate != "" {
+				return nil, fmt.Errorf("no Aggregate allowed if no primary key on %v", eventClass)
+			}
+		}
+
 		// Add the global mappings
 		if primary {
 			eventClass.FieldMappings = append(getGlobalFieldMappings(), eventClass.FieldMappings...)
@@ -133,13 +141,26 @@ func NewProjection(spec types.ProjectionSpec) (*Projection, error) {
 			}
 
 			columns = append(columns, &types.SQLTableColumn{
-				Name:    mapping.ColumnName,
-				Type:    sqlType,
-				Primary: mapping.Primary,
-				Length:  sqlTypeLength,
+				Name:      mapping.ColumnName,
+				Type:      sqlType,
+				Primary:   mapping.Primary,
+				Length:    sqlTypeLength,
+				Aggregate: mapping.Aggregate,
+				Index:     mapping.Index,
 			})
 		}
 
+		// rows maintaining aggregates record their index within the block so that a block is only applied once
+		for _, mapping := range eventClass.FieldMappings {
+			if mapping.Aggregate != "" {
+				columns = append(columns, &types.SQLTableColumn{
+					Name: types.DefaultSQLColumnNames.RowIndex,
+					Type: types.SQLColumnTypeBigInt,
+				})
+				break
+			}
+		}
+
 		// Allow for compatible composition of tables
 		var err error
 		tables[eventClass.TableName], err = mergeTables(tables[eventClass.TableName],
@@ -186,6 +207,25 @@ func (p *Projection) GetColumn(tableName, columnName string) (*types.SQLTableCol
 	return nil, fmt.Errorf("GetColumn: table does not exist projection: %s ", tableName)
 }
 
+// TableSpecs returns the JSON of the event classes projected into each table so that changes to them can be detected
+func (p *Projection) TableSpecs() (map[string]string, error) {
+	classes := make(map[string][]string)
+	for _, eventClass := range p.Spec {
+		bs, err := json.Marshal(eventClass)
+		if err != nil {
+			return nil, fmt.Errorf("could not marshal event class %v: %v", eventClass, err)
+		}
+		classes[eventClass.TableName] = append(classes[eventClass.TableName], string(bs))
+	}
+	specs := make(map[string]string, len(classes))
+	for tableName, jsons := range classes {
+		// The order in which classes are given is not significant
+		sort.Strings(jsons)
+		specs[tableName] = "[" + strings.Join(jsons, ",") + "]"
+	}
+	return specs, nil
+}
+
 func ValidateJSONSpec(bs []byte) error {
 	schemaLoader := gojsonschema.NewGoLoader(types.ProjectionSpecSchema())
 	specLoader := gojsonschema.NewBytesLoader(bs)
@@ -269,6 +309,8 @@ func getSQLType(evmSignature string, bytesToString bool) (types.SQLColumnType, i
 		} else {
 			return types.SQLColumnTypeNumeric, 0, nil
 		}
+	case evmSignature == types.EventFieldTypeTimestamp:
+		return types.SQLColumnTypeTimeStamp, 0, nil
 	default:
 		return -1, 0, fmt.Errorf("do not know how to map evmSignature: %s ", evmSignature)
 	}
@@ -380,7 +422,8 @@ func mergeTables(tables ...*types.SQLTable) (*types.SQLTable, error) {
 						return nil, fmt.Errorf("cannot merge event class tables for %s because of "+
 							"conflicting columns: %v and %v", t.Name, columnA, columnB)
 					}
-					// Just keep existing column from A - they match
+					// Just keep existing column from A - they match - indexed if either is
+					columnA.Index = columnA.Index || columnB.Index
 				} else {
 					// Add as new column
 					table.Columns = append(table.Columns, columnB)
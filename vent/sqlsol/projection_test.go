@@ -228,6 +228,125 @@ func TestProjectionSpec(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestTransformedFieldMapping(t *testing.T) {
+	transferSpec := func(transform string) types.ProjectionSpec {
+		return types.ProjectionSpec{
+			{
+				TableName: "Transfers",
+				Filter:    "EventName = 'Transfer'",
+				FieldMappings: []*types.EventFieldMapping{
+					{Field: "amount", Type: "uint256", ColumnName: "amount"},
+					{Transform: transform, Type: types.EventFieldTypeString, ColumnName: "tokens"},
+					{Transform: "timestamp(blockTime)", Type: types.EventFieldTypeTimestamp, ColumnName: "time"},
+				},
+			},
+		}
+	}
+	spec := transferSpec("scale(amount, 18)")
+	projection, err := sqlsol.NewProjection(spec)
+	require.NoError(t, err)
+	column, err := projection.GetColumn("Transfers", "time")
+	require.NoError(t, err)
+	require.Equal(t, types.SQLColumnTypeTimeStamp, column.Type)
+	// Transformed mappings are not looked up by field
+	require.Equal(t, "amount", spec[0].GetFieldMapping("amount").ColumnName)
+	require.Nil(t, spec[0].GetFieldMapping(""))
+
+	_, err = sqlsol.NewProjection(transferSpec("scale(amount)"))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "cannot take 1 arguments")
+
+	_, err = sqlsol.NewProjection(transferSpec(""))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "one of Field or Transform is required")
+
+	spec = transferSpec("scale(amount, 18)")
+	spec[0].GetFieldMapping("amount").NullOnError = true
+	_, err = sqlsol.NewProjection(spec)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "NullOnError requires a Transform")
+}
+
+func TestAggregateFieldMapping(t *testing.T) {
+	balanceSpec := func(aggregate string, primary bool) types.ProjectionSpec {
+		return types.ProjectionSpec{
+			{
+				TableName: "Balances",
+				Filter:    "EventName = 'Transfer'",
+				FieldMappings: []*types.EventFieldMapping{
+					{Field: "to", Type: types.EventFieldTypeAddress, ColumnName: "holder", Primary: primary},
+					{Field: "amount", Type: "uint256", ColumnName: "balance", Aggregate: aggregate},
+					{Type: "uint64", ColumnName: "transfers", Aggregate: types.AggregateCount},
+				},
+			},
+			{
+				TableName: "Balances",
+				Filter:    "EventName = 'Transfer'",
+				FieldMappings: []*types.EventFieldMapping{
+					{Field: "from", Type: types.EventFieldTypeAddress, ColumnName: "holder", Primary: primary},
+					{Transform: "neg(amount)", Type: "uint256", ColumnName: "balance", Aggregate: aggregate},
+				},
+			},
+		}
+	}
+	projection, err := sqlsol.NewProjection(balanceSpec(types.AggregateSum, true))
+	require.NoError(t, err)
+	column, err := projection.GetColumn("Balances", "balance")
+	require.NoError(t, err)
+	require.Equal(t, types.AggregateSum, column.Aggregate)
+	column, err = projection.GetColumn("Balances", "transfers")
+	require.NoError(t, err)
+	require.Equal(t, types.AggregateCount, column.Aggregate)
+
+	_, err = sqlsol.NewProjection(balanceSpec(types.AggregateSum, false))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "no Aggregate allowed if no primary key")
+
+	_, err = sqlsol.NewProjection(balanceSpec("average", true))
+	require.Error(t, err)
+
+	spec := balanceSpec(types.AggregateSum, true)
+	spec[1].FieldMappings[1].Aggregate = types.AggregateMax
+	_, err = sqlsol.NewProjection(spec)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "conflicting columns")
+
+	spec = balanceSpec(types.AggregateSum, true)
+	spec[0].FieldMappings[0].Aggregate = types.AggregateCount
+	_, err = sqlsol.NewProjection(spec)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "a primary key column cannot be an aggregate")
+}
+
+func TestIndexedFieldMapping(t *testing.T) {
+	projection, err := sqlsol.NewProjection(types.ProjectionSpec{
+		{
+			TableName: "Transfers",
+			Filter:    "EventName = 'Transfer'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "from", Type: types.EventFieldTypeAddress, ColumnName: "holder"},
+				{Field: "amount", Type: "uint256", ColumnName: "amount"},
+			},
+		},
+		{
+			TableName: "Transfers",
+			Filter:    "EventName = 'Mint'",
+			FieldMappings: []*types.EventFieldMapping{
+				{Field: "to", Type: types.EventFieldTypeAddress, ColumnName: "holder", Index: true},
+				{Field: "amount", Type: "uint256", ColumnName: "amount"},
+			},
+		},
+	})
+	require.NoError(t, err)
+	// A column is indexed if any event class projecting into its table indexes it
+	column, err := projection.GetColumn("Transfers", "holder")
+	require.NoError(t, err)
+	require.True(t, column.Index)
+	column, err = projection.GetColumn("Transfers", "amount")
+	require.NoError(t, err)
+	require.False(t, column.Index)
+}
+
 func TestWithNoPrimaryKey(t *testing.T) {
 	tableName := "BurnNotices"
 	spec := types.ProjectionSpec{
@@ -12,6 +12,10 @@ type SpecOpt uint64
 const (
 	Block SpecOpt = 1 << iota
 	Tx
+	// Project several chains into the same tables by making the chain ID part of every table's primary key
+	MultiChain
+	// Record the events that could not be decoded for want of an ABI in a dead letter table
+	DeadLetter
 )
 
 const (
@@ -25,10 +29,15 @@ func (so SpecOpt) Enabled(opt SpecOpt) bool {
 
 // SpecLoader loads spec files and parses them
 func SpecLoader(specFileOrDirs []string, opts SpecOpt) (*Projection, error) {
+	return ProjectionLoader(specFileOrDirs, nil, opts)
+}
+
+// ProjectionLoader loads event and state spec files and parses them
+func ProjectionLoader(specFileOrDirs, stateSpecFileOrDirs []string, opts SpecOpt) (*Projection, error) {
 	var projection *Projection
 	var err error
 
-	if len(specFileOrDirs) == 0 {
+	if len(specFileOrDirs) == 0 && len(stateSpecFileOrDirs) == 0 {
 		return nil, fmt.Errorf("please provide a spec file or directory")
 	}
 
@@ -37,6 +46,17 @@ func SpecLoader(specFileOrDirs []string, opts SpecOpt) (*Projection, error) {
 		return nil, fmt.Errorf("error parsing spec: %v", err)
 	}
 
+	if len(stateSpecFileOrDirs) > 0 {
+		stateSpec, err := NewStateSpecFromFolder(stateSpecFileOrDirs...)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing state spec: %v", err)
+		}
+		err = projection.AddStateSpec(stateSpec)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing state spec: %v", err)
+		}
+	}
+
 	// add block & tx to tables definition
 	if opts.Enabled(Block) {
 		for k, v := range blockTables() {
@@ -48,10 +68,89 @@ func SpecLoader(specFileOrDirs []string, opts SpecOpt) (*Projection, error) {
 			projection.Tables[k] = v
 		}
 	}
+	if opts.Enabled(DeadLetter) {
+		for k, v := range deadLetterTables() {
+			projection.Tables[k] = v
+		}
+	}
+	if opts.Enabled(MultiChain) {
+		for _, table := range projection.Tables {
+			addChainIDKey(table)
+		}
+	}
 
 	return projection, nil
 }
 
+func deadLetterTables() types.EventTables {
+	return types.EventTables{
+		tables.DeadLetter: &types.SQLTable{
+			Name: tables.DeadLetter,
+			Columns: []*types.SQLTableColumn{
+				{
+					Name:    columns.Height,
+					Type:    types.SQLColumnTypeVarchar,
+					Length:  100,
+					Primary: true,
+				},
+				{
+					Name:    columns.TxHash,
+					Type:    types.SQLColumnTypeVarchar,
+					Length:  txs.HashLengthHex,
+					Primary: true,
+				},
+				{
+					Name:    columns.EventIndex,
+					Type:    types.SQLColumnTypeNumeric,
+					Primary: true,
+				},
+				{
+					Name: columns.Address,
+					Type: types.SQLColumnTypeVarchar,
+					// hex of a 20 byte address
+					Length: 40,
+				},
+				{
+					Name: columns.EventID,
+					Type: types.SQLColumnTypeVarchar,
+					// hex of a 32 byte topic
+					Length: 64,
+				},
+				// The JSON array of the hex of each topic
+				{
+					Name: columns.Topics,
+					Type: types.SQLColumnTypeJSON,
+				},
+				{
+					Name: columns.LogData,
+					Type: types.SQLColumnTypeText,
+				},
+				// Why no ABI could be found for the event
+				{
+					Name: columns.Error,
+					Type: types.SQLColumnTypeText,
+				},
+			},
+		},
+	}
+}
+
+// addChainIDKey makes the chain ID column part of table's primary key, adding it if necessary
+func addChainIDKey(table *types.SQLTable) {
+	for _, column := range table.Columns {
+		if column.Name == columns.ChainID {
+			column.Primary = true
+			return
+		}
+	}
+	table.Columns = append([]*types.SQLTableColumn{{
+		Name:    columns.ChainID,
+		Type:    types.SQLColumnTypeVarchar,
+		Length:  100,
+		Primary: true,
+	}}, table.Columns...)
+}
+
 // getBlockTxTablesDefinition returns block & transaction structures
 func blockTables() types.EventTables {
 	return types.EventTables{
@@ -33,3 +33,50 @@ func TestSpecLoader(t *testing.T) {
 			projection.Tables[tables.Tx].GetColumn(columns.TxHash).Name)
 	})
 }
+
+func TestSpecLoaderMultiChain(t *testing.T) {
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	specFile := []string{path.Join(dir, "../test/sqlsol_view.json")}
+
+	projection, err := sqlsol.SpecLoader(specFile, sqlsol.BlockTx|sqlsol.MultiChain)
+	require.NoError(t, err)
+
+	// Every table is keyed by chain so that chains do not overwrite each other's rows
+	for name, table := range projection.Tables {
+		column := table.GetColumn(columns.ChainID)
+		require.NotNil(t, column, "table %s should have a chain ID column", name)
+		require.True(t, column.Primary, "chain ID should be part of the primary key of table %s", name)
+	}
+
+	projection, err = sqlsol.SpecLoader(specFile, sqlsol.BlockTx)
+	require.NoError(t, err)
+	require.Nil(t, projection.Tables[tables.Block].GetColumn(columns.ChainID))
+	require.False(t, projection.Tables["EventTest"].GetColumn(columns.ChainID).Primary)
+}
+
+func TestProjectionLoaderState(t *testing.T) {
+	dir, err := os.Getwd()
+	require.NoError(t, err)
+	stateSpecFile := []string{path.Join(dir, "../test/sqlsol_state.json")}
+
+	projection, err := sqlsol.ProjectionLoader(nil, stateSpecFile, sqlsol.MultiChain)
+	require.NoError(t, err)
+	require.Len(t, projection.StateSpec, 2)
+	require.Len(t, projection.Tables, 2)
+
+	balances := projection.Tables["TokenBalances"]
+	for _, name := range []string{columns.Address, columns.StorageKey, columns.ChainID} {
+		require.True(t, balances.GetColumn(name).Primary, "%s should be part of the primary key", name)
+	}
+	require.Equal(t, types.SQLColumnTypeVarchar, balances.GetColumn(columns.StorageKey).Type)
+	require.Equal(t, types.SQLColumnTypeBigInt, balances.GetColumn("balance").Type)
+	require.Equal(t, types.SQLColumnTypeInt, balances.GetColumn("decimals").Type)
+
+	accounts := projection.Tables["Accounts"]
+	require.Nil(t, accounts.GetColumn(columns.StorageKey))
+	require.Equal(t, types.SQLColumnTypeText, accounts.GetColumn("roles").Type)
+
+	_, err = sqlsol.ProjectionLoader(nil, nil, sqlsol.None)
+	require.Error(t, err)
+}
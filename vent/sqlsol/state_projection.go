@@ -0,0 +1,159 @@
+package sqlsol
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/xeipuuv/gojsonschema"
+)
+
+// NewStateSpecFromFolder reads the state classes from a folder containing state spec files
+func NewStateSpecFromFolder(stateSpecFileOrDirs ...string) (types.StateSpec, error) {
+	spec := types.StateSpec{}
+
+	for _, dir := range stateSpecFileOrDirs {
+		err := filepath.Walk(dir, func(path string, _ os.FileInfo, err error) error {
+			if err != nil {
+				return fmt.Errorf("error walking state spec files location '%s': %v", dir, err)
+			}
+			if filepath.Ext(path) == ".json" {
+				bs, err := readFile(path)
+				if err != nil {
+					return fmt.Errorf("error reading state spec file '%s': %v", path, err)
+				}
+
+				err = ValidateJSONStateSpec(bs)
+				if err != nil {
+					return fmt.Errorf("could not validate state spec file '%s': %v", path, err)
+				}
+
+				fileStateSpec := types.StateSpec{}
+				err = json.Unmarshal(bs, &fileStateSpec)
+				if err != nil {
+					return fmt.Errorf("error reading state spec file '%s': %v", path, err)
+				}
+
+				spec = append(spec, fileStateSpec...)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("NewStateSpecFromFolder(): %v", err)
+		}
+	}
+
+	return spec, nil
+}
+
+// AddStateSpec adds the tables into which the state classes of spec project
+func (p *Projection) AddStateSpec(spec types.StateSpec) error {
+	for _, stateClass := range spec {
+		if err := stateClass.Validate(); err != nil {
+			return fmt.Errorf("validation error on %v: %v", stateClass, err)
+		}
+
+		fields, err := stateClass.Fields()
+		if err != nil {
+			return err
+		}
+
+		tableColumns := []*types.SQLTableColumn{
+			{Name: columns.Address, Type: types.SQLColumnTypeVarchar, Length: 40, Primary: true},
+		}
+
+		if stateClass.Mapping != "" {
+			keyType, err := stateClass.MappingKeyType()
+			if err != nil {
+				return err
+			}
+			evmType, err := keyType.EVMType()
+			if err != nil {
+				return fmt.Errorf("could not project keys of mapping '%s': %v", stateClass.Mapping, err)
+			}
+			column, err := stateColumn(columns.StorageKey, evmType)
+			if err != nil {
+				return err
+			}
+			column.Primary = true
+			tableColumns = append(tableColumns, column)
+		}
+
+		for _, name := range []string{columns.ChainID, columns.Height} {
+			column, err := stateColumn(name, stateGlobalColumnTypes[name])
+			if err != nil {
+				return err
+			}
+			tableColumns = append(tableColumns, column)
+		}
+
+		channels := make(map[string][]string)
+		for _, field := range fields {
+			for _, column := range tableColumns {
+				if column.Name == field.ColumnName {
+					return fmt.Errorf("duplicated column name: '%s' in table '%s'", column.Name, stateClass.TableName)
+				}
+			}
+			column, err := stateColumn(field.ColumnName, field.Type)
+			if err != nil {
+				return err
+			}
+			for _, channel := range field.Notify {
+				channels[channel] = append(channels[channel], field.ColumnName)
+			}
+			tableColumns = append(tableColumns, column)
+		}
+
+		p.Tables[stateClass.TableName], err = mergeTables(p.Tables[stateClass.TableName],
+			&types.SQLTable{
+				Name:           stateClass.TableName,
+				NotifyChannels: channels,
+				Columns:        tableColumns,
+			})
+		if err != nil {
+			return err
+		}
+		p.StateSpec = append(p.StateSpec, stateClass)
+	}
+
+	return nil
+}
+
+var stateGlobalColumnTypes = map[string]string{
+	columns.ChainID: types.EventFieldTypeString,
+	columns.Height:  types.EventFieldTypeUInt,
+}
+
+func stateColumn(name, evmType string) (*types.SQLTableColumn, error) {
+	sqlType, sqlTypeLength, err := getSQLType(evmType, false)
+	if err != nil {
+		return nil, err
+	}
+	return &types.SQLTableColumn{
+		Name:   name,
+		Type:   sqlType,
+		Length: sqlTypeLength,
+	}, nil
+}
+
+func ValidateJSONStateSpec(bs []byte) error {
+	schemaLoader := gojsonschema.NewGoLoader(types.StateSpecSchema())
+	specLoader := gojsonschema.NewBytesLoader(bs)
+	result, err := gojsonschema.Validate(schemaLoader, specLoader)
+	if err != nil {
+		return fmt.Errorf("could not validate using JSONSchema: %v", err)
+	}
+
+	if !result.Valid() {
+		errs := make([]string, len(result.Errors()))
+		for i, err := range result.Errors() {
+			errs[i] = err.String()
+		}
+		return fmt.Errorf("StateSpec failed JSONSchema validation:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
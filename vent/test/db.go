@@ -12,6 +12,7 @@ import (
 	"github.com/hyperledger/burrow/logging"
 	"github.com/hyperledger/burrow/vent/config"
 	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/sqlsol"
 	"github.com/hyperledger/burrow/vent/types"
 	"github.com/stretchr/testify/require"
 )
@@ -19,6 +20,8 @@ import (
 const (
 	ChainID       = "CHAIN_123"
 	BurrowVersion = "1.0.0"
+	// Override with MYSQL_DB_URL
+	DefaultMySQLDBURL = "root@tcp(localhost:3306)/"
 )
 
 var letters = []rune("abcdefghijklmnopqrstuvwxyz")
@@ -31,11 +34,17 @@ func init() {
 func NewTestDB(t *testing.T, cfg *config.VentConfig) (*sqldb.SQLDB, func()) {
 	t.Helper()
 
-	if cfg.DBAdapter != types.SQLiteDB {
+	switch cfg.DBAdapter {
+	case types.PostgresDB:
 		if dbURL, ok := syscall.Getenv("DB_URL"); ok {
 			t.Logf("Using DB_URL '%s'", dbURL)
 			cfg.DBURL = dbURL
 		}
+	case types.MySQLDB:
+		if dbURL, ok := syscall.Getenv("MYSQL_DB_URL"); ok {
+			t.Logf("Using MYSQL_DB_URL '%s'", dbURL)
+			cfg.DBURL = dbURL
+		}
 	}
 
 	connection := types.SQLConnection{
@@ -43,7 +52,8 @@ func NewTestDB(t *testing.T, cfg *config.VentConfig) (*sqldb.SQLDB, func()) {
 		DBURL:     cfg.DBURL,
 		DBSchema:  cfg.DBSchema,
 
-		Log: logging.NewNoopLogger(),
+		MultiChain: cfg.SpecOpt.Enabled(sqlsol.MultiChain),
+		Log:        logging.NewNoopLogger(),
 	}
 
 	db, err := sqldb.NewSQLDB(connection)
@@ -58,6 +68,10 @@ func NewTestDB(t *testing.T, cfg *config.VentConfig) (*sqldb.SQLDB, func()) {
 			os.Remove(connection.DBURL)
 			os.Remove(connection.DBURL + "-shm")
 			os.Remove(connection.DBURL + "-wal")
+		} else if cfg.DBAdapter == types.MySQLDB {
+			// MySQL schemas are databases which drop their tables with them
+			dropDatabase(db, connection.DBSchema)
+			db.Close()
 		} else {
 			destroySchema(db, connection.DBSchema)
 			db.Close()
@@ -92,6 +106,29 @@ func PostgresVentConfig(grpcAddress string) *config.VentConfig {
 	return cfg
 }
 
+func MySQLVentConfig(grpcAddress string) *config.VentConfig {
+	cfg := config.DefaultVentConfig()
+	cfg.DBSchema = fmt.Sprintf("test_%s", randString(10))
+	cfg.DBAdapter = types.MySQLDB
+	cfg.DBURL = DefaultMySQLDBURL
+	cfg.GRPCAddr = grpcAddress
+	cfg.AnnounceEvery = time.Millisecond * 100
+	return cfg
+}
+
+func dropDatabase(db *sqldb.SQLDB, dbSchema string) error {
+	query := fmt.Sprintf("DROP DATABASE %s;", db.DBAdapter.SecureName(dbSchema))
+
+	db.Log.InfoMsg("Drop database", "query", query)
+
+	if _, err := db.DB.Exec(query); err != nil {
+		db.Log.InfoMsg("Error dropping database", "err", err)
+		return err
+	}
+
+	return nil
+}
+
 func destroySchema(db *sqldb.SQLDB, dbSchema string) error {
 	db.Log.InfoMsg("Dropping schema")
 	query := fmt.Sprintf("DROP SCHEMA %s CASCADE;", dbSchema)
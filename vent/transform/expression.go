@@ -0,0 +1,159 @@
+package transform
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hyperledger/burrow/logging/errors"
+)
+
+// Instruction is a container suitable for the code tape and the stack to hold values and function applications
+type instruction struct {
+	// A function applied to the arity values on top of the stack
+	function *function
+	arity    int
+	// The name of a field whose value is pushed on to the stack
+	field *string
+	// A literal pushed on to the stack
+	literal *string
+}
+
+func (in *instruction) String() string {
+	switch {
+	case in.function != nil:
+		return fmt.Sprintf("%s/%d", in.function.name, in.arity)
+	case in.field != nil:
+		return *in.field
+	default:
+		return "'" + *in.literal + "'"
+	}
+}
+
+// A function call whose arguments are being parsed
+type frame struct {
+	name  string
+	arity int
+}
+
+// A value expression for the transform grammar
+type Expression struct {
+	// This is our 'bytecode'
+	code []*instruction
+	// Calls whose arguments are being parsed
+	frames []*frame
+	errors errors.MultipleErrors
+}
+
+// Evaluate expects an Execute() to have filled the code of the Expression so it can be run in the little stack machine
+// below
+func (e *Expression) Evaluate(getField func(name string) (interface{}, bool)) (interface{}, error) {
+	if len(e.errors) > 0 {
+		return nil, e.errors
+	}
+	stack := make([]interface{}, 0, len(e.code))
+	for _, in := range e.code {
+		switch {
+		case in.function != nil:
+			if len(stack) < in.arity {
+				return nil, fmt.Errorf("cannot pop %d arguments for %s from stack for transform expression [%v] "+
+					"because stack has %d elements", in.arity, in.function.name, e, len(stack))
+			}
+			args := stack[len(stack)-in.arity:]
+			value, err := in.function.apply(args)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %v", in.function.name, err)
+			}
+			stack = append(stack[:len(stack)-in.arity], value)
+		case in.field != nil:
+			value, ok := getField(*in.field)
+			if !ok {
+				return nil, fmt.Errorf("no field named '%s'", *in.field)
+			}
+			stack = append(stack, value)
+		default:
+			stack = append(stack, *in.literal)
+		}
+	}
+	if len(stack) != 1 {
+		return nil, fmt.Errorf("stack for transform expression [%v] should have exactly one element after "+
+			"evaluation but has %d", e, len(stack))
+	}
+	return stack[0], nil
+}
+
+// Fields returns the names of the fields read by the expression in the order they are first read
+func (e *Expression) Fields() []string {
+	var fields []string
+	seen := make(map[string]struct{})
+	for _, in := range e.code {
+		if in.field != nil {
+			if _, ok := seen[*in.field]; !ok {
+				seen[*in.field] = struct{}{}
+				fields = append(fields, *in.field)
+			}
+		}
+	}
+	return fields
+}
+
+// These methods implement the various visitors that are called in the PEG grammar with statements like
+// { p.Apply() }
+
+func (e *Expression) String() string {
+	strs := make([]string, len(e.code))
+	for i, in := range e.code {
+		strs[i] = in.String()
+	}
+	return strings.Join(strs, ", ")
+}
+
+// Starts a call to the function named name whose arguments follow
+func (e *Expression) Function(name string) {
+	e.frames = append(e.frames, &frame{name: name})
+}
+
+// Counts an argument of the innermost function call
+func (e *Expression) Argument() {
+	e.frames[len(e.frames)-1].arity++
+}
+
+// Applies the innermost function to its arguments
+func (e *Expression) Apply() {
+	last := len(e.frames) - 1
+	fr := e.frames[last]
+	e.frames = e.frames[:last]
+	fn, ok := functions[fr.name]
+	if !ok {
+		e.pushErr(fmt.Errorf("unknown function '%s'", fr.name))
+		return
+	}
+	if fr.arity < fn.minArity || (fn.maxArity >= 0 && fr.arity > fn.maxArity) {
+		e.pushErr(fmt.Errorf("function %s cannot take %d arguments", fr.name, fr.arity))
+		return
+	}
+	e.code = append(e.code, &instruction{
+		function: fn,
+		arity:    fr.arity,
+	})
+}
+
+// Terminals...
+
+func (e *Expression) Field(name string) {
+	e.code = append(e.code, &instruction{
+		field: &name,
+	})
+}
+
+// Literal strings and numbers are both held as strings (as are the integers decoded from events)
+func (e *Expression) Literal(value string) {
+	e.code = append(e.code, &instruction{
+		literal: &value,
+	})
+}
+
+func (e *Expression) pushErr(err error) {
+	if err != nil {
+		e.errors = append(e.errors, err)
+	}
+}
@@ -0,0 +1,266 @@
+package transform
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/elgs/gojq"
+	"github.com/hyperledger/burrow/crypto"
+	hex "github.com/tmthrgd/go-hex"
+)
+
+// Maximum number of decimal places scale will shift by
+const MaxScale = 77
+
+type function struct {
+	name string
+	// The number of arguments taken, maxArity is negative for a variadic function
+	minArity int
+	maxArity int
+	apply    func(args []interface{}) (interface{}, error)
+}
+
+var functions = make(map[string]*function)
+
+func init() {
+	for _, fn := range []*function{
+		// concat(a, b, ...) joins the string values of its arguments
+		{name: "concat", minArity: 1, maxArity: -1, apply: concat},
+		// lower(a) and upper(a) change the case of the string value of a
+		{name: "lower", minArity: 1, maxArity: 1, apply: func(args []interface{}) (interface{}, error) {
+			return strings.ToLower(stringValue(args[0])), nil
+		}},
+		{name: "upper", minArity: 1, maxArity: 1, apply: func(args []interface{}) (interface{}, error) {
+			return strings.ToUpper(stringValue(args[0])), nil
+		}},
+		// string(a) interprets bytes as a UTF-8 string with trailing null bytes removed
+		{name: "string", minArity: 1, maxArity: 1, apply: toString},
+		// hex(a) is the 0x prefixed hex of bytes, an integer, or the bytes of a string
+		{name: "hex", minArity: 1, maxArity: 1, apply: toHex},
+		// checksum(a) is the mixed case (EIP-55) checksum encoding of an address
+		{name: "checksum", minArity: 1, maxArity: 1, apply: checksum},
+		// neg(a) is the integer -a
+		{name: "neg", minArity: 1, maxArity: 1, apply: func(args []interface{}) (interface{}, error) {
+			n, err := intValue(args[0])
+			if err != nil {
+				return nil, err
+			}
+			return new(big.Int).Neg(n).String(), nil
+		}},
+		// scale(a, n) is the integer a divided by 10^n as an exact decimal string with n decimal places
+		{name: "scale", minArity: 2, maxArity: 2, apply: scale},
+		// json(a, path) extracts the value at the dot separated path (using [i] for array indices) from the JSON a
+		{name: "json", minArity: 2, maxArity: 2, apply: jsonExtract},
+		// timestamp(a) is the time at a Unix time in seconds, an RFC3339 string, or a time itself (such as blockTime)
+		{name: "timestamp", minArity: 1, maxArity: 1, apply: timestamp},
+	} {
+		functions[fn.name] = fn
+	}
+}
+
+func concat(args []interface{}) (interface{}, error) {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = stringValue(arg)
+	}
+	return strings.Join(strs, ""), nil
+}
+
+func toString(args []interface{}) (interface{}, error) {
+	if bs, ok := bytesValue(args[0]); ok {
+		// Converting to runes replaces invalid UTF-8 with the replacement character
+		return strings.TrimRight(string([]rune(string(bs))), "\x00"), nil
+	}
+	return stringValue(args[0]), nil
+}
+
+func toHex(args []interface{}) (interface{}, error) {
+	if bs, ok := bytesValue(args[0]); ok {
+		return "0x" + hex.EncodeToString(bs), nil
+	}
+	if n, err := intValue(args[0]); err == nil {
+		if n.Sign() < 0 {
+			return "-0x" + new(big.Int).Neg(n).Text(16), nil
+		}
+		return "0x" + n.Text(16), nil
+	}
+	return "0x" + hex.EncodeToString([]byte(stringValue(args[0]))), nil
+}
+
+func checksum(args []interface{}) (interface{}, error) {
+	var address crypto.Address
+	switch v := deref(args[0]).(type) {
+	case crypto.Address:
+		address = v
+	default:
+		var err error
+		address, err = crypto.AddressFromHexString(strings.TrimPrefix(stringValue(v), "0x"))
+		if err != nil {
+			return nil, fmt.Errorf("could not parse address: %v", err)
+		}
+	}
+	lower := hex.EncodeToString(address.Bytes())
+	hash := crypto.Keccak256([]byte(lower))
+	bs := []byte(lower)
+	for i, c := range bs {
+		// Upper case each letter whose corresponding nibble of the hash is 8 or more
+		nibble := hash[i/2]
+		if i%2 == 0 {
+			nibble >>= 4
+		}
+		if c >= 'a' && nibble&0xf >= 8 {
+			bs[i] = c - 'a' + 'A'
+		}
+	}
+	return "0x" + string(bs), nil
+}
+
+func scale(args []interface{}) (interface{}, error) {
+	n, err := intValue(args[0])
+	if err != nil {
+		return nil, err
+	}
+	places, err := intValue(args[1])
+	if err != nil {
+		return nil, err
+	}
+	if !places.IsInt64() || places.Int64() < 0 || places.Int64() > MaxScale {
+		return nil, fmt.Errorf("can only scale by between 0 and %d decimal places, not %v", MaxScale, places)
+	}
+	if places.Sign() == 0 {
+		return n.String(), nil
+	}
+	digits := new(big.Int).Abs(n).String()
+	shift := int(places.Int64())
+	if len(digits) <= shift {
+		digits = strings.Repeat("0", shift-len(digits)+1) + digits
+	}
+	str := digits[:len(digits)-shift] + "." + digits[len(digits)-shift:]
+	if n.Sign() < 0 {
+		str = "-" + str
+	}
+	return str, nil
+}
+
+func jsonExtract(args []interface{}) (interface{}, error) {
+	jq, err := gojq.NewStringQuery(stringValue(args[0]))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse JSON: %v", err)
+	}
+	value, err := jq.Query(stringValue(args[1]))
+	if err != nil {
+		return nil, err
+	}
+	switch v := value.(type) {
+	case nil, string, bool:
+		return v, nil
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64), nil
+	default:
+		bs, err := json.Marshal(v)
+		if err != nil {
+			return nil, err
+		}
+		return string(bs), nil
+	}
+}
+
+func timestamp(args []interface{}) (interface{}, error) {
+	if t, ok := deref(args[0]).(time.Time); ok {
+		return t.UTC(), nil
+	}
+	if n, err := intValue(args[0]); err == nil {
+		if !n.IsInt64() {
+			return nil, fmt.Errorf("%v is out of range for a Unix time", n)
+		}
+		return time.Unix(n.Int64(), 0).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, stringValue(args[0]))
+	if err != nil {
+		return nil, fmt.Errorf("could not parse time: %v", err)
+	}
+	return t.UTC(), nil
+}
+
+// Values are those decoded from events, possibly through pointers: strings (including integers and addresses), bools,
+// bytes, Go integers, or times along with the results of functions
+
+func deref(value interface{}) interface{} {
+	rv := reflect.ValueOf(value)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return nil
+		}
+		if _, ok := rv.Interface().(*big.Int); ok {
+			break
+		}
+		rv = rv.Elem()
+	}
+	if !rv.IsValid() {
+		return nil
+	}
+	return rv.Interface()
+}
+
+func stringValue(value interface{}) string {
+	if bs, ok := bytesValue(value); ok {
+		return "0x" + hex.EncodeToString(bs)
+	}
+	switch v := deref(value).(type) {
+	case nil:
+		return ""
+	case string:
+		return v
+	case time.Time:
+		return v.UTC().Format(time.RFC3339)
+	case fmt.Stringer:
+		return v.String()
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func bytesValue(value interface{}) ([]byte, bool) {
+	rv := reflect.ValueOf(deref(value))
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		if rv.Type().Elem().Kind() != reflect.Uint8 {
+			return nil, false
+		}
+		bs := make([]byte, rv.Len())
+		reflect.Copy(reflect.ValueOf(bs), rv)
+		return bs, true
+	}
+	return nil, false
+}
+
+func intValue(value interface{}) (*big.Int, error) {
+	value = deref(value)
+	rv := reflect.ValueOf(value)
+	switch rv.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return big.NewInt(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return new(big.Int).SetUint64(rv.Uint()), nil
+	}
+	switch v := value.(type) {
+	case *big.Int:
+		return v, nil
+	case string:
+		n, ok := new(big.Int), false
+		if strings.HasPrefix(v, "0x") {
+			n, ok = n.SetString(v[2:], 16)
+		} else {
+			n, ok = n.SetString(v, 10)
+		}
+		if ok {
+			return n, nil
+		}
+	}
+	return nil, fmt.Errorf("%v is not an integer", value)
+}
@@ -0,0 +1,68 @@
+// Package transform provides a parser and evaluator for the expressions used to derive column values in Vent field
+// mappings, for example:
+//
+//	concat(checksum(owner), ':', scale(amount, 18))
+//
+// See transform.peg for the grammar and functions.go for the functions available.
+package transform
+
+import (
+	"fmt"
+)
+
+// Transform holds the transform string and the parsed expression
+type Transform struct {
+	str    string
+	parser *TransformParser
+}
+
+// New parses the given string and returns a transform or error if the string is invalid or calls an unknown function
+// or a function with the wrong number of arguments
+func New(s string) (*Transform, error) {
+	p := &TransformParser{
+		Buffer: s,
+	}
+	err := p.Init()
+	if err != nil {
+		return nil, err
+	}
+	err = p.Parse()
+	if err != nil {
+		return nil, err
+	}
+	p.Execute()
+	if len(p.errors) > 0 {
+		return nil, p.errors
+	}
+	return &Transform{str: s, parser: p}, nil
+}
+
+// MustParse turns the given string into a transform or panics; for tests or others cases where you know the string is
+// valid.
+func MustParse(s string) *Transform {
+	t, err := New(s)
+	if err != nil {
+		panic(fmt.Sprintf("failed to parse %s: %v", s, err))
+	}
+	return t
+}
+
+// String returns the original string.
+func (t *Transform) String() string {
+	return t.str
+}
+
+// Fields returns the names of the fields the transform reads
+func (t *Transform) Fields() []string {
+	return t.parser.Fields()
+}
+
+// Evaluate returns the value of the transform reading fields with getField. It is an error for the transform to read a
+// field that getField does not have.
+func (t *Transform) Evaluate(getField func(name string) (interface{}, bool)) (interface{}, error) {
+	value, err := t.parser.Evaluate(getField)
+	if err != nil {
+		return nil, fmt.Errorf("could not evaluate transform '%s': %v", t.str, err)
+	}
+	return value, nil
+}
@@ -0,0 +1,804 @@
+package transform
+
+// Code generated by peg vent/transform/transform.peg DO NOT EDIT.
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const endSymbol rune = 1114112
+
+/* The rule types inferred from the grammar are below. */
+type pegRule uint8
+
+const (
+	ruleUnknown pegRule = iota
+	rulee
+	ruleexpr
+	rulecall
+	ruleargs
+	rulefield
+	rulestring
+	rulenumber
+	rulename
+	ruledigit
+	ruleopen
+	ruleclose
+	rulecomma
+	rulesp
+	rulePegText
+	ruleAction0
+	ruleAction1
+	ruleAction2
+	ruleAction3
+	ruleAction4
+	ruleAction5
+	ruleAction6
+)
+
+var rul3s = [...]string{
+	"Unknown",
+	"e",
+	"expr",
+	"call",
+	"args",
+	"field",
+	"string",
+	"number",
+	"name",
+	"digit",
+	"open",
+	"close",
+	"comma",
+	"sp",
+	"PegText",
+	"Action0",
+	"Action1",
+	"Action2",
+	"Action3",
+	"Action4",
+	"Action5",
+	"Action6",
+}
+
+type token32 struct {
+	pegRule
+	begin, end uint32
+}
+
+func (t *token32) String() string {
+	return fmt.Sprintf("\x1B[34m%v\x1B[m %v %v", rul3s[t.pegRule], t.begin, t.end)
+}
+
+type node32 struct {
+	token32
+	up, next *node32
+}
+
+func (node *node32) print(w io.Writer, pretty bool, buffer string) {
+	var print func(node *node32, depth int)
+	print = func(node *node32, depth int) {
+		for node != nil {
+			for c := 0; c < depth; c++ {
+				fmt.Fprintf(w, " ")
+			}
+			rule := rul3s[node.pegRule]
+			quote := strconv.Quote(string(([]rune(buffer)[node.begin:node.end])))
+			if !pretty {
+				fmt.Fprintf(w, "%v %v\n", rule, quote)
+			} else {
+				fmt.Fprintf(w, "\x1B[36m%v\x1B[m %v\n", rule, quote)
+			}
+			if node.up != nil {
+				print(node.up, depth+1)
+			}
+			node = node.next
+		}
+	}
+	print(node, 0)
+}
+
+func (node *node32) Print(w io.Writer, buffer string) {
+	node.print(w, false, buffer)
+}
+
+func (node *node32) PrettyPrint(w io.Writer, buffer string) {
+	node.print(w, true, buffer)
+}
+
+type tokens32 struct {
+	tree []token32
+}
+
+func (t *tokens32) Trim(length uint32) {
+	t.tree = t.tree[:length]
+}
+
+func (t *tokens32) Print() {
+	for _, token := range t.tree {
+		fmt.Println(token.String())
+	}
+}
+
+func (t *tokens32) AST() *node32 {
+	type element struct {
+		node *node32
+		down *element
+	}
+	tokens := t.Tokens()
+	var stack *element
+	for _, token := range tokens {
+		if token.begin == token.end {
+			continue
+		}
+		node := &node32{token32: token}
+		for stack != nil && stack.node.begin >= token.begin && stack.node.end <= token.end {
+			stack.node.next = node.up
+			node.up = stack.node
+			stack = stack.down
+		}
+		stack = &element{node: node, down: stack}
+	}
+	if stack != nil {
+		return stack.node
+	}
+	return nil
+}
+
+func (t *tokens32) PrintSyntaxTree(buffer string) {
+	t.AST().Print(os.Stdout, buffer)
+}
+
+func (t *tokens32) WriteSyntaxTree(w io.Writer, buffer string) {
+	t.AST().Print(w, buffer)
+}
+
+func (t *tokens32) PrettyPrintSyntaxTree(buffer string) {
+	t.AST().PrettyPrint(os.Stdout, buffer)
+}
+
+func (t *tokens32) Add(rule pegRule, begin, end, index uint32) {
+	tree, i := t.tree, int(index)
+	if i >= len(tree) {
+		t.tree = append(tree, token32{pegRule: rule, begin: begin, end: end})
+		return
+	}
+	tree[i] = token32{pegRule: rule, begin: begin, end: end}
+}
+
+func (t *tokens32) Tokens() []token32 {
+	return t.tree
+}
+
+type TransformParser struct {
+	Expression
+
+	Buffer string
+	buffer []rune
+	rules  [22]func() bool
+	parse  func(rule ...int) error
+	reset  func()
+	Pretty bool
+	tokens32
+}
+
+func (p *TransformParser) Parse(rule ...int) error {
+	return p.parse(rule...)
+}
+
+func (p *TransformParser) Reset() {
+	p.reset()
+}
+
+type textPosition struct {
+	line, symbol int
+}
+
+type textPositionMap map[int]textPosition
+
+func translatePositions(buffer []rune, positions []int) textPositionMap {
+	length, translations, j, line, symbol := len(positions), make(textPositionMap, len(positions)), 0, 1, 0
+	sort.Ints(positions)
+
+search:
+	for i, c := range buffer {
+		if c == '\n' {
+			line, symbol = line+1, 0
+		} else {
+			symbol++
+		}
+		if i == positions[j] {
+			translations[positions[j]] = textPosition{line, symbol}
+			for j++; j < length; j++ {
+				if i != positions[j] {
+					continue search
+				}
+			}
+			break search
+		}
+	}
+
+	return translations
+}
+
+type parseError struct {
+	p   *TransformParser
+	max token32
+}
+
+func (e *parseError) Error() string {
+	tokens, err := []token32{e.max}, "\n"
+	positions, p := make([]int, 2*len(tokens)), 0
+	for _, token := range tokens {
+		positions[p], p = int(token.begin), p+1
+		positions[p], p = int(token.end), p+1
+	}
+	translations := translatePositions(e.p.buffer, positions)
+	format := "parse error near %v (line %v symbol %v - line %v symbol %v):\n%v\n"
+	if e.p.Pretty {
+		format = "parse error near \x1B[34m%v\x1B[m (line %v symbol %v - line %v symbol %v):\n%v\n"
+	}
+	for _, token := range tokens {
+		begin, end := int(token.begin), int(token.end)
+		err += fmt.Sprintf(format,
+			rul3s[token.pegRule],
+			translations[begin].line, translations[begin].symbol,
+			translations[end].line, translations[end].symbol,
+			strconv.Quote(string(e.p.buffer[begin:end])))
+	}
+
+	return err
+}
+
+func (p *TransformParser) PrintSyntaxTree() {
+	if p.Pretty {
+		p.tokens32.PrettyPrintSyntaxTree(p.Buffer)
+	} else {
+		p.tokens32.PrintSyntaxTree(p.Buffer)
+	}
+}
+
+func (p *TransformParser) WriteSyntaxTree(w io.Writer) {
+	p.tokens32.WriteSyntaxTree(w, p.Buffer)
+}
+
+func (p *TransformParser) SprintSyntaxTree() string {
+	var bldr strings.Builder
+	p.WriteSyntaxTree(&bldr)
+	return bldr.String()
+}
+
+func (p *TransformParser) Execute() {
+	buffer, _buffer, text, begin, end := p.Buffer, p.buffer, "", 0, 0
+	for _, token := range p.Tokens() {
+		switch token.pegRule {
+
+		case rulePegText:
+			begin, end = int(token.begin), int(token.end)
+			text = string(_buffer[begin:end])
+
+		case ruleAction0:
+			p.Function(buffer[begin:end])
+		case ruleAction1:
+			p.Apply()
+		case ruleAction2:
+			p.Argument()
+		case ruleAction3:
+			p.Argument()
+		case ruleAction4:
+			p.Field(buffer[begin:end])
+		case ruleAction5:
+			p.Literal(buffer[begin:end])
+		case ruleAction6:
+			p.Literal(buffer[begin:end])
+
+		}
+	}
+	_, _, _, _, _ = buffer, _buffer, text, begin, end
+}
+
+func Pretty(pretty bool) func(*TransformParser) error {
+	return func(p *TransformParser) error {
+		p.Pretty = pretty
+		return nil
+	}
+}
+
+func Size(size int) func(*TransformParser) error {
+	return func(p *TransformParser) error {
+		p.tokens32 = tokens32{tree: make([]token32, 0, size)}
+		return nil
+	}
+}
+func (p *TransformParser) Init(options ...func(*TransformParser) error) error {
+	var (
+		max                  token32
+		position, tokenIndex uint32
+		buffer               []rune
+	)
+	for _, option := range options {
+		err := option(p)
+		if err != nil {
+			return err
+		}
+	}
+	p.reset = func() {
+		max = token32{}
+		position, tokenIndex = 0, 0
+
+		p.buffer = []rune(p.Buffer)
+		if len(p.buffer) == 0 || p.buffer[len(p.buffer)-1] != endSymbol {
+			p.buffer = append(p.buffer, endSymbol)
+		}
+		buffer = p.buffer
+	}
+	p.reset()
+
+	_rules := p.rules
+	tree := p.tokens32
+	p.parse = func(rule ...int) error {
+		r := 1
+		if len(rule) > 0 {
+			r = rule[0]
+		}
+		matches := p.rules[r]()
+		p.tokens32 = tree
+		if matches {
+			p.Trim(tokenIndex)
+			return nil
+		}
+		return &parseError{p, max}
+	}
+
+	add := func(rule pegRule, begin uint32) {
+		tree.Add(rule, begin, position, tokenIndex)
+		tokenIndex++
+		if begin != position && position > max.end {
+			max = token32{rule, begin, position}
+		}
+	}
+
+	matchDot := func() bool {
+		if buffer[position] != endSymbol {
+			position++
+			return true
+		}
+		return false
+	}
+
+	/*matchChar := func(c byte) bool {
+		if buffer[position] == c {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	/*matchRange := func(lower byte, upper byte) bool {
+		if c := buffer[position]; c >= lower && c <= upper {
+			position++
+			return true
+		}
+		return false
+	}*/
+
+	_rules = [...]func() bool{
+		nil,
+		/* 0 e <- <(sp expr !.)> */
+		func() bool {
+			position0, tokenIndex0 := position, tokenIndex
+			{
+				position1 := position
+				if !_rules[rulesp]() {
+					goto l0
+				}
+				if !_rules[ruleexpr]() {
+					goto l0
+				}
+				{
+					position2, tokenIndex2 := position, tokenIndex
+					if !matchDot() {
+						goto l2
+					}
+					goto l0
+				l2:
+					position, tokenIndex = position2, tokenIndex2
+				}
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex = position0, tokenIndex0
+			return false
+		},
+		/* 1 expr <- <(call / ((&('-' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number) | (&('\'') string) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') field)))> */
+		func() bool {
+			position3, tokenIndex3 := position, tokenIndex
+			{
+				position4 := position
+				{
+					position5, tokenIndex5 := position, tokenIndex
+					{
+						position7 := position
+						{
+							position8 := position
+							if !_rules[rulename]() {
+								goto l6
+							}
+							add(rulePegText, position8)
+						}
+						if !_rules[rulesp]() {
+							goto l6
+						}
+						{
+							position9 := position
+							if buffer[position] != rune('(') {
+								goto l6
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l6
+							}
+							add(ruleopen, position9)
+						}
+						{
+							add(ruleAction0, position)
+						}
+						{
+							position11, tokenIndex11 := position, tokenIndex
+							{
+								position13 := position
+								if !_rules[ruleexpr]() {
+									goto l11
+								}
+								{
+									add(ruleAction2, position)
+								}
+							l15:
+								{
+									position16, tokenIndex16 := position, tokenIndex
+									{
+										position17 := position
+										if buffer[position] != rune(',') {
+											goto l16
+										}
+										position++
+										if !_rules[rulesp]() {
+											goto l16
+										}
+										add(rulecomma, position17)
+									}
+									if !_rules[ruleexpr]() {
+										goto l16
+									}
+									{
+										add(ruleAction3, position)
+									}
+									goto l15
+								l16:
+									position, tokenIndex = position16, tokenIndex16
+								}
+								add(ruleargs, position13)
+							}
+							goto l12
+						l11:
+							position, tokenIndex = position11, tokenIndex11
+						}
+					l12:
+						{
+							position19 := position
+							if buffer[position] != rune(')') {
+								goto l6
+							}
+							position++
+							if !_rules[rulesp]() {
+								goto l6
+							}
+							add(ruleclose, position19)
+						}
+						{
+							add(ruleAction1, position)
+						}
+						add(rulecall, position7)
+					}
+					goto l5
+				l6:
+					position, tokenIndex = position5, tokenIndex5
+					{
+						switch buffer[position] {
+						case '-', '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							{
+								position22 := position
+								{
+									position23 := position
+									{
+										position24, tokenIndex24 := position, tokenIndex
+										if buffer[position] != rune('-') {
+											goto l24
+										}
+										position++
+										goto l25
+									l24:
+										position, tokenIndex = position24, tokenIndex24
+									}
+								l25:
+									if !_rules[ruledigit]() {
+										goto l3
+									}
+								l26:
+									{
+										position27, tokenIndex27 := position, tokenIndex
+										if !_rules[ruledigit]() {
+											goto l27
+										}
+										goto l26
+									l27:
+										position, tokenIndex = position27, tokenIndex27
+									}
+									{
+										position28, tokenIndex28 := position, tokenIndex
+										if buffer[position] != rune('.') {
+											goto l28
+										}
+										position++
+										if !_rules[ruledigit]() {
+											goto l28
+										}
+									l30:
+										{
+											position31, tokenIndex31 := position, tokenIndex
+											if !_rules[ruledigit]() {
+												goto l31
+											}
+											goto l30
+										l31:
+											position, tokenIndex = position31, tokenIndex31
+										}
+										goto l29
+									l28:
+										position, tokenIndex = position28, tokenIndex28
+									}
+								l29:
+									add(rulePegText, position23)
+								}
+								if !_rules[rulesp]() {
+									goto l3
+								}
+								{
+									add(ruleAction6, position)
+								}
+								add(rulenumber, position22)
+							}
+						case '\'':
+							{
+								position33 := position
+								if buffer[position] != rune('\'') {
+									goto l3
+								}
+								position++
+								{
+									position34 := position
+								l35:
+									{
+										position36, tokenIndex36 := position, tokenIndex
+										{
+											position37, tokenIndex37 := position, tokenIndex
+											if buffer[position] != rune('\'') {
+												goto l37
+											}
+											position++
+											goto l36
+										l37:
+											position, tokenIndex = position37, tokenIndex37
+										}
+										if !matchDot() {
+											goto l36
+										}
+										goto l35
+									l36:
+										position, tokenIndex = position36, tokenIndex36
+									}
+									add(rulePegText, position34)
+								}
+								if buffer[position] != rune('\'') {
+									goto l3
+								}
+								position++
+								if !_rules[rulesp]() {
+									goto l3
+								}
+								{
+									add(ruleAction5, position)
+								}
+								add(rulestring, position33)
+							}
+						default:
+							{
+								position39 := position
+								{
+									position40 := position
+									if !_rules[rulename]() {
+										goto l3
+									}
+									add(rulePegText, position40)
+								}
+								if !_rules[rulesp]() {
+									goto l3
+								}
+								{
+									add(ruleAction4, position)
+								}
+								add(rulefield, position39)
+							}
+						}
+					}
+
+				}
+			l5:
+				add(ruleexpr, position4)
+			}
+			return true
+		l3:
+			position, tokenIndex = position3, tokenIndex3
+			return false
+		},
+		/* 2 call <- <(<name> sp open Action0 args? close Action1)> */
+		nil,
+		/* 3 args <- <(expr Action2 (comma expr Action3)*)> */
+		nil,
+		/* 4 field <- <(<name> sp Action4)> */
+		nil,
+		/* 5 string <- <('\'' <(!'\'' .)*> '\'' sp Action5)> */
+		nil,
+		/* 6 number <- <(<('-'? digit+ ('.' digit+)?)> sp Action6)> */
+		nil,
+		/* 7 name <- <(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> */
+		func() bool {
+			position47, tokenIndex47 := position, tokenIndex
+			{
+				position48 := position
+				{
+					switch buffer[position] {
+					case '_':
+						if buffer[position] != rune('_') {
+							goto l47
+						}
+						position++
+					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+						if c := buffer[position]; c < rune('A') || c > rune('Z') {
+							goto l47
+						}
+						position++
+					default:
+						if c := buffer[position]; c < rune('a') || c > rune('z') {
+							goto l47
+						}
+						position++
+					}
+				}
+
+			l50:
+				{
+					position51, tokenIndex51 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+							if c := buffer[position]; c < rune('0') || c > rune('9') {
+								goto l51
+							}
+							position++
+						case '_':
+							if buffer[position] != rune('_') {
+								goto l51
+							}
+							position++
+						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+							if c := buffer[position]; c < rune('A') || c > rune('Z') {
+								goto l51
+							}
+							position++
+						default:
+							if c := buffer[position]; c < rune('a') || c > rune('z') {
+								goto l51
+							}
+							position++
+						}
+					}
+
+					goto l50
+				l51:
+					position, tokenIndex = position51, tokenIndex51
+				}
+				add(rulename, position48)
+			}
+			return true
+		l47:
+			position, tokenIndex = position47, tokenIndex47
+			return false
+		},
+		/* 8 digit <- <[0-9]> */
+		func() bool {
+			position53, tokenIndex53 := position, tokenIndex
+			{
+				position54 := position
+				if c := buffer[position]; c < rune('0') || c > rune('9') {
+					goto l53
+				}
+				position++
+				add(ruledigit, position54)
+			}
+			return true
+		l53:
+			position, tokenIndex = position53, tokenIndex53
+			return false
+		},
+		/* 9 open <- <('(' sp)> */
+		nil,
+		/* 10 close <- <(')' sp)> */
+		nil,
+		/* 11 comma <- <(',' sp)> */
+		nil,
+		/* 12 sp <- <((&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' '))*> */
+		func() bool {
+			{
+				position59 := position
+			l60:
+				{
+					position61, tokenIndex61 := position, tokenIndex
+					{
+						switch buffer[position] {
+						case '\r':
+							if buffer[position] != rune('\r') {
+								goto l61
+							}
+							position++
+						case '\n':
+							if buffer[position] != rune('\n') {
+								goto l61
+							}
+							position++
+						case '\t':
+							if buffer[position] != rune('\t') {
+								goto l61
+							}
+							position++
+						default:
+							if buffer[position] != rune(' ') {
+								goto l61
+							}
+							position++
+						}
+					}
+
+					goto l60
+				l61:
+					position, tokenIndex = position61, tokenIndex61
+				}
+				add(rulesp, position59)
+			}
+			return true
+		},
+		nil,
+		/* 15 Action0 <- <{ p.Function(buffer[begin:end]) }> */
+		nil,
+		/* 16 Action1 <- <{ p.Apply() }> */
+		nil,
+		/* 17 Action2 <- <{ p.Argument() }> */
+		nil,
+		/* 18 Action3 <- <{ p.Argument() }> */
+		nil,
+		/* 19 Action4 <- <{ p.Field(buffer[begin:end]) }> */
+		nil,
+		/* 20 Action5 <- <{ p.Literal(buffer[begin:end]) }> */
+		nil,
+		/* 21 Action6 <- <{ p.Literal(buffer[begin:end]) }> */
+		nil,
+	}
+	p.rules = _rules
+	return nil
+}
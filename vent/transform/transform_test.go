@@ -0,0 +1,88 @@
+package transform
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTransform(t *testing.T) {
+	name := []byte("frog\x00\x00\x00")
+	amount := big.NewInt(-1500)
+	fields := map[string]interface{}{
+		"name":      &name,
+		"amount":    amount,
+		"decimals":  "3",
+		"owner":     "5B38DA6A701C568545DCFCB03FCB875F56BEDDC4",
+		"data":      `{"tags": [{"colour": "green"}], "legs": 4}`,
+		"blockTime": time.Date(2020, 1, 2, 3, 4, 5, 0, time.FixedZone("CET", 3600)),
+	}
+	getField := func(name string) (interface{}, bool) {
+		value, ok := fields[name]
+		return value, ok
+	}
+
+	for expr, expected := range map[string]interface{}{
+		"name":                          &name,
+		"'literal'":                     "literal",
+		"string(name)":                  "frog",
+		"scale(amount, decimals)":       "-1.500",
+		"scale('7', 3)":                 "0.007",
+		"scale(12, 0)":                  "12",
+		"neg(amount)":                   "1500",
+		"neg('0x10')":                   "-16",
+		"hex(name)":                     "0x66726f67000000",
+		"hex(255)":                      "0xff",
+		"checksum(owner)":               "0x5B38Da6a701c568545dCfcB03FcB875f56beddC4",
+		"lower(owner)":                  "5b38da6a701c568545dcfcb03fcb875f56beddc4",
+		"json(data, 'tags.[0].colour')": "green",
+		"json(data, 'legs')":            "4",
+		"json(data, 'tags')":            `[{"colour":"green"}]`,
+		"timestamp(blockTime)":          time.Date(2020, 1, 2, 2, 4, 5, 0, time.UTC),
+		"timestamp(1577934245)":         time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC),
+		"concat(upper(string(name)), ' ', 1, '-', decimals)": "FROG 1-3",
+		" concat ( 'a' ,\n 'b' ) ":                           "ab",
+	} {
+		value, err := MustParse(expr).Evaluate(getField)
+		require.NoError(t, err, expr)
+		assert.Equal(t, expected, value, expr)
+	}
+}
+
+func TestTransformFields(t *testing.T) {
+	assert.Equal(t, []string{"a", "b"}, MustParse("concat(a, lower(b), a, 'c')").Fields())
+}
+
+func TestTransformErrors(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"concat(",
+		"concat('a' 'b')",
+		"frog(a)",
+		"lower(a, b)",
+		"scale(a)",
+		"'unterminated",
+	} {
+		_, err := New(expr)
+		assert.Error(t, err, expr)
+	}
+
+	getField := func(name string) (interface{}, bool) {
+		return "frog", name == "a"
+	}
+	for _, expr := range []string{
+		"b",
+		"scale(a, 2)",
+		"neg(a)",
+		"scale(2, 78)",
+		"checksum(a)",
+		"json(a, 'b')",
+		"timestamp(a)",
+	} {
+		_, err := MustParse(expr).Evaluate(getField)
+		assert.Error(t, err, expr)
+	}
+}
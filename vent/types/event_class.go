@@ -1,9 +1,20 @@
 package types
 
 import (
+	"fmt"
+
 	"github.com/alecthomas/jsonschema"
 	validation "github.com/go-ozzo/ozzo-validation"
 	"github.com/hyperledger/burrow/event/query"
+	"github.com/hyperledger/burrow/vent/transform"
+)
+
+// Aggregates that a column can maintain over the events upserting its row
+const (
+	AggregateSum   = "sum"
+	AggregateCount = "count"
+	AggregateMin   = "min"
+	AggregateMax   = "max"
 )
 
 // ProjectionSpec contains all event class specifications
@@ -24,6 +35,8 @@ type EventClass struct {
 	DeleteMarkerField string `json:",omitempty"`
 	// EventFieldMapping from solidity event field name to EventFieldMapping descriptor
 	FieldMappings []*EventFieldMapping
+	// The chains (by chain ID) whose events this class projects when Vent projects several chains, all chains if empty
+	ChainIDs []string `json:",omitempty"`
 	// Memoised lookup/query
 	query  query.Query
 	fields map[string]*EventFieldMapping
@@ -54,12 +67,28 @@ func (ec *EventClass) GetFieldMapping(fieldName string) *EventFieldMapping {
 	if ec.fields == nil {
 		ec.fields = make(map[string]*EventFieldMapping, len(ec.FieldMappings))
 		for _, fm := range ec.FieldMappings {
-			ec.fields[fm.Field] = fm
+			// Transformed and counting mappings derive their value from the event rather than map a field
+			if fm.Transform == "" && fm.Aggregate != AggregateCount {
+				ec.fields[fm.Field] = fm
+			}
 		}
 	}
 	return ec.fields[fieldName]
 }
 
+// AppliesToChain returns whether this EventClass projects events from chainID
+func (ec *EventClass) AppliesToChain(chainID string) bool {
+	if len(ec.ChainIDs) == 0 {
+		return true
+	}
+	for _, id := range ec.ChainIDs {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
 func (ec *EventClass) GetFilter() string {
 	if ec == nil {
 		return ""
@@ -70,7 +99,7 @@ func (ec *EventClass) GetFilter() string {
 // EventFieldMapping struct (table column definition)
 type EventFieldMapping struct {
 	// EVM event field name to process
-	Field string
+	Field string `json:",omitempty"`
 	// EVM type of this field - used to derive SQL type
 	Type string
 	// Destination SQL column name to which to map this event field
@@ -79,14 +108,65 @@ type EventFieldMapping struct {
 	Primary bool `json:",omitempty"`
 	// Whether to convert this event field from bytes32 to string
 	BytesToString bool `json:",omitempty"`
+	// An expression deriving the value of the column from the fields of the event (and its transaction and block) in
+	// place of Field, for example "scale(amount, 18)", see the transform package
+	Transform string `json:",omitempty"`
+	// Whether to leave the column null when Transform cannot be evaluated for an event rather than fail its block
+	NullOnError bool `json:",omitempty"`
+	// An aggregate (sum, count, min, or max) that this column maintains over the events upserting its row in place
+	// of holding the value from the latest event. A count takes no Field.
+	Aggregate string `json:",omitempty"`
+	// Whether to create an index on this column to speed up queries selecting rows by it
+	Index bool `json:",omitempty"`
 	// Notification channels on which submit (via a trigger) a payload that contains this column's new value (upsert) or
 	// old value (delete). The payload will contain all other values with the same channel set as a JSON object.
 	Notify []string `json:",omitempty"`
+	// Memoised transform
+	transform *transform.Transform
 }
 
 // Validate checks the structure of an EventFieldMapping
 func (evColumn EventFieldMapping) Validate() error {
 	return validation.ValidateStruct(&evColumn,
 		validation.Field(&evColumn.ColumnName, validation.Required, validation.Length(1, 60)),
+		validation.Field(&evColumn.Field, validation.By(func(value interface{}) error {
+			if value.(string) == "" && evColumn.Transform == "" && evColumn.Aggregate != AggregateCount {
+				return fmt.Errorf("one of Field or Transform is required")
+			}
+			return nil
+		})),
+		validation.Field(&evColumn.Aggregate,
+			validation.In(AggregateSum, AggregateCount, AggregateMin, AggregateMax),
+			validation.By(func(value interface{}) error {
+				if value.(string) != "" && evColumn.Primary {
+					return fmt.Errorf("a primary key column cannot be an aggregate")
+				}
+				return nil
+			})),
+		validation.Field(&evColumn.NullOnError, validation.By(func(value interface{}) error {
+			if value.(bool) && evColumn.Transform == "" {
+				return fmt.Errorf("NullOnError requires a Transform")
+			}
+			return nil
+		})),
+		validation.Field(&evColumn.Transform, validation.By(func(value interface{}) error {
+			if value.(string) == "" {
+				return nil
+			}
+			_, err := transform.New(value.(string))
+			return err
+		})),
 	)
 }
+
+// Get a (memoised) Transform from the EventFieldMapping Transform string
+func (evColumn *EventFieldMapping) GetTransform() (*transform.Transform, error) {
+	if evColumn.transform == nil {
+		var err error
+		evColumn.transform, err = transform.New(evColumn.Transform)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return evColumn.transform, nil
+}
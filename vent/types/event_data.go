@@ -15,6 +15,8 @@ const (
 // already mapped to SQL columns & tables
 // Tables map key is the table name
 type EventData struct {
+	// The chain from which the block was projected
+	ChainID     string
 	BlockHeight uint64
 	Tables      map[string]EventDataTable
 }
@@ -11,5 +11,6 @@ const (
 	SQLErrorTypeInvalidType
 	SQLErrorTypeUndefinedTable
 	SQLErrorTypeUndefinedColumn
+	SQLErrorTypeDuplicatedIndex
 	SQLErrorTypeGeneric
 )
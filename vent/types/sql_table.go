@@ -31,6 +31,10 @@ type SQLTableColumn struct {
 	Primary bool
 	// Length of variable column type where applicable 0 indicates variable/unbounded length
 	Length int
+	// The aggregate the column maintains over the values upserted into its row, if any
+	Aggregate string
+	// Whether the column has an index of its own
+	Index bool
 }
 
 func (col *SQLTableColumn) String() string {
@@ -42,13 +46,23 @@ func (col *SQLTableColumn) String() string {
 	if col.Length != 0 {
 		lengthString = fmt.Sprintf(" (length %d)", col.Length)
 	}
-	return fmt.Sprintf("SQLTableColumn{%s%s: %v%s}",
-		col.Name, primaryString, col.Type, lengthString)
+	aggregateString := ""
+	if col.Aggregate != "" {
+		aggregateString = fmt.Sprintf(" (%s)", col.Aggregate)
+	}
+	indexString := ""
+	if col.Index {
+		indexString = " (index)"
+	}
+	return fmt.Sprintf("SQLTableColumn{%s%s: %v%s%s%s}",
+		col.Name, primaryString, col.Type, lengthString, aggregateString, indexString)
 }
 
 func (col *SQLTableColumn) Equals(otherCol *SQLTableColumn) bool {
 	columnA := *col
 	columnB := *otherCol
+	// Indexing a column does not change what it holds
+	columnA.Index, columnB.Index = false, false
 	return columnA == columnB
 }
 
@@ -75,6 +89,17 @@ type SQLTableNames struct {
 	Block      string
 	Tx         string
 	ChainInfo  string
+	Spec       string
+	// ABIs resolved from chain metadata
+	Abi string
+	// Events that could not be decoded for want of an ABI
+	DeadLetter string
+	// The progress of each table a backfill is building
+	Backfill string
+	// Prefix of the tables a backfill builds to replace those whose event classes have changed
+	BackfillPrefix string
+	// Prefix to which the tables replaced by a backfill are renamed before they are dropped
+	ReplacedPrefix string
 }
 
 var DefaultSQLTableNames = SQLTableNames{
@@ -83,6 +108,13 @@ var DefaultSQLTableNames = SQLTableNames{
 	Block:      "_vent_block",
 	Tx:         "_vent_tx",
 	ChainInfo:  "_vent_chain",
+	Spec:       "_vent_spec",
+	Abi:        "_vent_abi",
+	DeadLetter: "_vent_deadletter",
+	Backfill:   "_vent_backfill",
+
+	BackfillPrefix: "_vent_backfill_",
+	ReplacedPrefix: "_vent_replaced_",
 }
 
 type SQLColumnNames struct {
@@ -107,6 +139,15 @@ type SQLColumnNames struct {
 	// chain info
 	BurrowVersion string
 	ChainID       string
+	// spec
+	Spec string
+	// abi
+	Abi string
+	// dead letter
+	EventID string
+	Topics  string
+	LogData string
+	Error   string
 	// context
 	TxIndex     string
 	EventIndex  string
@@ -119,6 +160,11 @@ type SQLColumnNames struct {
 	Receipt     string
 	Origin      string
 	Exception   string
+	// state
+	Address    string
+	StorageKey string
+	// aggregates
+	RowIndex string
 }
 
 var DefaultSQLColumnNames = SQLColumnNames{
@@ -143,6 +189,15 @@ var DefaultSQLColumnNames = SQLColumnNames{
 	// chain info,
 	BurrowVersion: "_burrowversion",
 	ChainID:       "_chainid",
+	// spec,
+	Spec: "_spec",
+	// abi
+	Abi: "_abi",
+	// dead letter
+	EventID: "_eventid",
+	Topics:  "_topics",
+	LogData: "_data",
+	Error:   "_error",
 	// context,
 	TxIndex:     "_txindex",
 	EventIndex:  "_eventindex",
@@ -155,6 +210,11 @@ var DefaultSQLColumnNames = SQLColumnNames{
 	Receipt:     "_receipt",
 	Origin:      "_origin",
 	Exception:   "_exception",
+	// state
+	Address:    "_address",
+	StorageKey: "_key",
+	// aggregates
+	RowIndex: "_rowindex",
 }
 
 // labels for column mapping
@@ -168,7 +228,10 @@ const (
 	ChainIDLabel     = "chainID"
 	BlockHeightLabel = "height"
 	TxIndexLabel     = "txIndex"
+	BlockTimeLabel   = "blockTime"
 
 	// transaction related
 	TxTxHashLabel = "txHash"
+	// The account that signed the transaction (the EVM origin)
+	OriginLabel = "origin"
 )
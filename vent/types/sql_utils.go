@@ -1,13 +1,21 @@
 package types
 
-import "github.com/hyperledger/burrow/logging"
+import (
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+)
 
 // SQLConnection stores parameters to build a new db connection & initialize the database
 type SQLConnection struct {
 	DBAdapter string
 	DBURL     string
 	DBSchema  string
-	Log       *logging.Logger
+	// Keep the data of every chain projected rather than dropping it when the chain ID changes
+	MultiChain bool
+	// How long to keep notifications in databases that store them for clients to poll (MySQL), zero keeps them forever
+	NotifyRetention time.Duration
+	Log             *logging.Logger
 }
 
 // SQLCleanDBQuery stores queries needed to clean the database
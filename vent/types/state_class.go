@@ -0,0 +1,201 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+	validation "github.com/go-ozzo/ozzo-validation"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Account fields that can be projected by a StateClass
+const (
+	AccountBalanceField     = "Balance"
+	AccountSequenceField    = "Sequence"
+	AccountPermissionsField = "Permissions"
+	AccountRolesField       = "Roles"
+	AccountCodeHashField    = "CodeHash"
+)
+
+// The EVM types with which account fields are projected
+var AccountFieldTypes = map[string]string{
+	AccountBalanceField:     "uint64",
+	AccountSequenceField:    "uint64",
+	AccountPermissionsField: "uint64",
+	AccountRolesField:       EventFieldTypeString,
+	AccountCodeHashField:    "bytes32",
+}
+
+// StateSpec contains all state class specifications
+type StateSpec []*StateClass
+
+func StateSpecSchema() *jsonschema.Schema {
+	return jsonschema.Reflect(StateSpec{})
+}
+
+// StateClass projects the state of accounts, and the variables in their contract storage, into a table with a row for
+// each account (or each key of a mapping) that is updated whenever a block touches the account
+type StateClass struct {
+	// Destination table in DB
+	TableName string
+	// The accounts whose state to project, when empty every account touched by a block is projected (which is only
+	// possible when projecting account fields)
+	Addresses []string `json:",omitempty"`
+	// The storage layout (from the storageLayout output of solc) of the contracts at Addresses, required to project
+	// their storage variables
+	StorageLayout *StorageLayout `json:",omitempty"`
+	// The label of a mapping in StorageLayout to project with a row for each of its keys (rather than one per account)
+	Mapping string `json:",omitempty"`
+	// Keys of Mapping to project, required with Mapping since the keys of a mapping cannot be listed from storage
+	Keys []string `json:",omitempty"`
+	// StateFieldMapping from account field or storage variable to column
+	FieldMappings []*StateFieldMapping
+	// The chains (by chain ID) whose state this class projects when Vent projects several chains, all chains if empty
+	ChainIDs []string `json:",omitempty"`
+	// Memoised addresses
+	addresses []crypto.Address
+}
+
+// Validate checks the structure of a StateClass
+func (sc *StateClass) Validate() error {
+	err := validation.ValidateStruct(sc,
+		validation.Field(&sc.TableName, validation.Required, validation.Length(1, 60)),
+		validation.Field(&sc.FieldMappings, validation.Required, validation.Length(1, 0)),
+	)
+	if err != nil {
+		return err
+	}
+	if sc.Mapping != "" && sc.StorageLayout == nil {
+		return fmt.Errorf("StorageLayout is required to project Mapping %s", sc.Mapping)
+	}
+	if sc.Mapping != "" && len(sc.Keys) == 0 {
+		return fmt.Errorf("Keys are required to project Mapping %s", sc.Mapping)
+	}
+	if sc.Mapping == "" && len(sc.Keys) > 0 {
+		return fmt.Errorf("Keys can only be given with a Mapping")
+	}
+	if sc.StorageLayout != nil && len(sc.Addresses) == 0 {
+		return fmt.Errorf("Addresses are required to project storage")
+	}
+	_, err = sc.GetAddresses()
+	return err
+}
+
+// GetAddresses returns the parsed Addresses of the StateClass
+func (sc *StateClass) GetAddresses() ([]crypto.Address, error) {
+	if sc.addresses == nil {
+		addresses := make([]crypto.Address, len(sc.Addresses))
+		for i, hex := range sc.Addresses {
+			address, err := crypto.AddressFromHexString(hex)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse address %s of state class %s: %v", hex, sc.TableName, err)
+			}
+			addresses[i] = address
+		}
+		sc.addresses = addresses
+	}
+	return sc.addresses, nil
+}
+
+// AppliesToChain returns whether this StateClass projects state from chainID
+func (sc *StateClass) AppliesToChain(chainID string) bool {
+	if len(sc.ChainIDs) == 0 {
+		return true
+	}
+	for _, id := range sc.ChainIDs {
+		if id == chainID {
+			return true
+		}
+	}
+	return false
+}
+
+// StateFieldMapping struct (table column definition)
+type StateFieldMapping struct {
+	// An account field (Balance, Sequence, Permissions, Roles, or CodeHash) or the label of a storage variable (the
+	// value of Mapping at each key when the label of the Mapping)
+	Field string
+	// Destination SQL column name to which to map this field
+	ColumnName string
+	// Notification channels on which submit (via a trigger) a payload that contains this column's new value as for
+	// EventFieldMapping
+	Notify []string `json:",omitempty"`
+}
+
+// Validate checks the structure of a StateFieldMapping
+func (stColumn StateFieldMapping) Validate() error {
+	return validation.ValidateStruct(&stColumn,
+		validation.Field(&stColumn.Field, validation.Required),
+		validation.Field(&stColumn.ColumnName, validation.Required, validation.Length(1, 60)),
+	)
+}
+
+// StateField is a StateFieldMapping resolved to the account field or storage variable from which its value is read
+type StateField struct {
+	*StateFieldMapping
+	// EVM type of the field's value
+	Type string
+	// The storage variable (nil for account fields) and the type of its value
+	Variable    *StorageVariable
+	StorageType *StorageType
+	// Whether the value is that of Mapping at each key
+	MappingValue bool
+}
+
+// Fields resolves the FieldMappings of the StateClass, storage variables take precedence over account fields
+func (sc *StateClass) Fields() ([]*StateField, error) {
+	fields := make([]*StateField, len(sc.FieldMappings))
+	for i, mapping := range sc.FieldMappings {
+		field := &StateField{StateFieldMapping: mapping}
+		var err error
+		if sc.StorageLayout != nil && sc.hasVariable(mapping.Field) {
+			field.Variable, field.StorageType, err = sc.StorageLayout.Variable(mapping.Field)
+			if err != nil {
+				return nil, err
+			}
+			if mapping.Field == sc.Mapping {
+				if field.StorageType.Encoding != StorageEncodingMapping {
+					return nil, fmt.Errorf("state variable '%s' of state class %s is not a mapping",
+						sc.Mapping, sc.TableName)
+				}
+				field.MappingValue = true
+				field.StorageType, err = sc.StorageLayout.Type(field.StorageType.Value)
+				if err != nil {
+					return nil, err
+				}
+			}
+			field.Type, err = field.StorageType.EVMType()
+			if err != nil {
+				return nil, fmt.Errorf("could not project '%s' of state class %s: %v", mapping.Field, sc.TableName, err)
+			}
+		} else if typ, ok := AccountFieldTypes[mapping.Field]; ok {
+			field.Type = typ
+		} else {
+			return nil, fmt.Errorf("'%s' of state class %s is neither an account field nor a state variable",
+				mapping.Field, sc.TableName)
+		}
+		fields[i] = field
+	}
+	return fields, nil
+}
+
+// MappingKeyType returns the type of the keys of Mapping
+func (sc *StateClass) MappingKeyType() (*StorageType, error) {
+	_, typ, err := sc.StorageLayout.Variable(sc.Mapping)
+	if err != nil {
+		return nil, err
+	}
+	if typ.Encoding != StorageEncodingMapping {
+		return nil, fmt.Errorf("state variable '%s' of state class %s is not a mapping", sc.Mapping, sc.TableName)
+	}
+	return sc.StorageLayout.Type(typ.Key)
+}
+
+func (sc *StateClass) hasVariable(label string) bool {
+	for _, variable := range sc.StorageLayout.Storage {
+		if variable.Label == label {
+			return true
+		}
+	}
+	return false
+}
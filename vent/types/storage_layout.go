@@ -0,0 +1,248 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+)
+
+// Storage type encodings
+const (
+	StorageEncodingInplace = "inplace"
+	StorageEncodingMapping = "mapping"
+)
+
+// StorageLayout is the storageLayout output of solc describing where a contract's state variables are stored
+type StorageLayout struct {
+	Storage []*StorageVariable      `json:"storage"`
+	Types   map[string]*StorageType `json:"types"`
+}
+
+// StorageVariable is a state variable occupying numberOfBytes of its type from offset bytes (from the right) into slot
+type StorageVariable struct {
+	AstID    int    `json:"astId,omitempty"`
+	Contract string `json:"contract,omitempty"`
+	Label    string `json:"label"`
+	Offset   int    `json:"offset"`
+	Slot     string `json:"slot"`
+	Type     string `json:"type"`
+}
+
+// StorageType describes the encoding of a type in storage, Key and Value are the types of a mapping
+type StorageType struct {
+	Encoding      string             `json:"encoding"`
+	Label         string             `json:"label"`
+	NumberOfBytes string             `json:"numberOfBytes"`
+	Key           string             `json:"key,omitempty"`
+	Value         string             `json:"value,omitempty"`
+	Base          string             `json:"base,omitempty"`
+	Members       []*StorageVariable `json:"members,omitempty"`
+}
+
+// Variable returns the state variable labelled label along with its type
+func (sl *StorageLayout) Variable(label string) (*StorageVariable, *StorageType, error) {
+	for _, variable := range sl.Storage {
+		if variable.Label == label {
+			typ, err := sl.Type(variable.Type)
+			if err != nil {
+				return nil, nil, err
+			}
+			return variable, typ, nil
+		}
+	}
+	return nil, nil, fmt.Errorf("no state variable '%s' in storage layout", label)
+}
+
+// Type returns the type identified by id
+func (sl *StorageLayout) Type(id string) (*StorageType, error) {
+	typ, ok := sl.Types[id]
+	if !ok {
+		return nil, fmt.Errorf("no type '%s' in storage layout", id)
+	}
+	return typ, nil
+}
+
+// SlotKey is the storage key of the slot of the variable
+func (sv *StorageVariable) SlotKey() (binary.Word256, error) {
+	slot, ok := new(big.Int).SetString(sv.Slot, 10)
+	if !ok {
+		return binary.Zero256, fmt.Errorf("could not parse slot '%s' of state variable '%s'", sv.Slot, sv.Label)
+	}
+	return binary.BigIntToWord256(slot), nil
+}
+
+// MappingKey is the storage key of the value at key (encoded with EncodeKey) of the mapping stored at slot
+func MappingKey(slot, key binary.Word256) binary.Word256 {
+	return binary.LeftPadWord256(crypto.Keccak256(append(key.Bytes(), slot.Bytes()...)))
+}
+
+// EVMType returns the elementary type name of a value type, or an error for a type that does not fit in a slot
+func (st *StorageType) EVMType() (string, error) {
+	if st.Encoding != StorageEncodingInplace {
+		return "", fmt.Errorf("only value types can be projected from storage but '%s' is encoded as %s",
+			st.Label, st.Encoding)
+	}
+	label := st.Label
+	switch {
+	case label == "bool":
+		return EventFieldTypeBool, nil
+	case strings.HasPrefix(label, "address"), strings.HasPrefix(label, "contract "):
+		return EventFieldTypeAddress, nil
+	case strings.HasPrefix(label, "enum "):
+		return "uint8", nil
+	case strings.HasPrefix(label, EventFieldTypeUInt), strings.HasPrefix(label, EventFieldTypeInt):
+		return label, nil
+	case strings.HasPrefix(label, EventFieldTypeBytes) && label != EventFieldTypeBytes:
+		return label, nil
+	}
+	return "", fmt.Errorf("only value types can be projected from storage but '%s' is not a value type", label)
+}
+
+// Size is the number of bytes the type occupies in its slot
+func (st *StorageType) Size() (int, error) {
+	size, err := strconv.Atoi(st.NumberOfBytes)
+	if err != nil || size < 1 || size > binary.Word256Bytes {
+		return 0, fmt.Errorf("type '%s' has invalid numberOfBytes '%s'", st.Label, st.NumberOfBytes)
+	}
+	return size, nil
+}
+
+// DecodeValue decodes the value of the type stored offset bytes from the right of slot. Integers and addresses are
+// returned as strings as for decoded events.
+func (st *StorageType) DecodeValue(slot []byte, offset int) (interface{}, error) {
+	evmType, err := st.EVMType()
+	if err != nil {
+		return nil, err
+	}
+	size, err := st.Size()
+	if err != nil {
+		return nil, err
+	}
+	word := binary.LeftPadWord256(slot).Bytes()
+	if offset < 0 || offset+size > len(word) {
+		return nil, fmt.Errorf("type '%s' does not fit at offset %d of slot", st.Label, offset)
+	}
+	bs := word[len(word)-offset-size : len(word)-offset]
+	return decodeValue(evmType, bs)
+}
+
+// EncodeKey encodes a mapping key of the type given as a string (hex for addresses and bytes) as a storage word
+func (st *StorageType) EncodeKey(key string) (binary.Word256, error) {
+	evmType, err := st.EVMType()
+	if err != nil {
+		return binary.Zero256, err
+	}
+	switch {
+	case evmType == EventFieldTypeBool:
+		b, err := strconv.ParseBool(key)
+		if err != nil {
+			return binary.Zero256, fmt.Errorf("could not parse bool mapping key '%s': %v", key, err)
+		}
+		if b {
+			return binary.One256, nil
+		}
+		return binary.Zero256, nil
+	case evmType == EventFieldTypeAddress:
+		address, err := crypto.AddressFromHexString(key)
+		if err != nil {
+			return binary.Zero256, fmt.Errorf("could not parse address mapping key '%s': %v", key, err)
+		}
+		return address.Word256(), nil
+	case strings.HasPrefix(evmType, EventFieldTypeBytes):
+		bs, err := hex.DecodeString(strings.TrimPrefix(key, "0x"))
+		if err != nil {
+			return binary.Zero256, fmt.Errorf("could not parse bytes mapping key '%s': %v", key, err)
+		}
+		return binary.RightPadWord256(bs), nil
+	default:
+		x, ok := new(big.Int).SetString(key, 0)
+		if !ok {
+			return binary.Zero256, fmt.Errorf("could not parse integer mapping key '%s'", key)
+		}
+		return binary.BigIntToWord256(binary.U256(x)), nil
+	}
+}
+
+// DecodeKey decodes a mapping key of the type from its storage word, it returns false if word could not be an encoding
+// of a key of the type
+func (st *StorageType) DecodeKey(word binary.Word256) (interface{}, bool) {
+	evmType, err := st.EVMType()
+	if err != nil {
+		return nil, false
+	}
+	size, err := st.Size()
+	if err != nil {
+		return nil, false
+	}
+	bs := word.Bytes()
+	if strings.HasPrefix(evmType, EventFieldTypeBytes) {
+		// Fixed bytes are left aligned
+		if !isZero(bs[size:]) {
+			return nil, false
+		}
+		bs = bs[:size]
+	} else {
+		if !isZero(bs[:len(bs)-size]) && !(strings.HasPrefix(evmType, EventFieldTypeInt) && isSignExtended(bs, size)) {
+			return nil, false
+		}
+		bs = bs[len(bs)-size:]
+	}
+	value, err := decodeValue(evmType, bs)
+	if err != nil {
+		return nil, false
+	}
+	if evmType == EventFieldTypeBool && bs[0] > 1 {
+		return nil, false
+	}
+	return value, true
+}
+
+func decodeValue(evmType string, bs []byte) (interface{}, error) {
+	switch {
+	case evmType == EventFieldTypeBool:
+		return !isZero(bs), nil
+	case evmType == EventFieldTypeAddress:
+		address, err := crypto.AddressFromBytes(bs)
+		if err != nil {
+			return nil, err
+		}
+		return address.String(), nil
+	case strings.HasPrefix(evmType, EventFieldTypeBytes):
+		value := make([]byte, len(bs))
+		copy(value, bs)
+		return value, nil
+	case strings.HasPrefix(evmType, EventFieldTypeUInt):
+		return new(big.Int).SetBytes(bs).String(), nil
+	case strings.HasPrefix(evmType, EventFieldTypeInt):
+		x := new(big.Int).SetBytes(bs)
+		return binary.SignExtend(x, uint(len(bs)*8)).String(), nil
+	}
+	return nil, fmt.Errorf("cannot decode storage value of type %s", evmType)
+}
+
+func isZero(bs []byte) bool {
+	for _, b := range bs {
+		if b != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Whether the high bytes of word are the sign extension of its low size bytes
+func isSignExtended(word []byte, size int) bool {
+	if word[len(word)-size]&0x80 == 0 {
+		return false
+	}
+	for _, b := range word[:len(word)-size] {
+		if b != 0xff {
+			return false
+		}
+	}
+	return true
+}
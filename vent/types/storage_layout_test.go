@@ -0,0 +1,81 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/binary"
+	"github.com/hyperledger/burrow/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestStorageTypeDecodeValue(t *testing.T) {
+	// uint8 a = 0x12; int16 b = -2; bool c = true; packed into slot 0 from the right
+	slot := make([]byte, 32)
+	slot[31] = 0x12
+	slot[29], slot[30] = 0xff, 0xfe
+	slot[28] = 1
+
+	value, err := (&StorageType{Encoding: StorageEncodingInplace, Label: "uint8", NumberOfBytes: "1"}).
+		DecodeValue(slot, 0)
+	require.NoError(t, err)
+	assert.Equal(t, "18", value)
+
+	value, err = (&StorageType{Encoding: StorageEncodingInplace, Label: "int16", NumberOfBytes: "2"}).
+		DecodeValue(slot, 1)
+	require.NoError(t, err)
+	assert.Equal(t, "-2", value)
+
+	value, err = (&StorageType{Encoding: StorageEncodingInplace, Label: "bool", NumberOfBytes: "1"}).
+		DecodeValue(slot, 3)
+	require.NoError(t, err)
+	assert.Equal(t, true, value)
+
+	address := crypto.Address{1, 2, 3}
+	value, err = (&StorageType{Encoding: StorageEncodingInplace, Label: "address", NumberOfBytes: "20"}).
+		DecodeValue(address.Bytes(), 0)
+	require.NoError(t, err)
+	assert.Equal(t, address.String(), value)
+
+	_, err = (&StorageType{Encoding: StorageEncodingInplace, Label: "uint256", NumberOfBytes: "32"}).
+		DecodeValue(slot, 1)
+	require.Error(t, err)
+
+	_, err = (&StorageType{Encoding: "bytes", Label: "string", NumberOfBytes: "32"}).DecodeValue(slot, 0)
+	require.Error(t, err)
+}
+
+func TestStorageTypeKeys(t *testing.T) {
+	addressType := &StorageType{Encoding: StorageEncodingInplace, Label: "address", NumberOfBytes: "20"}
+	address := crypto.Address{1, 2, 3}
+	word, err := addressType.EncodeKey(address.String())
+	require.NoError(t, err)
+	assert.Equal(t, address.Word256(), word)
+	key, ok := addressType.DecodeKey(word)
+	require.True(t, ok)
+	assert.Equal(t, address.String(), key)
+	_, ok = addressType.DecodeKey(binary.RightPadWord256([]byte{1}))
+	assert.False(t, ok, "should not decode a word with high bytes set as an address")
+
+	intType := &StorageType{Encoding: StorageEncodingInplace, Label: "int32", NumberOfBytes: "4"}
+	word, err = intType.EncodeKey("-5")
+	require.NoError(t, err)
+	key, ok = intType.DecodeKey(word)
+	require.True(t, ok)
+	assert.Equal(t, "-5", key)
+
+	bytesType := &StorageType{Encoding: StorageEncodingInplace, Label: "bytes4", NumberOfBytes: "4"}
+	word, err = bytesType.EncodeKey("0xdeadbeef")
+	require.NoError(t, err)
+	assert.Equal(t, binary.RightPadWord256([]byte{0xde, 0xad, 0xbe, 0xef}), word)
+	key, ok = bytesType.DecodeKey(word)
+	require.True(t, ok)
+	assert.Equal(t, []byte{0xde, 0xad, 0xbe, 0xef}, key)
+}
+
+func TestMappingKey(t *testing.T) {
+	slot := binary.Int64ToWord256(3)
+	key := crypto.Address{1}.Word256()
+	assert.Equal(t, binary.LeftPadWord256(crypto.Keccak256(append(key.Bytes(), slot.Bytes()...))),
+		MappingKey(slot, key))
+}
@@ -0,0 +1,139 @@
+package ventquery
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Query parameters of the rows endpoint that are not column filters
+const (
+	OrderParam  = "_order"
+	LimitParam  = "_limit"
+	OffsetParam = "_offset"
+)
+
+// ChangesPage is the response of the changes endpoint
+type ChangesPage struct {
+	Changes []*Change
+	// The id from which to request the next page
+	After uint64
+}
+
+// HTTPHandler returns a handler serving the queries of s as JSON over HTTP:
+//
+//	GET /tables lists the tables
+//	GET /tables/<table>?<column>=<value>&<column>.<op>=<value>&_order=<column>,-<column>&_limit=<n>&_offset=<n>
+//	  gets the rows of table matching every filter, where op is one of eq, ne, lt, le, gt, or ge and descending
+//	  order is marked by -
+//	GET /changes?since=<height>&after=<id>&chainid=<chain ID>&table=<table>&limit=<n> gets a page of changes
+func (s *Server) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/tables", func(resp http.ResponseWriter, req *http.Request) {
+		tables, err := s.Tables(req.Context(), &TablesRequest{})
+		writeJSON(resp, tables, err)
+	})
+	mux.HandleFunc("/tables/", func(resp http.ResponseWriter, req *http.Request) {
+		rowsRequest, err := parseRowsRequest(strings.TrimPrefix(req.URL.Path, "/tables/"), req)
+		if err != nil {
+			writeJSON(resp, nil, err)
+			return
+		}
+		rows, err := s.Rows(req.Context(), rowsRequest)
+		writeJSON(resp, rows, err)
+	})
+	mux.HandleFunc("/changes", func(resp http.ResponseWriter, req *http.Request) {
+		var since, after, limit uint64
+		err := parseUints(req, map[string]*uint64{"since": &since, "after": &after, "limit": &limit})
+		if err != nil {
+			writeJSON(resp, nil, err)
+			return
+		}
+		query := req.URL.Query()
+		page := &ChangesPage{}
+		page.Changes, page.After, err = s.ChangesPage(since, after, query.Get("chainid"), query.Get("table"), limit)
+		writeJSON(resp, page, err)
+	})
+	return mux
+}
+
+func parseRowsRequest(table string, req *http.Request) (*RowsRequest, error) {
+	rowsRequest := &RowsRequest{Table: table}
+	err := parseUints(req, map[string]*uint64{LimitParam: &rowsRequest.Limit, OffsetParam: &rowsRequest.Offset})
+	if err != nil {
+		return nil, err
+	}
+	for param, values := range req.URL.Query() {
+		switch param {
+		case LimitParam, OffsetParam:
+		case OrderParam:
+			for _, value := range values {
+				for _, column := range strings.Split(value, ",") {
+					rowsRequest.OrderBy = append(rowsRequest.OrderBy, &Order{
+						Column:     strings.TrimPrefix(column, "-"),
+						Descending: strings.HasPrefix(column, "-"),
+					})
+				}
+			}
+		default:
+			filter := &Filter{Column: param}
+			if i := strings.LastIndex(param, "."); i >= 0 {
+				op, ok := Filter_Operator_value[strings.ToUpper(param[i+1:])]
+				if !ok {
+					return nil, status.Errorf(codes.InvalidArgument, "unknown filter operator in %s", param)
+				}
+				filter.Column = param[:i]
+				filter.Op = Filter_Operator(op)
+			}
+			for _, value := range values {
+				rowsRequest.Filters = append(rowsRequest.Filters, &Filter{
+					Column: filter.Column,
+					Op:     filter.Op,
+					Value:  value,
+				})
+			}
+		}
+	}
+	return rowsRequest, nil
+}
+
+func parseUints(req *http.Request, params map[string]*uint64) error {
+	for param, n := range params {
+		value := req.URL.Query().Get(param)
+		if value == "" {
+			continue
+		}
+		var err error
+		*n, err = strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return status.Errorf(codes.InvalidArgument, "could not parse %s: %v", param, err)
+		}
+	}
+	return nil
+}
+
+func writeJSON(resp http.ResponseWriter, value interface{}, err error) {
+	if err != nil {
+		code := http.StatusInternalServerError
+		switch status.Code(err) {
+		case codes.InvalidArgument:
+			code = http.StatusBadRequest
+		case codes.NotFound:
+			code = http.StatusNotFound
+		}
+		http.Error(resp, status.Convert(err).Message(), code)
+		return
+	}
+	bs, err := json.Marshal(value)
+	if err != nil {
+		http.Error(resp, fmt.Sprintf("could not marshal response: %v", err), http.StatusInternalServerError)
+		return
+	}
+	resp.Header().Set("Content-Type", "application/json")
+	resp.Write(bs)
+}
@@ -0,0 +1,27 @@
+package ventquery
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRowsRequest(t *testing.T) {
+	req := httptest.NewRequest("GET", "/tables/balances?holder=alice&balance.ge=10&_order=-balance,holder&_limit=5", nil)
+	rowsRequest, err := parseRowsRequest("balances", req)
+	require.NoError(t, err)
+	assert.Equal(t, "balances", rowsRequest.Table)
+	assert.Equal(t, uint64(5), rowsRequest.Limit)
+	assert.ElementsMatch(t, []*Filter{
+		{Column: "holder", Op: Filter_EQ, Value: "alice"},
+		{Column: "balance", Op: Filter_GE, Value: "10"},
+	}, rowsRequest.Filters)
+	assert.Equal(t, []*Order{{Column: "balance", Descending: true}, {Column: "holder"}}, rowsRequest.OrderBy)
+
+	_, err = parseRowsRequest("balances", httptest.NewRequest("GET", "/tables/balances?balance.near=10", nil))
+	assert.Error(t, err)
+	_, err = parseRowsRequest("balances", httptest.NewRequest("GET", "/tables/balances?_offset=-1", nil))
+	assert.Error(t, err)
+}
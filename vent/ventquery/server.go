@@ -0,0 +1,187 @@
+package ventquery
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/sqldb"
+	"github.com/hyperledger/burrow/vent/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultLimit is the number of rows returned when no limit is requested
+	DefaultLimit = 100
+	// MaxLimit is the most rows returned whatever limit is requested
+	MaxLimit = 1000
+	// How often a stream of changes that has caught up with the log looks for new changes
+	ChangesPollInterval = time.Second
+)
+
+var operators = map[Filter_Operator]string{
+	Filter_EQ: "=",
+	Filter_NE: "!=",
+	Filter_LT: "<",
+	Filter_LE: "<=",
+	Filter_GT: ">",
+	Filter_GE: ">=",
+}
+
+// Server serves read-only queries of the tables projected into an SQL database
+type Server struct {
+	db     *sqldb.SQLDB
+	tables types.EventTables
+	logger *logging.Logger
+}
+
+var _ QueryServer = &Server{}
+
+// NewServer returns a Server for the projected tables in db, which it does not close
+func NewServer(db *sqldb.SQLDB, tables types.EventTables, logger *logging.Logger) *Server {
+	return &Server{
+		db:     db,
+		tables: tables,
+		logger: logger.WithScope("ventquery"),
+	}
+}
+
+func (s *Server) Tables(ctx context.Context, req *TablesRequest) (*TablesResponse, error) {
+	names := make([]string, 0, len(s.tables))
+	for name := range s.tables {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	resp := &TablesResponse{}
+	for _, name := range names {
+		table := &Table{Name: name}
+		for _, column := range s.tables[name].Columns {
+			table.Columns = append(table.Columns, &Column{
+				Name:    column.Name,
+				Type:    column.Type.String(),
+				Primary: column.Primary,
+			})
+		}
+		resp.Tables = append(resp.Tables, table)
+	}
+	return resp, nil
+}
+
+func (s *Server) Rows(ctx context.Context, req *RowsRequest) (*RowsResponse, error) {
+	table, err := s.getTable(req.Table)
+	if err != nil {
+		return nil, err
+	}
+
+	filters := make([]sqldb.Filter, len(req.Filters))
+	for i, filter := range req.Filters {
+		if table.GetColumn(filter.Column) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no column named %s in table %s", filter.Column,
+				table.Name)
+		}
+		operator, ok := operators[filter.Op]
+		if !ok {
+			return nil, status.Errorf(codes.InvalidArgument, "unknown filter operator %v", filter.Op)
+		}
+		filters[i] = sqldb.Filter{Column: filter.Column, Operator: operator, Value: filter.Value}
+	}
+
+	orderBy := make([]sqldb.Order, len(req.OrderBy))
+	for i, order := range req.OrderBy {
+		if table.GetColumn(order.Column) == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "no column named %s in table %s", order.Column,
+				table.Name)
+		}
+		orderBy[i] = sqldb.Order{Column: order.Column, Descending: order.Descending}
+	}
+
+	rows, err := s.db.SelectRows(table, filters, orderBy, clampLimit(req.Limit), req.Offset)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "could not select rows: %v", err)
+	}
+	resp := &RowsResponse{Rows: make([]*Row, len(rows))}
+	for i, row := range rows {
+		resp.Rows[i] = &Row{Values: row}
+	}
+	return resp, nil
+}
+
+func (s *Server) Changes(req *ChangesRequest, stream Query_ChangesServer) error {
+	ctx := stream.Context()
+	after := req.After
+	for {
+		changes, last, err := s.ChangesPage(req.Since, after, req.ChainID, req.Table, DefaultLimit)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			err = stream.Send(change)
+			if err != nil {
+				return err
+			}
+		}
+		if last == after {
+			// Caught up so wait for more changes
+			select {
+			case <-ctx.Done():
+				return nil
+			case <-time.After(ChangesPollInterval):
+			}
+		}
+		after = last
+	}
+}
+
+// ChangesPage returns the changes to the projected tables at heights above since logged after the change with id
+// after, restricted to those of chainID and table unless empty, looking through at most limit entries of the log. It
+// also returns the id of the last entry looked through (or after if none) from which to carry on.
+func (s *Server) ChangesPage(since, after uint64, chainID, table string, limit uint64) ([]*Change, uint64, error) {
+	if table != "" {
+		if _, err := s.getTable(table); err != nil {
+			return nil, after, err
+		}
+	}
+	logged, err := s.db.SelectChanges(after, since, chainID, table, clampLimit(limit))
+	if err != nil {
+		return nil, after, status.Errorf(codes.Internal, "could not select changes: %v", err)
+	}
+	var changes []*Change
+	for _, change := range logged {
+		after = change.ID
+		// The log also holds the changes of tables no longer projected, or being backfilled
+		if _, ok := s.tables[change.TableName]; !ok {
+			continue
+		}
+		changes = append(changes, &Change{
+			Id:      change.ID,
+			ChainID: change.ChainID,
+			Table:   change.TableName,
+			Height:  change.Height,
+			TxHash:  change.TxHash,
+			Action:  string(change.Action),
+			Values:  change.RowData,
+		})
+	}
+	return changes, after, nil
+}
+
+func (s *Server) getTable(name string) (*types.SQLTable, error) {
+	table, ok := s.tables[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no projected table named %s", name)
+	}
+	return table, nil
+}
+
+func clampLimit(limit uint64) uint64 {
+	if limit == 0 {
+		return DefaultLimit
+	}
+	if limit > MaxLimit {
+		return MaxLimit
+	}
+	return limit
+}
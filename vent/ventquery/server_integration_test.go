@@ -0,0 +1,186 @@
+// +build integration
+
+package ventquery_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hyperledger/burrow/logging"
+	"github.com/hyperledger/burrow/vent/config"
+	"github.com/hyperledger/burrow/vent/test"
+	"github.com/hyperledger/burrow/vent/types"
+	"github.com/hyperledger/burrow/vent/ventquery"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func testQuery(t *testing.T, cfg *config.VentConfig) {
+	db, closeDB := test.NewTestDB(t, cfg)
+	defer closeDB()
+
+	tables := types.EventTables{
+		"test_balances": &types.SQLTable{
+			Name: "test_balances",
+			Columns: []*types.SQLTableColumn{
+				{Name: "holder", Type: types.SQLColumnTypeVarchar, Length: 100, Primary: true},
+				{Name: "balance", Type: types.SQLColumnTypeInt},
+				{Name: "memo", Type: types.SQLColumnTypeVarchar, Length: 100},
+			},
+		},
+	}
+	balance := func(holder string, amount int) types.EventDataRow {
+		return types.EventDataRow{Action: types.ActionUpsert, RowData: map[string]interface{}{
+			"holder": holder, "balance": amount,
+		}}
+	}
+	err := db.SetBlock(test.ChainID, tables, types.EventData{
+		BlockHeight: 1,
+		Tables: map[string]types.EventDataTable{
+			"test_balances": {balance("alice", 10), balance("bob", 5), balance("carol", 7)},
+		},
+	})
+	require.NoError(t, err)
+	err = db.SetBlock(test.ChainID, tables, types.EventData{
+		BlockHeight: 2,
+		Tables: map[string]types.EventDataTable{
+			"test_balances": {
+				balance("alice", 20),
+				{Action: types.ActionDelete, RowData: map[string]interface{}{"holder": "bob"}},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	server := ventquery.NewServer(db, tables, logging.NewNoopLogger())
+	ctx := context.Background()
+
+	t.Run("Tables", func(t *testing.T) {
+		resp, err := server.Tables(ctx, &ventquery.TablesRequest{})
+		require.NoError(t, err)
+		require.Len(t, resp.Tables, 1)
+		assert.Equal(t, "test_balances", resp.Tables[0].Name)
+		assert.Equal(t, &ventquery.Column{Name: "holder", Type: "varchar", Primary: true}, resp.Tables[0].Columns[0])
+	})
+
+	t.Run("Rows", func(t *testing.T) {
+		resp, err := server.Rows(ctx, &ventquery.RowsRequest{
+			Table:   "test_balances",
+			Filters: []*ventquery.Filter{{Column: "balance", Op: ventquery.Filter_GT, Value: "6"}},
+			OrderBy: []*ventquery.Order{{Column: "balance", Descending: true}},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"alice", "carol"}, holders(resp))
+
+		// Pages are ordered by primary key by default
+		resp, err = server.Rows(ctx, &ventquery.RowsRequest{Table: "test_balances", Limit: 1, Offset: 1})
+		require.NoError(t, err)
+		assert.Equal(t, []string{"carol"}, holders(resp))
+		assert.Equal(t, map[string]string{"holder": "carol", "balance": "7"}, resp.Rows[0].Values)
+
+		_, err = server.Rows(ctx, &ventquery.RowsRequest{Table: "test_balances",
+			Filters: []*ventquery.Filter{{Column: "nope", Value: "1"}}})
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		_, err = server.Rows(ctx, &ventquery.RowsRequest{Table: "nope"})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("ChangesPage", func(t *testing.T) {
+		changes, after, err := server.ChangesPage(1, 0, "", "", 0)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, uint64(2), changes[0].Height)
+		assert.Equal(t, string(types.ActionUpsert), changes[0].Action)
+		assert.Equal(t, map[string]string{"holder": "alice", "balance": "20"}, changes[0].Values)
+		assert.Equal(t, string(types.ActionDelete), changes[1].Action)
+		assert.Equal(t, changes[1].Id, after)
+
+		changes, last, err := server.ChangesPage(0, after, "", "", 0)
+		require.NoError(t, err)
+		assert.Empty(t, changes)
+		assert.Equal(t, after, last)
+
+		changes, _, err = server.ChangesPage(0, 0, test.ChainID, "test_balances", 2)
+		require.NoError(t, err)
+		require.Len(t, changes, 2)
+		assert.Equal(t, uint64(1), changes[0].Height)
+	})
+
+	t.Run("HTTP", func(t *testing.T) {
+		httpServer := httptest.NewServer(server.HTTPHandler())
+		defer httpServer.Close()
+
+		rows := new(ventquery.RowsResponse)
+		getJSON(t, httpServer.URL+"/tables/test_balances?balance.gt=6&_order=-balance", http.StatusOK, rows)
+		assert.Equal(t, []string{"alice", "carol"}, holders(rows))
+
+		page := new(ventquery.ChangesPage)
+		getJSON(t, httpServer.URL+"/changes?since=1", http.StatusOK, page)
+		require.Len(t, page.Changes, 2)
+		assert.Equal(t, page.Changes[1].Id, page.After)
+
+		getJSON(t, httpServer.URL+"/tables/test_balances?balance.between=6", http.StatusBadRequest, nil)
+		getJSON(t, httpServer.URL+"/tables/nope", http.StatusNotFound, nil)
+	})
+
+	t.Run("GRPC", func(t *testing.T) {
+		listener, err := net.Listen("tcp", "127.0.0.1:0")
+		require.NoError(t, err)
+		grpcServer := grpc.NewServer()
+		ventquery.RegisterQueryServer(grpcServer, server)
+		go grpcServer.Serve(listener)
+		defer grpcServer.Stop()
+
+		conn, err := grpc.Dial(listener.Addr().String(), grpc.WithInsecure())
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		stream, err := ventquery.NewQueryClient(conn).Changes(ctx, &ventquery.ChangesRequest{Since: 1})
+		require.NoError(t, err)
+		for _, holder := range []string{"alice", "bob"} {
+			change, err := stream.Recv()
+			require.NoError(t, err)
+			assert.Equal(t, holder, change.Values["holder"])
+		}
+
+		// The stream follows changes as they are logged
+		err = db.SetBlock(test.ChainID, tables, types.EventData{
+			BlockHeight: 3,
+			Tables:      map[string]types.EventDataTable{"test_balances": {balance("dave", 1)}},
+		})
+		require.NoError(t, err)
+		change, err := stream.Recv()
+		require.NoError(t, err)
+		assert.Equal(t, uint64(3), change.Height)
+		assert.Equal(t, "dave", change.Values["holder"])
+	})
+}
+
+func holders(resp *ventquery.RowsResponse) []string {
+	holders := make([]string, len(resp.Rows))
+	for i, row := range resp.Rows {
+		holders[i] = row.Values["holder"]
+	}
+	return holders
+}
+
+func getJSON(t *testing.T, url string, statusCode int, value interface{}) {
+	resp, err := http.Get(url)
+	require.NoError(t, err)
+	defer resp.Body.Close()
+	require.Equal(t, statusCode, resp.StatusCode, fmt.Sprintf("GET %s", url))
+	if value != nil {
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(value))
+	}
+}
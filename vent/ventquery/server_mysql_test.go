@@ -0,0 +1,13 @@
+// +build integration,mysql
+
+package ventquery_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/test"
+)
+
+func TestMySQLQuery(t *testing.T) {
+	testQuery(t, test.MySQLVentConfig(""))
+}
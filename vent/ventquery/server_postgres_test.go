@@ -0,0 +1,13 @@
+// +build integration
+
+package ventquery_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/test"
+)
+
+func TestPostgresQuery(t *testing.T) {
+	testQuery(t, test.PostgresVentConfig(""))
+}
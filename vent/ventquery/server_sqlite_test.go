@@ -0,0 +1,13 @@
+// +build integration sqlite
+
+package ventquery_test
+
+import (
+	"testing"
+
+	"github.com/hyperledger/burrow/vent/test"
+)
+
+func TestSqliteQuery(t *testing.T) {
+	testQuery(t, test.SqliteVentConfig(""))
+}